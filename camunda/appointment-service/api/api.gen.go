@@ -50,6 +50,13 @@ const (
 	Vaccination     AppointmentType = "vaccination"
 )
 
+// Defines values for ResourceType.
+const (
+	ResourceTypeEquipment ResourceType = "equipment"
+	ResourceTypeFacility  ResourceType = "facility"
+	ResourceTypeMedicine  ResourceType = "medicine"
+)
+
 // Defines values for SpecializationEnum.
 const (
 	Cardiologist        SpecializationEnum = "cardiologist"
@@ -105,11 +112,19 @@ type Appointment struct {
 	Prescriptions *[]PrescriptionDisplay `json:"prescriptions,omitempty"`
 	Reason        *string                `json:"reason,omitempty"`
 
+	// ReservationConflicts Set only when rescheduling with `keepReservations`. Resources which were taken
+	// in the new time slot, because of which the reservations were released and the
+	// appointment awaits a new decision.
+	ReservationConflicts *[]ReservationConflict `json:"reservationConflicts,omitempty"`
+
 	// Status The current status of the appointment.
 	Status AppointmentStatus `json:"status"`
 
 	// Type The type of the appointment.
 	Type AppointmentType `json:"type"`
+
+	// Version Version of the appointment, sent back as its ETag.
+	Version int64 `json:"version"`
 }
 
 // AppointmentCancellation Data required to cancel an appointment.
@@ -152,8 +167,40 @@ type AppointmentDisplay struct {
 	Type AppointmentType `json:"type"`
 }
 
+// AppointmentRecord defines model for AppointmentRecord.
+type AppointmentRecord struct {
+	AppointmentDateTime time.Time           `json:"appointmentDateTime"`
+	CancellationReason  *string             `json:"cancellationReason,omitempty"`
+	CancelledBy         *UserRole           `json:"cancelledBy,omitempty"`
+	ConditionId         *openapi_types.UUID `json:"conditionId,omitempty"`
+	DenialReason        *string             `json:"denialReason,omitempty"`
+	DoctorId            openapi_types.UUID  `json:"doctorId"`
+	EndTime             time.Time           `json:"endTime"`
+	Equipment           *[]Equipment        `json:"equipment,omitempty"`
+	Facilities          *[]Facility         `json:"facilities,omitempty"`
+	Id                  openapi_types.UUID  `json:"id"`
+	Medicine            *[]Medicine         `json:"medicine,omitempty"`
+	PatientId           openapi_types.UUID  `json:"patientId"`
+	Reason              *string             `json:"reason,omitempty"`
+
+	// Status The current status of the appointment.
+	Status AppointmentStatus `json:"status"`
+
+	// Type The type of the appointment.
+	Type AppointmentType `json:"type"`
+}
+
+// AppointmentRecordsExport defines model for AppointmentRecordsExport.
+type AppointmentRecordsExport struct {
+	Appointments []AppointmentRecord `json:"appointments"`
+}
+
 // AppointmentReschedule Data required for a patient to reschedule their appointment.
 type AppointmentReschedule struct {
+	// KeepReservations Move the reserved resources to the new time and keep the appointment's status
+	// if all of them are still free. Otherwise the reservations are released, the
+	// appointment awaits a new decision and the taken resources are reported.
+	KeepReservations       *bool     `json:"keepReservations,omitempty"`
 	NewAppointmentDateTime time.Time `json:"newAppointmentDateTime"`
 
 	// Reason Optional reason for rescheduling provided by the patient.
@@ -183,6 +230,13 @@ type Appointments struct {
 	Appointments *[]AppointmentDisplay `json:"appointments,omitempty"`
 }
 
+// CalendarFeed Secret iCalendar feed of a user's appointments. Anyone who knows the URL
+// can read the feed, it is shown only once when issued.
+type CalendarFeed struct {
+	// Url URL to subscribe to in a calendar application.
+	Url string `json:"url"`
+}
+
 // ConditionDisplay Basic info about a patient's condition.
 type ConditionDisplay struct {
 	AppointmentsIds *[]openapi_types.UUID `json:"appointmentsIds,omitempty"`
@@ -204,6 +258,17 @@ type Doctor struct {
 	Specialization SpecializationEnum `json:"specialization"`
 }
 
+// DoctorBusyIntervals defines model for DoctorBusyIntervals.
+type DoctorBusyIntervals struct {
+	Busy     []Interval         `json:"busy"`
+	DoctorId openapi_types.UUID `json:"doctorId"`
+}
+
+// DoctorsBusyIntervals defines model for DoctorsBusyIntervals.
+type DoctorsBusyIntervals struct {
+	Doctors []DoctorBusyIntervals `json:"doctors"`
+}
+
 // Equipment Represents a required equipment resource.
 type Equipment struct {
 	// Id Unique identifier for the equipment.
@@ -222,6 +287,12 @@ type Facility struct {
 	Name string `json:"name"`
 }
 
+// Interval Half-open time interval [start, end).
+type Interval struct {
+	End   time.Time `json:"end"`
+	Start time.Time `json:"start"`
+}
+
 // Medicine Represents a required medicine resource.
 type Medicine struct {
 	// Id Unique identifier for the medicine.
@@ -263,6 +334,16 @@ type PrescriptionDisplay struct {
 	Start         time.Time           `json:"start"`
 }
 
+// ReservationConflict Resource reserved by another appointment in the requested time slot.
+type ReservationConflict struct {
+	Id   openapi_types.UUID `json:"id"`
+	Name string             `json:"name"`
+	Type ResourceType       `json:"type"`
+}
+
+// ResourceType defines model for ResourceType.
+type ResourceType string
+
 // SpecializationEnum Medical specialization of a doctor.
 type SpecializationEnum string
 
@@ -293,6 +374,9 @@ type Date = openapi_types.Date
 // DoctorId defines model for doctorId.
 type DoctorId = openapi_types.UUID
 
+// DoctorIds defines model for doctorIds.
+type DoctorIds = []openapi_types.UUID
+
 // From defines model for from.
 type From = openapi_types.Date
 
@@ -302,6 +386,12 @@ type PatientId = openapi_types.UUID
 // To defines model for to.
 type To = openapi_types.Date
 
+// WindowFrom defines model for windowFrom.
+type WindowFrom = time.Time
+
+// WindowTo defines model for windowTo.
+type WindowTo = time.Time
+
 // DoctorTimeslots defines model for DoctorTimeslots.
 type DoctorTimeslots struct {
 	Slots []TimeSlot `json:"slots"`
@@ -316,6 +406,18 @@ type DoctorsCalendarParams struct {
 	To *To `form:"to,omitempty" json:"to,omitempty"`
 }
 
+// DoctorsBusyIntervalsParams defines parameters for DoctorsBusyIntervals.
+type DoctorsBusyIntervalsParams struct {
+	// DoctorIds Doctors whose busy intervals to retrieve.
+	DoctorIds DoctorIds `form:"doctorIds" json:"doctorIds"`
+
+	// From Start of the window.
+	From WindowFrom `form:"from" json:"from"`
+
+	// To End of the window.
+	To WindowTo `form:"to" json:"to"`
+}
+
 // PatientsCalendarParams defines parameters for PatientsCalendar.
 type PatientsCalendarParams struct {
 	// From The specific day form which to retrieve resources.
@@ -325,6 +427,45 @@ type PatientsCalendarParams struct {
 	To *To `form:"to,omitempty" json:"to,omitempty"`
 }
 
+// RescheduleAppointmentParams defines parameters for RescheduleAppointment.
+type RescheduleAppointmentParams struct {
+	// IfMatch ETags of the resource the update is based on, separated by commas. The
+	// update is applied only if the resource is at one of them, `*` or no
+	// header at all applies it at any version. ETags are compared strongly, a
+	// weak ETag never matches.
+	IfMatch *externalRef0.IfMatch `json:"If-Match,omitempty"`
+}
+
+// DecideAppointmentParams defines parameters for DecideAppointment.
+type DecideAppointmentParams struct {
+	// IfMatch ETags of the resource the update is based on, separated by commas. The
+	// update is applied only if the resource is at one of them, `*` or no
+	// header at all applies it at any version. ETags are compared strongly, a
+	// weak ETag never matches.
+	IfMatch *externalRef0.IfMatch `json:"If-Match,omitempty"`
+}
+
+// AuditEventsParams defines parameters for AuditEvents.
+type AuditEventsParams struct {
+	// TargetId Only events performed on the entity with this ID.
+	TargetId *externalRef0.AuditTargetId `form:"targetId,omitempty" json:"targetId,omitempty"`
+
+	// Actor Only events performed by this actor.
+	Actor *externalRef0.AuditActor `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// From Only events created at or after this instant.
+	From *externalRef0.AuditFrom `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only events created at or before this instant.
+	To *externalRef0.AuditTo `form:"to,omitempty" json:"to,omitempty"`
+
+	// AfterSeq Only events after this position in the audit chain.
+	AfterSeq *externalRef0.AuditAfterSeq `form:"afterSeq,omitempty" json:"afterSeq,omitempty"`
+
+	// Limit Maximum number of returned events, 100 if not set.
+	Limit *externalRef0.AuditLimit `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // DoctorsTimeslotsParams defines parameters for DoctorsTimeslots.
 type DoctorsTimeslotsParams struct {
 	// Date The specific day for which to retrieve timeslots (YYYY-MM-DD format).
@@ -357,9 +498,27 @@ type ServerInterface interface {
 	// Get doctors's calendar
 	// (GET /appointments/doctor/{doctorId})
 	DoctorsCalendar(w http.ResponseWriter, r *http.Request, doctorId DoctorId, params DoctorsCalendarParams)
+	// Revoke doctor's calendar feed
+	// (DELETE /appointments/doctor/{doctorId}/feed)
+	RevokeDoctorCalendarFeed(w http.ResponseWriter, r *http.Request, doctorId DoctorId)
+	// Issue doctor's calendar feed
+	// (POST /appointments/doctor/{doctorId}/feed)
+	IssueDoctorCalendarFeed(w http.ResponseWriter, r *http.Request, doctorId DoctorId)
+	// Get doctors' busy intervals
+	// (GET /appointments/doctors/busy)
+	DoctorsBusyIntervals(w http.ResponseWriter, r *http.Request, params DoctorsBusyIntervalsParams)
 	// Get patient's calendar
 	// (GET /appointments/patient/{patientId})
 	PatientsCalendar(w http.ResponseWriter, r *http.Request, patientId PatientId, params PatientsCalendarParams)
+	// Export patient's appointments
+	// (GET /appointments/patient/{patientId}/export)
+	ExportPatientAppointments(w http.ResponseWriter, r *http.Request, patientId PatientId)
+	// Revoke patient's calendar feed
+	// (DELETE /appointments/patient/{patientId}/feed)
+	RevokePatientCalendarFeed(w http.ResponseWriter, r *http.Request, patientId PatientId)
+	// Issue patient's calendar feed
+	// (POST /appointments/patient/{patientId}/feed)
+	IssuePatientCalendarFeed(w http.ResponseWriter, r *http.Request, patientId PatientId)
 	// Cancel an appointment
 	// (DELETE /appointments/{appointmentId})
 	CancelAppointment(w http.ResponseWriter, r *http.Request, appointmentId AppointmentId)
@@ -368,13 +527,22 @@ type ServerInterface interface {
 	AppointmentById(w http.ResponseWriter, r *http.Request, appointmentId AppointmentId)
 	// Reschedule an appointment
 	// (PATCH /appointments/{appointmentId})
-	RescheduleAppointment(w http.ResponseWriter, r *http.Request, appointmentId AppointmentId)
+	RescheduleAppointment(w http.ResponseWriter, r *http.Request, appointmentId AppointmentId, params RescheduleAppointmentParams)
 	// Decide appointment's status
 	// (POST /appointments/{appointmentId})
-	DecideAppointment(w http.ResponseWriter, r *http.Request, appointmentId AppointmentId)
+	DecideAppointment(w http.ResponseWriter, r *http.Request, appointmentId AppointmentId, params DecideAppointmentParams)
+	// Download appointment as iCalendar
+	// (GET /appointments/{appointmentId}/ics)
+	AppointmentIcs(w http.ResponseWriter, r *http.Request, appointmentId AppointmentId)
 	// Add or update resources for an appointment
 	// (PATCH /appointments/{appointmentId}/resources)
 	UpdateAppointmentResources(w http.ResponseWriter, r *http.Request, appointmentId AppointmentId)
+	// Audit log
+	// (GET /audit)
+	AuditEvents(w http.ResponseWriter, r *http.Request, params AuditEventsParams)
+	// Calendar feed
+	// (GET /calendar/{token}.ics)
+	CalendarFeed(w http.ResponseWriter, r *http.Request, token string)
 	// Get doctor's timeslots for a specific date
 	// (GET /timeslots/{doctorId})
 	DoctorsTimeslots(w http.ResponseWriter, r *http.Request, doctorId DoctorId, params DoctorsTimeslotsParams)
@@ -402,12 +570,48 @@ func (_ Unimplemented) DoctorsCalendar(w http.ResponseWriter, r *http.Request, d
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Revoke doctor's calendar feed
+// (DELETE /appointments/doctor/{doctorId}/feed)
+func (_ Unimplemented) RevokeDoctorCalendarFeed(w http.ResponseWriter, r *http.Request, doctorId DoctorId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Issue doctor's calendar feed
+// (POST /appointments/doctor/{doctorId}/feed)
+func (_ Unimplemented) IssueDoctorCalendarFeed(w http.ResponseWriter, r *http.Request, doctorId DoctorId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get doctors' busy intervals
+// (GET /appointments/doctors/busy)
+func (_ Unimplemented) DoctorsBusyIntervals(w http.ResponseWriter, r *http.Request, params DoctorsBusyIntervalsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get patient's calendar
 // (GET /appointments/patient/{patientId})
 func (_ Unimplemented) PatientsCalendar(w http.ResponseWriter, r *http.Request, patientId PatientId, params PatientsCalendarParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Export patient's appointments
+// (GET /appointments/patient/{patientId}/export)
+func (_ Unimplemented) ExportPatientAppointments(w http.ResponseWriter, r *http.Request, patientId PatientId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revoke patient's calendar feed
+// (DELETE /appointments/patient/{patientId}/feed)
+func (_ Unimplemented) RevokePatientCalendarFeed(w http.ResponseWriter, r *http.Request, patientId PatientId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Issue patient's calendar feed
+// (POST /appointments/patient/{patientId}/feed)
+func (_ Unimplemented) IssuePatientCalendarFeed(w http.ResponseWriter, r *http.Request, patientId PatientId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Cancel an appointment
 // (DELETE /appointments/{appointmentId})
 func (_ Unimplemented) CancelAppointment(w http.ResponseWriter, r *http.Request, appointmentId AppointmentId) {
@@ -422,13 +626,19 @@ func (_ Unimplemented) AppointmentById(w http.ResponseWriter, r *http.Request, a
 
 // Reschedule an appointment
 // (PATCH /appointments/{appointmentId})
-func (_ Unimplemented) RescheduleAppointment(w http.ResponseWriter, r *http.Request, appointmentId AppointmentId) {
+func (_ Unimplemented) RescheduleAppointment(w http.ResponseWriter, r *http.Request, appointmentId AppointmentId, params RescheduleAppointmentParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
 // Decide appointment's status
 // (POST /appointments/{appointmentId})
-func (_ Unimplemented) DecideAppointment(w http.ResponseWriter, r *http.Request, appointmentId AppointmentId) {
+func (_ Unimplemented) DecideAppointment(w http.ResponseWriter, r *http.Request, appointmentId AppointmentId, params DecideAppointmentParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Download appointment as iCalendar
+// (GET /appointments/{appointmentId}/ics)
+func (_ Unimplemented) AppointmentIcs(w http.ResponseWriter, r *http.Request, appointmentId AppointmentId) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -438,6 +648,18 @@ func (_ Unimplemented) UpdateAppointmentResources(w http.ResponseWriter, r *http
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Audit log
+// (GET /audit)
+func (_ Unimplemented) AuditEvents(w http.ResponseWriter, r *http.Request, params AuditEventsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Calendar feed
+// (GET /calendar/{token}.ics)
+func (_ Unimplemented) CalendarFeed(w http.ResponseWriter, r *http.Request, token string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get doctor's timeslots for a specific date
 // (GET /timeslots/{doctorId})
 func (_ Unimplemented) DoctorsTimeslots(w http.ResponseWriter, r *http.Request, doctorId DoctorId, params DoctorsTimeslotsParams) {
@@ -460,8 +682,8 @@ func (siw *ServerInterfaceWrapper) RequestAppointment(w http.ResponseWriter, r *
 		siw.Handler.RequestAppointment(w, r)
 	}))
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
 	handler.ServeHTTP(w, r)
@@ -485,8 +707,8 @@ func (siw *ServerInterfaceWrapper) AppointmentsByConditionId(w http.ResponseWrit
 		siw.Handler.AppointmentsByConditionId(w, r, conditionId)
 	}))
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
 	handler.ServeHTTP(w, r)
@@ -536,8 +758,122 @@ func (siw *ServerInterfaceWrapper) DoctorsCalendar(w http.ResponseWriter, r *htt
 		siw.Handler.DoctorsCalendar(w, r, doctorId, params)
 	}))
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RevokeDoctorCalendarFeed operation middleware
+func (siw *ServerInterfaceWrapper) RevokeDoctorCalendarFeed(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "doctorId" -------------
+	var doctorId DoctorId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "doctorId", chi.URLParam(r, "doctorId"), &doctorId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "doctorId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeDoctorCalendarFeed(w, r, doctorId)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// IssueDoctorCalendarFeed operation middleware
+func (siw *ServerInterfaceWrapper) IssueDoctorCalendarFeed(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "doctorId" -------------
+	var doctorId DoctorId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "doctorId", chi.URLParam(r, "doctorId"), &doctorId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "doctorId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.IssueDoctorCalendarFeed(w, r, doctorId)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DoctorsBusyIntervals operation middleware
+func (siw *ServerInterfaceWrapper) DoctorsBusyIntervals(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DoctorsBusyIntervalsParams
+
+	// ------------- Required query parameter "doctorIds" -------------
+
+	if paramValue := r.URL.Query().Get("doctorIds"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "doctorIds"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "doctorIds", r.URL.Query(), &params.DoctorIds)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "doctorIds", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := r.URL.Query().Get("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	if paramValue := r.URL.Query().Get("to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DoctorsBusyIntervals(w, r, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
 	handler.ServeHTTP(w, r)
@@ -587,8 +923,83 @@ func (siw *ServerInterfaceWrapper) PatientsCalendar(w http.ResponseWriter, r *ht
 		siw.Handler.PatientsCalendar(w, r, patientId, params)
 	}))
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportPatientAppointments operation middleware
+func (siw *ServerInterfaceWrapper) ExportPatientAppointments(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "patientId" -------------
+	var patientId PatientId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "patientId", chi.URLParam(r, "patientId"), &patientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "patientId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportPatientAppointments(w, r, patientId)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RevokePatientCalendarFeed operation middleware
+func (siw *ServerInterfaceWrapper) RevokePatientCalendarFeed(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "patientId" -------------
+	var patientId PatientId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "patientId", chi.URLParam(r, "patientId"), &patientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "patientId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokePatientCalendarFeed(w, r, patientId)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// IssuePatientCalendarFeed operation middleware
+func (siw *ServerInterfaceWrapper) IssuePatientCalendarFeed(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "patientId" -------------
+	var patientId PatientId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "patientId", chi.URLParam(r, "patientId"), &patientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "patientId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.IssuePatientCalendarFeed(w, r, patientId)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
 	handler.ServeHTTP(w, r)
@@ -612,8 +1023,8 @@ func (siw *ServerInterfaceWrapper) CancelAppointment(w http.ResponseWriter, r *h
 		siw.Handler.CancelAppointment(w, r, appointmentId)
 	}))
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
 	handler.ServeHTTP(w, r)
@@ -637,8 +1048,8 @@ func (siw *ServerInterfaceWrapper) AppointmentById(w http.ResponseWriter, r *htt
 		siw.Handler.AppointmentById(w, r, appointmentId)
 	}))
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
 	handler.ServeHTTP(w, r)
@@ -658,12 +1069,36 @@ func (siw *ServerInterfaceWrapper) RescheduleAppointment(w http.ResponseWriter,
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RescheduleAppointmentParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch externalRef0.IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RescheduleAppointment(w, r, appointmentId)
+		siw.Handler.RescheduleAppointment(w, r, appointmentId, params)
 	}))
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
 	handler.ServeHTTP(w, r)
@@ -683,12 +1118,61 @@ func (siw *ServerInterfaceWrapper) DecideAppointment(w http.ResponseWriter, r *h
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DecideAppointmentParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch externalRef0.IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DecideAppointment(w, r, appointmentId, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AppointmentIcs operation middleware
+func (siw *ServerInterfaceWrapper) AppointmentIcs(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "appointmentId" -------------
+	var appointmentId AppointmentId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appointmentId", chi.URLParam(r, "appointmentId"), &appointmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "appointmentId", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DecideAppointment(w, r, appointmentId)
+		siw.Handler.AppointmentIcs(w, r, appointmentId)
 	}))
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
 	handler.ServeHTTP(w, r)
@@ -712,8 +1196,100 @@ func (siw *ServerInterfaceWrapper) UpdateAppointmentResources(w http.ResponseWri
 		siw.Handler.UpdateAppointmentResources(w, r, appointmentId)
 	}))
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AuditEvents operation middleware
+func (siw *ServerInterfaceWrapper) AuditEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AuditEventsParams
+
+	// ------------- Optional query parameter "targetId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "targetId", r.URL.Query(), &params.TargetId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "targetId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "actor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor", r.URL.Query(), &params.Actor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "afterSeq" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "afterSeq", r.URL.Query(), &params.AfterSeq)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "afterSeq", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AuditEvents(w, r, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CalendarFeed operation middleware
+func (siw *ServerInterfaceWrapper) CalendarFeed(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token", chi.URLParam(r, "token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CalendarFeed(w, r, token)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
 	handler.ServeHTTP(w, r)
@@ -755,8 +1331,8 @@ func (siw *ServerInterfaceWrapper) DoctorsTimeslots(w http.ResponseWriter, r *ht
 		siw.Handler.DoctorsTimeslots(w, r, doctorId, params)
 	}))
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
 	handler.ServeHTTP(w, r)
@@ -884,9 +1460,27 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/appointments/doctor/{doctorId}", wrapper.DoctorsCalendar)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/appointments/doctor/{doctorId}/feed", wrapper.RevokeDoctorCalendarFeed)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/appointments/doctor/{doctorId}/feed", wrapper.IssueDoctorCalendarFeed)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/appointments/doctors/busy", wrapper.DoctorsBusyIntervals)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/appointments/patient/{patientId}", wrapper.PatientsCalendar)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/appointments/patient/{patientId}/export", wrapper.ExportPatientAppointments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/appointments/patient/{patientId}/feed", wrapper.RevokePatientCalendarFeed)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/appointments/patient/{patientId}/feed", wrapper.IssuePatientCalendarFeed)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/appointments/{appointmentId}", wrapper.CancelAppointment)
 	})
@@ -899,9 +1493,18 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/appointments/{appointmentId}", wrapper.DecideAppointment)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/appointments/{appointmentId}/ics", wrapper.AppointmentIcs)
+	})
 	r.Group(func(r chi.Router) {
 		r.Patch(options.BaseURL+"/appointments/{appointmentId}/resources", wrapper.UpdateAppointmentResources)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/audit", wrapper.AuditEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/calendar/{token}.ics", wrapper.CalendarFeed)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/timeslots/{doctorId}", wrapper.DoctorsTimeslots)
 	})
@@ -912,62 +1515,110 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xbeXPbNhb/KhhuZ5LMihZ9xYn2L8dKWs+s24ydTJtNvR4IfJLQQAADgHZVr7/7DgAe",
-	"oAhKVOzUaf+zLBzv+L0TT7cREYtMcOBaRaPbKMMSL0CDtJ9wlgnK9QK4Pk3NP1JQRNJMU8GjUfRuDijn",
-	"9HMOiKbANZ1SkOjp+/en42dITJGeA/KO2IkGEfyOFxmDaBSlB3A4fY6P4skL8jJOdvf244PD50fxi5cJ",
-	"npAUprt7+9EgouaiDOt5NIg4XpidTaoGkYTPOZWQRiMtcxhEisxhgQ25UyEXWEejKM+pWamXmTlAaUn5",
-	"LLq7G0RE8JQadr6Uv+qAJnckSXf1bsLj3bRmDE9iw5jh1fwnzJ1P0f14S7GGMFMqA0KnlKAUL9FUSHQz",
-	"p2SOtEAStKRwDUjTBSgmtEJPP3z48CE+O4vHY+QufdbkdS/ZO4iTo3j3sOTocw5yWbNkCenFS7EywIsg",
-	"WsgvVZLb3aR6skf2DQZjC8IXL5Pd2Kglfn5UIzCsoYqW+6lnKsWin3oWAf1IUCKXBNSWurC33k8XGdb0",
-	"Hh6h2P5Q2qipuZ86tOihDC0eUBVaRNsI/s7wpzLBFVjnPLYwfFfaqfkXEVwD14XrZpRgw8jwN2W4ufXu",
-	"yqTIQGrqTqr2Uw0L+8d3EqbRKPrHsI4OQ7dbDc2NF0zo6K6iEUuJl5GjsNTAx+LYy2qVmPwGRDtOmnK+",
-	"yAkBpaY5Y8tKrqlFC6NKW+TQBSB74k5k/fZiIfiVAnkN8gpn9Mr9JxYZcPPxlGuQHLMLu+K1lEKeF9Jb",
-	"I6lMigmDxT9LiVXaNDtSwwQtzt1xV++AOTkyDGlMWTSKjjnK+ScubjhyS5BdggQhuTSSGURKY52raHSY",
-	"JINIU23hUhLc2GVYrbW2TisbBWJFMHZUBlRwzFfo3EEX4KHf0YyMFGzEcPwaZVQkWuQc18G5bU8ngmtM",
-	"uUKUO7RTwRGeiFwjzFdThSZGvS/HWIMBYctoYgOStuUMIoI5AQbpq+UmMb5XIM8Fg3oXs1SeAy4g0T68",
-	"DNibzj4pF46pypgxGKMETjFbc7oLNpuOdr7ArDf2l4Wl/+/ClEobRdVaq9FAttbLH7yubmw5hEE0xYQy",
-	"WipxAz314nsR9MYdswzRQ9Me0WAQLSClhHLoQXS59F4kn5X3BUguAtymI94Wy8wOWZPc36+/9XZ5CF0l",
-	"R3ZjtfRr6+/xPMSF21Dd0nvjO7N8Nd5YVYb8RHF8RWAt08q+2lFq4LuyE88VtDExxhrXgNACOc+x0adN",
-	"tnJHteCbt/9k/8AMuQUok+KapsacCkD6fqyZorwBYJTP0AS0BjlAXCAm+Awk4lDEXyK4ypmuNrcTJ18F",
-	"k+UmSY6BUNVDioZ4pfF0auSJCYFMIyGRBHPmimTtLlChqEHCGjOJXVpQgtwic4/GnwCZP5tm7J8PPF8Y",
-	"Th1JNue0XF76cq2+bJlIwz1vdEPT0pNt67M2Lu4C03kp/wJMtKjinYioQk8cv092UIU7oecgb6iCJrhc",
-	"TEIGymnOLJKmjBK9g94ywAoQmQuhAGFuD7AZ3maEFRrdhLLCfwX4M87R2Ngqhq4p3PROOwJowtoVzR2N",
-	"j36JivNGP2J3S+trGii53rfKrY5AtHXrZSOICi/aSe63Gw88OTfZ8GKEJWEDzs6hhHcff4bL2teVj5Vl",
-	"6DlQuT5UcLg5vk8G3Dd4TK2PLSgzoaGKJpNld/X+s5CfKvtGWArVw5I7WNoscVtsv8/C7a0LV7CAqsty",
-	"G0HS1ISP3O5yymgGZ/SGAksVwhKQKITyL0Q5YXlqYgIz7Bt3ZcL7HPMZ7KD3ChDPGXPqXIhr48wQ/E6V",
-	"NqIrCUBYKUFoFUKbmq0iQlc/pc7ST8eWleI0GBiOVu9vXrVqwmY1nhiduQZJZ8TpIqb8/s+gpQxoXbRU",
-	"mfdXp+VuPSYvKj/XJtIW01yX9XNHV7xIKoo8wzYJiqzNNQwKT2H/L4zRuTWmeoS0mXv4a1siXXWZQZLN",
-	"ro2EznKG5RWZA/lk5Ac3V3VOPRWMiZurPDP+l/Mcs6tsvlSUYGYZqDPKaBBdY0IoLz/lcgZcXxEswVkK",
-	"gTS3f9tOBGZU6atrqqj2HEWQP9Xuc+GVb3uVRYGsItT4auGjVe23ZP0KK0psK6TsgZTe9Ylqvit0s3Ga",
-	"NjnZGLNXyzngaf8oEq6eJeD0J86WnYbM1yQIUve9PhTdeRWzpQ5GjnHVPsGM/TSNRh/7VtGtJmmBvz9w",
-	"n17PRWP1a2M2rfZo88A29Zd3g+h1dzvHT2VDPZ0y/LQBtF0aWZ3YjPnwOYuTZC+eHsHzOD0kB/FkH+/1",
-	"SRtLODQJMOlX6XU6rnzPtMRK5DxFZ5jMjev/5fv4cAuohCDyxiuy+ki4CoEPJeDywCazU0ziJNmN8d5k",
-	"PyYH6WEMz6dHDyPf8I1n56foIqca0Kt7ivSss30WFmkVyR9KpOWBTQYXkMZJsh+/xC8m8RF5nsaHcDB9",
-	"GJGGbzzmGJSeg6YE/fLhP/cU64+NjPncJQtrw9wXtMqbD+IbJeM/zPYtGHuu7u5RVKXKaqOmV7VSdr1y",
-	"fgOMDdAMOEjMkE1m4jyzzS9Id7rD5/3qUv/F0ntLxj2Lobd1P3ilkljYFyhPsu4/oTSfSqU3tBk26ofh",
-	"NWdIwaB/dzNkBjWN3lWDiid7QVA+gT7218i9eqL4b5RgOWZCMg+kOy2R26CAGWrmPcZ/Yn9Ao6gwlKkE",
-	"bEkww0pLAVyDFEzMqDKEZJBSrCUlFJs1KcUzLpQuPwNPBZGU1xsKI7/KJCbaKhekrbJkSutVKRgx1Z85",
-	"5N6lghPvg9RzYchw9KglmVuK7EeJ/VMbZ+g5yGbFtkp8S4vVi/v6cKoonzGoX8qLnpOTLRLcLPGGGdrg",
-	"Vh117Ck3mtOg0M0cXK927t9DFcLXmNoK2tbeotkqL78zouD1p2bL3FvU9rqdbVe/42ppefrDD6Ozs2JQ",
-	"aYD2DuK5yCUiTJBPK3NLycvRfuL6fxqkOfG/Tz8mu5e//pr+b+9jEu9fPhs9/ZjEh+Y/z77baDCFVRVS",
-	"DJlJ5fCMty6ks+Ypyo/KW7zuGzeVOgeG2VtPw857rPTLNOapMYI/TPFgJwCKp3309PzNCXp5cHj0rA0V",
-	"Nw0Req+uaFjTDq68EeXeKwnlGmYgncI1C53fkri2gLHUeK3bgojw6Ilx+uZoRgkU0yDFQM7Z6TtrrSwa",
-	"RXOtMzUaDo2Ai5xUyNmw2KSGZm1NqHVuJ5ihM0qkuAB5TQkodPz2NBpE1yDdi1e0u5PsJGZbobZoFO3v",
-	"JDsHDoRzK5vhapciEy7JM+LHZWYWFdmfP24xKPtHr0S63GocaF2QDiedgQmSVkjteEVrzWmtDjbtJbsP",
-	"Rr0vn9DUi0eeag4hFZ04O210mCRdF1WUD+81kmSnaPLFAstlNCpTPEQkWLcbfu40loNnyhhCo+91aQ5r",
-	"wGhYZTTDWy/BvzNMzSAYV9wMlgkr5QSWf2DdbE3RDdVzP7RUF3iFmXEgTfz6FL9anjSGXv0h5I5+Tb1k",
-	"6Fcsd5ctLCVfA0tqiym2tXJbGSF+BKx9D7pJ4mTpqfB0vAXKXAAb3pZFjY+vpvZdW06dYAYm+myt86ps",
-	"uhtsXGsnXnus0+Jx4XMOOpcc0iJhe6KaanHJ3IxeA3dZTwaSivSxIOOIVKZaqpVYAqVQbwgjRbozvK2q",
-	"4G6UFG7wy2FSV9p/R5x4BWshoG8NJG0KPZCU2g2h5LZRZ9+5AMXAPfg2QeJGs5p50HYoadb0TrcPn0d1",
-	"TZMFNVw1uIpXQMpn7ee4dt500I7knRlO9b74KFHnJDQh1x1qBmEH4a16tfyC1CGk+a9v1ZsS0Xq0+tGz",
-	"gZKWdZrJsCbzUApZvkSr0g80ItoAYabKWQpl38dDr87uDb8av1stgso7/lL2700Nfbn1b66ikkevoh7X",
-	"x9Ri3sLPlNX2yiyX66EBtd0vN96pkO2VcNoB8G7YjoHQ9K8F2WpsN6D+n4umYNFo3FY63yJwMSGZqdFK",
-	"Hh4HwA4nvtSeKFTPJ/asylZyqWE1F+d+7xt03seMiRuFcJoa32M8kXHTpR8qn48H9UP9AGHuveOuaQ80",
-	"QoBeZpRgK/GpBomoRnOs0ASAV4PD9pdIPC1n8ABN3aTeUuToBrsIoUBXVIJbb4jFbgzsGrO8GPpzm9EN",
-	"ZawcDbOetTke1jRXN2oYmEFUf4lQ449LBmzg3bx+dkenY9UYpaubFI1ZyVN/LLJQhxZoAsWAZfpNhadK",
-	"XU0bL0gNjksjV2Qp+0W5cDX5/rPdwXFjhrUeb21Ps673DtVPu8Odmh6dwOqxxxi996Tj/UqzUYoWkeF0",
-	"bDcY8gNB0fUM6t+zfs2ekDOGjnx/vUJXf3b7qP2XJ8r7ob6TuPfE535G3O7JmMPsJU6uTYW/5qlFTPUS",
-	"MrSCKo6p3kqaXYrLu/8HAAD//8k3CrlJQgAA",
+	"H4sIAAAAAAAC/+w9a3PbOJJ/BaXbqszcUbLs2Hn4PjlxsuOq8WzKTnZmLs7FENmSsKYADgDKo835v1/h",
+	"SZAEJcpyxqnZ/WZTINBoNPrdzS+DlC0KRoFKMTj+MpgDzoDrP1O2WDD6WQBfAv+MC/LZPBmyAqj69817",
+	"PFMDMxApJ4UkjA6OB38HLgijiE2RnAPiIFjJU0iQZGgCSACViFB0Nh2eY5nO1TgiBSqLDEsYDZKBSOew",
+	"wGpiuSpgcDwQkhM6G9zd3SWDAnO8AGlBxEXBCJULoPIsa4Pyfg6opOS3EhDJgEoyJcDRdx8+nJ1+7+AL",
+	"plCLw+94UeRq1ewQjqbP8PPh5EX6cjjeP3g6PDx69nz44uUYT9IMpvsHTwfJgKiFCizng2RA8UK9WYcq",
+	"GXD4rSQcssGx5CWEG5wyvsBycDwoS6JGNjecbD6EkzIj8iSVjLf3/zearxAs1dmiArhaDTI0WSE5JwJh",
+	"9dLIbeG3Evgq2IOecd1h9IZtKoFfwm/rwcNqlIGrYIKoEYpK9AmpWVA6x4R2QuvWiCKXUPnscJAMFoSS",
+	"RbkYHI89pgmVMAO+xXbecrZYv5WUA5aQISwR4+HGCBUSU9m1iamaOboBdTOGkixgBxL5kSyIbAN+jn9X",
+	"OEG0XEyAq0vBQZacQma3k6D98RiRKaJMIgGd0Od6/hD8hZl6cLw/Ho8D7O/vgP33mM8getfjtM4MCam7",
+	"L1folsi5OYqz0659SLfC17in79k2pDOBKePQi3Yk+yqUczbVPLoNtGL9osni9T+GjyMi0AQLfQIJEqDY",
+	"tjS8Ry2BxQi9n8MVrUbjosiJHp+vFLnVJla/S8Qo2CUXCbr+z2uFI8quqJFZagTOczuRQETqJ3SFlkYi",
+	"jZCBGnNQQBSYQ4aE5IzO8lWC8BW9BXyjByEKS+BooTYPYnRFHdrNUhXenRTbyClppnnafaWUn6Auo9Jx",
+	"ti/3x3S4n1XiCU+GSjwpiaWexGVUCNFuEkodYHxTooCUTEmKMrxCU8bR7Zykc6UHcJCcwBKQIk2RMynQ",
+	"d7/++uuvw/Pz4ekpMot+X9/rwfjgcDh+Ptw/6rgDGpBee7EjI3thSuzd95DM23WoJwfpU6VJDLUq8eLl",
+	"eH+ojmX47HmlR8RPyMOy4/HYaUR7T6f6J4Fu50wAmpRihQiVwJc4F+EpdTGdaup1IBIJC9EDVv8Ac45X",
+	"GvZpVNbGSGsRoS3HPcSWdGTl8C50VGBJdtBJ7esPRUkVNLuRkmQ9DkOyBzyKdWItCuItoRm7jetolxJz",
+	"6VBsBo4ehAS6BaxZJSb139CsHyiS7Q7InZpBFIwK0DfxNc6BZpgbK49KoFozlPC73EuD39ZaYvX9nFQ2",
+	"j0BYIEwRccug7y7evkZHR4dH3yseWRpb6y7xcLwFyBqwaCmeYjX73j8Eo3Vw/sJhOjge/MdeZbzumV/F",
+	"Xm3SCKQeqilAhogQJWQJKjgsCStFvrKPzM8fLn5ElKGc0RlwdMv4jdCQG9b53kmvrYAvOCuAS2LOwr/v",
+	"+eS6zakVL3Mmo+yyopKPdtpPfhSb/ANSGcPHZZmmIMS0zPOVv7GZpsycCHNhyAKQnlFvvp+u+2YJdCfM",
+	"gJ+gF2q2gKqNvWRA4fc1puolSKWULhgHp6tPWZ6z2wQVWBhlU6BrZ4deK+43A6mweEXV1KjAMzCKZMsu",
+	"jVhD4VFaPOxwlsaCNvP0O8G3jE9IlgG9sHxjzTkWnE1yWPzXdtd0s3eJc8ZPQWKSx7bqIURDZUqgFOc5",
+	"cEQEfaLtAHariJg5W1DTs6IubLToXtYPlcApzi/1CA3PPdDhxZ16I3MHreYdmaVHoGYeqA3qzR4PTigq",
+	"6Q1ltxSZIUgPQSxNS66oIhkIiWUpBsdHyrSWRObaGrET195SW/1jjuSENuAcoUsI1AMDM1JY0OaA2W9P",
+	"ivyJybespNk3S5A/MYk0hJYg1RUGoYxeb8ZmDIT2o8DvRMh++37HwdtqbzHJ4dvFQAgqMrB6XFgM3GKh",
+	"PHl0pkxvQq3PQJvcgU94pHmgBUtBHWgXbd78mlGJCRWIUMNa1fJ4wkpl/Tf9u3URE/x4iiUo6dpXqUoG",
+	"KaYp5JC9Wm1C7AcB/ILlUL2VaygvANsDak/uELlR3XEDT4kociPLMqAE52tmN5bbpqmNkqPGK2lUxLH/",
+	"o9URnMRCfqy+4xEXey9p/savGJHVU5ySnLhD3ABPNXgngN6aaVYxeEjWy75dQEZSQqEH0G7oTiCfu/Ui",
+	"IFubcNMU7+ww9QavQO6vlb0L3gootAkO76ZVDooh6fvymtFpTlIp4gqadh3ezoEqfpPOIStzQmfG63t9",
+	"A1BcVFOJ6xG6cFaptVhvgQOS+AboFbWxBwq3lfaboAmkuBTaC2lesV5KP6uZg0MO2vmJaWZUwOD4EL7F",
+	"RBlJevIMUqKdk8bH2AelF22ExFDqNIT1kwWc9dK84Kfq/eJ7NfwuGVg/68aQYICMxMQDJzi9UQq0wosS",
+	"BqN7qMn6zsUYun3TY6Qifs8IK9jbWnYSSp/XAfeOONSwxNUdlgwZZr9RDE22kiDVXWkEEvQfOEdmACo4",
+	"W5IMMs9DQtFTd8S8BdBXZQJSAk8Ck5eCtQVTRkWZS/9y29kRHsZktQmTp5bwN2FRAS8knk4VPnGaQqFj",
+	"IxzUnA3MOp0rIujT+Ikp5cRdQWQGqXUUE3Cxo475gapY1seBAUn7aPQuP4V49T+2uFpNom6UHFMnfLYV",
+	"MxsHdxHThcO/JSYbkbEoIgI9Mft9MkKe7picA78lAurEZdQIZDmypiTNtEbonWaTKJ0zJgBhqifQ/HYz",
+	"hdkT3URlVuRE9qfkmfFW1WloSeC2t6YYoSYsTVijI8Ggn25p+NJP2KzS+plEHMsfWk7lDt1h6xSHjURk",
+	"+WknuH+0KOovGQI817cRSAsNwgY6u4CU8aztw3oAA2OzqWCGbW2I1GOSGw+5p1HRczag2XbIqDHMhzYh",
+	"HsMCeCgVvifC12jY38L1DGNUQeQzfmsd8TQ1u16XVLz5vWBcrr2r/YmiNf1Gz3xtmY0QO6nZR03CLnBo",
+	"Ym/uVSUDCF+vgTatI7PcFJe5HBxPcS6g6ew5Z0sIzJ/AzaVjxzXjSZlBaoWmNHoirE/wipKpTt6w6R06",
+	"R0NIkudoygFG6G9OtWhbXDgwuJKe1pYzzIy1F0BuJlP0AZkxyezxTBjLAVMTKbg92YWt99Xfp4xXp6i0",
+	"c6/QT1bdYeKfGb/xKhbCnIkeylTHljZTp0bbhyKeA3JpHL8g6qSBs0xp8Db1RxNu3T5CbwnkmTkMZpHy",
+	"34jQNC8zMFa+1CkLklln4gh9EIBomeeG9BeKODE1flaFOgcAwkKwlHgrphFxchKjK3DvB6CzU70VOxsk",
+	"akfN9etLNTm0Go0nObiYbqfS3wWM+/2PgMUJri5Y3O9fH5a79TR56WVZG0gdlKDSxSE6EoCtXefd94NA",
+	"w7LZCFlp/lbyIAczJgNKIKubf+HYFkqbYjEKsnprI6CzMsf8czqH9Gag2dPnysFhopSfy0IJU0pLnH8u",
+	"5itBUpzrDVRGvXKC4DQl1P1X8hlQ+TnFHMxNSSEr9d86ooNzIuTnJRFEDj6t3594eGHb6UuM0Uczz6Dp",
+	"Okw5yCBdQUf+2RRhVArgT0SIeTFCJ3TFKKisKaRidEIfzoeLH69oipUswUa0qFkSRKQylsVcxfI052I0",
+	"BeOkNHkGRsrUkVPyPGLfXfyoLpEoJ+rpRHM/QhFGLmMDBRGgukyYS1mI4709+2SUssUeLojP9dg7mI7T",
+	"F5Nn2Th7OjmcvMAv8XM4nBylz7Ln8GL6cjwiqahdVU42yhS1iZgAaQUsWjt9hQVJdTTHhXGcqHsi6pmQ",
+	"3TR1ltXJasvkM61j9hfpcfVfkYLK7u3kqnSNwczlFnlGLXWaehuWy+gpnPoIEM7zv00Hxx/7BgKaN9kx",
+	"g3/iPuGqy9roN4qHNTfQmLAN/ScP/6tSrM5cymKbyaiUxt7Mxc0TI4YtTNvGZgJbRkPTfRZiw2bMTP2Z",
+	"ZQxDm2wTt0YMyjfdMcDQmRYLBDrtq31lt3Nk+Rnr7A1+K4bj8cFw+hyeDbOj9HA4eYoP+jiu3AVsRPJx",
+	"5b3rWPJDLjkWOtp/jtM5oYB++evwaIvLGUPx28DN2wfDXgN8KAS7CeubneJ0OB7vD/HB5OkwPcyOhvBs",
+	"+vxh8Btf8fziDF2WRAJ6tSNK/a1uQfEDzqc6s8HYqC7zGX3UTDNBQLPv2/jcSizswsbNu0YOxTZ23hlM",
+	"jtOK19AfilbchPWTW0A2HI+fDl/iF5Ph8/RZNjyCw+nD0Ep8xROKQcg5SJKiX379nx3p5aeaJXxhjICH",
+	"9utu63ndxrF6X69gk4a8C6IZA+vlhXABxZLeQp4naAYUOM6RNlKGZaHjipCNujWx3XyKW7gTY1TwrsqO",
+	"aFz/hc7QCzBrnsTMd8KF3BDB2Xg+OV4zB2c59Pf4x65BBWOwVOL3pBeI4ieS1fE11Pj+wYQ/i67ezfBj",
+	"aR+Re2v9XN4rO1n5wGp4i21+S5Wk6LNcuuRCbwZ+rwvtAO+OEFg0dUbjajMcf/H+ER9zCcLoYTgp5ruI",
+	"WCntylk1r8q1rY01foOgEsxCIZQ3RbtVZlhIzoBK4CxnMyLUoReQESw5SQlWYzKCZ5QJ6f4HmrGUE1q9",
+	"YBnq54LjVOqLpMsSU8wzUo3KQJ1Z9T+FMliU0TT4h8s5U2AYeMQqnWuI9L8ch7PW5lCUVfd6NYFvYdcX",
+	"MaxXXQShsxwqwrQxDoNbxJTrI6w8atOt6PAFnlF1clLnfYG+GXIerkMEwktMtBfS1JjWMz7cbwoVtPqv",
+	"hoRwUPtCdGYPhIkDGpbvfvjh+PzcVkQm6OBwOGclR2nO0ptGgeT45fHTsQmlSeBqxv/97uN4/9PVVfZ/",
+	"Bx/Hw6efvj/+7uN4eKSefP+XjczJcrA1sTUvXILr1sqt+hTVgPrUarzWvv1IOhnOSzAXzdLIVAUMXL20",
+	"iu24qnvbPQAym7kyQudEqJeu6LUZfo0WgKlx4plpVI6yAOn0G/NighbmRVvkYV+7okTqF4xvW7NbImM+",
+	"Pf3Wmr1U6wewp75YwQDb631fNh5MEPOKblMwc/wlkutPJV8Fzmmg2VC7ODXKUc5m9oyAL0kKI/RmCdyW",
+	"uitfKecEDN7nWPg+IAWHDFIQgvEECaYLuBcsU7fcMlhukI3zK6rnL7CwxS1owgHfmDlNv4jYQXSkgv08",
+	"x+YoM0YhcQJS145cX5Xj8dPUdBHQf8PIPFoCn5gH1/XLGGbLjjLIQUY5AY437jirzC2L31Ko+rM5C3ob",
+	"hAccu2Oml8CJ7K8aZWQ61SjKjG6G83c11O1efmWvdLuax0T8XGlA4+rdwMo8NASuNIHRIELRio4i/v0f",
+	"ToYHR888lel+CoZeTExR32pVAPgDFvPrKDLVu3/HecxC/jmQIUIyZWvrlWzzAP0cll7rldZWaseVe6pZ",
+	"DtCYR8NtEHw9o1k6QbAo5Kry8xAudD+FUdwo1EphLNZ3duoW+OH9+3dOfbTJ0Do3Oqs2HJ1cxMrr3rmW",
+	"L2xavR7p/5IgrSWrA8MS7fdKE06qlh5rtmPudpjjqFhB2EhktNmJEVNbhe5K49rpmLkHSdhmpLqoweFa",
+	"Yg4p79N9eHhYstN5s43J06qWppnSJv8JmS3rsvVappz35eHR84h/zJS4xbLDPAxb0VtWNRnRgxKkpbPL",
+	"Gbck8svQemqGZxkyPTpGHQ45qxGGZBMnGlNT92WjkiS1jqf3HSQN2u3GizaVTRxlJFTz+aGRccacGVrx",
+	"qbTSQNtMakFJm7dCM+CQXVFd8aDs+aEpIrQ6mUis8iy0jlQV5buEC49qU2B2RadERb0Nx7zFK71MTrS9",
+	"aDF//cvwUuIchmaKa4v9BMFoNrqijZ+PHSiJAeTaCOicpGAL2mzV+/nZe21o5EH0UpG0dV0yPtuzL4k9",
+	"NbY6MG2XvcY5OicpZ5cGeQKdvDsLsvyPB/uj8WisXrMXZXA8eDoajw6N/jzXNLLXDFIXzPgCfR3pWWZT",
+	"pUHIsEbN0/Qrlq0erLI97puMFOK1PC8deeytzgLNRgEH4/0Hgz7Ez/oGAkjUy5iti0LrwUfjcddCHvK9",
+	"nSp7FWiiXCwwXynJZDPqDJMWHQUHmqHPhGIItbSHT2qyGhntecfX3pfAD3ynNjWDqElsqriVRezq8etX",
+	"3+XaZKbSKbCK/QKB/17xxTr9hhC/Wr2uNQYK2+11RIirIXvBhgZ3n1q0NP4atCS2qYNfh7dGm6VHoLW/",
+	"gqyDOFlVEKGz0y2ozLDXvS/O9x3SV/30bfDZtwTZ9szdCjozYMNY3ValxzjJHpd8LlwLPLO5RgaQ9UPN",
+	"yNLFDgvghGWPRTJWpivzojpERyinLqLfg0b2pj4/SturEUm3ZDdgpqzlVN2faNrHfBip8K5lZnENhREH",
+	"h2b4jvhu9RZ4hJM0uK0oLg33HD3QxOsjDZ1ZiFKLCxFNbFN5ZLWGZQ3yTow1pzw07bwyBRet5aCNkFpP",
+	"W2Q6xTo4ImGysb0pquxNre/VqUrD+5WJan/zWdbW/lORlkbwNpTVwSrEnkuo6tJUSk5FovkjaF9fFV4y",
+	"M+j8/KDJHJu64oRUkmU9c18gtgSe46JQxFU1ylJeQS69k4hw4xPQKeDu4RXV/jJnOkmGOKY33vrxbwLm",
+	"OQEhdZWBiX5FCDSan3Vf6hR9BGDQxqz36PdfWWhGsRAzPup9BGusJrBAlc6lg8Lhic/xEhDVfqlHFqaN",
+	"doj9rok1bve++PSDbr3LGhb3V7z8Gn9KzSvIFPDs6ttSu9oQBkTiTrcnleyBr0lbx1otVw1ma3SMTAKP",
+	"ti9a0M4eU56gRLBoW4CmIs7CXDuiHYjyDyKjelFfzLFgegIHYqXRZPMR6MdAG5AQriP9vmTUT4G3s+6k",
+	"bK096H9tFT7GuuqaVnCsuyvxcSJ6HC3+q1PWv/X47cirxTW+1LLr7tYxC9Prpu7W3u4oa2vZ43x4t3hX",
+	"e56oeuHTWq14dLZFrbjurg9H63RYe8H7KKLldazlULfnMIlrp8GoV6t7eIJjJ//1dYFNcQXfcDIJv3Pj",
+	"PmMTW8QO2+v3LZy7u0c48IbX2G1y3ZEX8Y86VN0GhA8Z1pt14Vy4kmtTuh8rTjWlvqZR0hX9Od59rat1",
+	"AOaATCYVVh2ofKG+WYwIdAOFTK5oSXMQAgm2qH8mwhT0Y1lrQBCTV9VmH5DDJT1CJD0/ufEHcMsKBTvw",
+	"ys0hxPGjhxADjvw1b/7h/sED3Pw1DV8fRad1NLKFSImrtLYPGBCTjK5bowmk80oo6WA5Yce1hlMOUpL9",
+	"695d3z4vcg9cRprNlN4W09/iDcZpWkjI/B7+fZO3vsnmwkTb72wRWG7YD3skFZ1eq/cNvaD5WQgsJU7n",
+	"RrNwGYRUfSOQLEwHHt1KxJs5azMXzlLxlZTUftbmn8vSPGW3NGc4ax4fed12dW5LMV7XUzvq0ERPVNMS",
+	"gXCmPZmMG53T6R+ujCepqrMTrSj6Gtc1OTE1fVauClXDoxiMTronUuXuogkA9f0qdRd7mrm+QzbLXqAV",
+	"K9EtNuquAOmhBDPe+lJU65ulztE37mtbYqA6W9l2OHJewWtzuetUbtorRfouiW/fIG+0iIqw/Fp7+LNT",
+	"UWsfVGXm1PpDnYWtoOxxmK+LmqZS2Tellvrjqos0C2q0SydyDv/qi3pZy0XxRzOFk1rfrspka3fw2sAd",
+	"yox0hzpUK3RR+2KJz9l34VzGdZ5rFcZtfDH0itZSxgnVH1/R82CJFkxIdK0/V3ltV9AfI0T+My0moCyu",
+	"aFWJcx1+Hua6lWSvfKKqnbjkJDVy64ribEEoEZJjE/gMkpjRohQSpZjzlUs7m5JZqfak30KSaStWXNHr",
+	"k1LOGbfVhcfoFWAOHJnqEz3MlZ9EbNzwOzzb5/Jt9T3Ou+SBZjyx3xx4oOn6xtB7bpc94EYtNT3cjOYT",
+	"r/fTY7b6ppPWdZ4+wKTtzww9Bl9z5Woh21LPLL/yXbK+6Pt2N1qn855EIo3KPf9EIHZLgbeUYP9BNHTh",
+	"kmUaaTCYA5JAJZZkCUl3XFcPVO4xzTiqYe47viJoHpZ5rVoEesgixkTWB3OirdQM+wp2P4p/mFCPW/t5",
+	"u2bxxb919MDRH4/+RCSu/8pqPCG4R8K5L4dWNBeUoQSfhqvlZ5hlVDdITaT2y+7RtKrqI3pfM/XYqJ/3",
+	"Ip/mt/4eNTPpiQi+mWswHhTBSwgIIUhUUpPpRWKX9g3NNMX4gps9jSg7jS/JqafuJNVzzSrvPt39/wDF",
+	"494cS4AAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
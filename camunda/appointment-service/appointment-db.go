@@ -12,16 +12,20 @@ import (
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 
+	"github.com/Nesquiko/aass/common/audit"
 	"github.com/Nesquiko/aass/common/mongodb"
+	"github.com/Nesquiko/aass/common/reminders"
 )
 
 const (
-	appointmentCollection = "appointments"
+	appointmentCollection   = "appointments"
+	calendarFeedsCollection = "calendar_feeds"
 )
 
 var (
 	ErrNotFound          = errors.New("resource not found")
 	ErrDoctorUnavailable = errors.New("doctor unavailable at the specified time")
+	ErrVersionMismatch   = errors.New("resource was changed since the expected version")
 )
 
 type Appointment struct {
@@ -43,6 +47,20 @@ type Appointment struct {
 	Medicines  []Resource `bson:"medicines,omitempty"  json:"medicines,omitempty"`
 	Facilities []Resource `bson:"facilities,omitempty" json:"facilities,omitempty"`
 	Equipment  []Resource `bson:"equipment,omitempty"  json:"equipment,omitempty"`
+
+	// RequestedAt is when the appointment was requested, it is unset for
+	// appointments requested before it was recorded.
+	RequestedAt *time.Time `bson:"requestedAt,omitempty" json:"requestedAt,omitempty"`
+
+	// Version is bumped by every update, an update expecting another version
+	// fails with ErrVersionMismatch.
+	Version int64 `bson:"version" json:"version"`
+}
+
+// Interval is the half-open time interval [Start, End).
+type Interval struct {
+	Start time.Time `bson:"start" json:"start"`
+	End   time.Time `bson:"end"   json:"end"`
 }
 
 type ResourceType string
@@ -60,7 +78,10 @@ type Resource struct {
 }
 
 type mongoAppointmentDb struct {
-	appointments *mongo.Collection
+	appointments  *mongo.Collection
+	calendarFeeds *mongo.Collection
+	audit         audit.Log
+	reminders     reminders.MongoStore
 }
 
 func newMongoAppointmentDb(ctx context.Context, uri string, db string) (mongoAppointmentDb, error) {
@@ -80,6 +101,13 @@ func newMongoAppointmentDb(ctx context.Context, uri string, db string) (mongoApp
 			Keys:    bson.D{{Key: "appointmentDateTime", Value: 1}},
 			Options: options.Index().SetName("idx_appointment_datetime"),
 		},
+		{
+			Keys: bson.D{
+				{Key: "doctorId", Value: 1},
+				{Key: "appointmentDateTime", Value: 1},
+			},
+			Options: options.Index().SetName("idx_appointment_doctorId_datetime"),
+		},
 	}
 
 	for _, idx := range indexModels {
@@ -94,13 +122,41 @@ func newMongoAppointmentDb(ctx context.Context, uri string, db string) (mongoApp
 		}
 	}
 
-	return mongoAppointmentDb{appointments: appointmentColl}, nil
+	calendarFeedsColl := mongoDb.Collection(calendarFeedsCollection)
+	_, err = calendarFeedsColl.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "tokenHash", Value: 1}},
+		Options: options.Index().SetName("idx_calendar_feed_tokenHash_unique").SetUnique(true),
+	})
+	if err != nil {
+		slog.WarnContext(
+			ctx,
+			"Could not ensure calendar feed index (may already exist)",
+			"error",
+			err,
+		)
+	}
+
+	remindersCfg, err := reminders.LoadConfig(serviceEnvPrefix)
+	if err != nil {
+		return mongoAppointmentDb{}, fmt.Errorf("newMongoAppointmentDb: %w", err)
+	}
+
+	return mongoAppointmentDb{
+		appointments:  appointmentColl,
+		calendarFeeds: calendarFeedsColl,
+		audit:         audit.NewLog(ctx, mongoDb),
+		reminders:     reminders.NewMongoStore(ctx, mongoDb, remindersCfg.Offsets),
+	}, nil
 }
 
 func (db mongoAppointmentDb) Disconnect(ctx context.Context) error {
 	return db.appointments.Database().Client().Disconnect(ctx)
 }
 
+func (db mongoAppointmentDb) Ping(ctx context.Context) error {
+	return db.appointments.Database().Client().Ping(ctx, nil)
+}
+
 func (m *mongoAppointmentDb) CreateAppointment(
 	ctx context.Context,
 	appointment Appointment,
@@ -114,7 +170,8 @@ func (m *mongoAppointmentDb) CreateAppointment(
 
 	count, err := appointmentsColl.CountDocuments(ctx, availabilityFilter)
 	if err != nil {
-		slog.Error(
+		slog.ErrorContext(
+			ctx,
 			"Failed to count doctor appointments for availability check",
 			"doctorId", appointment.DoctorId,
 			"dateTime", appointment.AppointmentDateTime,
@@ -127,7 +184,8 @@ func (m *mongoAppointmentDb) CreateAppointment(
 	}
 
 	if count > 0 {
-		slog.Warn(
+		slog.WarnContext(
+			ctx,
 			"Attempted to schedule appointment when doctor is unavailable",
 			"doctorId", appointment.DoctorId,
 			"dateTime", appointment.AppointmentDateTime,
@@ -140,6 +198,9 @@ func (m *mongoAppointmentDb) CreateAppointment(
 	}
 
 	appointment.Id = uuid.New()
+	requestedAt := time.Now()
+	appointment.RequestedAt = &requestedAt
+	appointment.Version = 1
 	_, err = appointmentsColl.InsertOne(ctx, appointment)
 	if err != nil {
 		return Appointment{}, fmt.Errorf("CreateAppointment: failed to insert document: %w", err)
@@ -185,6 +246,7 @@ func (m *mongoAppointmentDb) CancelAppointment(
 			"cancellationReason": cancellationReason,
 			"cancelledBy":        by,
 		},
+		"$inc": bson.M{"version": 1},
 	}
 	filter := bson.M{"_id": appointmentId}
 
@@ -196,9 +258,12 @@ func (m *mongoAppointmentDb) CancelAppointment(
 	return nil
 }
 
+// DecideAppointment accepts or rejects the requested appointment if it is still
+// at version, otherwise it fails with ErrVersionMismatch.
 func (m *mongoAppointmentDb) DecideAppointment(
 	ctx context.Context,
 	appointmentId uuid.UUID,
+	version int64,
 	decision string,
 	denyReason *string,
 	resources []Resource,
@@ -207,6 +272,9 @@ func (m *mongoAppointmentDb) DecideAppointment(
 	if err != nil {
 		return Appointment{}, fmt.Errorf("DecideAppointment: %w", err)
 	}
+	if appointment.Version != version {
+		return Appointment{}, fmt.Errorf("DecideAppointment: %w", ErrVersionMismatch)
+	}
 
 	if appointment.Status != "requested" {
 		return Appointment{}, fmt.Errorf(
@@ -216,17 +284,12 @@ func (m *mongoAppointmentDb) DecideAppointment(
 	}
 
 	if decision == "accept" {
-		_, err = m.scheduleAppointment(ctx, appointmentId)
-		if err != nil {
-			return Appointment{}, fmt.Errorf("DecideAppointment: %w", err)
-		}
-
-		appointment, err = m.updateAppointmentResources(ctx, appointmentId, resources)
+		appointment, err = m.scheduleAppointment(ctx, appointmentId, version, resources)
 		if err != nil {
 			return Appointment{}, fmt.Errorf("DecideAppointment: %w", err)
 		}
 	} else if decision == "reject" {
-		appointment, err = m.denyAppointment(ctx, appointmentId, denyReason)
+		appointment, err = m.denyAppointment(ctx, appointmentId, version, denyReason)
 		if err != nil {
 			return Appointment{}, fmt.Errorf("DecideAppointment: %w", err)
 		}
@@ -265,6 +328,34 @@ func (m *mongoAppointmentDb) AppointmentsByPatientId(
 	return appts, nil
 }
 
+// AllAppointmentsByPatientId returns every appointment of the patient,
+// including cancelled and denied ones.
+func (m *mongoAppointmentDb) AllAppointmentsByPatientId(
+	ctx context.Context,
+	patientId uuid.UUID,
+) ([]Appointment, error) {
+	appointments := make([]Appointment, 0)
+	filter := bson.M{"patientId": patientId}
+	opts := options.Find().SetSort(bson.D{{Key: "appointmentDateTime", Value: 1}})
+
+	cursor, err := m.appointments.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("AllAppointmentsByPatientId find failed: %w", err)
+	}
+
+	defer func() {
+		if cerr := cursor.Close(ctx); cerr != nil {
+			slog.WarnContext(ctx, "Failed to close cursor", "error", cerr.Error())
+		}
+	}()
+
+	if err = cursor.All(ctx, &appointments); err != nil {
+		return nil, fmt.Errorf("AllAppointmentsByPatientId decode failed: %w", err)
+	}
+
+	return appointments, nil
+}
+
 func (m *mongoAppointmentDb) AppointmentsByDoctorIdAndDate(
 	ctx context.Context,
 	doctorId uuid.UUID,
@@ -287,15 +378,94 @@ func (m *mongoAppointmentDb) AppointmentsByDoctorIdAndDate(
 	return appts, nil
 }
 
+// BusyIntervalsByDoctorIds returns intervals of active appointments of the
+// doctors overlapping [from, to), sorted by their start and grouped by doctor
+// in a single aggregation. Doctors without such appointments are left out.
+func (m *mongoAppointmentDb) BusyIntervalsByDoctorIds(
+	ctx context.Context,
+	doctorIds []uuid.UUID,
+	from time.Time,
+	to time.Time,
+) (map[uuid.UUID][]Interval, error) {
+	pipeline := mongo.Pipeline{
+		bson.D{{Key: "$match", Value: bson.M{
+			"doctorId":            bson.M{"$in": doctorIds},
+			"appointmentDateTime": bson.M{"$lt": to},
+			"endTime":             bson.M{"$gt": from},
+			"status":              bson.M{"$nin": []string{"cancelled", "denied"}},
+		}}},
+		bson.D{{Key: "$sort", Value: bson.M{"appointmentDateTime": 1}}},
+		bson.D{{Key: "$group", Value: bson.M{
+			"_id": "$doctorId",
+			"busy": bson.M{"$push": bson.M{
+				"start": "$appointmentDateTime",
+				"end":   "$endTime",
+			}},
+		}}},
+	}
+
+	cursor, err := m.appointments.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("BusyIntervalsByDoctorIds aggregation failed: %w", err)
+	}
+	defer func() {
+		if cerr := cursor.Close(ctx); cerr != nil {
+			slog.WarnContext(ctx, "Failed to close cursor", "error", cerr.Error())
+		}
+	}()
+
+	var results []struct {
+		DoctorId uuid.UUID  `bson:"_id"`
+		Busy     []Interval `bson:"busy"`
+	}
+	if err = cursor.All(ctx, &results); err != nil {
+		return nil, fmt.Errorf("BusyIntervalsByDoctorIds decode failed: %w", err)
+	}
+
+	busy := make(map[uuid.UUID][]Interval, len(results))
+	for _, result := range results {
+		busy[result.DoctorId] = result.Busy
+	}
+	return busy, nil
+}
+
+// RescheduleAppointment moves the appointment at version to newDateTime and
+// sends it back to the requested state, an appointment at another version
+// fails it with ErrVersionMismatch.
 func (m *mongoAppointmentDb) RescheduleAppointment(
 	ctx context.Context,
 	appointmentId uuid.UUID,
+	version int64,
 	newDateTime time.Time,
+) (Appointment, error) {
+	return m.rescheduleAppointment(ctx, appointmentId, version, newDateTime, false)
+}
+
+// RescheduleAppointmentKeepingStatus moves the appointment to newDateTime
+// without sending it back to the requested state.
+func (m *mongoAppointmentDb) RescheduleAppointmentKeepingStatus(
+	ctx context.Context,
+	appointmentId uuid.UUID,
+	version int64,
+	newDateTime time.Time,
+) (Appointment, error) {
+	return m.rescheduleAppointment(ctx, appointmentId, version, newDateTime, true)
+}
+
+func (m *mongoAppointmentDb) rescheduleAppointment(
+	ctx context.Context,
+	appointmentId uuid.UUID,
+	version int64,
+	newDateTime time.Time,
+	keepStatus bool,
 ) (Appointment, error) {
 	appointment, err := m.AppointmentById(ctx, appointmentId)
 	if err != nil {
 		return Appointment{}, fmt.Errorf("RescheduleAppointment: %w", err)
 	}
+	if appointment.Version != version {
+		return Appointment{}, fmt.Errorf("RescheduleAppointment: %w", ErrVersionMismatch)
+	}
 
 	if appointment.Status != "scheduled" && appointment.Status != "requested" {
 		return Appointment{}, fmt.Errorf(
@@ -327,21 +497,26 @@ func (m *mongoAppointmentDb) RescheduleAppointment(
 		)
 	}
 
-	update := bson.M{
-		"$set": bson.M{
-			"appointmentDateTime": newDateTime,
-			"status":              "requested",
-		},
+	set := bson.M{"appointmentDateTime": newDateTime}
+	if !keepStatus {
+		set["status"] = "requested"
 	}
-	filter := bson.M{"_id": appointmentId}
+	update := bson.M{"$set": set, "$inc": bson.M{"version": 1}}
+	filter := bson.M{"_id": appointmentId, "version": versionFilter(version)}
 
-	_, err = appointmentsColl.UpdateOne(ctx, filter, update)
+	res, err := appointmentsColl.UpdateOne(ctx, filter, update)
 	if err != nil {
 		return Appointment{}, fmt.Errorf(
 			"RescheduleAppointment failed to update appointment: %w",
 			err,
 		)
 	}
+	if res.MatchedCount == 0 {
+		return Appointment{}, fmt.Errorf(
+			"RescheduleAppointment: %w",
+			m.missingOrMoved(ctx, bson.M{"_id": appointmentId}),
+		)
+	}
 
 	return m.AppointmentById(ctx, appointmentId)
 }
@@ -363,7 +538,12 @@ func (m *mongoAppointmentDb) AppointmentsByConditionId(
 
 	defer func() {
 		if cerr := cursor.Close(ctx); cerr != nil {
-			slog.Warn("Failed to close cursor in AppointmentsByConditionId", "error", cerr.Error())
+			slog.WarnContext(
+				ctx,
+				"Failed to close cursor in AppointmentsByConditionId",
+				"error",
+				cerr.Error(),
+			)
 		}
 	}()
 
@@ -378,6 +558,49 @@ func (m *mongoAppointmentDb) AppointmentsByConditionId(
 	return appointments, nil
 }
 
+// AppointmentIdsByConditionIds returns ids of appointments of each of the
+// conditions, the most recent first.
+func (m *mongoAppointmentDb) AppointmentIdsByConditionIds(
+	ctx context.Context,
+	conditionIds []uuid.UUID,
+) (map[uuid.UUID][]uuid.UUID, error) {
+	filter := bson.M{"conditionId": bson.M{"$in": conditionIds}}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "appointmentDateTime", Value: -1}}).
+		SetProjection(bson.M{"_id": 1, "conditionId": 1})
+
+	cursor, err := m.appointments.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("AppointmentIdsByConditionIds find failed: %w", err)
+	}
+
+	defer func() {
+		if cerr := cursor.Close(ctx); cerr != nil {
+			slog.WarnContext(
+				ctx,
+				"Failed to close cursor in AppointmentIdsByConditionIds",
+				"error",
+				cerr.Error(),
+			)
+		}
+	}()
+
+	var appointments []struct {
+		Id          uuid.UUID `bson:"_id"`
+		ConditionId uuid.UUID `bson:"conditionId"`
+	}
+	if err = cursor.All(ctx, &appointments); err != nil {
+		return nil, fmt.Errorf("AppointmentIdsByConditionIds decode failed: %w", err)
+	}
+
+	ids := make(map[uuid.UUID][]uuid.UUID, len(conditionIds))
+	for _, appt := range appointments {
+		ids[appt.ConditionId] = append(ids[appt.ConditionId], appt.Id)
+	}
+
+	return ids, nil
+}
+
 func (m *mongoAppointmentDb) appointmentsByIdFieldAndDateRange(
 	ctx context.Context,
 	idField string,
@@ -407,7 +630,7 @@ func (m *mongoAppointmentDb) appointmentsByIdFieldAndDateRange(
 
 	defer func() {
 		if cerr := cursor.Close(ctx); cerr != nil {
-			slog.Warn("Failed to close cursor", "error", cerr.Error())
+			slog.WarnContext(ctx, "Failed to close cursor", "error", cerr.Error())
 		}
 	}()
 
@@ -454,7 +677,7 @@ func (m *mongoAppointmentDb) appointmentsByIdField(
 
 	defer func() {
 		if cerr := cursor.Close(ctx); cerr != nil {
-			slog.Warn("Failed to close cursor", "error", cerr.Error())
+			slog.WarnContext(ctx, "Failed to close cursor", "error", cerr.Error())
 		}
 	}()
 
@@ -472,70 +695,82 @@ func (m *mongoAppointmentDb) appointmentsByIdField(
 func (m *mongoAppointmentDb) scheduleAppointment(
 	ctx context.Context,
 	appointmentId uuid.UUID,
+	version int64,
+	resources []Resource,
 ) (Appointment, error) {
-	appointmentsColl := m.appointments
-	update := bson.M{"$set": bson.M{"status": "scheduled"}}
-	filter := bson.M{"_id": appointmentId}
+	var facilities, equipment, medicine []Resource
+	for _, resource := range resources {
+		switch resource.Type {
+		case ResourceTypeFacility:
+			facilities = append(facilities, resource)
+		case ResourceTypeEquipment:
+			equipment = append(equipment, resource)
+		case ResourceTypeMedicine:
+			medicine = append(medicine, resource)
+		}
+	}
 
-	_, err := appointmentsColl.UpdateOne(ctx, filter, update)
+	update := bson.M{
+		"$set": bson.M{
+			"status":     "scheduled",
+			"facilities": facilities,
+			"equipment":  equipment,
+			"medicines":  medicine,
+		},
+		"$inc": bson.M{"version": 1},
+	}
+	appointment, err := m.decideRequested(ctx, appointmentId, version, update)
 	if err != nil {
 		return Appointment{}, fmt.Errorf("scheduleAppointment: %w", err)
 	}
 
-	return m.AppointmentById(ctx, appointmentId)
+	return appointment, nil
 }
 
 func (m *mongoAppointmentDb) denyAppointment(
 	ctx context.Context,
 	appointmentId uuid.UUID,
+	version int64,
 	reason *string,
 ) (Appointment, error) {
-	appointmentsColl := m.appointments
-	update := bson.M{"$set": bson.M{"status": "denied", "denialReason": reason}}
-	filter := bson.M{"_id": appointmentId}
-
-	_, err := appointmentsColl.UpdateOne(ctx, filter, update)
+	update := bson.M{
+		"$set": bson.M{"status": "denied", "denialReason": reason},
+		"$inc": bson.M{"version": 1},
+	}
+	appointment, err := m.decideRequested(ctx, appointmentId, version, update)
 	if err != nil {
 		return Appointment{}, fmt.Errorf("denyAppointment: %w", err)
 	}
 
-	return m.AppointmentById(ctx, appointmentId)
+	return appointment, nil
 }
 
-func (m *mongoAppointmentDb) updateAppointmentResources(
+// decideRequested applies the decision update to the appointment if it is
+// still requested at version, a concurrent decision or change of the
+// appointment fails it with ErrVersionMismatch.
+func (m *mongoAppointmentDb) decideRequested(
 	ctx context.Context,
 	appointmentId uuid.UUID,
-	resources []Resource,
+	version int64,
+	update bson.M,
 ) (Appointment, error) {
-	appointmentsColl := m.appointments
-
-	var facilities, equipment, medicine []Resource
-	for _, resource := range resources {
-		switch resource.Type {
-		case ResourceTypeFacility:
-			facilities = append(facilities, resource)
-		case ResourceTypeEquipment:
-			equipment = append(equipment, resource)
-		case ResourceTypeMedicine:
-			medicine = append(medicine, resource)
-		}
-	}
-
-	update := bson.M{
-		"$set": bson.M{
-			"facilities": facilities,
-			"equipment":  equipment,
-			"medicines":  medicine,
-		},
+	filter := bson.M{
+		"_id":     appointmentId,
+		"status":  "requested",
+		"version": versionFilter(version),
 	}
-	filter := bson.M{"_id": appointmentId}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
 
-	_, err := appointmentsColl.UpdateOne(ctx, filter, update)
+	var appointment Appointment
+	err := m.appointments.FindOneAndUpdate(ctx, filter, update, opts).Decode(&appointment)
 	if err != nil {
-		return Appointment{}, fmt.Errorf("updateAppointmentResources: %w", err)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return Appointment{}, m.missingOrMoved(ctx, bson.M{"_id": appointmentId})
+		}
+		return Appointment{}, err
 	}
 
-	return m.AppointmentById(ctx, appointmentId)
+	return appointment, nil
 }
 
 func (m *mongoAppointmentDb) appointmentExists(ctx context.Context, id uuid.UUID) error {
@@ -576,6 +811,7 @@ func (m *mongoAppointmentDb) UpdateAppointmentResources(
 			"equipment":  equipment,
 			"medicines":  medicine,
 		},
+		"$inc": bson.M{"version": 1},
 	}
 
 	_, err = appointmentsColl.UpdateOne(ctx, filter, update)
@@ -585,3 +821,26 @@ func (m *mongoAppointmentDb) UpdateAppointmentResources(
 
 	return m.AppointmentById(ctx, appointmentId)
 }
+
+// versionFilter matches documents at version. Documents written before they
+// were versioned have no version and are at version 0.
+func versionFilter(version int64) any {
+	if version == 0 {
+		return bson.M{"$in": bson.A{0, nil}}
+	}
+	return version
+}
+
+// missingOrMoved tells why a versioned update of the appointment matching
+// filter matched none, ErrNotFound if there is no such appointment,
+// ErrVersionMismatch if it is at another version.
+func (m *mongoAppointmentDb) missingOrMoved(ctx context.Context, filter bson.M) error {
+	count, err := m.appointments.CountDocuments(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("missingOrMoved: failed to count documents: %w", err)
+	}
+	if count == 0 {
+		return ErrNotFound
+	}
+	return ErrVersionMismatch
+}
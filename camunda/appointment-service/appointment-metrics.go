@@ -0,0 +1,54 @@
+package main
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	appointmentsRequested = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "appointments_requested_total",
+		Help: "Appointments requested by patients.",
+	})
+	appointmentsAccepted = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "appointments_accepted_total",
+		Help: "Appointment requests accepted by doctors.",
+	})
+	appointmentsDenied = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "appointments_denied_total",
+		Help: "Appointment requests denied by doctors.",
+	})
+	appointmentsCancelled = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "appointments_cancelled_total",
+		Help: "Appointments cancelled by their patient or doctor.",
+	})
+	timeToDecision = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name: "appointment_time_to_decision_seconds",
+		Help: "Time from requesting an appointment until a doctor accepted or denied it.",
+		Buckets: []float64{
+			(5 * time.Minute).Seconds(),
+			(15 * time.Minute).Seconds(),
+			time.Hour.Seconds(),
+			(4 * time.Hour).Seconds(),
+			(12 * time.Hour).Seconds(),
+			(24 * time.Hour).Seconds(),
+			(3 * 24 * time.Hour).Seconds(),
+			(7 * 24 * time.Hour).Seconds(),
+		},
+	})
+)
+
+// observeDecision counts the decision and how long the appointment waited for
+// it, appointments without a recorded request time are only counted.
+func observeDecision(appt Appointment, accepted bool, decidedAt time.Time) {
+	if accepted {
+		appointmentsAccepted.Inc()
+	} else {
+		appointmentsDenied.Inc()
+	}
+	if appt.RequestedAt != nil {
+		timeToDecision.Observe(decidedAt.Sub(*appt.RequestedAt).Seconds())
+	}
+}
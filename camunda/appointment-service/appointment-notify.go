@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+
+	camunda_client_go "github.com/citilinkru/camunda-client-go/v3"
+	"github.com/google/uuid"
+
+	"github.com/Nesquiko/aass/common/audit"
+	"github.com/Nesquiko/aass/common/notify"
+	"github.com/Nesquiko/aass/common/server"
+)
+
+const notifyProcessDefinitionKey = "Process_NotifyAppointmentChange"
+
+// startNotifyProcess lets the camunda worker notify participants about the
+// change of the appointment. The change already happened, so failures are
+// only logged.
+func (a appointmentServer) startNotifyProcess(
+	ctx context.Context,
+	kind notify.Kind,
+	appointmentId uuid.UUID,
+) {
+	processDefinitionKey := notifyProcessDefinitionKey
+	businessKey := appointmentId.String()
+	variables := map[string]camunda_client_go.Variable{
+		"appointmentId": {
+			Value: appointmentId.String(),
+			Type:  "String",
+		},
+		"notificationKind": {
+			Value: string(kind),
+			Type:  "String",
+		},
+		"actor": {
+			Value: audit.MetaFrom(ctx).Actor,
+			Type:  "String",
+		},
+	}
+
+	server.InjectTraceVariables(ctx, variables)
+
+	_, err := a.camunda.ProcessDefinition.StartInstance(
+		camunda_client_go.QueryProcessDefinitionBy{Key: &processDefinitionKey},
+		camunda_client_go.ReqStartInstance{
+			BusinessKey: &businessKey,
+			Variables:   &variables,
+		},
+	)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to start Camunda process instance",
+			"processDefinitionKey", processDefinitionKey,
+			"businessKey", businessKey,
+			"error", err,
+		)
+	}
+}
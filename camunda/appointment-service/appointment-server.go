@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -9,32 +10,68 @@ import (
 
 	camunda_client_go "github.com/citilinkru/camunda-client-go/v3"
 	"github.com/go-chi/httplog/v2"
+	"github.com/google/uuid"
 
 	"github.com/Nesquiko/aass/appointment-service/api"
 	medicalapi "github.com/Nesquiko/aass/appointment-service/medical-api"
 	resourceapi "github.com/Nesquiko/aass/appointment-service/resources-api"
 	userapi "github.com/Nesquiko/aass/appointment-service/user-api"
+	"github.com/Nesquiko/aass/common/audit"
+	"github.com/Nesquiko/aass/common/notify"
 	"github.com/Nesquiko/aass/common/server"
 	commonapi "github.com/Nesquiko/aass/common/server/api"
 )
 
+const (
+	auditActionAppointmentDecide = "appointment.decide"
+	auditActionAppointmentCancel = "appointment.cancel"
+	auditActionRecordsExport     = "appointments.export"
+)
+
 type appointmentServer struct {
 	db          mongoAppointmentDb
 	medicalApi  *medicalapi.ClientWithResponses
 	resourceApi *resourceapi.ClientWithResponses
 	userApi     *userapi.ClientWithResponses
+	readModel   *readModel
 
 	camunda *camunda_client_go.Client
 }
 
 func newAppointmentServer(
 	db mongoAppointmentDb,
+	clients *server.Clients,
 	logger *httplog.Logger,
 	opts commonapi.ChiServerOptions,
-) http.Handler {
-	medicalClient, _ := medicalapi.NewClientWithResponses("http://medical-service:8080/")
-	resourceClient, _ := resourceapi.NewClientWithResponses("http://resource-service:8080/")
-	userClient, _ := userapi.NewClientWithResponses("http://user-service:8080/")
+) (http.Handler, error) {
+	medicalUrl, medicalHttpClient := clients.Client(server.MedicalService)
+	medicalClient, err := medicalapi.NewClientWithResponses(
+		medicalUrl,
+		medicalapi.WithHTTPClient(medicalHttpClient),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("newAppointmentServer medical client: %w", err)
+	}
+	resourceUrl, resourceHttpClient := clients.Client(server.ResourceService)
+	resourceClient, err := resourceapi.NewClientWithResponses(
+		resourceUrl,
+		resourceapi.WithHTTPClient(resourceHttpClient),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("newAppointmentServer resource client: %w", err)
+	}
+	userUrl, userHttpClient := clients.Client(server.UserService)
+	userClient, err := userapi.NewClientWithResponses(
+		userUrl,
+		userapi.WithHTTPClient(userHttpClient),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("newAppointmentServer user client: %w", err)
+	}
+	readModelCfg, err := loadReadModelConfig(serviceEnvPrefix)
+	if err != nil {
+		return nil, fmt.Errorf("newAppointmentServer: %w", err)
+	}
 
 	client := camunda_client_go.NewClient(camunda_client_go.ClientOptions{
 		EndpointUrl: "http://camunda-platform:8080/engine-rest",
@@ -42,19 +79,23 @@ func newAppointmentServer(
 		ApiPassword: "demo",
 		Timeout:     time.Second * 10,
 	})
+	server.RegisterHealthCheck(server.CamundaCheck("http://camunda-platform:8080/engine-rest"))
 
 	srv := appointmentServer{
 		db:          db,
 		medicalApi:  medicalClient,
 		resourceApi: resourceClient,
 		userApi:     userClient,
+		readModel:   newReadModel(readModelCfg),
 		camunda:     client,
 	}
+	go srv.syncEvery(context.Background(), readModelCfg.SyncInterval)
 
-	middlewares := make([]api.MiddlewareFunc, len(opts.Middlewares))
+	middlewares := make([]api.MiddlewareFunc, len(opts.Middlewares), len(opts.Middlewares)+1)
 	for i, mid := range opts.Middlewares {
 		middlewares[i] = api.MiddlewareFunc(mid)
 	}
+	middlewares = append(middlewares, staleFieldsMiddleware)
 
 	mappedOpts := api.ChiServerOptions{
 		BaseURL:          opts.BaseURL,
@@ -63,7 +104,7 @@ func newAppointmentServer(
 		ErrorHandlerFunc: opts.ErrorHandlerFunc,
 	}
 
-	return api.HandlerWithOptions(srv, mappedOpts)
+	return api.HandlerWithOptions(srv, mappedOpts), nil
 }
 
 // AppointmentById implements api.ServerInterface.
@@ -78,7 +119,14 @@ func (a appointmentServer) AppointmentById(
 		server.EncodeError(w, server.NotFoundId("Appointment", appointmentId))
 		return
 	} else if err != nil {
-		slog.Error(server.UnexpectedError, "error", err.Error(), "where", "AppointmentById db")
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"AppointmentById db",
+		)
 		server.EncodeError(w, server.InternalServerError())
 		return
 	}
@@ -89,6 +137,7 @@ func (a appointmentServer) AppointmentById(
 		return
 	}
 
+	server.SetETag(w, apptData.Version)
 	server.Encode(w, http.StatusOK, apiAppt)
 }
 
@@ -101,7 +150,8 @@ func (a appointmentServer) AppointmentsByConditionId(
 	ctx := r.Context()
 	apptsData, err := a.db.AppointmentsByConditionId(ctx, conditionId)
 	if err != nil {
-		slog.Error(
+		slog.ErrorContext(
+			r.Context(),
 			server.UnexpectedError,
 			"error",
 			err.Error(),
@@ -112,14 +162,10 @@ func (a appointmentServer) AppointmentsByConditionId(
 		return
 	}
 
-	apiAppts := make([]api.AppointmentDisplay, 0, len(apptsData))
-	for _, apptData := range apptsData {
-		apiApptDisplay, apiErr := a.mapDataApptToApiApptDisplay(ctx, apptData)
-		if apiErr != nil {
-			server.EncodeError(w, apiErr)
-			return
-		}
-		apiAppts = append(apiAppts, apiApptDisplay)
+	apiAppts, apiErr := a.mapDataApptsToApiApptDisplays(ctx, apptsData)
+	if apiErr != nil {
+		server.EncodeError(w, apiErr)
+		return
 	}
 
 	server.Encode(w, http.StatusOK, api.Appointments{Appointments: &apiAppts})
@@ -138,12 +184,30 @@ func (a appointmentServer) CancelAppointment(
 		return
 	}
 
-	err := a.db.CancelAppointment(ctx, appointmentId, string(req.By), req.Reason)
+	before, err := a.db.AppointmentById(ctx, appointmentId)
 	if errors.Is(err, ErrNotFound) {
 		server.EncodeError(w, server.NotFoundId("Appointment", appointmentId))
 		return
 	} else if err != nil {
-		slog.Error(
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"CancelAppointment get appt db",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+
+	err = a.db.CancelAppointment(ctx, appointmentId, string(req.By), req.Reason)
+	if errors.Is(err, ErrNotFound) {
+		server.EncodeError(w, server.NotFoundId("Appointment", appointmentId))
+		return
+	} else if err != nil {
+		slog.ErrorContext(
+			r.Context(),
 			server.UnexpectedError,
 			"error",
 			err.Error(),
@@ -154,6 +218,37 @@ func (a appointmentServer) CancelAppointment(
 		return
 	}
 
+	after, err := a.db.AppointmentById(ctx, appointmentId)
+	if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"CancelAppointment get cancelled appt db",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+	appointmentsCancelled.Inc()
+	a.cancelReminders(ctx, appointmentId)
+	a.startNotifyProcess(ctx, notify.KindAppointmentCancelled, appointmentId)
+
+	err = a.db.audit.Record(ctx, auditActionAppointmentCancel, appointmentId, before, after)
+	if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"CancelAppointment audit",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+
 	w.WriteHeader(http.StatusNoContent)
 }
 
@@ -162,8 +257,15 @@ func (a appointmentServer) DecideAppointment(
 	w http.ResponseWriter,
 	r *http.Request,
 	appointmentId api.AppointmentId,
+	params api.DecideAppointmentParams,
 ) {
 	ctx := r.Context()
+	versions, preconditionErr := server.IfMatchVersions(params.IfMatch)
+	if preconditionErr != nil {
+		server.EncodeError(w, preconditionErr)
+		return
+	}
+
 	req, decodeErr := server.Decode[api.AppointmentDecision](w, r)
 	if decodeErr != nil {
 		server.EncodeError(w, decodeErr)
@@ -175,7 +277,8 @@ func (a appointmentServer) DecideAppointment(
 		server.EncodeError(w, server.NotFoundId("Appointment", appointmentId))
 		return
 	} else if err != nil {
-		slog.Error(
+		slog.ErrorContext(
+			r.Context(),
 			server.UnexpectedError,
 			"error",
 			err.Error(),
@@ -185,6 +288,11 @@ func (a appointmentServer) DecideAppointment(
 		server.EncodeError(w, server.InternalServerError())
 		return
 	}
+	version, preconditionErr := server.MatchVersion(apptData.Version, versions)
+	if preconditionErr != nil {
+		server.EncodeError(w, preconditionErr)
+		return
+	}
 
 	var updatedApptData Appointment
 
@@ -207,7 +315,8 @@ func (a appointmentServer) DecideAppointment(
 			reserveReqBody,
 		)
 		if resErr != nil {
-			slog.Error(
+			slog.ErrorContext(
+				r.Context(),
 				server.UnexpectedError,
 				"error",
 				resErr.Error(),
@@ -218,7 +327,8 @@ func (a appointmentServer) DecideAppointment(
 			return
 		}
 		if resResp.StatusCode() != http.StatusNoContent {
-			slog.Error(
+			slog.ErrorContext(
+				r.Context(),
 				"resource reservation failed",
 				"status",
 				resResp.StatusCode(),
@@ -262,6 +372,7 @@ func (a appointmentServer) DecideAppointment(
 		updatedApptData, err = a.db.DecideAppointment(
 			ctx,
 			appointmentId,
+			version,
 			string(req.Action),
 			nil,
 			resources,
@@ -287,6 +398,7 @@ func (a appointmentServer) DecideAppointment(
 		updatedApptData, err = a.db.DecideAppointment(
 			ctx,
 			appointmentId,
+			version,
 			string(req.Action),
 			req.Reason,
 			nil,
@@ -303,8 +415,12 @@ func (a appointmentServer) DecideAppointment(
 		return
 	}
 
-	if err != nil {
-		slog.Error(
+	if errors.Is(err, ErrVersionMismatch) {
+		server.EncodeError(w, server.PreconditionFailed())
+		return
+	} else if err != nil {
+		slog.ErrorContext(
+			r.Context(),
 			server.UnexpectedError,
 			"error",
 			err.Error(),
@@ -314,6 +430,12 @@ func (a appointmentServer) DecideAppointment(
 		server.EncodeError(w, server.InternalServerError())
 		return
 	}
+	observeDecision(apptData, req.Action == api.Accept, time.Now())
+	if req.Action == api.Accept {
+		a.scheduleReminders(ctx, updatedApptData)
+	} else {
+		a.cancelReminders(ctx, appointmentId)
+	}
 
 	reviewTaskDefinitionKey := "Activity_ReviewAppointment"
 	businessKeyStr := appointmentId.String()
@@ -346,6 +468,7 @@ func (a appointmentServer) DecideAppointment(
 	}
 
 	taskToComplete := tasks[0]
+	server.InjectTraceVariables(ctx, variables)
 	err = taskToComplete.Complete(camunda_client_go.QueryUserTaskComplete{
 		Variables: variables,
 	})
@@ -361,12 +484,33 @@ func (a appointmentServer) DecideAppointment(
 		return
 	}
 
+	err = a.db.audit.Record(
+		ctx,
+		auditActionAppointmentDecide,
+		appointmentId,
+		apptData,
+		updatedApptData,
+	)
+	if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"DecideAppointment audit",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+
 	apiAppt, apiErr := a.mapDataApptToApiAppt(ctx, updatedApptData)
 	if apiErr != nil {
 		server.EncodeError(w, apiErr)
 		return
 	}
 
+	server.SetETag(w, updatedApptData.Version)
 	server.Encode(w, http.StatusOK, apiAppt)
 }
 
@@ -385,19 +529,22 @@ func (a appointmentServer) DoctorsCalendar(
 
 	apptsData, err := a.db.AppointmentsByDoctorId(ctx, doctorId, params.From.Time, to)
 	if err != nil {
-		slog.Error(server.UnexpectedError, "error", err.Error(), "where", "DoctorsCalendar db")
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"DoctorsCalendar db",
+		)
 		server.EncodeError(w, server.InternalServerError())
 		return
 	}
 
-	apiAppts := make([]api.AppointmentDisplay, 0, len(apptsData))
-	for _, apptData := range apptsData {
-		apiApptDisplay, apiErr := a.mapDataApptToApiApptDisplay(ctx, apptData)
-		if apiErr != nil {
-			server.EncodeError(w, apiErr)
-			return
-		}
-		apiAppts = append(apiAppts, apiApptDisplay)
+	apiAppts, apiErr := a.mapDataApptsToApiApptDisplays(ctx, apptsData)
+	if apiErr != nil {
+		server.EncodeError(w, apiErr)
+		return
 	}
 
 	server.Encode(w, http.StatusOK, api.Appointments{Appointments: &apiAppts})
@@ -415,7 +562,8 @@ func (a appointmentServer) DoctorsTimeslots(
 
 	apptsData, err := a.db.AppointmentsByDoctorIdAndDate(ctx, doctorId, date)
 	if err != nil {
-		slog.Error(
+		slog.ErrorContext(
+			r.Context(),
 			server.UnexpectedError,
 			"error",
 			err.Error(),
@@ -456,6 +604,37 @@ func (a appointmentServer) DoctorsTimeslots(
 	server.Encode(w, http.StatusOK, api.DoctorTimeslots{Slots: allSlots})
 }
 
+// DoctorsBusyIntervals implements api.ServerInterface.
+func (a appointmentServer) DoctorsBusyIntervals(
+	w http.ResponseWriter,
+	r *http.Request,
+	params api.DoctorsBusyIntervalsParams,
+) {
+	busy, err := a.db.BusyIntervalsByDoctorIds(r.Context(), params.DoctorIds, params.From, params.To)
+	if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"DoctorsBusyIntervals",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+
+	doctors := make([]api.DoctorBusyIntervals, len(params.DoctorIds))
+	for i, doctorId := range params.DoctorIds {
+		doctors[i] = api.DoctorBusyIntervals{
+			DoctorId: doctorId,
+			Busy:     server.Map(busy[doctorId], dataIntervalToApiInterval),
+		}
+	}
+
+	server.Encode(w, http.StatusOK, api.DoctorsBusyIntervals{Doctors: doctors})
+}
+
 // PatientsCalendar implements api.ServerInterface.
 func (a appointmentServer) PatientsCalendar(
 	w http.ResponseWriter,
@@ -471,24 +650,65 @@ func (a appointmentServer) PatientsCalendar(
 
 	apptsData, err := a.db.AppointmentsByPatientId(ctx, patientId, params.From.Time, to)
 	if err != nil {
-		slog.Error(server.UnexpectedError, "error", err.Error(), "where", "PatientsCalendar db")
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"PatientsCalendar db",
+		)
 		server.EncodeError(w, server.InternalServerError())
 		return
 	}
 
-	apiAppts := make([]api.AppointmentDisplay, 0, len(apptsData))
-	for _, apptData := range apptsData {
-		apiApptDisplay, apiErr := a.mapDataApptToApiApptDisplay(ctx, apptData)
-		if apiErr != nil {
-			server.EncodeError(w, apiErr)
-			return
-		}
-		apiAppts = append(apiAppts, apiApptDisplay)
+	apiAppts, apiErr := a.mapDataApptsToApiApptDisplays(ctx, apptsData)
+	if apiErr != nil {
+		server.EncodeError(w, apiErr)
+		return
 	}
 
 	server.Encode(w, http.StatusOK, api.Appointments{Appointments: &apiAppts})
 }
 
+// ExportPatientAppointments implements api.ServerInterface.
+func (a appointmentServer) ExportPatientAppointments(
+	w http.ResponseWriter,
+	r *http.Request,
+	patientId api.PatientId,
+) {
+	apptsData, err := a.db.AllAppointmentsByPatientId(r.Context(), patientId)
+	if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"ExportPatientAppointments",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+
+	err = a.db.audit.Record(r.Context(), auditActionRecordsExport, patientId, nil, nil)
+	if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"ExportPatientAppointments audit",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+	server.Encode(w, http.StatusOK, api.AppointmentRecordsExport{
+		Appointments: server.Map(apptsData, dataApptToApptRecord),
+	})
+}
+
 // RequestAppointment implements api.ServerInterface.
 func (a appointmentServer) RequestAppointment(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -500,7 +720,14 @@ func (a appointmentServer) RequestAppointment(w http.ResponseWriter, r *http.Req
 
 	res, patientErr := a.userApi.GetPatientByIdWithResponse(ctx, req.PatientId)
 	if patientErr != nil || res.StatusCode() != http.StatusOK {
-		slog.Error("failed to get patient", "error", patientErr, "status", res.StatusCode())
+		slog.ErrorContext(
+			r.Context(),
+			"failed to get patient",
+			"error",
+			patientErr,
+			"status",
+			res.StatusCode(),
+		)
 		if patientErr != nil && res.ApplicationproblemJSON404 != nil {
 			server.EncodeError(w, server.NotFoundId("Patient", req.PatientId))
 			return
@@ -511,7 +738,14 @@ func (a appointmentServer) RequestAppointment(w http.ResponseWriter, r *http.Req
 
 	res2, doctorErr := a.userApi.GetDoctorByIdWithResponse(ctx, req.DoctorId)
 	if doctorErr != nil || res2.StatusCode() != http.StatusOK {
-		slog.Error("failed to get doctor", "error", doctorErr, "status", res2.StatusCode())
+		slog.ErrorContext(
+			r.Context(),
+			"failed to get doctor",
+			"error",
+			doctorErr,
+			"status",
+			res2.StatusCode(),
+		)
 		if doctorErr != nil && res2.ApplicationproblemJSON404 != nil {
 			server.EncodeError(w, server.NotFoundId("Doctor", req.DoctorId))
 			return
@@ -523,7 +757,8 @@ func (a appointmentServer) RequestAppointment(w http.ResponseWriter, r *http.Req
 	if req.ConditionId != nil {
 		res3, conditionErr := a.medicalApi.ConditionDetailWithResponse(ctx, *req.ConditionId)
 		if conditionErr != nil || res3.StatusCode() != http.StatusOK {
-			slog.Error(
+			slog.ErrorContext(
+				r.Context(),
 				"failed to get condition",
 				"error",
 				conditionErr,
@@ -569,7 +804,8 @@ func (a appointmentServer) RequestAppointment(w http.ResponseWriter, r *http.Req
 		})
 		return
 	} else if err != nil {
-		slog.Error(
+		slog.ErrorContext(
+			r.Context(),
 			server.UnexpectedError,
 			"error",
 			err.Error(),
@@ -579,6 +815,8 @@ func (a appointmentServer) RequestAppointment(w http.ResponseWriter, r *http.Req
 		server.EncodeError(w, server.InternalServerError())
 		return
 	}
+	appointmentsRequested.Inc()
+	a.scheduleReminders(ctx, createdApptData)
 
 	apiAppt, apiErr := a.mapDataApptToApiAppt(ctx, createdApptData)
 	if apiErr != nil {
@@ -618,6 +856,8 @@ func (a appointmentServer) RequestAppointment(w http.ResponseWriter, r *http.Req
 		}
 	}
 
+	server.InjectTraceVariables(ctx, variables)
+
 	_, err = a.camunda.ProcessDefinition.StartInstance(
 		camunda_client_go.QueryProcessDefinitionBy{Key: &processDefinitionKey},
 		camunda_client_go.ReqStartInstance{
@@ -643,22 +883,68 @@ func (a appointmentServer) RescheduleAppointment(
 	w http.ResponseWriter,
 	r *http.Request,
 	appointmentId api.AppointmentId,
+	params api.RescheduleAppointmentParams,
 ) {
 	ctx := r.Context()
+	versions, preconditionErr := server.IfMatchVersions(params.IfMatch)
+	if preconditionErr != nil {
+		server.EncodeError(w, preconditionErr)
+		return
+	}
+
 	req, decodeErr := server.Decode[api.AppointmentReschedule](w, r)
 	if decodeErr != nil {
 		server.EncodeError(w, decodeErr)
 		return
 	}
+	// the reschedule is versioned, the versions from If-Match are resolved to
+	// the current one
+	apptData, err := a.db.AppointmentById(ctx, appointmentId)
+	if errors.Is(err, ErrNotFound) {
+		server.EncodeError(w, server.NotFoundId("Appointment", appointmentId))
+		return
+	} else if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"RescheduleAppointment get appt db",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+	version, preconditionErr := server.MatchVersion(apptData.Version, versions)
+	if preconditionErr != nil {
+		server.EncodeError(w, preconditionErr)
+		return
+	}
 
-	updatedApptData, err := a.db.RescheduleAppointment(
-		ctx,
-		appointmentId,
-		req.NewAppointmentDateTime,
-	)
+	keepReservations := req.KeepReservations != nil && *req.KeepReservations
+	var updatedApptData Appointment
+	var conflicts []api.ReservationConflict
+	if keepReservations {
+		updatedApptData, conflicts, err = a.rescheduleKeepingReservations(
+			ctx,
+			appointmentId,
+			version,
+			req.NewAppointmentDateTime,
+		)
+	} else {
+		updatedApptData, err = a.db.RescheduleAppointment(
+			ctx,
+			appointmentId,
+			version,
+			req.NewAppointmentDateTime,
+		)
+	}
 	if errors.Is(err, ErrNotFound) {
 		server.EncodeError(w, server.NotFoundId("Appointment", appointmentId))
 		return
+	} else if errors.Is(err, ErrVersionMismatch) {
+		server.EncodeError(w, server.PreconditionFailed())
+		return
 	} else if errors.Is(err, ErrDoctorUnavailable) {
 		server.EncodeError(w, &server.ApiError{
 			ErrorDetail: commonapi.ErrorDetail{
@@ -670,7 +956,8 @@ func (a appointmentServer) RescheduleAppointment(
 		})
 		return
 	} else if err != nil {
-		slog.Error(
+		slog.ErrorContext(
+			r.Context(),
 			server.UnexpectedError,
 			"error",
 			err.Error(),
@@ -680,16 +967,94 @@ func (a appointmentServer) RescheduleAppointment(
 		server.EncodeError(w, server.InternalServerError())
 		return
 	}
+	a.scheduleReminders(ctx, updatedApptData)
+	a.startNotifyProcess(ctx, notify.KindAppointmentRescheduled, appointmentId)
 
 	apiAppt, apiErr := a.mapDataApptToApiAppt(ctx, updatedApptData)
 	if apiErr != nil {
 		server.EncodeError(w, apiErr)
 		return
 	}
+	if keepReservations {
+		apiAppt.ReservationConflicts = &conflicts
+	}
 
+	server.SetETag(w, updatedApptData.Version)
 	server.Encode(w, http.StatusOK, apiAppt)
 }
 
+// rescheduleKeepingReservations moves the appointment to newDateTime keeping
+// its status and asks the resource service to move its reservations along.
+// If some resource is taken at the new time, the resource service releases
+// all of the reservations and the appointment is sent back to the requested
+// state without resources, the taken ones are returned as conflicts.
+func (a appointmentServer) rescheduleKeepingReservations(
+	ctx context.Context,
+	appointmentId uuid.UUID,
+	version int64,
+	newDateTime time.Time,
+) (Appointment, []api.ReservationConflict, error) {
+	conflicts := make([]api.ReservationConflict, 0)
+	appt, err := a.db.RescheduleAppointmentKeepingStatus(
+		ctx,
+		appointmentId,
+		version,
+		newDateTime,
+	)
+	if err != nil {
+		return Appointment{}, nil, fmt.Errorf("rescheduleKeepingReservations: %w", err)
+	}
+	if len(appt.Facilities) == 0 && len(appt.Equipment) == 0 && len(appt.Medicines) == 0 {
+		return appt, conflicts, nil
+	}
+
+	moveResp, err := a.resourceApi.MoveAppointmentReservationsWithResponse(
+		ctx,
+		appointmentId,
+		resourceapi.MoveAppointmentReservationsJSONRequestBody{Start: newDateTime},
+	)
+	if err != nil {
+		return Appointment{}, nil, fmt.Errorf(
+			"rescheduleKeepingReservations move reservations api call: %w",
+			err,
+		)
+	} else if moveResp.JSON200 == nil {
+		return Appointment{}, nil, fmt.Errorf(
+			"rescheduleKeepingReservations move reservations failed with status %d: %s",
+			moveResp.StatusCode(),
+			string(moveResp.Body),
+		)
+	}
+	if len(moveResp.JSON200.Conflicts) == 0 {
+		return appt, conflicts, nil
+	}
+
+	for _, res := range moveResp.JSON200.Conflicts {
+		conflicts = append(conflicts, api.ReservationConflict{
+			Id:   *res.Id,
+			Name: res.Name,
+			Type: api.ResourceType(res.Type),
+		})
+	}
+
+	appt, err = a.db.RescheduleAppointment(ctx, appointmentId, appt.Version, newDateTime)
+	if err != nil {
+		return Appointment{}, nil, fmt.Errorf("rescheduleKeepingReservations: %w", err)
+	}
+	appt, err = a.db.UpdateAppointmentResources(
+		ctx,
+		appointmentId,
+		[]Resource{},
+		[]Resource{},
+		[]Resource{},
+	)
+	if err != nil {
+		return Appointment{}, nil, fmt.Errorf("rescheduleKeepingReservations: %w", err)
+	}
+
+	return appt, conflicts, nil
+}
+
 // UpdateAppointmentResources implements api.ServerInterface.
 func (a appointmentServer) UpdateAppointmentResources(
 	w http.ResponseWriter,
@@ -709,7 +1074,8 @@ func (a appointmentServer) UpdateAppointmentResources(
 		server.EncodeError(w, server.NotFoundId("Appointment", appointmentId))
 		return
 	} else if err != nil {
-		slog.Error(
+		slog.ErrorContext(
+			r.Context(),
 			server.UnexpectedError,
 			"error",
 			err.Error(),
@@ -773,7 +1139,8 @@ func (a appointmentServer) UpdateAppointmentResources(
 			server.EncodeError(w, server.NotFoundId("Appointment", appointmentId))
 			return
 		}
-		slog.Error(
+		slog.ErrorContext(
+			r.Context(),
 			server.UnexpectedError,
 			"error",
 			err.Error(),
@@ -792,3 +1159,24 @@ func (a appointmentServer) UpdateAppointmentResources(
 
 	server.Encode(w, http.StatusOK, apiAppt)
 }
+
+// AuditEvents implements api.ServerInterface.
+func (a appointmentServer) AuditEvents(
+	w http.ResponseWriter,
+	r *http.Request,
+	params api.AuditEventsParams,
+) {
+	filter := audit.Filter{
+		TargetId: params.TargetId,
+		Actor:    params.Actor,
+		From:     params.From,
+		To:       params.To,
+	}
+	if params.AfterSeq != nil {
+		filter.AfterSeq = *params.AfterSeq
+	}
+	if params.Limit != nil {
+		filter.Limit = *params.Limit
+	}
+	server.AuditEvents(w, r, a.db.audit, filter)
+}
@@ -0,0 +1,358 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Nesquiko/aass/appointment-service/api"
+	"github.com/Nesquiko/aass/common/ics"
+	"github.com/Nesquiko/aass/common/server"
+)
+
+const (
+	// calendarFeedHistoryMonths is how many months of past appointments a
+	// calendar feed contains, all future ones are always included.
+	calendarFeedHistoryMonths = 3
+
+	calendarFeedTokenBytes = 32
+	calendarUidDomain      = "aass"
+)
+
+// IssueDoctorCalendarFeed implements api.ServerInterface.
+func (a appointmentServer) IssueDoctorCalendarFeed(
+	w http.ResponseWriter,
+	r *http.Request,
+	doctorId api.DoctorId,
+) {
+	a.issueCalendarFeed(w, r, doctorId, api.UserRoleDoctor)
+}
+
+// RevokeDoctorCalendarFeed implements api.ServerInterface.
+func (a appointmentServer) RevokeDoctorCalendarFeed(
+	w http.ResponseWriter,
+	r *http.Request,
+	doctorId api.DoctorId,
+) {
+	a.revokeCalendarFeed(w, r, doctorId, "Doctor")
+}
+
+// IssuePatientCalendarFeed implements api.ServerInterface.
+func (a appointmentServer) IssuePatientCalendarFeed(
+	w http.ResponseWriter,
+	r *http.Request,
+	patientId api.PatientId,
+) {
+	a.issueCalendarFeed(w, r, patientId, api.UserRolePatient)
+}
+
+// RevokePatientCalendarFeed implements api.ServerInterface.
+func (a appointmentServer) RevokePatientCalendarFeed(
+	w http.ResponseWriter,
+	r *http.Request,
+	patientId api.PatientId,
+) {
+	a.revokeCalendarFeed(w, r, patientId, "Patient")
+}
+
+// issueCalendarFeed creates a new secret feed of the user's appointments, the
+// user's previous feed stops working.
+func (a appointmentServer) issueCalendarFeed(
+	w http.ResponseWriter,
+	r *http.Request,
+	userId uuid.UUID,
+	role api.UserRole,
+) {
+	ctx := r.Context()
+	if _, apiErr := a.userName(ctx, userId, role); apiErr != nil {
+		server.EncodeError(w, apiErr)
+		return
+	}
+
+	raw := make([]byte, calendarFeedTokenBytes)
+	if _, err := rand.Read(raw); err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"issueCalendarFeed token",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+	token := hex.EncodeToString(raw)
+
+	err := a.db.SaveCalendarFeed(ctx, CalendarFeed{
+		UserId:    userId,
+		Role:      string(role),
+		TokenHash: hashCalendarFeedToken(token),
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"issueCalendarFeed db",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+
+	feedUrl := server.ExternalUrl(r, fmt.Sprintf("/calendar/%s.ics", token))
+	server.Encode(w, http.StatusCreated, api.CalendarFeed{Url: feedUrl})
+}
+
+func (a appointmentServer) revokeCalendarFeed(
+	w http.ResponseWriter,
+	r *http.Request,
+	userId uuid.UUID,
+	resource string,
+) {
+	err := a.db.DeleteCalendarFeed(r.Context(), userId)
+	if errors.Is(err, ErrNotFound) {
+		server.EncodeError(w, server.NotFound(resource+" calendar feed", userId.String()))
+		return
+	} else if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"revokeCalendarFeed db",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// CalendarFeed implements api.ServerInterface. Events are written in the
+// local time zone, which is the one configured for the service.
+func (a appointmentServer) CalendarFeed(w http.ResponseWriter, r *http.Request, token string) {
+	ctx := r.Context()
+	feed, err := a.db.CalendarFeedByTokenHash(ctx, hashCalendarFeedToken(token))
+	if errors.Is(err, ErrNotFound) {
+		// don't echo the secret token back
+		server.EncodeError(w, server.NotFound("Calendar feed", "***"))
+		return
+	} else if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"CalendarFeed db",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+
+	role := api.UserRole(feed.Role)
+	name, apiErr := a.userName(ctx, feed.UserId, role)
+	if apiErr != nil {
+		server.EncodeError(w, apiErr)
+		return
+	}
+
+	from := time.Now().AddDate(0, -calendarFeedHistoryMonths, 0)
+	var appts []Appointment
+	if role == api.UserRoleDoctor {
+		appts, err = a.db.AppointmentsByDoctorId(ctx, feed.UserId, from, nil)
+	} else {
+		appts, err = a.db.AppointmentsByPatientId(ctx, feed.UserId, from, nil)
+	}
+	if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"CalendarFeed appointments",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+
+	names := make(map[uuid.UUID]string)
+	events := make([]ics.Event, len(appts))
+	for i, appt := range appts {
+		// named after the other participant of the appointment
+		withId, withRole := appt.DoctorId, api.UserRoleDoctor
+		if role == api.UserRoleDoctor {
+			withId, withRole = appt.PatientId, api.UserRolePatient
+		}
+
+		with, ok := names[withId]
+		if !ok {
+			with, apiErr = a.userName(ctx, withId, withRole)
+			if apiErr != nil {
+				server.EncodeError(w, apiErr)
+				return
+			}
+			names[withId] = with
+		}
+		events[i] = apptToIcsEvent(appt, with)
+	}
+
+	encodeCalendar(w, ics.Calendar{Name: name, Location: time.Local, Events: events}, "")
+}
+
+// AppointmentIcs implements api.ServerInterface.
+func (a appointmentServer) AppointmentIcs(
+	w http.ResponseWriter,
+	r *http.Request,
+	appointmentId api.AppointmentId,
+) {
+	ctx := r.Context()
+	appt, err := a.db.AppointmentById(ctx, appointmentId)
+	if errors.Is(err, ErrNotFound) {
+		server.EncodeError(w, server.NotFoundId("Appointment", appointmentId))
+		return
+	} else if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"AppointmentIcs db",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+
+	doctor, apiErr := a.userName(ctx, appt.DoctorId, api.UserRoleDoctor)
+	if apiErr != nil {
+		server.EncodeError(w, apiErr)
+		return
+	}
+	patient, apiErr := a.userName(ctx, appt.PatientId, api.UserRolePatient)
+	if apiErr != nil {
+		server.EncodeError(w, apiErr)
+		return
+	}
+
+	calendar := ics.Calendar{
+		Location: time.Local,
+		Events:   []ics.Event{apptToIcsEvent(appt, doctor+" and "+patient)},
+	}
+	encodeCalendar(w, calendar, fmt.Sprintf("appointment-%s.ics", appointmentId))
+}
+
+// userName returns the display name of the user from the user service, or
+// the last-known one if it is unavailable. Doctors are prefixed with their
+// title.
+func (a appointmentServer) userName(
+	ctx context.Context,
+	userId uuid.UUID,
+	role api.UserRole,
+) (string, *server.ApiError) {
+	if role == api.UserRoleDoctor {
+		res, err := a.userApi.GetDoctorByIdWithResponse(ctx, userId)
+		if err == nil && res.StatusCode() == http.StatusNotFound {
+			return "", server.NotFoundId("Doctor", userId)
+		} else if err != nil || res.JSON200 == nil {
+			doctor, ok := a.readModel.doctors.get(userId)
+			if !ok {
+				slog.ErrorContext(ctx, "failed to get doctor", "error", err, "where", "userName")
+				return "", server.InternalServerError()
+			}
+			return fmt.Sprintf("Dr. %s %s", doctor.FirstName, doctor.LastName), nil
+		}
+		return fmt.Sprintf("Dr. %s %s", res.JSON200.FirstName, res.JSON200.LastName), nil
+	}
+
+	res, err := a.userApi.GetPatientByIdWithResponse(ctx, userId)
+	if err == nil && res.StatusCode() == http.StatusNotFound {
+		return "", server.NotFoundId("Patient", userId)
+	} else if err != nil || res.JSON200 == nil {
+		patient, ok := a.readModel.patients.get(userId)
+		if !ok {
+			slog.ErrorContext(ctx, "failed to get patient", "error", err, "where", "userName")
+			return "", server.InternalServerError()
+		}
+		return fmt.Sprintf("%s %s", patient.FirstName, patient.LastName), nil
+	}
+	return fmt.Sprintf("%s %s", res.JSON200.FirstName, res.JSON200.LastName), nil
+}
+
+// apptToIcsEvent maps the appointment to an event named after its type and
+// the other participant.
+func apptToIcsEvent(appt Appointment, with string) ics.Event {
+	event := ics.Event{
+		Uid:     appt.Id.String() + "@" + calendarUidDomain,
+		Start:   appt.AppointmentDateTime,
+		End:     appt.EndTime,
+		Summary: appointmentTypeLabel(appt.Type) + " - " + with,
+	}
+
+	description := make([]string, 0, 2)
+	if appt.Reason != nil {
+		description = append(description, "Reason: "+*appt.Reason)
+	}
+
+	switch api.AppointmentStatus(appt.Status) {
+	case api.Requested:
+		event.Status = ics.StatusTentative
+	case api.Cancelled:
+		event.Status = ics.StatusCancelled
+		if appt.CancellationReason != nil {
+			description = append(description, "Cancelled: "+*appt.CancellationReason)
+		}
+	case api.Denied:
+		event.Status = ics.StatusCancelled
+		if appt.DenialReason != nil {
+			description = append(description, "Denied: "+*appt.DenialReason)
+		}
+	default:
+		event.Status = ics.StatusConfirmed
+	}
+	event.Description = strings.Join(description, "\n")
+
+	return event
+}
+
+func appointmentTypeLabel(typ string) string {
+	if typ == "" {
+		return "Appointment"
+	}
+	label := strings.ReplaceAll(typ, "_", " ")
+	return strings.ToUpper(label[:1]) + label[1:]
+}
+
+func hashCalendarFeedToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// encodeCalendar writes the calendar, if filename isn't empty as an
+// attachment.
+func encodeCalendar(w http.ResponseWriter, calendar ics.Calendar, filename string) {
+	w.Header().Set(server.ContentType, ics.ContentType)
+	if filename != "" {
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	}
+	w.WriteHeader(http.StatusOK)
+
+	if err := calendar.Encode(w); err != nil {
+		slog.Error(server.UnexpectedError, "error", err.Error(), "where", "encodeCalendar")
+	}
+}
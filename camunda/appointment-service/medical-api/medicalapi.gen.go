@@ -72,6 +72,9 @@ type Condition struct {
 	Id              *openapi_types.UUID   `json:"id,omitempty"`
 	Name            string                `json:"name"`
 	Start           time.Time             `json:"start"`
+
+	// Version Version of the condition, sent back as its ETag.
+	Version int64 `json:"version"`
 }
 
 // ConditionDisplay Basic info about a patient's condition.
@@ -83,6 +86,21 @@ type ConditionDisplay struct {
 	Start           time.Time             `json:"start"`
 }
 
+// ConditionRecord defines model for ConditionRecord.
+type ConditionRecord struct {
+	End       *time.Time         `json:"end,omitempty"`
+	Id        openapi_types.UUID `json:"id"`
+	Name      string             `json:"name"`
+	PatientId openapi_types.UUID `json:"patientId"`
+	Start     time.Time          `json:"start"`
+}
+
+// MedicalRecordsExport defines model for MedicalRecordsExport.
+type MedicalRecordsExport struct {
+	Conditions    []ConditionRecord    `json:"conditions"`
+	Prescriptions []PrescriptionRecord `json:"prescriptions"`
+}
+
 // NewCondition defines model for NewCondition.
 type NewCondition struct {
 	AppointmentsIds *[]openapi_types.UUID `json:"appointmentsIds,omitempty"`
@@ -113,6 +131,9 @@ type Prescription struct {
 	Id            *openapi_types.UUID `json:"id,omitempty"`
 	Name          string              `json:"name"`
 	Start         time.Time           `json:"start"`
+
+	// Version Version of the prescription, sent back as its ETag.
+	Version int64 `json:"version"`
 }
 
 // PrescriptionDisplay Basic info about a patient's condition.
@@ -124,6 +145,18 @@ type PrescriptionDisplay struct {
 	Start         time.Time           `json:"start"`
 }
 
+// PrescriptionRecord defines model for PrescriptionRecord.
+type PrescriptionRecord struct {
+	AppointmentId *openapi_types.UUID `json:"appointmentId,omitempty"`
+	DeletedAt     *time.Time          `json:"deletedAt,omitempty"`
+	DoctorsNote   *string             `json:"doctorsNote,omitempty"`
+	End           time.Time           `json:"end"`
+	Id            openapi_types.UUID  `json:"id"`
+	Name          string              `json:"name"`
+	PatientId     openapi_types.UUID  `json:"patientId"`
+	Start         time.Time           `json:"start"`
+}
+
 // UpdateCondition defines model for UpdateCondition.
 type UpdateCondition struct {
 	End       *time.Time          `json:"end"`
@@ -170,6 +203,27 @@ type Prescriptions struct {
 	Prescriptions []PrescriptionDisplay `json:"prescriptions"`
 }
 
+// AuditEventsParams defines parameters for AuditEvents.
+type AuditEventsParams struct {
+	// TargetId Only events performed on the entity with this ID.
+	TargetId *externalRef0.AuditTargetId `form:"targetId,omitempty" json:"targetId,omitempty"`
+
+	// Actor Only events performed by this actor.
+	Actor *externalRef0.AuditActor `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// From Only events created at or after this instant.
+	From *externalRef0.AuditFrom `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only events created at or before this instant.
+	To *externalRef0.AuditTo `form:"to,omitempty" json:"to,omitempty"`
+
+	// AfterSeq Only events after this position in the audit chain.
+	AfterSeq *externalRef0.AuditAfterSeq `form:"afterSeq,omitempty" json:"afterSeq,omitempty"`
+
+	// Limit Maximum number of returned events, 100 if not set.
+	Limit *externalRef0.AuditLimit `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // ConditionsInDateRangeParams defines parameters for ConditionsInDateRange.
 type ConditionsInDateRangeParams struct {
 	// From The specific day form which to retrieve resources.
@@ -179,6 +233,15 @@ type ConditionsInDateRangeParams struct {
 	To *To `form:"to,omitempty" json:"to,omitempty"`
 }
 
+// UpdateConditionParams defines parameters for UpdateCondition.
+type UpdateConditionParams struct {
+	// IfMatch ETags of the resource the update is based on, separated by commas. The
+	// update is applied only if the resource is at one of them, `*` or no
+	// header at all applies it at any version. ETags are compared strongly, a
+	// weak ETag never matches.
+	IfMatch *externalRef0.IfMatch `json:"If-Match,omitempty"`
+}
+
 // PrescriptionsInDateRangeParams defines parameters for PrescriptionsInDateRange.
 type PrescriptionsInDateRangeParams struct {
 	// From The specific day form which to retrieve resources.
@@ -188,15 +251,30 @@ type PrescriptionsInDateRangeParams struct {
 	To *To `form:"to,omitempty" json:"to,omitempty"`
 }
 
+// UpdatePrescriptionParams defines parameters for UpdatePrescription.
+type UpdatePrescriptionParams struct {
+	// IfMatch ETags of the resource the update is based on, separated by commas. The
+	// update is applied only if the resource is at one of them, `*` or no
+	// header at all applies it at any version. ETags are compared strongly, a
+	// weak ETag never matches.
+	IfMatch *externalRef0.IfMatch `json:"If-Match,omitempty"`
+}
+
 // CreatePatientConditionJSONRequestBody defines body for CreatePatientCondition for application/json ContentType.
 type CreatePatientConditionJSONRequestBody = NewCondition
 
+// GetConditionsBatchJSONRequestBody defines body for GetConditionsBatch for application/json ContentType.
+type GetConditionsBatchJSONRequestBody = externalRef0.BatchIds
+
 // UpdateConditionJSONRequestBody defines body for UpdateCondition for application/json ContentType.
 type UpdateConditionJSONRequestBody = UpdateCondition
 
 // CreatePrescriptionJSONRequestBody defines body for CreatePrescription for application/json ContentType.
 type CreatePrescriptionJSONRequestBody = NewPrescription
 
+// GetPrescriptionsByAppointmentIdsJSONRequestBody defines body for GetPrescriptionsByAppointmentIds for application/json ContentType.
+type GetPrescriptionsByAppointmentIdsJSONRequestBody = externalRef0.BatchIds
+
 // UpdatePrescriptionJSONRequestBody defines body for UpdatePrescription for application/json ContentType.
 type UpdatePrescriptionJSONRequestBody = UpdatePrescription
 
@@ -273,27 +351,43 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// AuditEvents request
+	AuditEvents(ctx context.Context, params *AuditEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CreatePatientConditionWithBody request with any body
 	CreatePatientConditionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	CreatePatientCondition(ctx context.Context, body CreatePatientConditionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetConditionsBatchWithBody request with any body
+	GetConditionsBatchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	GetConditionsBatch(ctx context.Context, body GetConditionsBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ConditionsInDateRange request
 	ConditionsInDateRange(ctx context.Context, patientId PatientId, params *ConditionsInDateRangeParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ExportPatientMedicalRecords request
+	ExportPatientMedicalRecords(ctx context.Context, patientId PatientId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ConditionDetail request
 	ConditionDetail(ctx context.Context, conditionId ConditionId, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// UpdateConditionWithBody request with any body
-	UpdateConditionWithBody(ctx context.Context, conditionId ConditionId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	UpdateConditionWithBody(ctx context.Context, conditionId ConditionId, params *UpdateConditionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	UpdateCondition(ctx context.Context, conditionId ConditionId, body UpdateConditionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	UpdateCondition(ctx context.Context, conditionId ConditionId, params *UpdateConditionParams, body UpdateConditionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CreatePrescriptionWithBody request with any body
 	CreatePrescriptionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	CreatePrescription(ctx context.Context, body CreatePrescriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetPrescriptionsByAppointmentIdsWithBody request with any body
+	GetPrescriptionsByAppointmentIdsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	GetPrescriptionsByAppointmentIds(ctx context.Context, body GetPrescriptionsByAppointmentIdsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetPrescriptionsByAppointmentId request
 	GetPrescriptionsByAppointmentId(ctx context.Context, appointmentId AppointmentId, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -307,9 +401,21 @@ type ClientInterface interface {
 	PrescriptionDetail(ctx context.Context, prescriptionId PrescriptionId, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// UpdatePrescriptionWithBody request with any body
-	UpdatePrescriptionWithBody(ctx context.Context, prescriptionId PrescriptionId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	UpdatePrescriptionWithBody(ctx context.Context, prescriptionId PrescriptionId, params *UpdatePrescriptionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	UpdatePrescription(ctx context.Context, prescriptionId PrescriptionId, body UpdatePrescriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	UpdatePrescription(ctx context.Context, prescriptionId PrescriptionId, params *UpdatePrescriptionParams, body UpdatePrescriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) AuditEvents(ctx context.Context, params *AuditEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuditEventsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
 func (c *Client) CreatePatientConditionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -336,6 +442,30 @@ func (c *Client) CreatePatientCondition(ctx context.Context, body CreatePatientC
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetConditionsBatchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetConditionsBatchRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetConditionsBatch(ctx context.Context, body GetConditionsBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetConditionsBatchRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) ConditionsInDateRange(ctx context.Context, patientId PatientId, params *ConditionsInDateRangeParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewConditionsInDateRangeRequest(c.Server, patientId, params)
 	if err != nil {
@@ -348,6 +478,18 @@ func (c *Client) ConditionsInDateRange(ctx context.Context, patientId PatientId,
 	return c.Client.Do(req)
 }
 
+func (c *Client) ExportPatientMedicalRecords(ctx context.Context, patientId PatientId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportPatientMedicalRecordsRequest(c.Server, patientId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) ConditionDetail(ctx context.Context, conditionId ConditionId, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewConditionDetailRequest(c.Server, conditionId)
 	if err != nil {
@@ -360,8 +502,8 @@ func (c *Client) ConditionDetail(ctx context.Context, conditionId ConditionId, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateConditionWithBody(ctx context.Context, conditionId ConditionId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateConditionRequestWithBody(c.Server, conditionId, contentType, body)
+func (c *Client) UpdateConditionWithBody(ctx context.Context, conditionId ConditionId, params *UpdateConditionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateConditionRequestWithBody(c.Server, conditionId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -372,8 +514,8 @@ func (c *Client) UpdateConditionWithBody(ctx context.Context, conditionId Condit
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateCondition(ctx context.Context, conditionId ConditionId, body UpdateConditionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateConditionRequest(c.Server, conditionId, body)
+func (c *Client) UpdateCondition(ctx context.Context, conditionId ConditionId, params *UpdateConditionParams, body UpdateConditionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateConditionRequest(c.Server, conditionId, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -408,6 +550,30 @@ func (c *Client) CreatePrescription(ctx context.Context, body CreatePrescription
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetPrescriptionsByAppointmentIdsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPrescriptionsByAppointmentIdsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPrescriptionsByAppointmentIds(ctx context.Context, body GetPrescriptionsByAppointmentIdsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPrescriptionsByAppointmentIdsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetPrescriptionsByAppointmentId(ctx context.Context, appointmentId AppointmentId, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetPrescriptionsByAppointmentIdRequest(c.Server, appointmentId)
 	if err != nil {
@@ -456,8 +622,8 @@ func (c *Client) PrescriptionDetail(ctx context.Context, prescriptionId Prescrip
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdatePrescriptionWithBody(ctx context.Context, prescriptionId PrescriptionId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdatePrescriptionRequestWithBody(c.Server, prescriptionId, contentType, body)
+func (c *Client) UpdatePrescriptionWithBody(ctx context.Context, prescriptionId PrescriptionId, params *UpdatePrescriptionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePrescriptionRequestWithBody(c.Server, prescriptionId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -468,8 +634,8 @@ func (c *Client) UpdatePrescriptionWithBody(ctx context.Context, prescriptionId
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdatePrescription(ctx context.Context, prescriptionId PrescriptionId, body UpdatePrescriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdatePrescriptionRequest(c.Server, prescriptionId, body)
+func (c *Client) UpdatePrescription(ctx context.Context, prescriptionId PrescriptionId, params *UpdatePrescriptionParams, body UpdatePrescriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePrescriptionRequest(c.Server, prescriptionId, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -480,6 +646,135 @@ func (c *Client) UpdatePrescription(ctx context.Context, prescriptionId Prescrip
 	return c.Client.Do(req)
 }
 
+// NewAuditEventsRequest generates requests for AuditEvents
+func NewAuditEventsRequest(server string, params *AuditEventsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/audit")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.TargetId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "targetId", runtime.ParamLocationQuery, *params.TargetId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Actor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "actor", runtime.ParamLocationQuery, *params.Actor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.AfterSeq != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "afterSeq", runtime.ParamLocationQuery, *params.AfterSeq); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewCreatePatientConditionRequest calls the generic CreatePatientCondition builder with application/json body
 func NewCreatePatientConditionRequest(server string, body CreatePatientConditionJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
@@ -520,6 +815,46 @@ func NewCreatePatientConditionRequestWithBody(server string, contentType string,
 	return req, nil
 }
 
+// NewGetConditionsBatchRequest calls the generic GetConditionsBatch builder with application/json body
+func NewGetConditionsBatchRequest(server string, body GetConditionsBatchJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewGetConditionsBatchRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewGetConditionsBatchRequestWithBody generates requests for GetConditionsBatch with any type of body
+func NewGetConditionsBatchRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/conditions/batch")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewConditionsInDateRangeRequest generates requests for ConditionsInDateRange
 func NewConditionsInDateRangeRequest(server string, patientId PatientId, params *ConditionsInDateRangeParams) (*http.Request, error) {
 	var err error
@@ -588,6 +923,40 @@ func NewConditionsInDateRangeRequest(server string, patientId PatientId, params
 	return req, nil
 }
 
+// NewExportPatientMedicalRecordsRequest generates requests for ExportPatientMedicalRecords
+func NewExportPatientMedicalRecordsRequest(server string, patientId PatientId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "patientId", runtime.ParamLocationPath, patientId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/conditions/patient/%s/export", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewConditionDetailRequest generates requests for ConditionDetail
 func NewConditionDetailRequest(server string, conditionId ConditionId) (*http.Request, error) {
 	var err error
@@ -623,18 +992,18 @@ func NewConditionDetailRequest(server string, conditionId ConditionId) (*http.Re
 }
 
 // NewUpdateConditionRequest calls the generic UpdateCondition builder with application/json body
-func NewUpdateConditionRequest(server string, conditionId ConditionId, body UpdateConditionJSONRequestBody) (*http.Request, error) {
+func NewUpdateConditionRequest(server string, conditionId ConditionId, params *UpdateConditionParams, body UpdateConditionJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateConditionRequestWithBody(server, conditionId, "application/json", bodyReader)
+	return NewUpdateConditionRequestWithBody(server, conditionId, params, "application/json", bodyReader)
 }
 
 // NewUpdateConditionRequestWithBody generates requests for UpdateCondition with any type of body
-func NewUpdateConditionRequestWithBody(server string, conditionId ConditionId, contentType string, body io.Reader) (*http.Request, error) {
+func NewUpdateConditionRequestWithBody(server string, conditionId ConditionId, params *UpdateConditionParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -666,6 +1035,21 @@ func NewUpdateConditionRequestWithBody(server string, conditionId ConditionId, c
 
 	req.Header.Add("Content-Type", contentType)
 
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
 	return req, nil
 }
 
@@ -709,6 +1093,46 @@ func NewCreatePrescriptionRequestWithBody(server string, contentType string, bod
 	return req, nil
 }
 
+// NewGetPrescriptionsByAppointmentIdsRequest calls the generic GetPrescriptionsByAppointmentIds builder with application/json body
+func NewGetPrescriptionsByAppointmentIdsRequest(server string, body GetPrescriptionsByAppointmentIdsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewGetPrescriptionsByAppointmentIdsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewGetPrescriptionsByAppointmentIdsRequestWithBody generates requests for GetPrescriptionsByAppointmentIds with any type of body
+func NewGetPrescriptionsByAppointmentIdsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/prescriptions/appointment/batch")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewGetPrescriptionsByAppointmentIdRequest generates requests for GetPrescriptionsByAppointmentId
 func NewGetPrescriptionsByAppointmentIdRequest(server string, appointmentId AppointmentId) (*http.Request, error) {
 	var err error
@@ -880,18 +1304,18 @@ func NewPrescriptionDetailRequest(server string, prescriptionId PrescriptionId)
 }
 
 // NewUpdatePrescriptionRequest calls the generic UpdatePrescription builder with application/json body
-func NewUpdatePrescriptionRequest(server string, prescriptionId PrescriptionId, body UpdatePrescriptionJSONRequestBody) (*http.Request, error) {
+func NewUpdatePrescriptionRequest(server string, prescriptionId PrescriptionId, params *UpdatePrescriptionParams, body UpdatePrescriptionJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdatePrescriptionRequestWithBody(server, prescriptionId, "application/json", bodyReader)
+	return NewUpdatePrescriptionRequestWithBody(server, prescriptionId, params, "application/json", bodyReader)
 }
 
 // NewUpdatePrescriptionRequestWithBody generates requests for UpdatePrescription with any type of body
-func NewUpdatePrescriptionRequestWithBody(server string, prescriptionId PrescriptionId, contentType string, body io.Reader) (*http.Request, error) {
+func NewUpdatePrescriptionRequestWithBody(server string, prescriptionId PrescriptionId, params *UpdatePrescriptionParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -921,7 +1345,22 @@ func NewUpdatePrescriptionRequestWithBody(server string, prescriptionId Prescrip
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
 
 	return req, nil
 }
@@ -969,27 +1408,43 @@ func WithBaseURL(baseURL string) ClientOption {
 
 // ClientWithResponsesInterface is the interface specification for the client with responses above.
 type ClientWithResponsesInterface interface {
+	// AuditEventsWithResponse request
+	AuditEventsWithResponse(ctx context.Context, params *AuditEventsParams, reqEditors ...RequestEditorFn) (*AuditEventsResponse, error)
+
 	// CreatePatientConditionWithBodyWithResponse request with any body
 	CreatePatientConditionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePatientConditionResponse, error)
 
 	CreatePatientConditionWithResponse(ctx context.Context, body CreatePatientConditionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePatientConditionResponse, error)
 
+	// GetConditionsBatchWithBodyWithResponse request with any body
+	GetConditionsBatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GetConditionsBatchResponse, error)
+
+	GetConditionsBatchWithResponse(ctx context.Context, body GetConditionsBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*GetConditionsBatchResponse, error)
+
 	// ConditionsInDateRangeWithResponse request
 	ConditionsInDateRangeWithResponse(ctx context.Context, patientId PatientId, params *ConditionsInDateRangeParams, reqEditors ...RequestEditorFn) (*ConditionsInDateRangeResponse, error)
 
+	// ExportPatientMedicalRecordsWithResponse request
+	ExportPatientMedicalRecordsWithResponse(ctx context.Context, patientId PatientId, reqEditors ...RequestEditorFn) (*ExportPatientMedicalRecordsResponse, error)
+
 	// ConditionDetailWithResponse request
 	ConditionDetailWithResponse(ctx context.Context, conditionId ConditionId, reqEditors ...RequestEditorFn) (*ConditionDetailResponse, error)
 
 	// UpdateConditionWithBodyWithResponse request with any body
-	UpdateConditionWithBodyWithResponse(ctx context.Context, conditionId ConditionId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateConditionResponse, error)
+	UpdateConditionWithBodyWithResponse(ctx context.Context, conditionId ConditionId, params *UpdateConditionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateConditionResponse, error)
 
-	UpdateConditionWithResponse(ctx context.Context, conditionId ConditionId, body UpdateConditionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateConditionResponse, error)
+	UpdateConditionWithResponse(ctx context.Context, conditionId ConditionId, params *UpdateConditionParams, body UpdateConditionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateConditionResponse, error)
 
 	// CreatePrescriptionWithBodyWithResponse request with any body
 	CreatePrescriptionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePrescriptionResponse, error)
 
 	CreatePrescriptionWithResponse(ctx context.Context, body CreatePrescriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePrescriptionResponse, error)
 
+	// GetPrescriptionsByAppointmentIdsWithBodyWithResponse request with any body
+	GetPrescriptionsByAppointmentIdsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GetPrescriptionsByAppointmentIdsResponse, error)
+
+	GetPrescriptionsByAppointmentIdsWithResponse(ctx context.Context, body GetPrescriptionsByAppointmentIdsJSONRequestBody, reqEditors ...RequestEditorFn) (*GetPrescriptionsByAppointmentIdsResponse, error)
+
 	// GetPrescriptionsByAppointmentIdWithResponse request
 	GetPrescriptionsByAppointmentIdWithResponse(ctx context.Context, appointmentId AppointmentId, reqEditors ...RequestEditorFn) (*GetPrescriptionsByAppointmentIdResponse, error)
 
@@ -1003,9 +1458,33 @@ type ClientWithResponsesInterface interface {
 	PrescriptionDetailWithResponse(ctx context.Context, prescriptionId PrescriptionId, reqEditors ...RequestEditorFn) (*PrescriptionDetailResponse, error)
 
 	// UpdatePrescriptionWithBodyWithResponse request with any body
-	UpdatePrescriptionWithBodyWithResponse(ctx context.Context, prescriptionId PrescriptionId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePrescriptionResponse, error)
+	UpdatePrescriptionWithBodyWithResponse(ctx context.Context, prescriptionId PrescriptionId, params *UpdatePrescriptionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePrescriptionResponse, error)
+
+	UpdatePrescriptionWithResponse(ctx context.Context, prescriptionId PrescriptionId, params *UpdatePrescriptionParams, body UpdatePrescriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePrescriptionResponse, error)
+}
+
+type AuditEventsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *externalRef0.AuditEvents
+	ApplicationproblemJSON403 *externalRef0.ForbiddenResponse
+	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AuditEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	UpdatePrescriptionWithResponse(ctx context.Context, prescriptionId PrescriptionId, body UpdatePrescriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePrescriptionResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuditEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
 type CreatePatientConditionResponse struct {
@@ -1031,6 +1510,30 @@ func (r CreatePatientConditionResponse) StatusCode() int {
 	return 0
 }
 
+type GetConditionsBatchResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Conditions
+	ApplicationproblemJSON400 *externalRef0.ErrorDetail
+	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetConditionsBatchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetConditionsBatchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type ConditionsInDateRangeResponse struct {
 	Body                      []byte
 	HTTPResponse              *http.Response
@@ -1054,6 +1557,29 @@ func (r ConditionsInDateRangeResponse) StatusCode() int {
 	return 0
 }
 
+type ExportPatientMedicalRecordsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *MedicalRecordsExport
+	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportPatientMedicalRecordsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportPatientMedicalRecordsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type ConditionDetailResponse struct {
 	Body                      []byte
 	HTTPResponse              *http.Response
@@ -1081,6 +1607,7 @@ type UpdateConditionResponse struct {
 	Body                      []byte
 	HTTPResponse              *http.Response
 	JSON200                   *Condition
+	ApplicationproblemJSON412 *externalRef0.PreconditionFailedResponse
 	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
 }
 
@@ -1123,6 +1650,30 @@ func (r CreatePrescriptionResponse) StatusCode() int {
 	return 0
 }
 
+type GetPrescriptionsByAppointmentIdsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Prescriptions
+	ApplicationproblemJSON400 *externalRef0.ErrorDetail
+	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPrescriptionsByAppointmentIdsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPrescriptionsByAppointmentIdsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type GetPrescriptionsByAppointmentIdResponse struct {
 	Body                      []byte
 	HTTPResponse              *http.Response
@@ -1218,6 +1769,7 @@ type UpdatePrescriptionResponse struct {
 	Body                      []byte
 	HTTPResponse              *http.Response
 	JSON200                   *Prescription
+	ApplicationproblemJSON412 *externalRef0.PreconditionFailedResponse
 	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
 }
 
@@ -1237,6 +1789,15 @@ func (r UpdatePrescriptionResponse) StatusCode() int {
 	return 0
 }
 
+// AuditEventsWithResponse request returning *AuditEventsResponse
+func (c *ClientWithResponses) AuditEventsWithResponse(ctx context.Context, params *AuditEventsParams, reqEditors ...RequestEditorFn) (*AuditEventsResponse, error) {
+	rsp, err := c.AuditEvents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuditEventsResponse(rsp)
+}
+
 // CreatePatientConditionWithBodyWithResponse request with arbitrary body returning *CreatePatientConditionResponse
 func (c *ClientWithResponses) CreatePatientConditionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePatientConditionResponse, error) {
 	rsp, err := c.CreatePatientConditionWithBody(ctx, contentType, body, reqEditors...)
@@ -1254,6 +1815,23 @@ func (c *ClientWithResponses) CreatePatientConditionWithResponse(ctx context.Con
 	return ParseCreatePatientConditionResponse(rsp)
 }
 
+// GetConditionsBatchWithBodyWithResponse request with arbitrary body returning *GetConditionsBatchResponse
+func (c *ClientWithResponses) GetConditionsBatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GetConditionsBatchResponse, error) {
+	rsp, err := c.GetConditionsBatchWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetConditionsBatchResponse(rsp)
+}
+
+func (c *ClientWithResponses) GetConditionsBatchWithResponse(ctx context.Context, body GetConditionsBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*GetConditionsBatchResponse, error) {
+	rsp, err := c.GetConditionsBatch(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetConditionsBatchResponse(rsp)
+}
+
 // ConditionsInDateRangeWithResponse request returning *ConditionsInDateRangeResponse
 func (c *ClientWithResponses) ConditionsInDateRangeWithResponse(ctx context.Context, patientId PatientId, params *ConditionsInDateRangeParams, reqEditors ...RequestEditorFn) (*ConditionsInDateRangeResponse, error) {
 	rsp, err := c.ConditionsInDateRange(ctx, patientId, params, reqEditors...)
@@ -1263,6 +1841,15 @@ func (c *ClientWithResponses) ConditionsInDateRangeWithResponse(ctx context.Cont
 	return ParseConditionsInDateRangeResponse(rsp)
 }
 
+// ExportPatientMedicalRecordsWithResponse request returning *ExportPatientMedicalRecordsResponse
+func (c *ClientWithResponses) ExportPatientMedicalRecordsWithResponse(ctx context.Context, patientId PatientId, reqEditors ...RequestEditorFn) (*ExportPatientMedicalRecordsResponse, error) {
+	rsp, err := c.ExportPatientMedicalRecords(ctx, patientId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportPatientMedicalRecordsResponse(rsp)
+}
+
 // ConditionDetailWithResponse request returning *ConditionDetailResponse
 func (c *ClientWithResponses) ConditionDetailWithResponse(ctx context.Context, conditionId ConditionId, reqEditors ...RequestEditorFn) (*ConditionDetailResponse, error) {
 	rsp, err := c.ConditionDetail(ctx, conditionId, reqEditors...)
@@ -1273,16 +1860,16 @@ func (c *ClientWithResponses) ConditionDetailWithResponse(ctx context.Context, c
 }
 
 // UpdateConditionWithBodyWithResponse request with arbitrary body returning *UpdateConditionResponse
-func (c *ClientWithResponses) UpdateConditionWithBodyWithResponse(ctx context.Context, conditionId ConditionId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateConditionResponse, error) {
-	rsp, err := c.UpdateConditionWithBody(ctx, conditionId, contentType, body, reqEditors...)
+func (c *ClientWithResponses) UpdateConditionWithBodyWithResponse(ctx context.Context, conditionId ConditionId, params *UpdateConditionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateConditionResponse, error) {
+	rsp, err := c.UpdateConditionWithBody(ctx, conditionId, params, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
 	return ParseUpdateConditionResponse(rsp)
 }
 
-func (c *ClientWithResponses) UpdateConditionWithResponse(ctx context.Context, conditionId ConditionId, body UpdateConditionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateConditionResponse, error) {
-	rsp, err := c.UpdateCondition(ctx, conditionId, body, reqEditors...)
+func (c *ClientWithResponses) UpdateConditionWithResponse(ctx context.Context, conditionId ConditionId, params *UpdateConditionParams, body UpdateConditionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateConditionResponse, error) {
+	rsp, err := c.UpdateCondition(ctx, conditionId, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -1306,6 +1893,23 @@ func (c *ClientWithResponses) CreatePrescriptionWithResponse(ctx context.Context
 	return ParseCreatePrescriptionResponse(rsp)
 }
 
+// GetPrescriptionsByAppointmentIdsWithBodyWithResponse request with arbitrary body returning *GetPrescriptionsByAppointmentIdsResponse
+func (c *ClientWithResponses) GetPrescriptionsByAppointmentIdsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GetPrescriptionsByAppointmentIdsResponse, error) {
+	rsp, err := c.GetPrescriptionsByAppointmentIdsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPrescriptionsByAppointmentIdsResponse(rsp)
+}
+
+func (c *ClientWithResponses) GetPrescriptionsByAppointmentIdsWithResponse(ctx context.Context, body GetPrescriptionsByAppointmentIdsJSONRequestBody, reqEditors ...RequestEditorFn) (*GetPrescriptionsByAppointmentIdsResponse, error) {
+	rsp, err := c.GetPrescriptionsByAppointmentIds(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPrescriptionsByAppointmentIdsResponse(rsp)
+}
+
 // GetPrescriptionsByAppointmentIdWithResponse request returning *GetPrescriptionsByAppointmentIdResponse
 func (c *ClientWithResponses) GetPrescriptionsByAppointmentIdWithResponse(ctx context.Context, appointmentId AppointmentId, reqEditors ...RequestEditorFn) (*GetPrescriptionsByAppointmentIdResponse, error) {
 	rsp, err := c.GetPrescriptionsByAppointmentId(ctx, appointmentId, reqEditors...)
@@ -1343,22 +1947,62 @@ func (c *ClientWithResponses) PrescriptionDetailWithResponse(ctx context.Context
 }
 
 // UpdatePrescriptionWithBodyWithResponse request with arbitrary body returning *UpdatePrescriptionResponse
-func (c *ClientWithResponses) UpdatePrescriptionWithBodyWithResponse(ctx context.Context, prescriptionId PrescriptionId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePrescriptionResponse, error) {
-	rsp, err := c.UpdatePrescriptionWithBody(ctx, prescriptionId, contentType, body, reqEditors...)
+func (c *ClientWithResponses) UpdatePrescriptionWithBodyWithResponse(ctx context.Context, prescriptionId PrescriptionId, params *UpdatePrescriptionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePrescriptionResponse, error) {
+	rsp, err := c.UpdatePrescriptionWithBody(ctx, prescriptionId, params, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
 	return ParseUpdatePrescriptionResponse(rsp)
 }
 
-func (c *ClientWithResponses) UpdatePrescriptionWithResponse(ctx context.Context, prescriptionId PrescriptionId, body UpdatePrescriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePrescriptionResponse, error) {
-	rsp, err := c.UpdatePrescription(ctx, prescriptionId, body, reqEditors...)
+func (c *ClientWithResponses) UpdatePrescriptionWithResponse(ctx context.Context, prescriptionId PrescriptionId, params *UpdatePrescriptionParams, body UpdatePrescriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePrescriptionResponse, error) {
+	rsp, err := c.UpdatePrescription(ctx, prescriptionId, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
 	return ParseUpdatePrescriptionResponse(rsp)
 }
 
+// ParseAuditEventsResponse parses an HTTP response from a AuditEventsWithResponse call
+func ParseAuditEventsResponse(rsp *http.Response) (*AuditEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuditEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest externalRef0.AuditEvents
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest externalRef0.ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseCreatePatientConditionResponse parses an HTTP response from a CreatePatientConditionWithResponse call
 func ParseCreatePatientConditionResponse(rsp *http.Response) (*CreatePatientConditionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -1392,6 +2036,46 @@ func ParseCreatePatientConditionResponse(rsp *http.Response) (*CreatePatientCond
 	return response, nil
 }
 
+// ParseGetConditionsBatchResponse parses an HTTP response from a GetConditionsBatchWithResponse call
+func ParseGetConditionsBatchResponse(rsp *http.Response) (*GetConditionsBatchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetConditionsBatchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Conditions
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.ErrorDetail
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseConditionsInDateRangeResponse parses an HTTP response from a ConditionsInDateRangeWithResponse call
 func ParseConditionsInDateRangeResponse(rsp *http.Response) (*ConditionsInDateRangeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -1425,6 +2109,39 @@ func ParseConditionsInDateRangeResponse(rsp *http.Response) (*ConditionsInDateRa
 	return response, nil
 }
 
+// ParseExportPatientMedicalRecordsResponse parses an HTTP response from a ExportPatientMedicalRecordsWithResponse call
+func ParseExportPatientMedicalRecordsResponse(rsp *http.Response) (*ExportPatientMedicalRecordsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportPatientMedicalRecordsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MedicalRecordsExport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseConditionDetailResponse parses an HTTP response from a ConditionDetailWithResponse call
 func ParseConditionDetailResponse(rsp *http.Response) (*ConditionDetailResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -1479,6 +2196,13 @@ func ParseUpdateConditionResponse(rsp *http.Response) (*UpdateConditionResponse,
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest externalRef0.PreconditionFailedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest externalRef0.InternalServerErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -1524,6 +2248,46 @@ func ParseCreatePrescriptionResponse(rsp *http.Response) (*CreatePrescriptionRes
 	return response, nil
 }
 
+// ParseGetPrescriptionsByAppointmentIdsResponse parses an HTTP response from a GetPrescriptionsByAppointmentIdsWithResponse call
+func ParseGetPrescriptionsByAppointmentIdsResponse(rsp *http.Response) (*GetPrescriptionsByAppointmentIdsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPrescriptionsByAppointmentIdsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Prescriptions
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.ErrorDetail
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetPrescriptionsByAppointmentIdResponse parses an HTTP response from a GetPrescriptionsByAppointmentIdWithResponse call
 func ParseGetPrescriptionsByAppointmentIdResponse(rsp *http.Response) (*GetPrescriptionsByAppointmentIdResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -1670,6 +2434,13 @@ func ParseUpdatePrescriptionResponse(rsp *http.Response) (*UpdatePrescriptionRes
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest externalRef0.PreconditionFailedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest externalRef0.InternalServerErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -0,0 +1,299 @@
+package main
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/viper"
+
+	resourceapi "github.com/Nesquiko/aass/appointment-service/resources-api"
+	userapi "github.com/Nesquiko/aass/appointment-service/user-api"
+)
+
+// StaleFieldsHeader lists fields of the response filled from the read model,
+// because the service owning them was unavailable.
+const StaleFieldsHeader = "X-Stale-Fields"
+
+type readModelConfig struct {
+	// MaxAge is for how long a last-known user or resource may be served.
+	MaxAge time.Duration `mapstructure:"max_age"`
+	// MaxEntries bounds each of the cached users and resources, the least
+	// recently used are evicted first.
+	MaxEntries   int           `mapstructure:"max_entries"`
+	SyncInterval time.Duration `mapstructure:"sync_interval"`
+}
+
+// loadReadModelConfig reads the `<envPrefix>_READ_MODEL_*` environment
+// variables.
+func loadReadModelConfig(envPrefix string) (readModelConfig, error) {
+	v := viper.New()
+
+	v.SetEnvPrefix(envPrefix)
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	v.AutomaticEnv()
+
+	v.SetDefault("read_model.max_age", 24*time.Hour)
+	v.SetDefault("read_model.max_entries", 10_000)
+	v.SetDefault("read_model.sync_interval", 5*time.Minute)
+
+	var cfg struct {
+		ReadModel readModelConfig `mapstructure:"read_model"`
+	}
+	err := v.Unmarshal(&cfg)
+	if err != nil {
+		return readModelConfig{}, fmt.Errorf("loadReadModelConfig failed to unmarshal: %w", err)
+	}
+
+	return cfg.ReadModel, nil
+}
+
+// readModel keeps the last-known patients, doctors and resources, so
+// appointments can be rendered while user- or resource-service is down.
+type readModel struct {
+	patients  *lruCache[userapi.Patient]
+	doctors   *lruCache[userapi.Doctor]
+	resources *lruCache[resourceapi.NewResource]
+}
+
+func newReadModel(cfg readModelConfig) *readModel {
+	return &readModel{
+		patients:  newLruCache[userapi.Patient](cfg.MaxEntries, cfg.MaxAge),
+		doctors:   newLruCache[userapi.Doctor](cfg.MaxEntries, cfg.MaxAge),
+		resources: newLruCache[resourceapi.NewResource](cfg.MaxEntries, cfg.MaxAge),
+	}
+}
+
+// syncEvery refreshes the cached users and resources every interval, until
+// the context is done. Those which no longer exist are evicted.
+func (a appointmentServer) syncEvery(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			a.syncReadModel(ctx)
+		}
+	}
+}
+
+func (a appointmentServer) syncReadModel(ctx context.Context) {
+	userIds := slices.Concat(a.readModel.patients.keys(), a.readModel.doctors.keys())
+	for chunk := range slices.Chunk(userIds, batchSize) {
+		res, err := a.userApi.GetUsersBatchWithResponse(
+			ctx,
+			userapi.GetUsersBatchJSONRequestBody{Ids: chunk},
+		)
+		if err != nil {
+			slog.WarnContext(ctx, "failed to sync users of read model", "error", err)
+			return
+		} else if res.JSON200 == nil {
+			slog.WarnContext(ctx, "failed to sync users of read model", "status", res.StatusCode())
+			return
+		}
+
+		found := make(map[uuid.UUID]struct{}, len(chunk))
+		for _, p := range res.JSON200.Patients {
+			a.readModel.patients.put(p.Id, p)
+			found[p.Id] = struct{}{}
+		}
+		for _, d := range res.JSON200.Doctors {
+			a.readModel.doctors.put(d.Id, d)
+			found[d.Id] = struct{}{}
+		}
+		for _, id := range chunk {
+			if _, ok := found[id]; !ok {
+				a.readModel.patients.remove(id)
+				a.readModel.doctors.remove(id)
+			}
+		}
+	}
+
+	for chunk := range slices.Chunk(a.readModel.resources.keys(), batchSize) {
+		res, err := a.resourceApi.GetResourcesBatchWithResponse(
+			ctx,
+			resourceapi.GetResourcesBatchJSONRequestBody{Ids: chunk},
+		)
+		if err != nil {
+			slog.WarnContext(ctx, "failed to sync resources of read model", "error", err)
+			return
+		} else if res.JSON200 == nil {
+			slog.WarnContext(
+				ctx,
+				"failed to sync resources of read model",
+				"status",
+				res.StatusCode(),
+			)
+			return
+		}
+
+		found := make(map[uuid.UUID]struct{}, len(chunk))
+		for _, r := range res.JSON200.Resources {
+			a.readModel.resources.put(*r.Id, r)
+			found[*r.Id] = struct{}{}
+		}
+		for _, id := range chunk {
+			if _, ok := found[id]; !ok {
+				a.readModel.resources.remove(id)
+			}
+		}
+	}
+}
+
+// lruCache holds at most maxEntries values for at most maxAge since they
+// were put, evicting the least recently used ones first.
+type lruCache[V any] struct {
+	maxEntries int
+	maxAge     time.Duration
+
+	mu      sync.Mutex
+	entries map[uuid.UUID]*list.Element
+	// order has the most recently used entry at its front
+	order *list.List
+}
+
+type lruEntry[V any] struct {
+	key   uuid.UUID
+	value V
+	putAt time.Time
+}
+
+func newLruCache[V any](maxEntries int, maxAge time.Duration) *lruCache[V] {
+	return &lruCache[V]{
+		maxEntries: maxEntries,
+		maxAge:     maxAge,
+		entries:    make(map[uuid.UUID]*list.Element),
+		order:      list.New(),
+	}
+}
+
+func (c *lruCache[V]) put(key uuid.UUID, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &lruEntry[V]{key: key, value: value, putAt: time.Now()}
+	if el, ok := c.entries[key]; ok {
+		el.Value = entry
+		c.order.MoveToFront(el)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(entry)
+	for c.order.Len() > c.maxEntries {
+		c.removeElement(c.order.Back())
+	}
+}
+
+func (c *lruCache[V]) get(key uuid.UUID) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	entry := el.Value.(*lruEntry[V])
+	if time.Since(entry.putAt) > c.maxAge {
+		c.removeElement(el)
+		var zero V
+		return zero, false
+	}
+
+	c.order.MoveToFront(el)
+	return entry.value, true
+}
+
+func (c *lruCache[V]) remove(key uuid.UUID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.entries[key]; ok {
+		c.removeElement(el)
+	}
+}
+
+func (c *lruCache[V]) keys() []uuid.UUID {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	keys := make([]uuid.UUID, 0, len(c.entries))
+	for key := range c.entries {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func (c *lruCache[V]) removeElement(el *list.Element) {
+	c.order.Remove(el)
+	delete(c.entries, el.Value.(*lruEntry[V]).key)
+}
+
+type staleFieldsKey struct{}
+
+// staleFields collects fields of the response filled from the read model.
+type staleFields struct {
+	mu     sync.Mutex
+	fields []string
+}
+
+// markStale records that the fields of the response being served are stale.
+func markStale(ctx context.Context, fields ...string) {
+	stale, ok := ctx.Value(staleFieldsKey{}).(*staleFields)
+	if !ok {
+		return
+	}
+
+	stale.mu.Lock()
+	defer stale.mu.Unlock()
+	for _, field := range fields {
+		if !slices.Contains(stale.fields, field) {
+			stale.fields = append(stale.fields, field)
+		}
+	}
+}
+
+// staleFieldsMiddleware sets the StaleFieldsHeader on responses with fields
+// marked as stale while they were being served.
+func staleFieldsMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		stale := &staleFields{}
+		ctx := context.WithValue(r.Context(), staleFieldsKey{}, stale)
+		next.ServeHTTP(&staleFieldsWriter{ResponseWriter: w, stale: stale}, r.WithContext(ctx))
+	})
+}
+
+type staleFieldsWriter struct {
+	http.ResponseWriter
+	stale       *staleFields
+	wroteHeader bool
+}
+
+func (w *staleFieldsWriter) WriteHeader(statusCode int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		w.stale.mu.Lock()
+		if len(w.stale.fields) > 0 {
+			w.Header().Set(StaleFieldsHeader, strings.Join(w.stale.fields, ","))
+		}
+		w.stale.mu.Unlock()
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *staleFieldsWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(b)
+}
@@ -111,6 +111,12 @@ type Doctors struct {
 	Doctors []Doctor `json:"doctors"`
 }
 
+// UsersBatch defines model for UsersBatch.
+type UsersBatch struct {
+	Doctors  []Doctor  `json:"doctors"`
+	Patients []Patient `json:"patients"`
+}
+
 // LoginUserJSONBody defines parameters for LoginUser.
 type LoginUserJSONBody struct {
 	// Email User's email address.
@@ -124,6 +130,9 @@ type LoginUserJSONRequestBody LoginUserJSONBody
 // RegisterUserJSONRequestBody defines body for RegisterUser for application/json ContentType.
 type RegisterUserJSONRequestBody = Registration
 
+// GetUsersBatchJSONRequestBody defines body for GetUsersBatch for application/json ContentType.
+type GetUsersBatchJSONRequestBody = externalRef0.BatchIds
+
 // AsPatientRegistration returns the union data inside the Registration as a PatientRegistration
 func (t Registration) AsPatientRegistration() (PatientRegistration, error) {
 	var body PatientRegistration
@@ -393,6 +402,11 @@ type ClientInterface interface {
 
 	// GetPatientById request
 	GetPatientById(ctx context.Context, patientId PatientId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUsersBatchWithBody request with any body
+	GetUsersBatchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	GetUsersBatch(ctx context.Context, body GetUsersBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) LoginUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -479,6 +493,30 @@ func (c *Client) GetPatientById(ctx context.Context, patientId PatientId, reqEdi
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetUsersBatchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersBatchRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUsersBatch(ctx context.Context, body GetUsersBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersBatchRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 // NewLoginUserRequest calls the generic LoginUser builder with application/json body
 func NewLoginUserRequest(server string, body LoginUserJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
@@ -654,6 +692,46 @@ func NewGetPatientByIdRequest(server string, patientId PatientId) (*http.Request
 	return req, nil
 }
 
+// NewGetUsersBatchRequest calls the generic GetUsersBatch builder with application/json body
+func NewGetUsersBatchRequest(server string, body GetUsersBatchJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewGetUsersBatchRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewGetUsersBatchRequestWithBody generates requests for GetUsersBatch with any type of body
+func NewGetUsersBatchRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/batch")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
 	for _, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
@@ -715,6 +793,11 @@ type ClientWithResponsesInterface interface {
 
 	// GetPatientByIdWithResponse request
 	GetPatientByIdWithResponse(ctx context.Context, patientId PatientId, reqEditors ...RequestEditorFn) (*GetPatientByIdResponse, error)
+
+	// GetUsersBatchWithBodyWithResponse request with any body
+	GetUsersBatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GetUsersBatchResponse, error)
+
+	GetUsersBatchWithResponse(ctx context.Context, body GetUsersBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*GetUsersBatchResponse, error)
 }
 
 type LoginUserResponse struct {
@@ -836,6 +919,30 @@ func (r GetPatientByIdResponse) StatusCode() int {
 	return 0
 }
 
+type GetUsersBatchResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *UsersBatch
+	ApplicationproblemJSON400 *externalRef0.ErrorDetail
+	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersBatchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersBatchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 // LoginUserWithBodyWithResponse request with arbitrary body returning *LoginUserResponse
 func (c *ClientWithResponses) LoginUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginUserResponse, error) {
 	rsp, err := c.LoginUserWithBody(ctx, contentType, body, reqEditors...)
@@ -897,6 +1004,23 @@ func (c *ClientWithResponses) GetPatientByIdWithResponse(ctx context.Context, pa
 	return ParseGetPatientByIdResponse(rsp)
 }
 
+// GetUsersBatchWithBodyWithResponse request with arbitrary body returning *GetUsersBatchResponse
+func (c *ClientWithResponses) GetUsersBatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GetUsersBatchResponse, error) {
+	rsp, err := c.GetUsersBatchWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersBatchResponse(rsp)
+}
+
+func (c *ClientWithResponses) GetUsersBatchWithResponse(ctx context.Context, body GetUsersBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*GetUsersBatchResponse, error) {
+	rsp, err := c.GetUsersBatch(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersBatchResponse(rsp)
+}
+
 // ParseLoginUserResponse parses an HTTP response from a LoginUserWithResponse call
 func ParseLoginUserResponse(rsp *http.Response) (*LoginUserResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -1089,3 +1213,43 @@ func ParseGetPatientByIdResponse(rsp *http.Response) (*GetPatientByIdResponse, e
 
 	return response, nil
 }
+
+// ParseGetUsersBatchResponse parses an HTTP response from a GetUsersBatchWithResponse call
+func ParseGetUsersBatchResponse(rsp *http.Response) (*GetUsersBatchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersBatchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UsersBatch
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.ErrorDetail
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
@@ -4,24 +4,31 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
+	_ "time/tzdata"
 
 	camunda_client_go "github.com/citilinkru/camunda-client-go/v3"
 	"github.com/citilinkru/camunda-client-go/v3/processor"
 	"github.com/google/uuid"
 
+	appointmentapi "github.com/Nesquiko/aass/camunda-worker/appointment-api"
 	resourceapi "github.com/Nesquiko/aass/camunda-worker/resources-api"
+	"github.com/Nesquiko/aass/common/mongodb"
+	"github.com/Nesquiko/aass/common/notify"
 	"github.com/Nesquiko/aass/common/server"
 )
 
 const (
-	camundaRestURL = "http://camunda-platform:8080/engine-rest" // Your Camunda REST endpoint URL
-	workerID       = "resource-reservation-worker"
-	topicName      = "appointment-reserve-resources"
+	camundaRestURL  = "http://camunda-platform:8080/engine-rest" // Your Camunda REST endpoint URL
+	serviceName     = "camunda-worker"
+	workerID        = "resource-reservation-worker"
+	workerEnvPrefix = "CAMUNDAWORKER"
+	topicName       = "appointment-reserve-resources"
 
 	lockDuration              = 5 * time.Second // How long the task is locked for this worker
 	maxTasks                  = 10              // How many tasks to fetch at once
@@ -43,12 +50,68 @@ type ReserveResourcesRequestBody struct {
 func main() {
 	slog.Info("Starting Camunda External Task Worker", "workerId", workerID, "topic", topicName)
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cfg, err := server.LoadConfig(workerEnvPrefix)
+	if err != nil {
+		slog.Error("failed to read config", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+
+	shutdownTracing, err := server.SetupTracing(ctx, serviceName, cfg.Tracing)
+	if err != nil {
+		slog.Error("failed to setup tracing", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	defer shutdownTracing(context.Background())
+
+	go server.ServeMonitoring(ctx, net.JoinHostPort(cfg.App.Host, cfg.App.Port))
+
+	loc, err := time.LoadLocation(cfg.App.Timezone)
+	if err != nil {
+		slog.Error("failed to load timezone", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	time.Local = loc
+
+	notifyCfg, err := notify.LoadConfig(workerEnvPrefix)
+	if err != nil {
+		slog.Error("failed to read notify config", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	sender, err := notify.NewSender(notifyCfg)
+	if err != nil {
+		slog.Error("failed to create notification sender", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+
+	db, err := mongodb.ConnectMongo(ctx, cfg.MongoURI(), cfg.Mongo.Db)
+	if err != nil {
+		slog.Error("failed to connect to database", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	defer db.Client().Disconnect(context.Background())
+	server.RegisterHealthCheck(server.MongoCheck(func(ctx context.Context) error {
+		return db.Client().Ping(ctx, nil)
+	}))
+
+	store := notify.NewMongoStore(ctx, db)
+	dispatcher := notify.NewDispatcher(
+		store,
+		sender,
+		notifyCfg.PollInterval,
+		notifyCfg.MaxAttempts,
+	)
+	go dispatcher.Run(ctx)
+
 	client := camunda_client_go.NewClient(camunda_client_go.ClientOptions{
 		EndpointUrl: camundaRestURL,
 		ApiUser:     "demo",
 		ApiPassword: "demo",
 		Timeout:     time.Second * 15,
 	})
+	server.RegisterHealthCheck(server.CamundaCheck(camundaRestURL))
 
 	proc := processor.NewProcessor(client, &processor.Options{
 		WorkerId:                  workerID,
@@ -61,19 +124,48 @@ func main() {
 		slog.Error("Camunda Processor Error", "error", err)
 	})
 
-	resourceClient, _ := resourceapi.NewClientWithResponses("http://resource-service:8080/")
+	clients, err := server.NewClients(cfg.Clients)
+	if err != nil {
+		slog.Error("failed to create clients", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	resourceUrl, resourceHttpClient := clients.Client(server.ResourceService)
+	resourceClient, err := resourceapi.NewClientWithResponses(
+		resourceUrl,
+		resourceapi.WithHTTPClient(resourceHttpClient),
+	)
+	if err != nil {
+		slog.Error("failed to create resource client", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
 
 	proc.AddHandler(
 		[]*camunda_client_go.QueryFetchAndLockTopic{
 			{TopicName: topicName, LockDuration: int(lockDuration.Milliseconds())},
 		},
-		func(ctx *processor.Context) error {
+		observeTask(func(ctx *processor.Context) error {
 			return handleReserveResources(ctx, resourceClient)
-		},
+		}),
 	)
 
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+	apptUrl, apptHttpClient := clients.Client(server.AppointmentService)
+	appointmentClient, err := appointmentapi.NewClientWithResponses(
+		apptUrl,
+		appointmentapi.WithHTTPClient(apptHttpClient),
+	)
+	if err != nil {
+		slog.Error("failed to create appointment client", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+
+	proc.AddHandler(
+		[]*camunda_client_go.QueryFetchAndLockTopic{
+			{TopicName: notifyTopicName, LockDuration: int(lockDuration.Milliseconds())},
+		},
+		observeTask(func(ctx *processor.Context) error {
+			return handleNotify(ctx, appointmentClient, store)
+		}),
+	)
 
 	sigint := make(chan os.Signal, 1)
 	signal.Notify(sigint, os.Interrupt, syscall.SIGTERM)
@@ -95,6 +187,9 @@ func handleReserveResources(
 	ctx *processor.Context,
 	resourceClient *resourceapi.ClientWithResponses,
 ) error {
+	traceCtx, span := server.StartTaskSpan(context.Background(), ctx.Task)
+	defer span.End()
+
 	slog.Info("Processing task",
 		"taskId", ctx.Task.Id,
 		"topicName", ctx.Task.TopicName,
@@ -104,7 +199,7 @@ func handleReserveResources(
 	appointmentIdVar, ok := ctx.Task.Variables["appointmentId"]
 	if !ok || appointmentIdVar.Value == nil {
 		slog.Error("Missing 'appointmentId' variable", "taskId", ctx.Task.Id)
-		return ctx.HandleFailure(processor.QueryHandleFailure{
+		return handleFailure(ctx, processor.QueryHandleFailure{
 			ErrorMessage: server.AsPtr("Missing 'appointmentId' variable"),
 			Retries:      server.AsPtr(0),
 		})
@@ -118,7 +213,7 @@ func handleReserveResources(
 			"type",
 			fmt.Sprintf("%T", appointmentIdVar.Value),
 		)
-		return ctx.HandleFailure(processor.QueryHandleFailure{
+		return handleFailure(ctx, processor.QueryHandleFailure{
 			ErrorMessage: server.AsPtr("Invalid type for 'appointmentId', expected string UUID"),
 			Retries:      server.AsPtr(0),
 		})
@@ -135,7 +230,7 @@ func handleReserveResources(
 			"error",
 			err,
 		)
-		return ctx.HandleFailure(processor.QueryHandleFailure{
+		return handleFailure(ctx, processor.QueryHandleFailure{
 			ErrorMessage: server.AsPtr(fmt.Sprintf("Invalid format for 'appointmentId': %v", err)),
 			Retries:      server.AsPtr(0),
 		})
@@ -144,7 +239,7 @@ func handleReserveResources(
 	appointmentDateTimeVar, ok := ctx.Task.Variables["appointmentDateTime"]
 	if !ok || appointmentDateTimeVar.Value == nil {
 		slog.Error("Missing 'appointmentDateTime' variable", "taskId", ctx.Task.Id)
-		return ctx.HandleFailure(processor.QueryHandleFailure{
+		return handleFailure(ctx, processor.QueryHandleFailure{
 			ErrorMessage: server.AsPtr("Missing 'appointmentDateTime' variable"),
 			Retries:      server.AsPtr(0),
 		})
@@ -157,7 +252,7 @@ func handleReserveResources(
 		parsedTime, parseErr := time.Parse(time.RFC3339, v)
 		if parseErr != nil {
 			slog.Error("Failed to parse 'appointmentDateTime' string", "taskId", ctx.Task.Id, "value", v, "error", parseErr)
-			return ctx.HandleFailure(processor.QueryHandleFailure{
+			return handleFailure(ctx, processor.QueryHandleFailure{
 				ErrorMessage: server.AsPtr(fmt.Sprintf("Failed to parse 'appointmentDateTime' string: %v", parseErr)),
 				Retries:      server.AsPtr(0),
 			})
@@ -165,7 +260,7 @@ func handleReserveResources(
 		appointmentTime = parsedTime
 	default:
 		slog.Error("Invalid type for 'appointmentDateTime'", "taskId", ctx.Task.Id, "type", fmt.Sprintf("%T", appointmentDateTimeVar.Value))
-		return ctx.HandleFailure(processor.QueryHandleFailure{
+		return handleFailure(ctx, processor.QueryHandleFailure{
 			ErrorMessage: server.AsPtr("Invalid type for 'appointmentDateTime', expected time.Time or string"),
 			Retries:      server.AsPtr(0),
 		})
@@ -185,7 +280,7 @@ func handleReserveResources(
 					"error",
 					err,
 				)
-				return ctx.HandleFailure(processor.QueryHandleFailure{
+				return handleFailure(ctx, processor.QueryHandleFailure{
 					ErrorMessage: server.AsPtr(
 						fmt.Sprintf("Invalid format for 'facilityId': %v", err),
 					),
@@ -210,7 +305,7 @@ func handleReserveResources(
 					"error",
 					err,
 				)
-				return ctx.HandleFailure(processor.QueryHandleFailure{
+				return handleFailure(ctx, processor.QueryHandleFailure{
 					ErrorMessage: server.AsPtr(
 						fmt.Sprintf("Invalid format for 'equipmentId': %v", err),
 					),
@@ -235,7 +330,7 @@ func handleReserveResources(
 					"error",
 					err,
 				)
-				return ctx.HandleFailure(processor.QueryHandleFailure{
+				return handleFailure(ctx, processor.QueryHandleFailure{
 					ErrorMessage: server.AsPtr(
 						fmt.Sprintf("Invalid format for 'medicineId': %v", err),
 					),
@@ -253,7 +348,7 @@ func handleReserveResources(
 		Start:       appointmentTime,
 	}
 	res, err := resourceClient.ReserveAppointmentResourcesWithResponse(
-		context.Background(),
+		traceCtx,
 		apptUUID,
 		request,
 	)
@@ -265,7 +360,7 @@ func handleReserveResources(
 			"where",
 			"DecideAppointment reserve resources api call",
 		)
-		return ctx.HandleFailure(processor.QueryHandleFailure{
+		return handleFailure(ctx, processor.QueryHandleFailure{
 			ErrorMessage: server.AsPtr(fmt.Sprintf("Failed to send reservation: %v", err)),
 			Retries:      server.AsPtr(0),
 		})
@@ -274,7 +369,7 @@ func handleReserveResources(
 	if res.StatusCode() == http.StatusNoContent {
 		err = ctx.Complete(processor.QueryComplete{})
 		if err != nil {
-			return ctx.HandleFailure(processor.QueryHandleFailure{
+			return handleFailure(ctx, processor.QueryHandleFailure{
 				ErrorMessage: server.AsPtr(fmt.Sprintf("failed to complete Camunda task: %v", err)),
 				Retries:      server.AsPtr(0),
 			})
@@ -282,7 +377,7 @@ func handleReserveResources(
 		return nil
 	}
 
-	return ctx.HandleFailure(processor.QueryHandleFailure{
+	return handleFailure(ctx, processor.QueryHandleFailure{
 		ErrorMessage: server.AsPtr(fmt.Sprintf("Failed to reserve resources: %v", err)),
 		Retries:      server.AsPtr(0),
 	})
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"time"
+
+	"github.com/citilinkru/camunda-client-go/v3/processor"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	taskDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "camunda_task_duration_seconds",
+		Help:    "Duration of handling external tasks by topic.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"topic"})
+	taskFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "camunda_task_failures_total",
+		Help: "External tasks reported to camunda as failed by topic.",
+	}, []string{"topic"})
+)
+
+// observeTask records how long the handler took to handle each task.
+func observeTask(handler processor.Handler) processor.Handler {
+	return func(ctx *processor.Context) error {
+		start := time.Now()
+		err := handler(ctx)
+		taskDuration.WithLabelValues(ctx.Task.TopicName).Observe(time.Since(start).Seconds())
+		return err
+	}
+}
+
+// handleFailure reports the task as failed to camunda and counts the failure.
+func handleFailure(ctx *processor.Context, query processor.QueryHandleFailure) error {
+	taskFailures.WithLabelValues(ctx.Task.TopicName).Inc()
+	return ctx.HandleFailure(query)
+}
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/citilinkru/camunda-client-go/v3/processor"
+	"github.com/google/uuid"
+
+	appointmentapi "github.com/Nesquiko/aass/camunda-worker/appointment-api"
+	"github.com/Nesquiko/aass/common/notify"
+	"github.com/Nesquiko/aass/common/server"
+)
+
+const (
+	notifyTopicName = "appointment-notify"
+
+	// notifyRetries is how many times a failed notify task is fetched again
+	// before an incident is created.
+	notifyRetries      = 3
+	notifyRetryTimeout = 10_000 // milliseconds
+)
+
+type participant struct {
+	id      string
+	name    string
+	address string
+}
+
+// notice is a notification for one participant about the appointment with
+// the other.
+type notice struct {
+	to   participant
+	with participant
+}
+
+// handleNotify queues notifications about the appointment's transition for
+// its participants. Delivery itself is retried by the dispatcher, so the
+// task only fails when the notifications couldn't be queued.
+func handleNotify(
+	ctx *processor.Context,
+	appointmentClient *appointmentapi.ClientWithResponses,
+	store notify.MongoStore,
+) error {
+	traceCtx, span := server.StartTaskSpan(context.Background(), ctx.Task)
+	defer span.End()
+
+	slog.Info("Processing task",
+		"taskId", ctx.Task.Id,
+		"topicName", ctx.Task.TopicName,
+		"businessKey", ctx.Task.BusinessKey,
+	)
+
+	appointmentIdStr, _ := stringVariable(ctx, "appointmentId")
+	apptUUID, err := uuid.Parse(appointmentIdStr)
+	if err != nil {
+		slog.Error("Invalid 'appointmentId' variable", "taskId", ctx.Task.Id, "error", err)
+		return handleFailure(ctx, processor.QueryHandleFailure{
+			ErrorMessage: server.AsPtr(fmt.Sprintf("Invalid 'appointmentId': %v", err)),
+			Retries:      server.AsPtr(0),
+		})
+	}
+
+	kindStr, ok := stringVariable(ctx, "notificationKind")
+	if !ok {
+		slog.Error("Missing 'notificationKind' variable", "taskId", ctx.Task.Id)
+		return handleFailure(ctx, processor.QueryHandleFailure{
+			ErrorMessage: server.AsPtr("Missing 'notificationKind' variable"),
+			Retries:      server.AsPtr(0),
+		})
+	}
+	actor, _ := stringVariable(ctx, "actor")
+
+	res, err := appointmentClient.AppointmentByIdWithResponse(traceCtx, apptUUID)
+	if err != nil || res.StatusCode() != http.StatusOK || res.JSON200 == nil {
+		slog.Error("Failed to get appointment", "taskId", ctx.Task.Id, "error", err)
+		return handleFailure(ctx, processor.QueryHandleFailure{
+			ErrorMessage: server.AsPtr(fmt.Sprintf("Failed to get appointment %s", apptUUID)),
+			Retries:      server.AsPtr(retriesLeft(ctx)),
+			RetryTimeout: server.AsPtr(notifyRetryTimeout),
+		})
+	}
+	appt := *res.JSON200
+
+	doctor := participant{
+		id:      appt.Doctor.Id.String(),
+		name:    "Dr. " + appt.Doctor.FirstName + " " + appt.Doctor.LastName,
+		address: string(appt.Doctor.Email),
+	}
+	patient := participant{
+		id:      appt.Patient.Id.String(),
+		name:    appt.Patient.FirstName + " " + appt.Patient.LastName,
+		address: string(appt.Patient.Email),
+	}
+
+	kind := notify.Kind(kindStr)
+	var reason *string
+	notices := make([]notice, 0, 2)
+	switch kind {
+	case notify.KindAppointmentAccepted:
+		notices = append(notices, notice{patient, doctor})
+	case notify.KindAppointmentDenied:
+		reason = appt.DenialReason
+		notices = append(notices, notice{patient, doctor})
+	case notify.KindAppointmentCancelled:
+		reason = appt.CancellationReason
+		if appt.CanceledBy != nil && *appt.CanceledBy == appointmentapi.UserRoleDoctor {
+			notices = append(notices, notice{patient, doctor})
+		} else {
+			notices = append(notices, notice{doctor, patient})
+		}
+	case notify.KindAppointmentRescheduled:
+		// the participant who rescheduled already knows about it
+		if actor != doctor.id {
+			notices = append(notices, notice{doctor, patient})
+		}
+		if actor != patient.id {
+			notices = append(notices, notice{patient, doctor})
+		}
+	default:
+		slog.Error("Unknown 'notificationKind'", "taskId", ctx.Task.Id, "kind", kindStr)
+		return handleFailure(ctx, processor.QueryHandleFailure{
+			ErrorMessage: server.AsPtr(fmt.Sprintf("Unknown 'notificationKind': %q", kindStr)),
+			Retries:      server.AsPtr(0),
+		})
+	}
+
+	for _, n := range notices {
+		err = store.Queue(traceCtx, apptUUID, notify.Event{
+			Kind:             kind,
+			RecipientName:    n.to.name,
+			RecipientAddress: n.to.address,
+			With:             n.with.name,
+			Start:            appt.AppointmentDateTime,
+			Reason:           reason,
+		})
+		if err != nil {
+			slog.Error("Failed to queue notification", "taskId", ctx.Task.Id, "error", err)
+			return handleFailure(ctx, processor.QueryHandleFailure{
+				ErrorMessage: server.AsPtr(fmt.Sprintf("Failed to queue notification: %v", err)),
+				Retries:      server.AsPtr(retriesLeft(ctx)),
+				RetryTimeout: server.AsPtr(notifyRetryTimeout),
+			})
+		}
+	}
+
+	err = ctx.Complete(processor.QueryComplete{})
+	if err != nil {
+		return handleFailure(ctx, processor.QueryHandleFailure{
+			ErrorMessage: server.AsPtr(fmt.Sprintf("failed to complete Camunda task: %v", err)),
+			Retries:      server.AsPtr(0),
+		})
+	}
+	return nil
+}
+
+func stringVariable(ctx *processor.Context, name string) (string, bool) {
+	variable, ok := ctx.Task.Variables[name]
+	if !ok || variable.Value == nil {
+		return "", false
+	}
+	value, ok := variable.Value.(string)
+	return value, ok
+}
+
+// retriesLeft returns the retries of the task after this failed attempt.
+func retriesLeft(ctx *processor.Context) int {
+	if ctx.Task.Retries == nil {
+		return notifyRetries
+	}
+	return max(*ctx.Task.Retries-1, 0)
+}
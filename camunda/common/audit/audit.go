@@ -0,0 +1,367 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/Nesquiko/aass/common/server/api"
+)
+
+const (
+	eventsCollection = "audit_events"
+
+	// how many times an append is retried when another writer takes the
+	// next sequence number first
+	appendAttempts = 10
+	// bounds of the random wait before a retry, the upper one doubles with
+	// each attempt up to appendBackoffMax
+	appendBackoffBase = 5 * time.Millisecond
+	appendBackoffMax  = 500 * time.Millisecond
+
+	// PageLimit is the size of a page of the audit log, if the request
+	// doesn't limit it.
+	PageLimit = 100
+
+	// AnonymousActor is recorded when a request doesn't identify its caller.
+	AnonymousActor = "anonymous"
+)
+
+var ErrChainContention = errors.New("audit chain is being appended by too many writers")
+
+// Event is an entry of the append-only audit log. Events are chained by
+// their sequence number, each one stores the hash of its predecessor and its
+// own hash covers it, so changing or removing any past event breaks the chain
+// from that point on.
+type Event struct {
+	Id        uuid.UUID         `bson:"_id"                 json:"id"`
+	Seq       int64             `bson:"seq"                 json:"seq"`
+	Actor     string            `bson:"actor"               json:"actor"`
+	Action    string            `bson:"action"              json:"action"`
+	TargetId  uuid.UUID         `bson:"targetId"            json:"targetId"`
+	Diff      map[string]Change `bson:"diff,omitempty"      json:"diff,omitempty"`
+	RequestId string            `bson:"requestId,omitempty" json:"requestId,omitempty"`
+	CreatedAt time.Time         `bson:"createdAt"           json:"createdAt"`
+	PrevHash  string            `bson:"prevHash"            json:"prevHash"`
+	Hash      string            `bson:"hash"                json:"hash"`
+}
+
+// Change holds JSON encoded values of a field before and after the audited
+// action. A nil value means the field wasn't present.
+type Change struct {
+	Before json.RawMessage `bson:"before,omitempty" json:"before,omitempty"`
+	After  json.RawMessage `bson:"after,omitempty"  json:"after,omitempty"`
+}
+
+// Filter selects events of the log. Events are returned in pages of at most
+// Limit events, starting after the event with sequence number AfterSeq.
+type Filter struct {
+	TargetId *uuid.UUID
+	Actor    *string
+	From     *time.Time
+	To       *time.Time
+	AfterSeq int64
+	Limit    int
+}
+
+// ComputeHash returns the hex encoded SHA-256 hash of the event's content
+// and the hash of its predecessor.
+func (e Event) ComputeHash() (string, error) {
+	payload, err := json.Marshal(struct {
+		Id        uuid.UUID         `json:"id"`
+		Seq       int64             `json:"seq"`
+		Actor     string            `json:"actor"`
+		Action    string            `json:"action"`
+		TargetId  uuid.UUID         `json:"targetId"`
+		Diff      map[string]Change `json:"diff,omitempty"`
+		RequestId string            `json:"requestId,omitempty"`
+		CreatedAt string            `json:"createdAt"`
+		PrevHash  string            `json:"prevHash"`
+	}{
+		Id:        e.Id,
+		Seq:       e.Seq,
+		Actor:     e.Actor,
+		Action:    e.Action,
+		TargetId:  e.TargetId,
+		Diff:      e.Diff,
+		RequestId: e.RequestId,
+		CreatedAt: e.CreatedAt.UTC().Format(time.RFC3339Nano),
+		PrevHash:  e.PrevHash,
+	})
+	if err != nil {
+		return "", fmt.Errorf("ComputeHash: %w", err)
+	}
+
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Log is the audit log of a single service, stored in the service's own
+// database.
+type Log struct {
+	events *mongo.Collection
+}
+
+func NewLog(ctx context.Context, db *mongo.Database) Log {
+	events := db.Collection(eventsCollection)
+	indexModels := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "seq", Value: 1}},
+			Options: options.Index().SetUnique(true).SetName("idx_audit_event_seq_unique"),
+		},
+		{
+			Keys:    bson.D{{Key: "targetId", Value: 1}},
+			Options: options.Index().SetName("idx_audit_event_targetId"),
+		},
+		{
+			Keys:    bson.D{{Key: "actor", Value: 1}},
+			Options: options.Index().SetName("idx_audit_event_actor"),
+		},
+		{
+			Keys:    bson.D{{Key: "createdAt", Value: 1}},
+			Options: options.Index().SetName("idx_audit_event_createdAt"),
+		},
+	}
+
+	for _, idx := range indexModels {
+		_, err := events.Indexes().CreateOne(ctx, idx)
+		if err != nil {
+			slog.WarnContext(
+				ctx,
+				"Could not ensure audit events index (may already exist)",
+				"error",
+				err,
+			)
+		}
+	}
+
+	return Log{events: events}
+}
+
+// Append links the event after the last event of the chain and stores it.
+// Creation time is truncated to milliseconds, which is the precision of
+// MongoDB dates, so the hash stays verifiable after reading the event back.
+func (l Log) Append(ctx context.Context, event Event) (Event, error) {
+	event.Id = uuid.New()
+	event.CreatedAt = time.Now().UTC().Truncate(time.Millisecond)
+
+	for attempt := range appendAttempts {
+		if attempt > 0 {
+			if err := appendBackoff(ctx, attempt); err != nil {
+				return Event{}, fmt.Errorf("Append: %w", err)
+			}
+		}
+
+		var last Event
+		opts := options.FindOne().SetSort(bson.D{{Key: "seq", Value: -1}})
+		err := l.events.FindOne(ctx, bson.M{}, opts).Decode(&last)
+		if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+			return Event{}, fmt.Errorf("Append failed to find last event: %w", err)
+		}
+
+		event.Seq = last.Seq + 1
+		event.PrevHash = last.Hash
+		event.Hash, err = event.ComputeHash()
+		if err != nil {
+			return Event{}, fmt.Errorf("Append: %w", err)
+		}
+
+		_, err = l.events.InsertOne(ctx, event)
+		if mongo.IsDuplicateKeyError(err) {
+			// someone else appended an event with the same seq, retry
+			// on top of theirs
+			continue
+		} else if err != nil {
+			return Event{}, fmt.Errorf("Append failed to insert document: %w", err)
+		}
+
+		return event, nil
+	}
+
+	return Event{}, fmt.Errorf("Append: %w", ErrChainContention)
+}
+
+// appendBackoff waits a random time before the attempt-th retry of an append,
+// so that contending writers don't keep colliding. It returns early with the
+// context's error if it's cancelled.
+func appendBackoff(ctx context.Context, attempt int) error {
+	ceiling := min(appendBackoffBase<<attempt, appendBackoffMax)
+	timer := time.NewTimer(rand.N(ceiling))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (l Log) Events(ctx context.Context, filter Filter) ([]Event, error) {
+	events := make([]Event, 0)
+
+	query := bson.M{"seq": bson.M{"$gt": filter.AfterSeq}}
+	if filter.TargetId != nil {
+		query["targetId"] = *filter.TargetId
+	}
+	if filter.Actor != nil {
+		query["actor"] = *filter.Actor
+	}
+	createdAt := bson.M{}
+	if filter.From != nil {
+		createdAt["$gte"] = *filter.From
+	}
+	if filter.To != nil {
+		createdAt["$lte"] = *filter.To
+	}
+	if len(createdAt) != 0 {
+		query["createdAt"] = createdAt
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "seq", Value: 1}}).SetLimit(int64(filter.Limit))
+	cursor, err := l.events.Find(ctx, query, opts)
+	if err != nil {
+		return nil, fmt.Errorf("Events find failed: %w", err)
+	}
+
+	defer func() {
+		if cerr := cursor.Close(ctx); cerr != nil {
+			slog.WarnContext(ctx, "Failed to close cursor", "error", cerr.Error())
+		}
+	}()
+
+	if err = cursor.All(ctx, &events); err != nil {
+		return nil, fmt.Errorf("Events decode failed: %w", err)
+	}
+
+	return events, nil
+}
+
+// Record appends an event of an already performed action to the log. before
+// and after are states of the target, either can be nil, for reads both are
+// nil. An action which can't be audited must fail, so callers record it after
+// the action's side effects and return the error.
+func (l Log) Record(
+	ctx context.Context,
+	action string,
+	targetId uuid.UUID,
+	before, after any,
+) error {
+	meta := MetaFrom(ctx)
+	diff, err := Diff(before, after)
+	if err != nil {
+		return fmt.Errorf("Record %s: %w", action, err)
+	}
+
+	_, err = l.Append(ctx, Event{
+		Actor:     meta.Actor,
+		Action:    action,
+		TargetId:  targetId,
+		Diff:      diff,
+		RequestId: meta.RequestId,
+	})
+	if err != nil {
+		return fmt.Errorf("Record %s: %w", action, err)
+	}
+	return nil
+}
+
+// Diff compares JSON representations of before and after field by field
+// and returns the fields which differ.
+func Diff(before, after any) (map[string]Change, error) {
+	beforeFields, err := fields(before)
+	if err != nil {
+		return nil, fmt.Errorf("Diff before: %w", err)
+	}
+	afterFields, err := fields(after)
+	if err != nil {
+		return nil, fmt.Errorf("Diff after: %w", err)
+	}
+
+	diff := make(map[string]Change)
+	for field, value := range beforeFields {
+		if !bytes.Equal(value, afterFields[field]) {
+			diff[field] = Change{Before: value, After: afterFields[field]}
+		}
+	}
+	for field, value := range afterFields {
+		if _, ok := beforeFields[field]; !ok {
+			diff[field] = Change{After: value}
+		}
+	}
+
+	if len(diff) == 0 {
+		return nil, nil
+	}
+	return diff, nil
+}
+
+func fields(v any) (map[string]json.RawMessage, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	err = json.Unmarshal(raw, &fields)
+	return fields, err
+}
+
+func EventToApi(e Event) (api.AuditEvent, error) {
+	hash, err := e.ComputeHash()
+	if err != nil {
+		return api.AuditEvent{}, err
+	}
+
+	event := api.AuditEvent{
+		Id:        e.Id,
+		Seq:       e.Seq,
+		Actor:     e.Actor,
+		Action:    e.Action,
+		TargetId:  e.TargetId,
+		CreatedAt: e.CreatedAt,
+		PrevHash:  e.PrevHash,
+		Hash:      e.Hash,
+		HashValid: hash == e.Hash,
+	}
+	if e.RequestId != "" {
+		event.RequestId = &e.RequestId
+	}
+	if len(e.Diff) != 0 {
+		diff := make(map[string]api.AuditChange, len(e.Diff))
+		for field, change := range e.Diff {
+			var apiChange api.AuditChange
+			if change.Before != nil {
+				apiChange.Before = new(any)
+				if err := json.Unmarshal(change.Before, apiChange.Before); err != nil {
+					return api.AuditEvent{}, err
+				}
+			}
+			if change.After != nil {
+				apiChange.After = new(any)
+				if err := json.Unmarshal(change.After, apiChange.After); err != nil {
+					return api.AuditEvent{}, err
+				}
+			}
+			diff[field] = apiChange
+		}
+		event.Diff = &diff
+	}
+
+	return event, nil
+}
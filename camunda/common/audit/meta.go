@@ -0,0 +1,63 @@
+package audit
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	chi_middleware "github.com/go-chi/chi/v5/middleware"
+)
+
+// ActorHeader identifies the user on whose behalf the request is made.
+const ActorHeader = "X-User-Id"
+
+// Meta identifies who caused an audited action and by which request.
+type Meta struct {
+	Actor     string
+	RequestId string
+	// Admin is set when the request carries the audit admin token.
+	Admin bool
+}
+
+type metaKey struct{}
+
+func WithMeta(ctx context.Context, meta Meta) context.Context {
+	return context.WithValue(ctx, metaKey{}, meta)
+}
+
+func MetaFrom(ctx context.Context) Meta {
+	meta, _ := ctx.Value(metaKey{}).(Meta)
+	if meta.Actor == "" {
+		meta.Actor = AnonymousActor
+	}
+	return meta
+}
+
+// Middleware stores Meta of the request in its context. It has to run after
+// the request id is stored in the context. If adminToken is empty, no request
+// is admin.
+func Middleware(adminToken string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := WithMeta(r.Context(), Meta{
+				Actor:     r.Header.Get(ActorHeader),
+				RequestId: chi_middleware.GetReqID(r.Context()),
+				Admin:     isAdmin(r, adminToken),
+			})
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func isAdmin(r *http.Request, adminToken string) bool {
+	if adminToken == "" {
+		return false
+	}
+
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(token), []byte(adminToken)) == 1
+}
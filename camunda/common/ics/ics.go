@@ -0,0 +1,204 @@
+// Package ics encodes appointments as iCalendar (RFC 5545) documents, which
+// calendar applications can import or subscribe to.
+package ics
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+const ContentType = "text/calendar; charset=utf-8"
+
+const (
+	productId = "-//Nesquiko//AASS//EN"
+
+	// lines longer than this many octets are folded
+	maxLineOctets = 75
+
+	utcLayout   = "20060102T150405Z"
+	localLayout = "20060102T150405"
+)
+
+type Status string
+
+const (
+	StatusTentative Status = "TENTATIVE"
+	StatusConfirmed Status = "CONFIRMED"
+	StatusCancelled Status = "CANCELLED"
+)
+
+type Event struct {
+	// Uid identifies the event across all versions of the calendar, so it
+	// must be stable and globally unique.
+	Uid         string
+	Start       time.Time
+	End         time.Time
+	Summary     string
+	Description string
+	Status      Status
+}
+
+type Calendar struct {
+	Name string
+	// Location is the time zone in which event times are written.
+	Location *time.Location
+	Events   []Event
+}
+
+// Encode writes the calendar to w. Event times are written in the calendar's
+// time zone, which is described by a VTIMEZONE covering all events.
+func (c Calendar) Encode(w io.Writer) error {
+	loc := c.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	var b writer
+	b.line("BEGIN:VCALENDAR")
+	b.line("VERSION:2.0")
+	b.line("PRODID:" + productId)
+	b.line("CALSCALE:GREGORIAN")
+	b.line("METHOD:PUBLISH")
+	if c.Name != "" {
+		b.line("X-WR-CALNAME:" + escape(c.Name))
+	}
+	b.line("X-WR-TIMEZONE:" + loc.String())
+	c.writeTimezone(&b, loc)
+
+	stamp := time.Now().UTC().Format(utcLayout)
+	for _, e := range c.Events {
+		b.line("BEGIN:VEVENT")
+		b.line("UID:" + escape(e.Uid))
+		b.line("DTSTAMP:" + stamp)
+		b.line(fmt.Sprintf("DTSTART;TZID=%s:%s", loc, e.Start.In(loc).Format(localLayout)))
+		b.line(fmt.Sprintf("DTEND;TZID=%s:%s", loc, e.End.In(loc).Format(localLayout)))
+		b.line("SUMMARY:" + escape(e.Summary))
+		if e.Description != "" {
+			b.line("DESCRIPTION:" + escape(e.Description))
+		}
+		if e.Status != "" {
+			b.line("STATUS:" + string(e.Status))
+		}
+		b.line("END:VEVENT")
+	}
+	b.line("END:VCALENDAR")
+
+	_, err := w.Write(b.Bytes())
+	return err
+}
+
+// writeTimezone describes offsets of loc in effect during the calendar's
+// events. Each offset change is written as an observance starting at the
+// change, so no recurrence rules are needed.
+func (c Calendar) writeTimezone(b *writer, loc *time.Location) {
+	from, to := time.Now(), time.Now()
+	for _, e := range c.Events {
+		if e.Start.Before(from) {
+			from = e.Start
+		}
+		if e.End.After(to) {
+			to = e.End
+		}
+	}
+
+	b.line("BEGIN:VTIMEZONE")
+	b.line("TZID:" + loc.String())
+
+	prevOffset := offset(from.In(loc))
+	observance(b, from.In(loc), prevOffset, prevOffset)
+	for _, change := range transitions(from.In(loc), to.In(loc)) {
+		observance(b, change, prevOffset, offset(change))
+		prevOffset = offset(change)
+	}
+
+	b.line("END:VTIMEZONE")
+}
+
+func observance(b *writer, start time.Time, offsetFrom, offsetTo int) {
+	kind := "STANDARD"
+	if start.IsDST() {
+		kind = "DAYLIGHT"
+	}
+	name, _ := start.Zone()
+
+	b.line("BEGIN:" + kind)
+	// DTSTART of an observance is in the local time before the change
+	b.line("DTSTART:" + start.In(time.FixedZone("", offsetFrom)).Format(localLayout))
+	b.line("TZOFFSETFROM:" + formatOffset(offsetFrom))
+	b.line("TZOFFSETTO:" + formatOffset(offsetTo))
+	b.line("TZNAME:" + escape(name))
+	b.line("END:" + kind)
+}
+
+// transitions returns instants in (from, to] at which the offset of their
+// location changes.
+func transitions(from, to time.Time) []time.Time {
+	changes := make([]time.Time, 0)
+	for day := from; day.Before(to); {
+		next := day.Add(24 * time.Hour)
+		if offset(next) != offset(day) {
+			lo, hi := day, next
+			for hi.Sub(lo) > time.Second {
+				mid := lo.Add(hi.Sub(lo) / 2)
+				if offset(mid) == offset(lo) {
+					lo = mid
+				} else {
+					hi = mid
+				}
+			}
+			changes = append(changes, hi.Truncate(time.Second))
+		}
+		day = next
+	}
+	return changes
+}
+
+func offset(t time.Time) int {
+	_, offset := t.Zone()
+	return offset
+}
+
+func formatOffset(seconds int) string {
+	sign := '+'
+	if seconds < 0 {
+		sign, seconds = '-', -seconds
+	}
+	return fmt.Sprintf("%c%02d%02d", sign, seconds/3600, seconds%3600/60)
+}
+
+// escape escapes a TEXT value.
+func escape(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(s)
+}
+
+// writer writes content lines terminated by CRLF, folding long ones.
+type writer struct {
+	bytes.Buffer
+}
+
+func (w *writer) line(l string) {
+	limit := maxLineOctets
+	for len(l) > limit {
+		cut := limit
+		// don't split a multi-byte UTF-8 character
+		for cut > 0 && l[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		w.WriteString(l[:cut])
+		w.WriteString("\r\n ")
+		l = l[cut:]
+		// the leading space of a continuation line counts too
+		limit = maxLineOctets - 1
+	}
+	w.WriteString(l)
+	w.WriteString("\r\n")
+}
@@ -22,7 +22,10 @@ func ConnectMongo(ctx context.Context, uri string, db string) (*mongo.Database,
 	mongoRegistry.RegisterTypeEncoder(tUUID, bson.ValueEncoderFunc(uuidEncodeValue))
 	mongoRegistry.RegisterTypeDecoder(tUUID, bson.ValueDecoderFunc(uuidDecodeValue))
 
-	opts := options.Client().ApplyURI(uri).SetRegistry(mongoRegistry)
+	opts := options.Client().
+		ApplyURI(uri).
+		SetRegistry(mongoRegistry).
+		SetMonitor(newCommandMonitor())
 	client, err := mongo.Connect(opts)
 	if err != nil {
 		return nil, fmt.Errorf("ConnectMongo: %w", err)
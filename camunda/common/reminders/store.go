@@ -0,0 +1,152 @@
+// Package reminders keeps reminders of upcoming appointments as durable
+// jobs, which are claimed under a lease, so several workers can run at once
+// without sending a reminder twice.
+package reminders
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const reminderJobsCollection = "reminder_jobs"
+
+// ErrNoDueJob is returned by Claim when there is no job to claim.
+var ErrNoDueJob = errors.New("no due reminder job")
+
+// Job is a reminder of an appointment due at DueAt. A worker claims the job
+// by leasing it until LockedUntil and deletes it once the reminder is
+// queued. Jobs whose lease expired can be claimed again. Offset is how long
+// before the appointment the reminder is sent.
+type Job struct {
+	Id            uuid.UUID     `bson:"_id"                   json:"id"`
+	AppointmentId uuid.UUID     `bson:"appointmentId"         json:"appointmentId"`
+	Offset        time.Duration `bson:"offset"                json:"offset"`
+	DueAt         time.Time     `bson:"dueAt"                 json:"dueAt"`
+	LockedBy      *string       `bson:"lockedBy,omitempty"    json:"lockedBy,omitempty"`
+	LockedUntil   *time.Time    `bson:"lockedUntil,omitempty" json:"lockedUntil,omitempty"`
+	CreatedAt     time.Time     `bson:"createdAt"             json:"createdAt"`
+}
+
+// MongoStore keeps reminder jobs in the reminder_jobs collection.
+type MongoStore struct {
+	jobs    *mongo.Collection
+	offsets []time.Duration
+}
+
+// NewMongoStore returns a store scheduling reminders offsets before
+// appointments.
+func NewMongoStore(ctx context.Context, db *mongo.Database, offsets []time.Duration) MongoStore {
+	jobs := db.Collection(reminderJobsCollection)
+	_, err := jobs.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "dueAt", Value: 1}},
+			Options: options.Index().SetName("idx_reminder_job_dueAt"),
+		},
+		{
+			Keys:    bson.D{{Key: "appointmentId", Value: 1}},
+			Options: options.Index().SetName("idx_reminder_job_appointmentId"),
+		},
+	})
+	if err != nil {
+		slog.WarnContext(
+			ctx,
+			"Could not ensure reminder job indexes (may already exist)",
+			"error",
+			err,
+		)
+	}
+
+	return MongoStore{jobs: jobs, offsets: offsets}
+}
+
+// Schedule replaces reminders of the appointment with ones before its start.
+// Reminders which would already be due are skipped.
+func (s MongoStore) Schedule(ctx context.Context, appointmentId uuid.UUID, start time.Time) error {
+	if err := s.Cancel(ctx, appointmentId); err != nil {
+		return fmt.Errorf("Schedule: %w", err)
+	}
+
+	now := time.Now()
+	jobs := make([]Job, 0, len(s.offsets))
+	for _, offset := range s.offsets {
+		dueAt := start.Add(-offset)
+		if !dueAt.After(now) {
+			continue
+		}
+		jobs = append(jobs, Job{
+			Id:            uuid.New(),
+			AppointmentId: appointmentId,
+			Offset:        offset,
+			DueAt:         dueAt,
+			CreatedAt:     now,
+		})
+	}
+	if len(jobs) == 0 {
+		return nil
+	}
+
+	_, err := s.jobs.InsertMany(ctx, jobs)
+	if err != nil {
+		return fmt.Errorf("Schedule: failed to insert documents: %w", err)
+	}
+
+	return nil
+}
+
+// Cancel deletes reminders of the appointment.
+func (s MongoStore) Cancel(ctx context.Context, appointmentId uuid.UUID) error {
+	_, err := s.jobs.DeleteMany(ctx, bson.M{"appointmentId": appointmentId})
+	if err != nil {
+		return fmt.Errorf("Cancel: failed to delete documents: %w", err)
+	}
+	return nil
+}
+
+// Claim leases the longest due job, which isn't leased by anyone else, to
+// owner.
+func (s MongoStore) Claim(
+	ctx context.Context,
+	now time.Time,
+	owner string,
+	lease time.Duration,
+) (Job, error) {
+	filter := bson.M{
+		"dueAt": bson.M{"$lte": now},
+		"$or": bson.A{
+			bson.M{"lockedUntil": bson.M{"$exists": false}},
+			bson.M{"lockedUntil": bson.M{"$lte": now}},
+		},
+	}
+	update := bson.M{"$set": bson.M{"lockedBy": owner, "lockedUntil": now.Add(lease)}}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.M{"dueAt": 1}).
+		SetReturnDocument(options.After)
+
+	var job Job
+	err := s.jobs.FindOneAndUpdate(ctx, filter, update, opts).Decode(&job)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return Job{}, ErrNoDueJob
+		}
+		return Job{}, fmt.Errorf("Claim: failed to update document: %w", err)
+	}
+
+	return job, nil
+}
+
+// Complete deletes the job, if it is still leased by owner.
+func (s MongoStore) Complete(ctx context.Context, id uuid.UUID, owner string) error {
+	_, err := s.jobs.DeleteOne(ctx, bson.M{"_id": id, "lockedBy": owner})
+	if err != nil {
+		return fmt.Errorf("Complete: failed to delete document: %w", err)
+	}
+	return nil
+}
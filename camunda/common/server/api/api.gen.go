@@ -13,23 +13,118 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// AuditChange Value of a single field before and after the audited action. Missing
+// `before` means the field was set by the action, missing `after` means
+// it was removed by it.
+type AuditChange struct {
+	// After Value of the field after the action.
+	After *interface{} `json:"after,omitempty"`
+
+	// Before Value of the field before the action.
+	Before *interface{} `json:"before,omitempty"`
+}
+
+// AuditEvent An entry of the append-only audit log of a service. Every event
+// carries the hash of its predecessor, so any modification or removal
+// of a past event breaks the chain.
+type AuditEvent struct {
+	// Action What was done, in the form `<entity>.<verb>`.
+	Action string `json:"action"`
+
+	// Actor Identifier of the user who performed the action.
+	Actor     string    `json:"actor"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Diff Fields changed by the action, keyed by field name.
+	Diff *map[string]AuditChange `json:"diff,omitempty"`
+
+	// Hash SHA-256 hash of this event including `prevHash`.
+	Hash string `json:"hash"`
+
+	// HashValid Whether the stored hash matches the event's content.
+	HashValid bool               `json:"hashValid"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// PrevHash Hash of the previous event, empty for the first one.
+	PrevHash string `json:"prevHash"`
+
+	// RequestId ID of the HTTP request which caused the event.
+	RequestId *string `json:"requestId,omitempty"`
+
+	// Seq Position of the event in the audit chain, starting at 1.
+	Seq int64 `json:"seq"`
+
+	// TargetId ID of the entity the action was performed on.
+	TargetId openapi_types.UUID `json:"targetId"`
+}
+
+// BatchIds Identifiers of entities fetched at once. Unknown identifiers are
+// skipped in the response.
+type BatchIds struct {
+	Ids []openapi_types.UUID `json:"ids"`
+}
+
 // ErrorDetail Standardized error details (RFC 9457).
 type ErrorDetail struct {
-	Code                 string                 `json:"code"`
-	Detail               string                 `json:"detail"`
+	Code   string `json:"code"`
+	Detail string `json:"detail"`
+
+	// RequestId Id of the request, as sent back in the X-Request-Id header.
+	RequestId            *string                `json:"requestId,omitempty"`
 	Status               int                    `json:"status"`
 	Title                string                 `json:"title"`
 	AdditionalProperties map[string]interface{} `json:"-"`
 }
 
+// AuditActor defines model for AuditActor.
+type AuditActor = string
+
+// AuditAfterSeq defines model for AuditAfterSeq.
+type AuditAfterSeq = int64
+
+// AuditFrom defines model for AuditFrom.
+type AuditFrom = time.Time
+
+// AuditLimit defines model for AuditLimit.
+type AuditLimit = int
+
+// AuditTargetId defines model for AuditTargetId.
+type AuditTargetId = openapi_types.UUID
+
+// AuditTo defines model for AuditTo.
+type AuditTo = time.Time
+
+// IfMatch defines model for IfMatch.
+type IfMatch = string
+
+// AuditEvents defines model for AuditEvents.
+type AuditEvents struct {
+	Events []AuditEvent `json:"events"`
+
+	// NextAfterSeq Set if more events follow, pass it as `afterSeq` to get the
+	// next page.
+	NextAfterSeq *int64 `json:"nextAfterSeq,omitempty"`
+}
+
+// ForbiddenResponse Standardized error details (RFC 9457).
+type ForbiddenResponse = ErrorDetail
+
 // InternalServerErrorResponse Standardized error details (RFC 9457).
 type InternalServerErrorResponse = ErrorDetail
 
+// NotFoundResponse Standardized error details (RFC 9457).
+type NotFoundResponse = ErrorDetail
+
+// PreconditionFailedResponse Standardized error details (RFC 9457).
+type PreconditionFailedResponse = ErrorDetail
+
 // Getter for additional properties for ErrorDetail. Returns the specified
 // element and whether it was found
 func (a ErrorDetail) Get(fieldName string) (value interface{}, found bool) {
@@ -71,6 +166,14 @@ func (a *ErrorDetail) UnmarshalJSON(b []byte) error {
 		delete(object, "detail")
 	}
 
+	if raw, found := object["requestId"]; found {
+		err = json.Unmarshal(raw, &a.RequestId)
+		if err != nil {
+			return fmt.Errorf("error reading 'requestId': %w", err)
+		}
+		delete(object, "requestId")
+	}
+
 	if raw, found := object["status"]; found {
 		err = json.Unmarshal(raw, &a.Status)
 		if err != nil {
@@ -116,6 +219,13 @@ func (a ErrorDetail) MarshalJSON() ([]byte, error) {
 		return nil, fmt.Errorf("error marshaling 'detail': %w", err)
 	}
 
+	if a.RequestId != nil {
+		object["requestId"], err = json.Marshal(a.RequestId)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'requestId': %w", err)
+		}
+	}
+
 	object["status"], err = json.Marshal(a.Status)
 	if err != nil {
 		return nil, fmt.Errorf("error marshaling 'status': %w", err)
@@ -266,14 +376,36 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/2yST2/UQAzFv8rIcAARJYtohcitKiDtAanqckMcphPv7pTEHjxO+bPKd0fOZHdb4JbI",
-	"z88/P88BAg+JCUkztAcQzIkp4/yzJkUh329QHlA+iLDcLnUrByZFUvv0KfUxeI1MTRK+63F4dZ+ZrIY/",
-	"/ZD6paNDaCEuvnWejWs0Z6igQ/WxhxauyI30jfgHuSJxs8RxCKMIdlBBVq9jhvZytapAo9qAE/CTLpgq",
-	"yGGPgzeE54JbaOFZc967KdXczBu+LxDTNBlQDhKTrVWonmLUboPocsIQtzG4guRsSbdlcWWdXMN0Iphj",
-	"fTzGsuu6aBN8fyOcUDRa+ioj/g2wUU+dly7+xm5BWWa4F7cfr927i8u3L2uoID0yOqZ+AP2VLKSsEmkH",
-	"0znv/5SO8R5gyzJ4LVeD6qi0E+5wznYJ/x+TqQLB72O0e7VfFllVaE4DThBfT9Z8d49BywEibdms+xhw",
-	"eXXkB1N9Wn+GCkax57JXTbltGk5ImUcJWLPsmqUpN6Y9g8I1DwOTu7pZu+NVKnhAySXl1/WqXpne7HyK",
-	"0MKbelVfWK5e9xlaGvt++hMAAP//8et2jzoDAAA=",
+	"H4sIAAAAAAAC/8RYbW/juBH+KwO2wPVFUZze7RX1t3R3gzXQvQa76bVAfYBpcWTxIpFacuTEDfLfiyEp",
+	"WYllJ3c4YD8lcciZZ94ePuMHUdimtQYNeTF/EBVKhS78+v5GbvinQl843ZK2RszFj+i8tgZsCVQhOPS2",
+	"cwVmQBbWCB4NgTawKM8+SioqPqfJQ9cqSZiLTPiiwkayYdq1KObCk9NmIx4fHzPRSicbpITgslOaLguy",
+	"7hDHP029A9wybmjRldY1qGC9A6q0B8mX2Jvms186dDuRCSMbdhj+eRJJllyXhO4zfjntXfKp6La1XvMJ",
+	"zgBnR7IVKCqpzVEwvY8xHo5GkpgLbej770QmGm100zViPst6sNoQbtDt0V4525xGWjiUhAokgXVj3Np4",
+	"koaOYSzZ8iQ+LuoZ6QZFdiyJ/9CNpkNcH+U9RwSma9bouE0cUucMqoQ2g4vZDHQJxhJ4PAquDvbH6Jpo",
+	"WswvZrPZKHcXx3N3I90GaaFe22c21hcNadrBnaYqJnLx7hhM6j1M5rHrtDqewhv7S+q6xtI6fFVhyf6a",
+	"si7KMNqHmJgx/HNmCH/E8QftYS19yF8GHnnaKU5tYZtG+hxuKlya/WnZtrUO5+sd98ITw/x/AmswuWwy",
+	"WP1pxSkwdmkik/EJWdfJkAdN4ROzg20kshwiaumQQbTSoQJPzppNvctALs0dyttwCAxu0UHDwaPPl6bP",
+	"anS1T2tPfi+xnUPfWuNxT3bvtz0RF9YQmjA4AXshOcnnP3vO9MPIbutsi450tIKDAU3YhF9+77AUc/G7",
+	"8z3Tn8fr/nzvVDwOpZbOyR3/bfD+BAd+RuKSNNxsqRFLW9f2LoNW+phqD6ue4Fb8QmyQuFJLw6ahlRuM",
+	"aTwgvIlBdfil0w6VmP+3D/On4aBd/4wFxbQ+g9kVBXpfdnW9A4fkNG55VgI1Rzs5B3tl3VorheZTqsqJ",
+	"KrTOrmts/nxYjVPJfu+cde+QpK6ngA4A4IzHAApZ1+hAe/NN6GF7h4pzmFgozAKXPkAKISwMoTOy/oxu",
+	"iy64+xXB4L1s2jrdUH0V2G7ug+Ec2bJg/CGWubg00JlbY+8MxCMQjoAtis5xyTLhSVLnxfwNUzJpqsOg",
+	"JMNPbnEkv0lCL80zGDl8RgTfYqFLXUCEBBwklNZBDCd2ww+Wrmxn1Ndqhh8sQQCQmoGbHz2T5UB/yqIP",
+	"jyPea08B9bXDwhoVNMiV1DV+NfxjJBChDJEk/HfSszQyGyZcbdJLEYh2JCDzMPvJ68CTb8O9CXUq6y48",
+	"B5JNbmqEUmOt+jdRGjXInqTOmAqKMEHwUXu+tDSreHwFDUrjw9lohiF7pCgzMV3MoIkXE9ela0ujKVxw",
+	"2NhtfOU0Rbp7ytnh1olY9v5H2Ith6iPYV90fpMHIwOMBh2ajt+jQLE+VIbfrLcu2RaPOwgsdSbW2m1QC",
+	"dFtdYA7vt+iSWlmaQjqnMaa1kn5YEVqHCpmqrcvA2/BIN1bpMjUqP+whl7JemmC/lT5ROKwdyttoM8rt",
+	"qTwXMYTnEf27krFSyhrMeu0eOHa17Gazb4uo88LvmMePtujW8YMVq6uBNEXr9sZzhTXShIrK0hJygGWh",
+	"2FepoyQO0smjg7vKjtTnuH4TppMcvKTXarpMKF2WIUUqjqysr5+k7kUNkQby8FHjvtvP+bPBucVd/DC2",
+	"J8unXEz0I7fJhAD5cHn2lzffD00UFG9sB22KulNhJluH2w/SV6vJXPHdH2Wt1VRbIFVp3DxZhyp6Svov",
+	"fB68feMhUevIxdraGqVhH1o9KcS01s9ED/QQyYchQOQp2WrbpUAzwKalXXi+4pg7HyTxZLDpEZlacxbv",
+	"egcfbm6u++cG7ipdVFDIzqe2C14njfspjXjdL8S23F+f2I4zfowdccEkwUX+GkmY7XeqE+GkFW3fdWHS",
+	"x5vcE2dHF7Gx+gxHfNjZ++8Som2Rjfe8/RyOipuaedx5P010/N+5yRbKn2KIsGrxHzylUCK3ZVwCDZPu",
+	"v5Ii06ML0uHS+Fvdtqj6MvRbyBRlavV0l3ixiRt5v4iHL2az5xvFQRr9ZOxjaXGUk8h1eCD1SRolndL/",
+	"Q5WkX9J08IdPV2/hb9+9+esf84Mgo8p9mGDFAcMvGiW1X4HDoQyCbOBXSha3fdr/c/Yp/vtsoSBukNNz",
+	"lYTz02+FpuchyuqHF9o3Hsti3IODIdzppUqbMnwLUesCk55M2+7HxY3IROdqMRcVUevn5+e2RRNlXm7d",
+	"5jxd8ud8dg9UvLVNYw1cXi+gV3iZSIu5mIuLfJbP+Dybk60Wc/FtPsuZDFpJlRdz09X14/8HAOYNjknE",
+	"FAAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
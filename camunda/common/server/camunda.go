@@ -0,0 +1,77 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	camunda_client_go "github.com/citilinkru/camunda-client-go/v3"
+	chi_middleware "github.com/go-chi/chi/v5/middleware"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	camundaTracerName = "github.com/Nesquiko/aass/common/server/camunda"
+	requestIdVariable = "requestId"
+)
+
+// CamundaCheck checks that the engine at its REST endpoint url is reachable.
+func CamundaCheck(engineRestUrl string) HealthCheck {
+	return HealthCheck{
+		Name:  "camunda",
+		Check: httpCheck(strings.TrimSuffix(engineRestUrl, "/") + "/version"),
+	}
+}
+
+// InjectTraceVariables adds the trace context and request id of ctx to the
+// process variables, so the external tasks of the process continue the trace
+// and log the request which started them.
+func InjectTraceVariables(ctx context.Context, variables map[string]camunda_client_go.Variable) {
+	otel.GetTextMapPropagator().Inject(ctx, variablesCarrier(variables))
+	if id := chi_middleware.GetReqID(ctx); id != "" {
+		variablesCarrier(variables).Set(requestIdVariable, id)
+	}
+}
+
+// StartTaskSpan starts the span of handling the external task, continuing the
+// trace and request id propagated in the variables of its process.
+func StartTaskSpan(
+	ctx context.Context,
+	task *camunda_client_go.ResLockedExternalTask,
+) (context.Context, trace.Span) {
+	ctx = otel.GetTextMapPropagator().Extract(ctx, variablesCarrier(task.Variables))
+	if id := variablesCarrier(task.Variables).Get(requestIdVariable); validRequestId(id) {
+		ctx = withRequestId(ctx, id)
+	}
+	return otel.Tracer(camundaTracerName).Start(
+		ctx,
+		"process "+task.TopicName,
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			attribute.String("camunda.topic", task.TopicName),
+			attribute.String("camunda.process_instance_id", task.ProcessInstanceId),
+			attribute.String("camunda.business_key", task.BusinessKey),
+		),
+	)
+}
+
+// variablesCarrier adapts process variables to propagation.TextMapCarrier.
+type variablesCarrier map[string]camunda_client_go.Variable
+
+func (c variablesCarrier) Get(key string) string {
+	value, _ := c[key].Value.(string)
+	return value
+}
+
+func (c variablesCarrier) Set(key, value string) {
+	c[key] = camunda_client_go.Variable{Value: value, Type: "String"}
+}
+
+func (c variablesCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
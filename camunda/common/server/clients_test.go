@@ -0,0 +1,137 @@
+package server
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBreaker(t *testing.T) {
+	const cooldown = 10 * time.Second
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	// step either records the outcome of a call, asks whether a call is
+	// allowed, or releases the probing call, at start+at
+	type step struct {
+		at        time.Duration
+		allow     bool
+		wantAllow bool
+		record    bool
+		ok        bool
+		release   bool
+	}
+	allow := func(at time.Duration, want bool) step {
+		return step{at: at, allow: true, wantAllow: want}
+	}
+	record := func(at time.Duration, ok bool) step {
+		return step{at: at, record: true, ok: ok}
+	}
+
+	tests := []struct {
+		name      string
+		threshold int
+		steps     []step
+	}{
+		{
+			name:      "stays closed below threshold",
+			threshold: 3,
+			steps: []step{
+				record(0, false),
+				record(0, false),
+				allow(0, true),
+			},
+		},
+		{
+			name:      "success resets failures",
+			threshold: 2,
+			steps: []step{
+				record(0, false),
+				record(0, true),
+				record(0, false),
+				allow(0, true),
+			},
+		},
+		{
+			name:      "opens at threshold",
+			threshold: 2,
+			steps: []step{
+				record(0, false),
+				record(0, false),
+				allow(0, false),
+				allow(cooldown-time.Nanosecond, false),
+			},
+		},
+		{
+			name:      "lets a single probe through after cooldown",
+			threshold: 2,
+			steps: []step{
+				record(0, false),
+				record(0, false),
+				allow(cooldown, true),
+				allow(cooldown, false),
+			},
+		},
+		{
+			name:      "successful probe closes it",
+			threshold: 2,
+			steps: []step{
+				record(0, false),
+				record(0, false),
+				allow(cooldown, true),
+				record(cooldown, true),
+				allow(cooldown, true),
+				allow(cooldown, true),
+			},
+		},
+		{
+			name:      "failed probe opens it for another cooldown",
+			threshold: 2,
+			steps: []step{
+				record(0, false),
+				record(0, false),
+				allow(cooldown, true),
+				record(cooldown, false),
+				allow(2*cooldown-time.Nanosecond, false),
+				allow(2*cooldown, true),
+			},
+		},
+		{
+			name:      "released probe lets another one through",
+			threshold: 2,
+			steps: []step{
+				record(0, false),
+				record(0, false),
+				allow(cooldown, true),
+				{at: cooldown, release: true},
+				allow(cooldown, true),
+			},
+		},
+		{
+			name:      "zero threshold never opens",
+			threshold: 0,
+			steps: []step{
+				record(0, false),
+				record(0, false),
+				allow(0, true),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &breaker{threshold: tt.threshold, cooldown: cooldown}
+			for i, s := range tt.steps {
+				now := start.Add(s.at)
+				switch {
+				case s.allow:
+					if got := b.allow(now); got != s.wantAllow {
+						t.Errorf("step %d: allow = %v, want %v", i, got, s.wantAllow)
+					}
+				case s.record:
+					b.record(s.ok, now)
+				case s.release:
+					b.release()
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package server
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Nesquiko/aass/common/server/api"
+)
+
+const (
+	// ETagHeader carries the version of the returned resource.
+	ETagHeader = "ETag"
+	// IfMatchHeader carries the ETag of the resource to update, so that an
+	// update based on a stale read doesn't overwrite a newer change.
+	IfMatchHeader = "If-Match"
+)
+
+const PreconditionFailedCode = "precondition.failed"
+
+// AnyVersion is what If-Match: * asks for, it matches whatever version the
+// resource is at.
+const AnyVersion int64 = -1
+
+func SetETag(w http.ResponseWriter, version int64) {
+	w.Header().Set(ETagHeader, strconv.Quote(strconv.FormatInt(version, 10)))
+}
+
+// IfMatchVersions parses the versions out of the If-Match header, a list of
+// ETags separated by commas. * or a missing header is parsed as AnyVersion, so
+// that clients which don't read ETags can still update. ETags are compared
+// strongly, a weak one never matches, nor does one which isn't a version. If
+// none of the ETags can match, the request is rejected with 412.
+func IfMatchVersions(ifMatch *string) ([]int64, *ApiError) {
+	if ifMatch == nil || strings.TrimSpace(*ifMatch) == "" {
+		return []int64{AnyVersion}, nil
+	}
+	header := strings.TrimSpace(*ifMatch)
+	if header == "*" {
+		return []int64{AnyVersion}, nil
+	}
+
+	var versions []int64
+	for header != "" {
+		weak := strings.HasPrefix(header, "W/")
+		header = strings.TrimPrefix(header, "W/")
+		if !strings.HasPrefix(header, `"`) {
+			return nil, PreconditionFailed()
+		}
+		opaque, rest, ok := strings.Cut(header[1:], `"`)
+		if !ok {
+			return nil, PreconditionFailed()
+		}
+		header = strings.TrimSpace(rest)
+		if header != "" {
+			header, ok = strings.CutPrefix(header, ",")
+			if !ok {
+				return nil, PreconditionFailed()
+			}
+			header = strings.TrimSpace(header)
+		}
+
+		version, err := strconv.ParseInt(opaque, 10, 64)
+		if weak || err != nil {
+			continue
+		}
+		versions = append(versions, version)
+	}
+	if len(versions) == 0 {
+		return nil, PreconditionFailed()
+	}
+	return versions, nil
+}
+
+// MatchVersion resolves the versions from If-Match against the current one of
+// the resource, which must be among them, AnyVersion matches current. A
+// mismatch is rejected with 412.
+func MatchVersion(current int64, versions []int64) (int64, *ApiError) {
+	if !slices.Contains(versions, AnyVersion) && !slices.Contains(versions, current) {
+		return 0, PreconditionFailed()
+	}
+	return current, nil
+}
+
+func PreconditionFailed() *ApiError {
+	return &ApiError{
+		ErrorDetail: api.ErrorDetail{
+			Code:   PreconditionFailedCode,
+			Title:  "Precondition Failed",
+			Detail: "Resource was changed since it was read, read it again and retry.",
+			Status: http.StatusPreconditionFailed,
+		},
+	}
+}
@@ -0,0 +1,68 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	chi_middleware "github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+)
+
+// maxRequestIdLength limits the length of an accepted request id, longer ids
+// are replaced, so that a caller can't bloat every log line.
+const maxRequestIdLength = 128
+
+// RequestId accepts the request id sent by the caller, or creates one, stores
+// it in the context of the request and sends it back in the response headers.
+// It's stored under chi's request id key, so GetReqID and the request logger
+// see it.
+func RequestId(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(chi_middleware.RequestIDHeader)
+		if !validRequestId(id) {
+			id = uuid.NewString()
+		}
+		w.Header().Set(chi_middleware.RequestIDHeader, id)
+		next.ServeHTTP(w, r.WithContext(withRequestId(r.Context(), id)))
+	})
+}
+
+func withRequestId(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, chi_middleware.RequestIDKey, id)
+}
+
+// validRequestId accepts only printable ASCII without spaces, so that the id
+// can't forge log lines or headers.
+func validRequestId(id string) bool {
+	if id == "" || len(id) > maxRequestIdLength {
+		return false
+	}
+	for i := range len(id) {
+		if id[i] <= ' ' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// requestIdHandler adds the request id of the context to every record logged
+// with it.
+type requestIdHandler struct {
+	slog.Handler
+}
+
+func (h requestIdHandler) Handle(ctx context.Context, record slog.Record) error {
+	if id := chi_middleware.GetReqID(ctx); id != "" {
+		record.AddAttrs(slog.String("request_id", id))
+	}
+	return h.Handler.Handle(ctx, record)
+}
+
+func (h requestIdHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return requestIdHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h requestIdHandler) WithGroup(name string) slog.Handler {
+	return requestIdHandler{h.Handler.WithGroup(name)}
+}
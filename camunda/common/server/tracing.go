@@ -0,0 +1,140 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/httplog/v2"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// OtlpExporter sends spans to a collector over OTLP/HTTP.
+	OtlpExporter = "otlp"
+	// StdoutExporter prints spans, meant for running a service locally.
+	StdoutExporter = "stdout"
+	// NoExporter disables tracing.
+	NoExporter = "none"
+)
+
+type TracingConfig struct {
+	// Exporter is one of OtlpExporter, StdoutExporter or NoExporter.
+	Exporter string `mapstructure:"exporter"`
+	// OtlpEndpoint is the host and port of the collector.
+	OtlpEndpoint string `mapstructure:"otlp_endpoint"`
+	OtlpInsecure bool   `mapstructure:"otlp_insecure"`
+	// SampleRatio is the fraction of traces started by this service which are
+	// sampled, a trace propagated from a caller is sampled as the caller decided.
+	SampleRatio float64 `mapstructure:"sample_ratio"`
+}
+
+// SetupTracing installs the global tracer provider of the service and the W3C
+// trace context propagator. The returned func flushes the buffered spans.
+func SetupTracing(
+	ctx context.Context,
+	serviceName string,
+	cfg TracingConfig,
+) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch cfg.Exporter {
+	case OtlpExporter:
+		opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cfg.OtlpEndpoint)}
+		if cfg.OtlpInsecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		exporter, err = otlptracehttp.New(ctx, opts...)
+	case StdoutExporter:
+		exporter, err = stdouttrace.New(stdouttrace.WithPrettyPrint())
+	case NoExporter:
+		return func(context.Context) error { return nil }, nil
+	default:
+		return nil, fmt.Errorf("SetupTracing unknown exporter %q", cfg.Exporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("SetupTracing failed to create %s exporter: %w", cfg.Exporter, err)
+	}
+
+	res, err := resource.New(
+		ctx,
+		resource.WithTelemetrySDK(),
+		resource.WithAttributes(semconv.ServiceName(serviceName)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("SetupTracing failed to create resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// traceHandler starts a server span for every request, except heartbeats,
+// health checks and metric scrapes, continuing the trace propagated by the
+// caller.
+func traceHandler(next http.Handler) http.Handler {
+	return otelhttp.NewHandler(
+		next,
+		"server",
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return r.Method
+		}),
+		otelhttp.WithFilter(func(r *http.Request) bool {
+			switch r.URL.Path {
+			case heartbeatPath, livenessPath, readinessPath, metricsPath:
+				return false
+			}
+			return true
+		}),
+	)
+}
+
+// traceRoute adds the trace id to the log entry of the request and names the
+// server span after the route the request matched.
+func traceRoute(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		span := trace.SpanFromContext(r.Context())
+		if traceId := span.SpanContext().TraceID(); traceId.IsValid() {
+			httplog.LogEntrySetField(r.Context(), "trace_id", slog.StringValue(traceId.String()))
+		}
+
+		next.ServeHTTP(w, r)
+
+		if pattern := chi.RouteContext(r.Context()).RoutePattern(); pattern != "" {
+			span.SetName(r.Method + " " + pattern)
+			span.SetAttributes(semconv.HTTPRoute(pattern))
+		}
+	})
+}
+
+// traceTransport starts a client span for every call to the upstream and
+// propagates the trace context to it.
+func traceTransport(upstream Upstream, next http.RoundTripper) http.RoundTripper {
+	return otelhttp.NewTransport(
+		next,
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return fmt.Sprintf("%s %s", r.Method, upstream)
+		}),
+	)
+}
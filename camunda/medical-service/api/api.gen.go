@@ -75,6 +75,9 @@ type Condition struct {
 	Id              *openapi_types.UUID   `json:"id,omitempty"`
 	Name            string                `json:"name"`
 	Start           time.Time             `json:"start"`
+
+	// Version Version of the condition, sent back as its ETag.
+	Version int64 `json:"version"`
 }
 
 // ConditionDisplay Basic info about a patient's condition.
@@ -86,6 +89,21 @@ type ConditionDisplay struct {
 	Start           time.Time             `json:"start"`
 }
 
+// ConditionRecord defines model for ConditionRecord.
+type ConditionRecord struct {
+	End       *time.Time         `json:"end,omitempty"`
+	Id        openapi_types.UUID `json:"id"`
+	Name      string             `json:"name"`
+	PatientId openapi_types.UUID `json:"patientId"`
+	Start     time.Time          `json:"start"`
+}
+
+// MedicalRecordsExport defines model for MedicalRecordsExport.
+type MedicalRecordsExport struct {
+	Conditions    []ConditionRecord    `json:"conditions"`
+	Prescriptions []PrescriptionRecord `json:"prescriptions"`
+}
+
 // NewCondition defines model for NewCondition.
 type NewCondition struct {
 	AppointmentsIds *[]openapi_types.UUID `json:"appointmentsIds,omitempty"`
@@ -116,6 +134,9 @@ type Prescription struct {
 	Id            *openapi_types.UUID `json:"id,omitempty"`
 	Name          string              `json:"name"`
 	Start         time.Time           `json:"start"`
+
+	// Version Version of the prescription, sent back as its ETag.
+	Version int64 `json:"version"`
 }
 
 // PrescriptionDisplay Basic info about a patient's condition.
@@ -127,6 +148,18 @@ type PrescriptionDisplay struct {
 	Start         time.Time           `json:"start"`
 }
 
+// PrescriptionRecord defines model for PrescriptionRecord.
+type PrescriptionRecord struct {
+	AppointmentId *openapi_types.UUID `json:"appointmentId,omitempty"`
+	DeletedAt     *time.Time          `json:"deletedAt,omitempty"`
+	DoctorsNote   *string             `json:"doctorsNote,omitempty"`
+	End           time.Time           `json:"end"`
+	Id            openapi_types.UUID  `json:"id"`
+	Name          string              `json:"name"`
+	PatientId     openapi_types.UUID  `json:"patientId"`
+	Start         time.Time           `json:"start"`
+}
+
 // UpdateCondition defines model for UpdateCondition.
 type UpdateCondition struct {
 	End       nullable.Nullable[time.Time] `json:"end"`
@@ -173,6 +206,27 @@ type Prescriptions struct {
 	Prescriptions []PrescriptionDisplay `json:"prescriptions"`
 }
 
+// AuditEventsParams defines parameters for AuditEvents.
+type AuditEventsParams struct {
+	// TargetId Only events performed on the entity with this ID.
+	TargetId *externalRef0.AuditTargetId `form:"targetId,omitempty" json:"targetId,omitempty"`
+
+	// Actor Only events performed by this actor.
+	Actor *externalRef0.AuditActor `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// From Only events created at or after this instant.
+	From *externalRef0.AuditFrom `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only events created at or before this instant.
+	To *externalRef0.AuditTo `form:"to,omitempty" json:"to,omitempty"`
+
+	// AfterSeq Only events after this position in the audit chain.
+	AfterSeq *externalRef0.AuditAfterSeq `form:"afterSeq,omitempty" json:"afterSeq,omitempty"`
+
+	// Limit Maximum number of returned events, 100 if not set.
+	Limit *externalRef0.AuditLimit `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // ConditionsInDateRangeParams defines parameters for ConditionsInDateRange.
 type ConditionsInDateRangeParams struct {
 	// From The specific day form which to retrieve resources.
@@ -182,6 +236,15 @@ type ConditionsInDateRangeParams struct {
 	To *To `form:"to,omitempty" json:"to,omitempty"`
 }
 
+// UpdateConditionParams defines parameters for UpdateCondition.
+type UpdateConditionParams struct {
+	// IfMatch ETags of the resource the update is based on, separated by commas. The
+	// update is applied only if the resource is at one of them, `*` or no
+	// header at all applies it at any version. ETags are compared strongly, a
+	// weak ETag never matches.
+	IfMatch *externalRef0.IfMatch `json:"If-Match,omitempty"`
+}
+
 // PrescriptionsInDateRangeParams defines parameters for PrescriptionsInDateRange.
 type PrescriptionsInDateRangeParams struct {
 	// From The specific day form which to retrieve resources.
@@ -191,35 +254,62 @@ type PrescriptionsInDateRangeParams struct {
 	To *To `form:"to,omitempty" json:"to,omitempty"`
 }
 
+// UpdatePrescriptionParams defines parameters for UpdatePrescription.
+type UpdatePrescriptionParams struct {
+	// IfMatch ETags of the resource the update is based on, separated by commas. The
+	// update is applied only if the resource is at one of them, `*` or no
+	// header at all applies it at any version. ETags are compared strongly, a
+	// weak ETag never matches.
+	IfMatch *externalRef0.IfMatch `json:"If-Match,omitempty"`
+}
+
 // CreatePatientConditionJSONRequestBody defines body for CreatePatientCondition for application/json ContentType.
 type CreatePatientConditionJSONRequestBody = NewCondition
 
+// GetConditionsBatchJSONRequestBody defines body for GetConditionsBatch for application/json ContentType.
+type GetConditionsBatchJSONRequestBody = externalRef0.BatchIds
+
 // UpdateConditionJSONRequestBody defines body for UpdateCondition for application/json ContentType.
 type UpdateConditionJSONRequestBody = UpdateCondition
 
 // CreatePrescriptionJSONRequestBody defines body for CreatePrescription for application/json ContentType.
 type CreatePrescriptionJSONRequestBody = NewPrescription
 
+// GetPrescriptionsByAppointmentIdsJSONRequestBody defines body for GetPrescriptionsByAppointmentIds for application/json ContentType.
+type GetPrescriptionsByAppointmentIdsJSONRequestBody = externalRef0.BatchIds
+
 // UpdatePrescriptionJSONRequestBody defines body for UpdatePrescription for application/json ContentType.
 type UpdatePrescriptionJSONRequestBody = UpdatePrescription
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Audit log
+	// (GET /audit)
+	AuditEvents(w http.ResponseWriter, r *http.Request, params AuditEventsParams)
 	// Create a condition record for a patient
 	// (POST /conditions)
 	CreatePatientCondition(w http.ResponseWriter, r *http.Request)
+	// Get conditions by IDs
+	// (POST /conditions/batch)
+	GetConditionsBatch(w http.ResponseWriter, r *http.Request)
 	// Conditions in date range
 	// (GET /conditions/patient/{patientId})
 	ConditionsInDateRange(w http.ResponseWriter, r *http.Request, patientId PatientId, params ConditionsInDateRangeParams)
+	// Export patient's medical records
+	// (GET /conditions/patient/{patientId}/export)
+	ExportPatientMedicalRecords(w http.ResponseWriter, r *http.Request, patientId PatientId)
 	// Condition detail
 	// (GET /conditions/{conditionId})
 	ConditionDetail(w http.ResponseWriter, r *http.Request, conditionId ConditionId)
 	// Update a condition
 	// (PATCH /conditions/{conditionId})
-	UpdateCondition(w http.ResponseWriter, r *http.Request, conditionId ConditionId)
+	UpdateCondition(w http.ResponseWriter, r *http.Request, conditionId ConditionId, params UpdateConditionParams)
 	// Create a prescriptions record for a patient
 	// (POST /prescriptions)
 	CreatePrescription(w http.ResponseWriter, r *http.Request)
+	// Get prescriptions by appointment IDs
+	// (POST /prescriptions/appointment/batch)
+	GetPrescriptionsByAppointmentIds(w http.ResponseWriter, r *http.Request)
 	// Get prescriptions by appointment ID
 	// (GET /prescriptions/appointment/{appointmentId})
 	GetPrescriptionsByAppointmentId(w http.ResponseWriter, r *http.Request, appointmentId AppointmentId)
@@ -234,25 +324,43 @@ type ServerInterface interface {
 	PrescriptionDetail(w http.ResponseWriter, r *http.Request, prescriptionId PrescriptionId)
 	// Update prescription
 	// (PATCH /prescriptions/{prescriptionId})
-	UpdatePrescription(w http.ResponseWriter, r *http.Request, prescriptionId PrescriptionId)
+	UpdatePrescription(w http.ResponseWriter, r *http.Request, prescriptionId PrescriptionId, params UpdatePrescriptionParams)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
+// Audit log
+// (GET /audit)
+func (_ Unimplemented) AuditEvents(w http.ResponseWriter, r *http.Request, params AuditEventsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Create a condition record for a patient
 // (POST /conditions)
 func (_ Unimplemented) CreatePatientCondition(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get conditions by IDs
+// (POST /conditions/batch)
+func (_ Unimplemented) GetConditionsBatch(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Conditions in date range
 // (GET /conditions/patient/{patientId})
 func (_ Unimplemented) ConditionsInDateRange(w http.ResponseWriter, r *http.Request, patientId PatientId, params ConditionsInDateRangeParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Export patient's medical records
+// (GET /conditions/patient/{patientId}/export)
+func (_ Unimplemented) ExportPatientMedicalRecords(w http.ResponseWriter, r *http.Request, patientId PatientId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Condition detail
 // (GET /conditions/{conditionId})
 func (_ Unimplemented) ConditionDetail(w http.ResponseWriter, r *http.Request, conditionId ConditionId) {
@@ -261,7 +369,7 @@ func (_ Unimplemented) ConditionDetail(w http.ResponseWriter, r *http.Request, c
 
 // Update a condition
 // (PATCH /conditions/{conditionId})
-func (_ Unimplemented) UpdateCondition(w http.ResponseWriter, r *http.Request, conditionId ConditionId) {
+func (_ Unimplemented) UpdateCondition(w http.ResponseWriter, r *http.Request, conditionId ConditionId, params UpdateConditionParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -271,6 +379,12 @@ func (_ Unimplemented) CreatePrescription(w http.ResponseWriter, r *http.Request
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get prescriptions by appointment IDs
+// (POST /prescriptions/appointment/batch)
+func (_ Unimplemented) GetPrescriptionsByAppointmentIds(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get prescriptions by appointment ID
 // (GET /prescriptions/appointment/{appointmentId})
 func (_ Unimplemented) GetPrescriptionsByAppointmentId(w http.ResponseWriter, r *http.Request, appointmentId AppointmentId) {
@@ -297,7 +411,7 @@ func (_ Unimplemented) PrescriptionDetail(w http.ResponseWriter, r *http.Request
 
 // Update prescription
 // (PATCH /prescriptions/{prescriptionId})
-func (_ Unimplemented) UpdatePrescription(w http.ResponseWriter, r *http.Request, prescriptionId PrescriptionId) {
+func (_ Unimplemented) UpdatePrescription(w http.ResponseWriter, r *http.Request, prescriptionId PrescriptionId, params UpdatePrescriptionParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -310,6 +424,73 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
+// AuditEvents operation middleware
+func (siw *ServerInterfaceWrapper) AuditEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AuditEventsParams
+
+	// ------------- Optional query parameter "targetId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "targetId", r.URL.Query(), &params.TargetId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "targetId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "actor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor", r.URL.Query(), &params.Actor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "afterSeq" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "afterSeq", r.URL.Query(), &params.AfterSeq)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "afterSeq", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AuditEvents(w, r, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // CreatePatientCondition operation middleware
 func (siw *ServerInterfaceWrapper) CreatePatientCondition(w http.ResponseWriter, r *http.Request) {
 
@@ -317,8 +498,22 @@ func (siw *ServerInterfaceWrapper) CreatePatientCondition(w http.ResponseWriter,
 		siw.Handler.CreatePatientCondition(w, r)
 	}))
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetConditionsBatch operation middleware
+func (siw *ServerInterfaceWrapper) GetConditionsBatch(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetConditionsBatch(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
 	handler.ServeHTTP(w, r)
@@ -368,8 +563,33 @@ func (siw *ServerInterfaceWrapper) ConditionsInDateRange(w http.ResponseWriter,
 		siw.Handler.ConditionsInDateRange(w, r, patientId, params)
 	}))
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportPatientMedicalRecords operation middleware
+func (siw *ServerInterfaceWrapper) ExportPatientMedicalRecords(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "patientId" -------------
+	var patientId PatientId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "patientId", chi.URLParam(r, "patientId"), &patientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "patientId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportPatientMedicalRecords(w, r, patientId)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
 	handler.ServeHTTP(w, r)
@@ -393,8 +613,8 @@ func (siw *ServerInterfaceWrapper) ConditionDetail(w http.ResponseWriter, r *htt
 		siw.Handler.ConditionDetail(w, r, conditionId)
 	}))
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
 	handler.ServeHTTP(w, r)
@@ -414,12 +634,36 @@ func (siw *ServerInterfaceWrapper) UpdateCondition(w http.ResponseWriter, r *htt
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateConditionParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch externalRef0.IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateCondition(w, r, conditionId)
+		siw.Handler.UpdateCondition(w, r, conditionId, params)
 	}))
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
 	handler.ServeHTTP(w, r)
@@ -432,8 +676,22 @@ func (siw *ServerInterfaceWrapper) CreatePrescription(w http.ResponseWriter, r *
 		siw.Handler.CreatePrescription(w, r)
 	}))
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPrescriptionsByAppointmentIds operation middleware
+func (siw *ServerInterfaceWrapper) GetPrescriptionsByAppointmentIds(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPrescriptionsByAppointmentIds(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
 	handler.ServeHTTP(w, r)
@@ -457,8 +715,8 @@ func (siw *ServerInterfaceWrapper) GetPrescriptionsByAppointmentId(w http.Respon
 		siw.Handler.GetPrescriptionsByAppointmentId(w, r, appointmentId)
 	}))
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
 	handler.ServeHTTP(w, r)
@@ -508,8 +766,8 @@ func (siw *ServerInterfaceWrapper) PrescriptionsInDateRange(w http.ResponseWrite
 		siw.Handler.PrescriptionsInDateRange(w, r, patientId, params)
 	}))
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
 	handler.ServeHTTP(w, r)
@@ -533,8 +791,8 @@ func (siw *ServerInterfaceWrapper) DeletePrescription(w http.ResponseWriter, r *
 		siw.Handler.DeletePrescription(w, r, prescriptionId)
 	}))
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
 	handler.ServeHTTP(w, r)
@@ -558,8 +816,8 @@ func (siw *ServerInterfaceWrapper) PrescriptionDetail(w http.ResponseWriter, r *
 		siw.Handler.PrescriptionDetail(w, r, prescriptionId)
 	}))
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
 	handler.ServeHTTP(w, r)
@@ -579,12 +837,36 @@ func (siw *ServerInterfaceWrapper) UpdatePrescription(w http.ResponseWriter, r *
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdatePrescriptionParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch externalRef0.IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdatePrescription(w, r, prescriptionId)
+		siw.Handler.UpdatePrescription(w, r, prescriptionId, params)
 	}))
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
 	handler.ServeHTTP(w, r)
@@ -703,12 +985,21 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/audit", wrapper.AuditEvents)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/conditions", wrapper.CreatePatientCondition)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/conditions/batch", wrapper.GetConditionsBatch)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/conditions/patient/{patientId}", wrapper.ConditionsInDateRange)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/conditions/patient/{patientId}/export", wrapper.ExportPatientMedicalRecords)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/conditions/{conditionId}", wrapper.ConditionDetail)
 	})
@@ -718,6 +1009,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/prescriptions", wrapper.CreatePrescription)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/prescriptions/appointment/batch", wrapper.GetPrescriptionsByAppointmentIds)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/prescriptions/appointment/{appointmentId}", wrapper.GetPrescriptionsByAppointmentId)
 	})
@@ -740,38 +1034,68 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xa33PbuBH+VzBoZ3o3lUT5V5zozYmvVz8kl3GSpxuPBgJXFu5IgAFAu6pG/3sHAEUC",
-	"IkVSlnyaXvtmWfjx7X7fLnYBrTAVaSY4cK3wZIUzIkkKGqT9RLJMMK5T4PouNv+IQVHJMs0ExxP8dQEo",
-	"5+x7DojFwDWbM5Doh2/f7m5/RGKO9AKQt8QIDzD8i6RZAniC40u4mr8h18PZW/puOD47vxheXr25Hr59",
-	"NyYzGsP87PwCDzAzG2VEL/AAc5KamSGqAZbwPWcSYjzRMocBVnQBKTFw50KmROMJznNmRuplZhZQWjL+",
-	"iNfrAaaCx8yY8xL7CCqnH8s2H89hls2lSJtNUhlQNmcUxWSJzELoecHoAmmBJGjJ4AmQBCVySUGFhp2P",
-	"zy+H4+vh2dUG/vcc5LLCb3ftBTwmGhqBZ0SzAwRXTA9xz87phSFlaFl5+258Njy/uLwavrmuKGkmpEJz",
-	"GB2ZrCx5mdb8FY4lty1Uh5moRQ+9aXFEtWmB99HW2tinMsEV2PT2YRNs9hMVXAPXRd5LGCXmq+g3ZQxZ",
-	"edtkUmQgNYPNNG8RpiG1f/xVwhxP8F+iKr9GbgkVlfveMpUlZInXJVYipfm89pn41d/joRwqZr8B1c6s",
-	"0OlfckpBqXmeJMvSyTFKmNJGStVqI7PzZ08Dhzgi216nly/83fu6I9zpCB4JFrROoSJNBZ8qkE8gpyRj",
-	"U/efociAm493XIPkJPliR/wkpZD3hbZafJhJMUsg/fvGl6XWzYzY2MCKdUdu6xGYlbGxRxOW4Am+4Sjn",
-	"v3PxzJEbguwQJCjNpXHQACtNdK7w5Go8HmDNtA2mDeBgljG14rONp06HWBfcOpQNDNzwLZwj9AW83OAw",
-	"I+MFcyYhZ68ho4RotXRTHf4budSSzj0YQg18RLhfgaAnBs8mx4TK9UbcEg1fWQrNmczkFaRZCjuqmyAB",
-	"Dc3AehYa4FhQLeQn4napfc0aTodvtZPB+OgY9VVHWi+P451wN2Jrl49H2xc3oQzy3hO/muHbucCCbiIw",
-	"8HNoRgm6gFDPIANcB9yoCKtlrjfy3VH08jw1WA1uUNrGKCWcQpK4eKULiHP3t3FBAm5MDJxBbOBVtPpj",
-	"a1xsO6sRspnVCfQxT4ic0gXQ381xC8/TwoFWMkkinqd5ZjzPeU6SabZYKkZJYg3gKk+0zXd4gJ8IpYxv",
-	"PuXyEbieUiLBRSGFOLd/20RATEKePjHFtEdKZV95cNqoTZJf5njy695n7Wp3+Pc/txryUNexFexT19yD",
-	"b+DO3PaeKEYR43OByEzk2hSGjpm/qbAd2W3kXRza2ZkDQrOMTuJavbUz3bG4aQ8JJP6FJ8tNoVmbxlsS",
-	"jtR9t2/KFrzMAVI3xv4neH4dpQWtTXdBHdQ85dRm5XyCZ7+UqpdntV6+k3WXP9UnoZuJ2EsFO+ncxyeH",
-	"kR/w7uAPWv0a1sb9ldBc07YQ8rJk087PulEmTdBeI8f0pPNPlEicMU0i+paZdYOMEvqtzQs8TxIyM0f/",
-	"vub9cYHVbvj/01JnZPboN/32yrgtdmIiyWfPnU4iW62vJjwmMmb/hrhowYreCv1w/48P6N3l1fWP9Vh2",
-	"7WiDY+ISQ0srULqB2YqxGGl620ewTWfRla46lOWGDRwar2wvQDS3/iZxmaUTRqFox4v7oo93X20Ravro",
-	"hdaZmkSRcbC7fRoJ+RgVk1RkxlZA8UeI2QeSoI+MSmGafkZBoZvPd6bGBamcs89G49HYTCtowxN8MRqP",
-	"Lt1Bs7C+icIbo0woqxzjfLK5HcQfJJjQcSKsUsdg00O8F/Fyr7uatuMlqHcaevfbQi9F01DiR1qgGSBq",
-	"scaj2vXh9n3b+fjsaJjr1VYddzkGSaBCxhukSHnXQSN0DzqXXDnbihGVjdVFxABfjce7cJWGRgddHdnb",
-	"jjxNiVyWKvDfGTaWmPa/PJVNhJFHZQLGu9J8MGt5YouK0dGqzG1rY80jNOmvnHbHTUt9T/ija6Or96Ed",
-	"VVA1JKqSqCl/Ogbbp4Me47TA64easnoQ4/nmFFyWuyPG3U2SLJzah7yV9zLUg7bipNiXMP/5aZeTjxu+",
-	"7XFbBN9J+SpA7OLJZna6qHOxXfYdzsXxM/82xgYy3BA/I84ZJLHqke3/l+Ti3OSn6rbArr2VtFYBfgH9",
-	"ahVAsEt3EeBbcMo6oAu2/31rGXDS8z3QQ9cZb0pRShL0T6a0kMsmSUVeWxWtgh7LPzy2n03cu5hCpPll",
-	"DBGlBGXWd89MLxCp3m/8V5bqmcJoIZT0z6CDB8f3y5utn3PslyXD/vFlhUH4AnoCHfwMesvTs2Xg0bvb",
-	"Pfnfp9YLzP/zlHsnZzUAsLPo68PmKvydxtrFbgLuBiRk89b+f+vM2JPH8FchDe6/rGcPt298Cke7rYMI",
-	"anXwoDsOXlg/d3tufJqT74SVUQOODnZaC+rjK/u1yuougjaVtaun6z/y+kMr7P8WNRV1du9Yt7Ptqk4g",
-	"oVU/8dgesuWtYGQ1USxX3hsGNwfVbeLWZuuH9X8CAAD//0p2tPNPKwAA",
+	"H4sIAAAAAAAC/+w8a2/buJZ/hdAucO/syo+kSTv1t7RppwGmnaJNZxYYBwktHducSKRKUk49gf/7gg9J",
+	"lETbcuw2vcV8c2zy8LxfPMx9ELE0YxSoFMHoPpgDjoHrjxFLU0avBfAF8GuckWvzTY9lQNWfry7xTC2M",
+	"QUScZJIwGoyC34ELwihiUyTngDgIlvMIQiQZmgASQCUiFF1Me2+xjOZqHZEC5VmMJfSDMBDRHFKsAMtl",
+	"BsEoEJITOgtWq1UYZJjjFKRFEWcZI1SmQOVF3Eblcg4op+RzDojEQCWZEuDo358+XZz/VODngFCHwxec",
+	"Zok6NT6B0+lT/Kw3+Tl63hseHT/pnZw+fdb7+fkQT6IYpkfHT4IwIOqgDMt5EAYUp2pnHasw4PA5Jxzi",
+	"YCR5Di6BU8ZTLINRkOdErWwSHG4XwlkeE3kWScbb9P9GkyWChZItyoCr0yBGkyWScyIQVpv6BQmfc+BL",
+	"hwYNcZMwOuM2lcA/wufN6GG1yuCVMUHUCqUlWkIKCormmNC12BZneJlLqHx6EoRBSihJ8zQYDUtOEyph",
+	"BnwHcl5zlm4mJeKAJcQIS8S4SxihQmIq1xExVZC9BCjL6EmSwh4q8itJiWwj/hZ/UTxBNE8nwJVRcJA5",
+	"pxBbckJ0NBwiMkWUSSRgLfaJhu+inxrQwehoOBw63D/ag/uXmM/Aa+t+XWdGhZTtyyW6I3JuRHFxvo4O",
+	"WZzwNez0ku2iOhOYMg6ddEeyr6I5F1Pto9tIK9cvmi5e/2H8OCICTbDQEgiRAOW2pfE96ggs+uhyDmNa",
+	"rcZZlhC9PlkqdasBVr9LxCjYI9MQ3fzPjeIRZWNqYpZagZPEAhKISP0NXaKFiUh9ZLDGHBQSGeYQIyE5",
+	"o7NkGSI8pneAb/UiRGEBHKWKeBD9MS3Ybo6q+F5Esa2eksbapz0kSmFUbj9UhHLx2S8+Tb3uUJEkMojI",
+	"lEQoxkukAKG7OYnmKg3gIDmBRSVgUSfseHh80hs+6x2dbnaVHRBX6uVFPMOS7JE22O11vCfH0RMllJ6W",
+	"ys/Ph0e94ycnp72nzyqR+AVSYbOfODJeUfIwXXMhHErdGljtR6JkHfRNsgNq2ybn6kFxpegTGaMCdJL6",
+	"sjA2m1VTCVTa7DUhEVY/Df4SipB755iMswy4JFBsc4AQCan+8N8cpsEo+K9BlcUPDAgxKM89JyJL8DJY",
+	"lbhiztXfK1cSf7pnXJVL2eQviKQhq870j3kUgRDTPEmWJZNjlBAhlSpV0Prq5PeODuzDiKwJpxMv3NO7",
+	"sqN+0gE4UgOomdIta3i1KKqzB/IMSgCdmLUDVm0mhgGFLxuS/o8gVXhPGYci65myJGF3IcqwMGFboJsi",
+	"o79RFjwDqZzumCrQKMMzMCG5leF78kpXopYPe4jS1CIGTjcJvmZ8QuIY6AfrEzbIMeNskkD6v2157iWu",
+	"V5wzfg4Sk8RHaokh6qmkDEU4SYAjIui/dEbF7iBWUrBZtQ5/SruwCRGd8kgqgVOcfNQrND4PYEfpstWO",
+	"uBC0gts3R/dBQQ4UgZrYUXBGUU5vKbujyCxBegliUZRzpRVhICSWuQhGp6pIkUQmOq+zgGu7FKnfRiRn",
+	"tIFnH30EJ8QZnJHigkqtkKG3o0a+51D65teYJBB/t6rpoooMrlZJy9LgDgvVG6AzlcwTaqsQncQ7Xaa+",
+	"9gUWLYX1WdWjKeJBy1V9AOWxTXuCuo0itCBwp5KIupt1VpxjCZckBX+qomseSVJY04TqUr6FQcwiyfg7",
+	"bE5p/Uw86d+nVuqntOcQbbAteVuZb69FtzDDzQrliO2j2VAGoM4bL9XyZmjQSPsEWONznYwSaYtCO66E",
+	"QRthr0ZoK6eyMOw1vUmq2id/arxBSO29IkwjSBIo+hVxbj4rFiRg1sRACcTBlStWd21LFk1meVFWu7Yi",
+	"OssTzK+jOUS3QRhQuLu2DNQqo2LLdZ4pzlOa4+Q6my8FiXCiCaAiT6T2PkEYLHAUEVr8lfMZUHkdYQ7G",
+	"CiOIc/1Zu0isMq7rBRFEBlce+srMWFttkvw2DUZ/7pxM3683/+65lscPeVIq28HY2mwvnWVo+uwTHN2q",
+	"dIpIoV1i/wFJU42sCpW2sl+5nF3rVF9gQSJE6JQhPGG5VCWnUYl/iXqjYz13L+I6g7c6nyY/gcZd22SF",
+	"I22ewQHHqnVXlLCtbXSDp+Nyhy5dy03R0vlw6XU6pRQ+QMR47CkI9qe/O721TstWMHtzx22lbOXUW4iV",
+	"vzF8Eq++ZIzLNrv2Kb6tCDxKuH8luw72+ro+3FrVhsE7uPs6DnIXRWjW4uVWv995B3cuY9oibN0UblVE",
+	"E/bFOyb9ar2TDX0HxlGzBYN+uJGv9Z5Nd03w91o2CORhMXKbfLrGTNcgDhg2N8dJH4++RqjsqFc/UDw0",
+	"xGzT5nWB8QFuAnSafSa7M/CgnuVHic7r5fZJ3xHWQlL3bIbmSYInCeyult+OMZsJ/yeutbnXYlW3lvVL",
+	"3SfyRASc5GCuvwShswTQlEASFxfwmMblGIcdR1Gt4Eh7X/SWCLVpTG/M8huUAqZCrzVgVItKgDSDN2A3",
+	"hig1G22v224bUyL1Bg4pW5gbcyJNu7shebVrAy3V+Q7uUdmzNch22l/OITgAHi4Ec28wuve0PKnkS6el",
+	"ADTu6XEA03VP2MzKCPiCRNBHrxbA7ezEmEaYcwKG73MsysGyjEMMEQjBeIgE0xMBKYtVH1W3ExDjhtk4",
+	"GVMNP8PC9vjRhAO+NTDNAJJPEJH05hl/zLERZcwohMU0k26h34zz4fBJZMZS9Gfom68WwCfmi5t6H652",
+	"L2uCjs+SsH8S7KLq+Fn+5gI4upszZ1jGFbAHtB1O2SnSkelUsyg2vhsn72us2/8Wypp0+1JDaW7VGW6Y",
+	"3i0szZdGwZUX6wcejVZ65LnCenPWOz59WmqZHtAx+kJolOSxtuqMw+INFvMbLzPV3t9x4mvS/jEHObcG",
+	"KyTjEJuT7DSK/h4WZRYobdPNHjFhLAFMd0gNCkTbmLwpCdRp8oKw3BIaIkgzuSzbx1PChR7Q8RJrG5a+",
+	"gYSL8+KAN5eX75FdaS/vI5wLq5f6VC9w4btlfF/MELJptd0zUBgiHXqUwLBER50S/bCaEdtAjh05q7RO",
+	"uwJ3Mq2/vW3uy6CEHnMs5jMN7CB059YqQ3WEa5XZ1byrh/jwF0oLbQNunY/RDWz1h7JzNAWlt2aojSq3",
+	"/cneyBFnA+YwpuKWZBnEhZyKKQqf0yW7tgBT/OXCLD4aDrc0TBTwBzHHvc5a6/ZMItpwKRLTGPOY/A2x",
+	"vfqzd3ro3x9ev0TPT06f/dSu9Mw16L2vKilw2MkY42qkTy8KkU5dipLYyuX/eh/Mz72LGJmJuP6arE7m",
+	"dekQKquVrkWZe9f7LQZgloWGbuf6xZLrv9hXBbQCnZAI7CWnHex5e3GpLxOSYBTMpczEaDBQojRXi33G",
+	"ZwO7SQzU2gpR3Th8iRP0lkScfTQJiUBn7y+cFvkoOOoP+0O1zSpIMAqe9If9E1MIzTVvBtodqU8z8ORF",
+	"vxIhRW3uoAw5Ng9CjMfAyyBHeHOCekxrHo9QPUKh4WCJUiYkutHjuzfFZIO+Yi2HLYyPFGNaJZI37pDH",
+	"TStGqECAPoCSYiT19MCY4jgllAjJsWRchK6aoTQXEqkEbllcY0zJLFc06V1IslugCIsxvTnL5Zxx8rfO",
+	"3UboBWAOHJnkSS8rsiftNMo5BaXugTtNU39KsKalVS0Z7DifvAoPBNGM9h8MnB5fPxi0S3ZAQq02HQ6i",
+	"GXlfXTXm8Y6Hw3W5Z7lusNNk1ioMToZPDgC0PSy0CoPTg+C7aQRHz0bkaYr5srASVW3prGKmrMN8F1yp",
+	"hYP6bUjGhPZadUt7qVOQ96YJULVuyujzgsXLnQbaNlUKtQsLzxTJuY2kzUtS+yLJpkv91lzqqqU4RwfD",
+	"uX1d0sa7XIO4blcWmCLhDKcpPytzbhsOxYqKRnc06FtrktECd4C9oETVDWU329Gzl84IakPZBpPiKUKh",
+	"cs1pHTOk57TGRfHiA9CMLKCRcTbS0TFt5KPIpqN95FxAtLXIrKVMjqmp/iD2xZ5foLID8cK+Gfga1tA9",
+	"g1+tVttVvoPGOELTnnD43c2RvcAxsjmrmh9jDKWqDeQK/A54mY9A/Cjm8gtIV60mS3RxLjoahzWlwX3Z",
+	"eF05KWXDOZfbLqiac/qgWyi7JkTlQV0C9rRj2iHZA+N1XQe/uaOrpEaoGe/jlqkPFN4AylkEb1lQOH3Q",
+	"LdDKv6petdsqbLxXCasO1Zjaq6vaemFbL9r/SZIkiCuron6nZgYmbJivz1Lso09rNOAg7tE78eEbwU0S",
+	"lJq1NmiJ1tufR9A0g7BzD9zA0dE4Syl6Q4RkfNlWu3vnGVgHb2Hd6+6FU3nI15XsxhzwZTMhCkL3rXvx",
+	"lN0H3y4bdHsPv1o9glY0qVvnd5TsbBJVF3LzjnUvIR+gfisenhqFOXyS1KTXozFmiZtK68sCEXTMmX40",
+	"nT45Oj6ATm94fPAIdmNk7BYomyJ2a2BvY+3rLP56dW/tlO2lby0zeMTqdxva7u8bi99HrWrredOWytYf",
+	"jWsgBs4wR/dqt44FFoJFRDNK176qzGFTpwR2zhhTTz0cttvJuv27pqZ1JSVeLM/ccRTxw1S4NSr/KXL3",
+	"LXLrKjtZ1t5W1WveXc3mvjYQtdpUP1n7wf73uW1Lqp7fuehW7O3vah87Z1k12h5YKLdU+TtUgB3lv0vv",
+	"o0b+j9P+eHSp1hBY2wTpIs37+n+LWBnb1fNOLWme6+8bqdaOcqyd5mP/Sdt7mHPjx2C0ObpmQRsZHG63",
+	"gwcW9ts5N3ychPFHrPA9BG4R+8ZK/6Am8x9T72/ToqLkN4V++//hfNPS/7tT+R+4AdDZm+rdGqqxlMZ/",
+	"RaOxTmPKoaKBVmgLrhw7qt1VVMNIjcOcn8zt++pq9f8DAGldyTmuUwAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -29,6 +29,7 @@ func dataCondToCond(c Condition, appts []appointmentapi.AppointmentDisplay) api.
 		Start:        c.Start,
 		End:          c.End,
 		Appointments: appointments,
+		Version:      c.Version,
 		AppointmentsIds: server.AsPtr(
 			server.Map(
 				appointments,
@@ -78,6 +79,7 @@ func dataPrescToPresc(
 		End:           p.End,
 		DoctorsNote:   p.DoctorsNote,
 		AppointmentId: p.AppointmentId,
+		Version:       p.Version,
 	}
 	if appt != nil {
 		presc.AppointmentId = &appt.Id
@@ -96,6 +98,29 @@ func dataPrescToPrescDisplay(p Prescription) api.PrescriptionDisplay {
 	}
 }
 
+func dataCondToCondRecord(c Condition) api.ConditionRecord {
+	return api.ConditionRecord{
+		Id:        c.Id,
+		PatientId: c.PatientId,
+		Name:      c.Name,
+		Start:     c.Start,
+		End:       c.End,
+	}
+}
+
+func dataPrescToPrescRecord(p Prescription) api.PrescriptionRecord {
+	return api.PrescriptionRecord{
+		Id:            p.Id,
+		PatientId:     p.PatientId,
+		AppointmentId: p.AppointmentId,
+		Name:          p.Name,
+		Start:         p.Start,
+		End:           p.End,
+		DoctorsNote:   p.DoctorsNote,
+		DeletedAt:     p.DeletedAt,
+	}
+}
+
 func apptToApptDisplay(appt appointmentapi.Appointment) api.AppointmentDisplay {
 	return api.AppointmentDisplay{
 		Id:                  appt.Id,
@@ -12,6 +12,7 @@ import (
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 
+	"github.com/Nesquiko/aass/common/audit"
 	"github.com/Nesquiko/aass/common/mongodb"
 )
 
@@ -20,7 +21,10 @@ const (
 	prescriptionsCollection = "prescriptions"
 )
 
-var ErrNotFound = errors.New("resource not found")
+var (
+	ErrNotFound        = errors.New("resource not found")
+	ErrVersionMismatch = errors.New("resource was changed since the expected version")
+)
 
 type Condition struct {
 	Id        uuid.UUID  `bson:"_id"           json:"id"`
@@ -28,6 +32,9 @@ type Condition struct {
 	Name      string     `bson:"name"          json:"name"`
 	Start     time.Time  `bson:"start"         json:"start"`
 	End       *time.Time `bson:"end,omitempty" json:"end,omitempty"`
+	// Version is bumped by every update, an update expecting another version
+	// fails with ErrVersionMismatch.
+	Version int64 `bson:"version" json:"version"`
 }
 
 type Prescription struct {
@@ -38,11 +45,16 @@ type Prescription struct {
 	Start         time.Time  `bson:"start"                   json:"start"`
 	End           time.Time  `bson:"end"                     json:"end"`
 	DoctorsNote   *string    `bson:"doctorsNote,omitempty"   json:"doctorsNote,omitempty"`
+	DeletedAt     *time.Time `bson:"deletedAt,omitempty"     json:"deletedAt,omitempty"`
+	// Version is bumped by every update, an update expecting another version
+	// fails with ErrVersionMismatch.
+	Version int64 `bson:"version" json:"version"`
 }
 
 type mongoMedicalDb struct {
 	conditions    *mongo.Collection
 	prescriptions *mongo.Collection
+	audit         audit.Log
 }
 
 func newMongoMedicalDb(ctx context.Context, uri string, db string) (mongoMedicalDb, error) {
@@ -100,18 +112,27 @@ func newMongoMedicalDb(ctx context.Context, uri string, db string) (mongoMedical
 		}
 	}
 
-	return mongoMedicalDb{conditions: conditionsColl, prescriptions: prescriptionsColl}, nil
+	return mongoMedicalDb{
+		conditions:    conditionsColl,
+		prescriptions: prescriptionsColl,
+		audit:         audit.NewLog(ctx, mongoDb),
+	}, nil
 }
 
 func (db mongoMedicalDb) Disconnect(ctx context.Context) error {
 	return db.prescriptions.Database().Client().Disconnect(ctx)
 }
 
+func (db mongoMedicalDb) Ping(ctx context.Context) error {
+	return db.prescriptions.Database().Client().Ping(ctx, nil)
+}
+
 func (m *mongoMedicalDb) CreateCondition(
 	ctx context.Context,
 	condition Condition,
 ) (Condition, error) {
 	condition.Id = uuid.New()
+	condition.Version = 1
 
 	_, err := m.conditions.InsertOne(ctx, condition)
 	if err != nil {
@@ -136,6 +157,32 @@ func (m *mongoMedicalDb) ConditionById(ctx context.Context, id uuid.UUID) (Condi
 	return condition, nil
 }
 
+// ConditionsByIds returns conditions with the ids, unknown ids are skipped.
+func (m *mongoMedicalDb) ConditionsByIds(
+	ctx context.Context,
+	ids []uuid.UUID,
+) ([]Condition, error) {
+	conditions := make([]Condition, 0, len(ids))
+	filter := bson.M{"_id": bson.M{"$in": ids}}
+
+	cursor, err := m.conditions.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("ConditionsByIds find failed: %w", err)
+	}
+
+	defer func() {
+		if cerr := cursor.Close(ctx); cerr != nil {
+			slog.WarnContext(ctx, "Failed to close conditions by ids cursor", "error", cerr.Error())
+		}
+	}()
+
+	if err = cursor.All(ctx, &conditions); err != nil {
+		return nil, fmt.Errorf("ConditionsByIds decode failed: %w", err)
+	}
+
+	return conditions, nil
+}
+
 func (m *mongoMedicalDb) FindConditionsByPatientId(
 	ctx context.Context,
 	patientId uuid.UUID,
@@ -161,7 +208,7 @@ func (m *mongoMedicalDb) FindConditionsByPatientId(
 
 	defer func() {
 		if cerr := cursor.Close(ctx); cerr != nil {
-			slog.Warn("Failed to close cursor", "error", cerr.Error())
+			slog.WarnContext(ctx, "Failed to close cursor", "error", cerr.Error())
 		}
 	}()
 
@@ -176,12 +223,15 @@ func (m *mongoMedicalDb) FindConditionsByPatientId(
 	return conditions, nil
 }
 
+// UpdateCondition replaces the condition if it is still at condition.Version,
+// otherwise it fails with ErrVersionMismatch.
 func (m *mongoMedicalDb) UpdateCondition(
 	ctx context.Context,
 	id uuid.UUID,
 	condition Condition,
 ) (Condition, error) {
-	filter := bson.M{"_id": id}
+	filter := bson.M{"_id": id, "version": versionFilter(condition.Version)}
+	condition.Version++
 
 	opts := options.FindOneAndReplace().SetReturnDocument(options.After)
 
@@ -190,7 +240,7 @@ func (m *mongoMedicalDb) UpdateCondition(
 		Decode(&updatedCondition)
 	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
-			return Condition{}, ErrNotFound
+			return Condition{}, missingOrMoved(ctx, m.conditions, bson.M{"_id": id})
 		}
 		return Condition{}, fmt.Errorf("UpdateCondition failed: %w", err)
 	}
@@ -229,17 +279,17 @@ func (m *mongoMedicalDb) FindConditionsByPatientIdAndDate(
 
 	defer func() {
 		if cerr := cursor.Close(ctx); cerr != nil {
-			slog.Warn("Failed to close conditions cursor", "error", cerr.Error())
+			slog.WarnContext(ctx, "Failed to close conditions cursor", "error", cerr.Error())
 		}
 	}()
 
 	if err = cursor.All(ctx, &conditions); err != nil {
-		slog.Error("Failed to decode condition documents from cursor", "error", err)
+		slog.ErrorContext(ctx, "Failed to decode condition documents from cursor", "error", err)
 		return nil, fmt.Errorf("FindConditionsByPatientIdAndDate decode failed: %w", err)
 	}
 
 	if err = cursor.Err(); err != nil {
-		slog.Error("Conditions cursor iteration error", "error", err)
+		slog.ErrorContext(ctx, "Conditions cursor iteration error", "error", err)
 		return nil, fmt.Errorf("FindConditionsByPatientIdAndDate cursor error: %w", err)
 	}
 
@@ -251,6 +301,7 @@ func (m *mongoMedicalDb) CreatePrescription(
 	prescription Prescription,
 ) (Prescription, error) {
 	prescription.Id = uuid.New()
+	prescription.Version = 1
 
 	_, err := m.prescriptions.InsertOne(ctx, prescription)
 	if err != nil {
@@ -261,7 +312,7 @@ func (m *mongoMedicalDb) CreatePrescription(
 }
 
 func (m *mongoMedicalDb) PrescriptionById(ctx context.Context, id uuid.UUID) (Prescription, error) {
-	filter := bson.M{"_id": id}
+	filter := bson.M{"_id": id, "deletedAt": nil}
 	var prescription Prescription
 
 	err := m.prescriptions.FindOne(ctx, filter).Decode(&prescription)
@@ -286,6 +337,7 @@ func (m *mongoMedicalDb) FindPrescriptionsByPatientId(
 	filter := bson.M{
 		"patientId": patientId,
 		"end":       bson.M{"$gte": from},
+		"deletedAt": nil,
 	}
 	if to != nil {
 		filter["start"] = bson.M{"$lte": *to}
@@ -302,29 +354,35 @@ func (m *mongoMedicalDb) FindPrescriptionsByPatientId(
 
 	defer func() {
 		if cerr := cursor.Close(ctx); cerr != nil {
-			slog.Warn("Failed to close prescriptions cursor", "error", cerr.Error())
+			slog.WarnContext(ctx, "Failed to close prescriptions cursor", "error", cerr.Error())
 		}
 	}()
 
 	if err = cursor.All(ctx, &prescriptions); err != nil {
-		slog.Error("Failed to decode documents from cursor", "error", err)
+		slog.ErrorContext(ctx, "Failed to decode documents from cursor", "error", err)
 		return nil, fmt.Errorf("FindPrescriptionsByPatientId decode failed: %w", err)
 	}
 
 	if err = cursor.Err(); err != nil {
-		slog.Error("Prescriptions cursor iteration error", "error", err)
+		slog.ErrorContext(ctx, "Prescriptions cursor iteration error", "error", err)
 		return nil, fmt.Errorf("FindPrescriptionsByPatientId cursor error: %w", err)
 	}
 
 	return prescriptions, nil
 }
 
+// UpdatePrescription updates the prescription if it is still at
+// prescription.Version, otherwise it fails with ErrVersionMismatch.
 func (m *mongoMedicalDb) UpdatePrescription(
 	ctx context.Context,
 	id uuid.UUID,
 	prescription Prescription,
 ) (Prescription, error) {
-	filter := bson.M{"_id": id}
+	filter := bson.M{
+		"_id":       id,
+		"deletedAt": nil,
+		"version":   versionFilter(prescription.Version),
+	}
 
 	updatePayload := bson.M{
 		"patientId":     prescription.PatientId,
@@ -334,7 +392,7 @@ func (m *mongoMedicalDb) UpdatePrescription(
 		"end":           prescription.End,
 		"doctorsNote":   prescription.DoctorsNote,
 	}
-	update := bson.M{"$set": updatePayload}
+	update := bson.M{"$set": updatePayload, "$inc": bson.M{"version": 1}}
 
 	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
 
@@ -343,7 +401,11 @@ func (m *mongoMedicalDb) UpdatePrescription(
 		Decode(&updatedPrescription)
 	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
-			return Prescription{}, ErrNotFound
+			return Prescription{}, missingOrMoved(
+				ctx,
+				m.prescriptions,
+				bson.M{"_id": id, "deletedAt": nil},
+			)
 		}
 		return Prescription{}, fmt.Errorf("UpdatePrescription failed: %w", err)
 	}
@@ -357,7 +419,7 @@ func (m *mongoMedicalDb) PrescriptionByAppointmentId(
 ) ([]Prescription, error) {
 	prescriptions := make([]Prescription, 0)
 
-	filter := bson.M{"appointmentId": appointmentId}
+	filter := bson.M{"appointmentId": appointmentId, "deletedAt": nil}
 
 	findOptions := options.Find().SetSort(bson.D{{Key: "start", Value: 1}})
 
@@ -371,34 +433,154 @@ func (m *mongoMedicalDb) PrescriptionByAppointmentId(
 
 	defer func() {
 		if cerr := cursor.Close(ctx); cerr != nil {
-			slog.Warn("Failed to close prescriptions by appointment cursor", "error", cerr.Error())
+			slog.WarnContext(
+				ctx,
+				"Failed to close prescriptions by appointment cursor",
+				"error",
+				cerr.Error(),
+			)
 		}
 	}()
 
 	if err = cursor.All(ctx, &prescriptions); err != nil {
-		slog.Error("Failed to decode prescription documents from cursor", "error", err)
+		slog.ErrorContext(ctx, "Failed to decode prescription documents from cursor", "error", err)
 		return nil, fmt.Errorf("PrescriptionByAppointmentId decode failed: %w", err)
 	}
 
 	if err = cursor.Err(); err != nil {
-		slog.Error("Prescriptions by appointment cursor iteration error", "error", err)
+		slog.ErrorContext(ctx, "Prescriptions by appointment cursor iteration error", "error", err)
 		return nil, fmt.Errorf("PrescriptionByAppointmentId cursor error: %w", err)
 	}
 
 	return prescriptions, nil
 }
 
+// PrescriptionsByAppointmentIds returns prescriptions of any of the
+// appointments sorted by their start.
+func (m *mongoMedicalDb) PrescriptionsByAppointmentIds(
+	ctx context.Context,
+	appointmentIds []uuid.UUID,
+) ([]Prescription, error) {
+	prescriptions := make([]Prescription, 0)
+	filter := bson.M{"appointmentId": bson.M{"$in": appointmentIds}, "deletedAt": nil}
+	opts := options.Find().SetSort(bson.D{{Key: "start", Value: 1}})
+
+	cursor, err := m.prescriptions.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("PrescriptionsByAppointmentIds find failed: %w", err)
+	}
+
+	defer func() {
+		if cerr := cursor.Close(ctx); cerr != nil {
+			slog.WarnContext(
+				ctx,
+				"Failed to close prescriptions by appointments cursor",
+				"error",
+				cerr.Error(),
+			)
+		}
+	}()
+
+	if err = cursor.All(ctx, &prescriptions); err != nil {
+		return nil, fmt.Errorf("PrescriptionsByAppointmentIds decode failed: %w", err)
+	}
+
+	return prescriptions, nil
+}
+
+// DeletePrescription soft deletes the prescription, it is no longer returned
+// by any query, except the patient's medical records export.
 func (m *mongoMedicalDb) DeletePrescription(ctx context.Context, id uuid.UUID) error {
-	filter := bson.M{"_id": id}
+	filter := bson.M{"_id": id, "deletedAt": nil}
+	update := bson.M{"$set": bson.M{"deletedAt": time.Now()}}
 
-	result, err := m.prescriptions.DeleteOne(ctx, filter)
+	result, err := m.prescriptions.UpdateOne(ctx, filter, update)
 	if err != nil {
 		return fmt.Errorf("DeletePrescription failed: %w", err)
 	}
 
-	if result.DeletedCount == 0 {
+	if result.MatchedCount == 0 {
 		return ErrNotFound
 	}
 
 	return nil
 }
+
+// AllConditionsByPatientId returns every condition of the patient, regardless
+// of when it started or ended.
+func (m *mongoMedicalDb) AllConditionsByPatientId(
+	ctx context.Context,
+	patientId uuid.UUID,
+) ([]Condition, error) {
+	conditions := make([]Condition, 0)
+	filter := bson.M{"patientId": patientId}
+	opts := options.Find().SetSort(bson.D{{Key: "start", Value: 1}})
+
+	cursor, err := m.conditions.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("AllConditionsByPatientId find failed: %w", err)
+	}
+
+	defer func() {
+		if cerr := cursor.Close(ctx); cerr != nil {
+			slog.WarnContext(ctx, "Failed to close conditions cursor", "error", cerr.Error())
+		}
+	}()
+
+	if err = cursor.All(ctx, &conditions); err != nil {
+		return nil, fmt.Errorf("AllConditionsByPatientId decode failed: %w", err)
+	}
+
+	return conditions, nil
+}
+
+// AllPrescriptionsByPatientId returns every prescription of the patient,
+// including the deleted ones.
+func (m *mongoMedicalDb) AllPrescriptionsByPatientId(
+	ctx context.Context,
+	patientId uuid.UUID,
+) ([]Prescription, error) {
+	prescriptions := make([]Prescription, 0)
+	filter := bson.M{"patientId": patientId}
+	opts := options.Find().SetSort(bson.D{{Key: "start", Value: 1}})
+
+	cursor, err := m.prescriptions.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("AllPrescriptionsByPatientId find failed: %w", err)
+	}
+
+	defer func() {
+		if cerr := cursor.Close(ctx); cerr != nil {
+			slog.WarnContext(ctx, "Failed to close prescriptions cursor", "error", cerr.Error())
+		}
+	}()
+
+	if err = cursor.All(ctx, &prescriptions); err != nil {
+		return nil, fmt.Errorf("AllPrescriptionsByPatientId decode failed: %w", err)
+	}
+
+	return prescriptions, nil
+}
+
+// versionFilter matches documents at version. Documents written before they
+// were versioned have no version and are at version 0.
+func versionFilter(version int64) any {
+	if version == 0 {
+		return bson.M{"$in": bson.A{0, nil}}
+	}
+	return version
+}
+
+// missingOrMoved tells why a versioned update of the document matching filter
+// matched none, ErrNotFound if there is no such document, ErrVersionMismatch
+// if it is at another version.
+func missingOrMoved(ctx context.Context, collection *mongo.Collection, filter bson.M) error {
+	count, err := collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("missingOrMoved: failed to count documents: %w", err)
+	}
+	if count == 0 {
+		return ErrNotFound
+	}
+	return ErrVersionMismatch
+}
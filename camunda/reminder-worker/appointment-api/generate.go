@@ -0,0 +1,3 @@
+package appointmentapi
+
+//go:generate go tool oapi-codegen --config=./cfg.yaml ./appointmentservice-openapi.yaml
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/Nesquiko/aass/common/notify"
+	"github.com/Nesquiko/aass/common/reminders"
+	appointmentapi "github.com/Nesquiko/aass/reminder-worker/appointment-api"
+)
+
+type appointmentReminder struct {
+	appointments  *appointmentapi.ClientWithResponses
+	notifications notify.MongoStore
+}
+
+// remind queues the reminder for the patient of the job's appointment.
+// Appointments which were deleted, cancelled or denied in the meantime
+// aren't reminded of.
+func (r appointmentReminder) remind(ctx context.Context, job reminders.Job) error {
+	ctx, span := otel.Tracer(serviceName).Start(ctx, "remind")
+	defer span.End()
+	span.SetAttributes(attribute.String("appointment.id", job.AppointmentId.String()))
+
+	res, err := r.appointments.AppointmentByIdWithResponse(ctx, job.AppointmentId)
+	if err != nil {
+		return fmt.Errorf("remind get appointment: %w", err)
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return nil
+	}
+	if res.StatusCode() != http.StatusOK || res.JSON200 == nil {
+		return fmt.Errorf("remind get appointment: unexpected status %d", res.StatusCode())
+	}
+
+	appt := *res.JSON200
+	switch appt.Status {
+	case appointmentapi.Cancelled, appointmentapi.Denied:
+		return nil
+	}
+
+	err = r.notifications.Queue(ctx, appt.Id, notify.Event{
+		Kind:             notify.KindAppointmentReminder,
+		RecipientName:    appt.Patient.FirstName + " " + appt.Patient.LastName,
+		RecipientAddress: string(appt.Patient.Email),
+		With:             "Dr. " + appt.Doctor.FirstName + " " + appt.Doctor.LastName,
+		Start:            appt.AppointmentDateTime,
+	})
+	if err != nil {
+		return fmt.Errorf("remind: %w", err)
+	}
+	return nil
+}
@@ -76,9 +76,36 @@ type NewResource struct {
 	Type ResourceType `json:"type"`
 }
 
+// ReservationRecord defines model for ReservationRecord.
+type ReservationRecord struct {
+	AppointmentId openapi_types.UUID `json:"appointmentId"`
+	End           time.Time          `json:"end"`
+	Id            openapi_types.UUID `json:"id"`
+	ResourceId    openapi_types.UUID `json:"resourceId"`
+	ResourceName  string             `json:"resourceName"`
+	ResourceType  ResourceType       `json:"resourceType"`
+	Start         time.Time          `json:"start"`
+}
+
+// ReservationRecordsExport defines model for ReservationRecordsExport.
+type ReservationRecordsExport struct {
+	Reservations []ReservationRecord `json:"reservations"`
+}
+
+// ReservationsMove Result of moving reservations of an appointment to a new time.
+type ReservationsMove struct {
+	// Conflicts Resources taken by other appointments in the new slot. Empty if the reservations were moved.
+	Conflicts []NewResource `json:"conflicts"`
+}
+
 // ResourceType defines model for ResourceType.
 type ResourceType string
 
+// Resources defines model for Resources.
+type Resources struct {
+	Resources []NewResource `json:"resources"`
+}
+
 // AppointmentId defines model for appointmentId.
 type AppointmentId = openapi_types.UUID
 
@@ -93,6 +120,11 @@ type GetAvailableResourcesParams struct {
 	DateTime DateTime `form:"date-time" json:"date-time"`
 }
 
+// ExportAppointmentsReservationsJSONBody defines parameters for ExportAppointmentsReservations.
+type ExportAppointmentsReservationsJSONBody struct {
+	AppointmentIds []openapi_types.UUID `json:"appointmentIds"`
+}
+
 // ReserveAppointmentResourcesJSONBody defines parameters for ReserveAppointmentResources.
 type ReserveAppointmentResourcesJSONBody struct {
 	EquipmentId *openapi_types.UUID `json:"equipmentId,omitempty"`
@@ -101,12 +133,26 @@ type ReserveAppointmentResourcesJSONBody struct {
 	Start       time.Time           `json:"start"`
 }
 
+// MoveAppointmentReservationsJSONBody defines parameters for MoveAppointmentReservations.
+type MoveAppointmentReservationsJSONBody struct {
+	Start time.Time `json:"start"`
+}
+
 // CreateResourceJSONRequestBody defines body for CreateResource for application/json ContentType.
 type CreateResourceJSONRequestBody = NewResource
 
+// GetResourcesBatchJSONRequestBody defines body for GetResourcesBatch for application/json ContentType.
+type GetResourcesBatchJSONRequestBody = externalRef0.BatchIds
+
+// ExportAppointmentsReservationsJSONRequestBody defines body for ExportAppointmentsReservations for application/json ContentType.
+type ExportAppointmentsReservationsJSONRequestBody ExportAppointmentsReservationsJSONBody
+
 // ReserveAppointmentResourcesJSONRequestBody defines body for ReserveAppointmentResources for application/json ContentType.
 type ReserveAppointmentResourcesJSONRequestBody ReserveAppointmentResourcesJSONBody
 
+// MoveAppointmentReservationsJSONRequestBody defines body for MoveAppointmentReservations for application/json ContentType.
+type MoveAppointmentReservationsJSONRequestBody MoveAppointmentReservationsJSONBody
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Create resource
@@ -115,9 +161,18 @@ type ServerInterface interface {
 	// Get available resources for a time slot
 	// (GET /resources/available)
 	GetAvailableResources(w http.ResponseWriter, r *http.Request, params GetAvailableResourcesParams)
+	// Get resources by IDs
+	// (POST /resources/batch)
+	GetResourcesBatch(w http.ResponseWriter, r *http.Request)
+	// Export reservations of appointments
+	// (POST /resources/reservations/export)
+	ExportAppointmentsReservations(w http.ResponseWriter, r *http.Request)
 	// Reserves resources for an appointment
 	// (POST /resources/reserve/{appointmentId})
 	ReserveAppointmentResources(w http.ResponseWriter, r *http.Request, appointmentId AppointmentId)
+	// Moves reservations of an appointment to a new time
+	// (POST /resources/reserve/{appointmentId}/move)
+	MoveAppointmentReservations(w http.ResponseWriter, r *http.Request, appointmentId AppointmentId)
 	// Get resource by ID
 	// (GET /resources/{resourceId})
 	GetResourceById(w http.ResponseWriter, r *http.Request, resourceId ResourceId)
@@ -139,12 +194,30 @@ func (_ Unimplemented) GetAvailableResources(w http.ResponseWriter, r *http.Requ
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get resources by IDs
+// (POST /resources/batch)
+func (_ Unimplemented) GetResourcesBatch(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Export reservations of appointments
+// (POST /resources/reservations/export)
+func (_ Unimplemented) ExportAppointmentsReservations(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Reserves resources for an appointment
 // (POST /resources/reserve/{appointmentId})
 func (_ Unimplemented) ReserveAppointmentResources(w http.ResponseWriter, r *http.Request, appointmentId AppointmentId) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Moves reservations of an appointment to a new time
+// (POST /resources/reserve/{appointmentId}/move)
+func (_ Unimplemented) MoveAppointmentReservations(w http.ResponseWriter, r *http.Request, appointmentId AppointmentId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get resource by ID
 // (GET /resources/{resourceId})
 func (_ Unimplemented) GetResourceById(w http.ResponseWriter, r *http.Request, resourceId ResourceId) {
@@ -167,8 +240,8 @@ func (siw *ServerInterfaceWrapper) CreateResource(w http.ResponseWriter, r *http
 		siw.Handler.CreateResource(w, r)
 	}))
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
 	handler.ServeHTTP(w, r)
@@ -201,8 +274,36 @@ func (siw *ServerInterfaceWrapper) GetAvailableResources(w http.ResponseWriter,
 		siw.Handler.GetAvailableResources(w, r, params)
 	}))
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetResourcesBatch operation middleware
+func (siw *ServerInterfaceWrapper) GetResourcesBatch(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetResourcesBatch(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportAppointmentsReservations operation middleware
+func (siw *ServerInterfaceWrapper) ExportAppointmentsReservations(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportAppointmentsReservations(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
 	handler.ServeHTTP(w, r)
@@ -226,8 +327,33 @@ func (siw *ServerInterfaceWrapper) ReserveAppointmentResources(w http.ResponseWr
 		siw.Handler.ReserveAppointmentResources(w, r, appointmentId)
 	}))
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// MoveAppointmentReservations operation middleware
+func (siw *ServerInterfaceWrapper) MoveAppointmentReservations(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "appointmentId" -------------
+	var appointmentId AppointmentId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appointmentId", chi.URLParam(r, "appointmentId"), &appointmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "appointmentId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MoveAppointmentReservations(w, r, appointmentId)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
 	handler.ServeHTTP(w, r)
@@ -251,8 +377,8 @@ func (siw *ServerInterfaceWrapper) GetResourceById(w http.ResponseWriter, r *htt
 		siw.Handler.GetResourceById(w, r, resourceId)
 	}))
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
 	handler.ServeHTTP(w, r)
@@ -377,9 +503,18 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/resources/available", wrapper.GetAvailableResources)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/resources/batch", wrapper.GetResourcesBatch)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/resources/reservations/export", wrapper.ExportAppointmentsReservations)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/resources/reserve/{appointmentId}", wrapper.ReserveAppointmentResources)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/resources/reserve/{appointmentId}/move", wrapper.MoveAppointmentReservations)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/resources/{resourceId}", wrapper.GetResourceById)
 	})
@@ -390,32 +525,43 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/8xYa2/bOBb9KwR3gSZYyVYSO2n8LW3SwsAmW+QBdKcIClq8itlKpEJSST2G//vgUm9b",
-	"btQm085Hy+Tl4bmvw7ukoUpSJUFaQydLmjLNErCg3S+WpkpIm4C0U44fOJhQi9QKJemEXs+BZFLcZ0AE",
-	"B2lFJECTnZub6ekuURGxcyANEwPqUfjGkjQGOqF8BOPokB35s9fhsR/s7R/4o/Hhkf/6OGCzkEO0t39A",
-	"PSrwoJTZOfWoZAnubKPyqIb7TGjgdGJ1Bh414RwShnAjpRNm6YRmmcCVdpGiAWO1kHd0tfIoZxZ8K9Du",
-	"Mj/rPgO9qA+rF/Q6qLl88zQNRmU6hJ8ls9zfZjJioR8Eez7bnx344YiPfTiMjrq5ayB4DnH5XVIlDbhA",
-	"CVWSKPnZgH4A/Zml4nP+xVcpSPw5lRa0ZPGVW3GmtdKXhYF8v7QgbRFzsQgZsjJMtZrFkPzni0GKlvWd",
-	"cQdHRKKwO8iPHgBaph7lYJmI6YSeSJLJr1I9SpIvIW4JUWGYaby7R41lNjN0Mg4Cj1phHakl4NYuumqS",
-	"9G8NEZ3Qfw3rFBrm/5rhk4Q4Ck5zlI7PdjScyDWcA3IFQEwKoYhESHLMBFkgkdIkv68ZuDArQCDGkwcm",
-	"YjaL4bJwvNmMvP8KYw2GGCsXV4FmyE7EQhELK8B4BOMlxbzzSAJchELCrjue1cgwAwiTnGAWEBMrl/ep",
-	"VilotOL8WNrpBtPGUi1GO8JCYp4i/6wyv6pCl2nNFvi7vk+fw+vVvU9/l29ZdB1estbn6HIt2WHGZImQ",
-	"d/WnkEkyA/KKxbEKmQX+iihN5syQWCTCAifGqvArSUE7F+z2Rn9eItxAv2oWjE9NHr2GQxt3vK0sqNkX",
-	"CJ03zrZ7/hJSDQbBEEbKk2rvt4pfO5xERzm92SilGKdYRFvxVFdRuE/9INj3oyM49Pk4HPmzA7ZPvadq",
-	"YVla1wFcsATKur3lyJvYamZUJjk5Z+EcHfvxvT/e0jpq6h0Md2oXxVX89WS4cOTixQguDfbsUi/Ab/eJ",
-	"55dTcpUJC+TNMyk935q23ZRWefpSlJYG2xdMgPtBcOAfs9cz/yg85P4YRtHLUNp94olkYOwcrAjJx///",
-	"8UxaL+CxbEtPMvt9HtcvrIHx/8l4USqbX0dA+eH7Vba89jWu3U5aYayLu5YFbKkyS3BzVX2rTrdo1efb",
-	"DsQ/JFZQonEukDEWf2j4Ime6TeiVZZIzzcWfWMqdoCmUCtm5fPeWHI/GR7ubTs3F3XITKa8wbPxVqrhG",
-	"MAjXj4qVqBTvwEm4QuMtnwjefJmXo6kOqEBsegUNCBkpNB2LEApxW0jv8+k19WimUZXOrU3NZDhEgovI",
-	"VvpuWGwyQ1xbA3X15y2LybkItUIJLVCanXyYUo8+gDY52XuDYBDgtsJtdEIPBsFghPQyO3fcDHVTB6bK",
-	"uEaM3DvFjQ8T+lYDs5VgLN4JYOwbxRffEeulSO8nkJu536F/L+CxSnpiFYqd0MHafLesv0X2g71fhTJn",
-	"ildIkfxxEGyzWsEcPuu95CR+liRMLyoMNQSPWnZnMH5ryX+LW2rXDyuRiUjvoCMG3oPteDp4rfnAp+5b",
-	"1kuG9Wt4dbvhpeDFvNSBtMNZV1kYgjFRFseodawW8AC889FTtt3iUQOtx8xv8PF7sFtxshpbL99jU9UP",
-	"MFy2xiir7eXgMt9wUi//+YBoj27yoPi52rLlQTnlPcYXdWvsubxsqj2XG8u0/aGxUN108r3dvWVdIKFf",
-	"HD9lU+1RG0ebqmctMZy3eUN05WEm26O8lUdHubGes5tfMze5UkmdIB4+ihuoySMz8pUlET65fkceF6lk",
-	"1lO4xW2vLF7Wg7xVo4ivR0he5IwrZqXwUlFzXFM5uRz0LFpjHqUbk57ZgghrNqeUKOA22keJ/c3CzRp/",
-	"rE40xpR/a+d4or9vaRkVZ/XU7Z+ZDRfKknduvuCT61Y/q64wPSVcgSFSWQLfhPl9Da6CNFuQ6em2LMBt",
-	"zlweR+37nknu0qjS2UMXPoWhSok3ZMLt6q8AAAD//3h0qjwCGQAA",
+	"H4sIAAAAAAAC/8xae2/bOBL/KgPeAW1xkq0kTtP6v6RNCwOX3CIPoHeboEuL45gbiVRIKqkv8HdfkHpb",
+	"cqw02aR/Wh7ODH/zHumehDJOpEBhNBnfk4QqGqNB5X7RJJFcmBiFmTD7gKEOFU8Ml4KMydkcIRX8JkXg",
+	"DIXhM44K3p6fTz6/AzkDM0eosRgQj+APGicRkjFhI9ydvad7/vRD+NEPtrZ3/NHu+z3/w8eATkOGs63t",
+	"HeIRbgUl1MyJRwSN7cmmVh5ReJNyhYyMjUrRIzqcY0ytujOpYmrImKQpt5RmkVgG2igurshy6RFGDfqG",
+	"W773maybFNWiElYR9BJUJ29LU6hlqkL8WTCL800kZzT0g2DLp9vTHT8csV0f38/2urGrafAU4LK7JFJo",
+	"dI4SyjiW4rtGdYvqO0349+yJLxMU9udEGFSCRqeO4lApqU5yBtl5YVCY3OciHlKLyjBRchph/K8/tYXo",
+	"vrqzPcGsRjznO8hED9ByJh5haCiPyJjsC0jFtZB3AjIScCQgwzBV9u4e0YaaVJPxbhB4xHDjQC0Ubpwi",
+	"yzpI/1Q4I2Pyj2EVQsPsXz3cCIiD4HOmpcOz6Q37YkXPAZwigk4w5DMeQqYzWBRgJhVk99UD52a5ElbH",
+	"/VvKIzqN8CQ3vG573r+5Ntq6GC2IS0fT8HZGQx5xw1F7YP0lsXHnQYyMh1zgOyeeVprZCAAqGNgoAB1J",
+	"F/eJkgkqwzP5JZ9uZZq6lMSWDzcY603gH5bsl6XrUqXowv6u7tNHeEXdW/qX7MiiS3iBWh/RBS28pVqn",
+	"MRdX1aOQCpgivKFRJENqkL0BqWBONUQ85gYZaCPDa0hQORO86639UaFhS/tlPWH8XsfRqxm0dsfLkoOc",
+	"/omhs8bhesufYKJQW2WAQiGpsn4j+TXdiXek0/NWKrV+apNow5+qLIo3iR8E2/5sD9/7bDcc+dMduk28",
+	"TbmwSK2rChzTGIu8vUbkeWQU1TIVDI5oOLeG/fbV311TOironRpOahfEpf/1RDg35OLZAC4Y9qxSz4Bv",
+	"t8SjkwmcptwgHDwR0qO1YdsNaRmnzwVpwbB5wRiZHwQ7/kf6Yervhe+Zv4uj2fNA2i1xX1DUZo6Gh/Dt",
+	"v/97IqzHeFeUpY3IPozj6oUVUvYfES2KzublACgePJxli2ufWdr1oOXMurA7QdtbuDbpBEOpHAxNaFrd",
+	"+0a3QMH6drPeGuRbZM2etzf5cW6gtQRnj4bZdXrKPKpfX7FKe/RotNM11VcULWRnGPcyqD78kUhl2nZV",
+	"FaX73auut9hvLPANMRs01kfytjOGdRq5tiaWt7aBqfO0j6moD4hgJFAQeOd6x3ash1LMIh4a3Skpb1gN",
+	"vUYB0wVIM0dVZ6+BCxfaVoJrTOEwTswCeDldVcrdoUKrNbLerVM9nW0Ct7rKGmQbPo4ije2psrUq29hF",
+	"o/m67AioRuPfcqTqr2e/YsW964obB6QDasL5hHXYelLWR+dD9oe9EczQhHNkQA1IEeIAzvPBj9cOUIUX",
+	"Ql/zJEFW+EMxyg4uREd9aaKzMYHF9MckI94KgvL/bog4+0lw6tOjzfSMcQsOjX6rKZ+VviZ2p4YKRhXj",
+	"/0eWT5j56AhvT758go+j3b13XZHHurMxK3Uw7UR9k6LuXBxNWLXRcEQeUA3apoApDa8Lu3zzT7K//QmD",
+	"OVKGatAFeTHA16zDhakouTB4hW56z8f7+w3JPiPzsnuXAsrrtm1mGXAxk5Z1xEPM9xr51uVockY8kqqI",
+	"jMncmESPh0NryrypkepqmB/SQ0tbKepaz080giMeKmm3J9wmuf3fJsQjt6h0BujWIBgE9ljuIGRMdgbB",
+	"YGQNSc3cYTNshHsitSst1sou5Vk7kU8KqSl3BaQ04oFkiwf2NMV+pt9upJFE2quPY7wr+z1bEaYIoVOr",
+	"vbJaXUNtB1svpWWGFCs1teDvBsE6rqWawyetyqweOo1jqhalDpUKHjH0Slv/rZL+pT1SmX5Y7hesplfY",
+	"4QNf0XRsjbzGavj37ltWJMOqsVpetqwUPJuVOjTtMNZpGoao9SyNIjvmGsXx1haKjn1XMXHl+yxs7LFe",
+	"wcZf0azVk1a69bL91JbUevCv9lAZMLom5o6bucPjit/iSiXdUGYhr7I2Zbc8rFTSlfm/KdH07zGWy+Xm",
+	"3PJ8Xvszzjpze6LSMs4ZR0HwiO35y2yuDyiDvGyDD2dSQkzFouEcrrfODY7s1cKq8vLpAiafda8Yqg8J",
+	"Q6wmtHURlSqhAW9RLerzRcfbMe1BqpHB3RwFZIztxESj6EIwaijMMWJApzI1QCGhhtt94oVoRVc2Nu7X",
+	"ONcHtSeE2gPbhUf2yA82xSuMu3utF43V7rm8661NFLVG3FUzv4q7Zyq3x++aXo/wfhzeN4y0XN9QZuhh",
+	"zRt/vqVoyMzbiufw5HJ+7rmiKkbvnuTF0N6T/Ck7quxsd8S09iVlLsoHwB7d9aid4laqlbM2q21ss0ZF",
+	"NL8DcKVr9MuVrlMZVy2WB7KxPYI7qsUbk9Xh14jhPJT0ahPYwPYpUTyM8yVedzGzKz5t69GmFGeHNvtI",
+	"CoS5TLM3kOB801U0A3+4H38MLkS9NLmm0a3cQIrIreXqhbM2bAHXMFOIqws970K4rd8d19hLU+oakQip",
+	"rbzupXXtKwt9IR5aJmZnbYG3ZzWUK72uonwkV5NgvSL/GnnwZTKPHe8dde2jltXu5MUre7a/fjhP1nfC",
+	"LjmUjpPtjxVmBJUfvEaWyKL0MXv2XinjvnrTsaxtDtYNkNauxV7Riq8+DylDuPiwZNH4rESq2pcl0wVw",
+	"o9tfRT04UR4s3MuYx4VUdbu/d12xYam0ZvQrMau+8vk1C+ixNPDFzak+nDWWKOUVJp+BSdQgpAH8wbV5",
+	"9fEvm/7WRYE95thlftS876FgLqjK5e7QuU/OqFz/VgyXl8u/BgC1ji4wcikAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
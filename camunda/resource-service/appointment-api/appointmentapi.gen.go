@@ -47,6 +47,13 @@ const (
 	Vaccination     AppointmentType = "vaccination"
 )
 
+// Defines values for ResourceType.
+const (
+	ResourceTypeEquipment ResourceType = "equipment"
+	ResourceTypeFacility  ResourceType = "facility"
+	ResourceTypeMedicine  ResourceType = "medicine"
+)
+
 // Defines values for SpecializationEnum.
 const (
 	Cardiologist        SpecializationEnum = "cardiologist"
@@ -102,11 +109,19 @@ type Appointment struct {
 	Prescriptions *[]PrescriptionDisplay `json:"prescriptions,omitempty"`
 	Reason        *string                `json:"reason,omitempty"`
 
+	// ReservationConflicts Set only when rescheduling with `keepReservations`. Resources which were taken
+	// in the new time slot, because of which the reservations were released and the
+	// appointment awaits a new decision.
+	ReservationConflicts *[]ReservationConflict `json:"reservationConflicts,omitempty"`
+
 	// Status The current status of the appointment.
 	Status AppointmentStatus `json:"status"`
 
 	// Type The type of the appointment.
 	Type AppointmentType `json:"type"`
+
+	// Version Version of the appointment, sent back as its ETag.
+	Version int64 `json:"version"`
 }
 
 // AppointmentCancellation Data required to cancel an appointment.
@@ -149,8 +164,40 @@ type AppointmentDisplay struct {
 	Type AppointmentType `json:"type"`
 }
 
+// AppointmentRecord defines model for AppointmentRecord.
+type AppointmentRecord struct {
+	AppointmentDateTime time.Time           `json:"appointmentDateTime"`
+	CancellationReason  *string             `json:"cancellationReason,omitempty"`
+	CancelledBy         *UserRole           `json:"cancelledBy,omitempty"`
+	ConditionId         *openapi_types.UUID `json:"conditionId,omitempty"`
+	DenialReason        *string             `json:"denialReason,omitempty"`
+	DoctorId            openapi_types.UUID  `json:"doctorId"`
+	EndTime             time.Time           `json:"endTime"`
+	Equipment           *[]Equipment        `json:"equipment,omitempty"`
+	Facilities          *[]Facility         `json:"facilities,omitempty"`
+	Id                  openapi_types.UUID  `json:"id"`
+	Medicine            *[]Medicine         `json:"medicine,omitempty"`
+	PatientId           openapi_types.UUID  `json:"patientId"`
+	Reason              *string             `json:"reason,omitempty"`
+
+	// Status The current status of the appointment.
+	Status AppointmentStatus `json:"status"`
+
+	// Type The type of the appointment.
+	Type AppointmentType `json:"type"`
+}
+
+// AppointmentRecordsExport defines model for AppointmentRecordsExport.
+type AppointmentRecordsExport struct {
+	Appointments []AppointmentRecord `json:"appointments"`
+}
+
 // AppointmentReschedule Data required for a patient to reschedule their appointment.
 type AppointmentReschedule struct {
+	// KeepReservations Move the reserved resources to the new time and keep the appointment's status
+	// if all of them are still free. Otherwise the reservations are released, the
+	// appointment awaits a new decision and the taken resources are reported.
+	KeepReservations       *bool     `json:"keepReservations,omitempty"`
 	NewAppointmentDateTime time.Time `json:"newAppointmentDateTime"`
 
 	// Reason Optional reason for rescheduling provided by the patient.
@@ -180,6 +227,13 @@ type Appointments struct {
 	Appointments *[]AppointmentDisplay `json:"appointments,omitempty"`
 }
 
+// CalendarFeed Secret iCalendar feed of a user's appointments. Anyone who knows the URL
+// can read the feed, it is shown only once when issued.
+type CalendarFeed struct {
+	// Url URL to subscribe to in a calendar application.
+	Url string `json:"url"`
+}
+
 // ConditionDisplay Basic info about a patient's condition.
 type ConditionDisplay struct {
 	AppointmentsIds *[]openapi_types.UUID `json:"appointmentsIds,omitempty"`
@@ -201,6 +255,17 @@ type Doctor struct {
 	Specialization SpecializationEnum `json:"specialization"`
 }
 
+// DoctorBusyIntervals defines model for DoctorBusyIntervals.
+type DoctorBusyIntervals struct {
+	Busy     []Interval         `json:"busy"`
+	DoctorId openapi_types.UUID `json:"doctorId"`
+}
+
+// DoctorsBusyIntervals defines model for DoctorsBusyIntervals.
+type DoctorsBusyIntervals struct {
+	Doctors []DoctorBusyIntervals `json:"doctors"`
+}
+
 // Equipment Represents a required equipment resource.
 type Equipment struct {
 	// Id Unique identifier for the equipment.
@@ -219,6 +284,12 @@ type Facility struct {
 	Name string `json:"name"`
 }
 
+// Interval Half-open time interval [start, end).
+type Interval struct {
+	End   time.Time `json:"end"`
+	Start time.Time `json:"start"`
+}
+
 // Medicine Represents a required medicine resource.
 type Medicine struct {
 	// Id Unique identifier for the medicine.
@@ -260,6 +331,16 @@ type PrescriptionDisplay struct {
 	Start         time.Time           `json:"start"`
 }
 
+// ReservationConflict Resource reserved by another appointment in the requested time slot.
+type ReservationConflict struct {
+	Id   openapi_types.UUID `json:"id"`
+	Name string             `json:"name"`
+	Type ResourceType       `json:"type"`
+}
+
+// ResourceType defines model for ResourceType.
+type ResourceType string
+
 // SpecializationEnum Medical specialization of a doctor.
 type SpecializationEnum string
 
@@ -290,6 +371,9 @@ type Date = openapi_types.Date
 // DoctorId defines model for doctorId.
 type DoctorId = openapi_types.UUID
 
+// DoctorIds defines model for doctorIds.
+type DoctorIds = []openapi_types.UUID
+
 // From defines model for from.
 type From = openapi_types.Date
 
@@ -299,6 +383,12 @@ type PatientId = openapi_types.UUID
 // To defines model for to.
 type To = openapi_types.Date
 
+// WindowFrom defines model for windowFrom.
+type WindowFrom = time.Time
+
+// WindowTo defines model for windowTo.
+type WindowTo = time.Time
+
 // DoctorTimeslots defines model for DoctorTimeslots.
 type DoctorTimeslots struct {
 	Slots []TimeSlot `json:"slots"`
@@ -313,6 +403,18 @@ type DoctorsCalendarParams struct {
 	To *To `form:"to,omitempty" json:"to,omitempty"`
 }
 
+// DoctorsBusyIntervalsParams defines parameters for DoctorsBusyIntervals.
+type DoctorsBusyIntervalsParams struct {
+	// DoctorIds Doctors whose busy intervals to retrieve.
+	DoctorIds DoctorIds `form:"doctorIds" json:"doctorIds"`
+
+	// From Start of the window.
+	From WindowFrom `form:"from" json:"from"`
+
+	// To End of the window.
+	To WindowTo `form:"to" json:"to"`
+}
+
 // PatientsCalendarParams defines parameters for PatientsCalendar.
 type PatientsCalendarParams struct {
 	// From The specific day form which to retrieve resources.
@@ -322,6 +424,45 @@ type PatientsCalendarParams struct {
 	To *To `form:"to,omitempty" json:"to,omitempty"`
 }
 
+// RescheduleAppointmentParams defines parameters for RescheduleAppointment.
+type RescheduleAppointmentParams struct {
+	// IfMatch ETags of the resource the update is based on, separated by commas. The
+	// update is applied only if the resource is at one of them, `*` or no
+	// header at all applies it at any version. ETags are compared strongly, a
+	// weak ETag never matches.
+	IfMatch *externalRef0.IfMatch `json:"If-Match,omitempty"`
+}
+
+// DecideAppointmentParams defines parameters for DecideAppointment.
+type DecideAppointmentParams struct {
+	// IfMatch ETags of the resource the update is based on, separated by commas. The
+	// update is applied only if the resource is at one of them, `*` or no
+	// header at all applies it at any version. ETags are compared strongly, a
+	// weak ETag never matches.
+	IfMatch *externalRef0.IfMatch `json:"If-Match,omitempty"`
+}
+
+// AuditEventsParams defines parameters for AuditEvents.
+type AuditEventsParams struct {
+	// TargetId Only events performed on the entity with this ID.
+	TargetId *externalRef0.AuditTargetId `form:"targetId,omitempty" json:"targetId,omitempty"`
+
+	// Actor Only events performed by this actor.
+	Actor *externalRef0.AuditActor `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// From Only events created at or after this instant.
+	From *externalRef0.AuditFrom `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only events created at or before this instant.
+	To *externalRef0.AuditTo `form:"to,omitempty" json:"to,omitempty"`
+
+	// AfterSeq Only events after this position in the audit chain.
+	AfterSeq *externalRef0.AuditAfterSeq `form:"afterSeq,omitempty" json:"afterSeq,omitempty"`
+
+	// Limit Maximum number of returned events, 100 if not set.
+	Limit *externalRef0.AuditLimit `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // DoctorsTimeslotsParams defines parameters for DoctorsTimeslots.
 type DoctorsTimeslotsParams struct {
 	// Date The specific day for which to retrieve timeslots (YYYY-MM-DD format).
@@ -427,9 +568,27 @@ type ClientInterface interface {
 	// DoctorsCalendar request
 	DoctorsCalendar(ctx context.Context, doctorId DoctorId, params *DoctorsCalendarParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// RevokeDoctorCalendarFeed request
+	RevokeDoctorCalendarFeed(ctx context.Context, doctorId DoctorId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// IssueDoctorCalendarFeed request
+	IssueDoctorCalendarFeed(ctx context.Context, doctorId DoctorId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DoctorsBusyIntervals request
+	DoctorsBusyIntervals(ctx context.Context, params *DoctorsBusyIntervalsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PatientsCalendar request
 	PatientsCalendar(ctx context.Context, patientId PatientId, params *PatientsCalendarParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ExportPatientAppointments request
+	ExportPatientAppointments(ctx context.Context, patientId PatientId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevokePatientCalendarFeed request
+	RevokePatientCalendarFeed(ctx context.Context, patientId PatientId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// IssuePatientCalendarFeed request
+	IssuePatientCalendarFeed(ctx context.Context, patientId PatientId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CancelAppointmentWithBody request with any body
 	CancelAppointmentWithBody(ctx context.Context, appointmentId AppointmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -439,20 +598,29 @@ type ClientInterface interface {
 	AppointmentById(ctx context.Context, appointmentId AppointmentId, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// RescheduleAppointmentWithBody request with any body
-	RescheduleAppointmentWithBody(ctx context.Context, appointmentId AppointmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	RescheduleAppointmentWithBody(ctx context.Context, appointmentId AppointmentId, params *RescheduleAppointmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	RescheduleAppointment(ctx context.Context, appointmentId AppointmentId, body RescheduleAppointmentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	RescheduleAppointment(ctx context.Context, appointmentId AppointmentId, params *RescheduleAppointmentParams, body RescheduleAppointmentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DecideAppointmentWithBody request with any body
-	DecideAppointmentWithBody(ctx context.Context, appointmentId AppointmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	DecideAppointmentWithBody(ctx context.Context, appointmentId AppointmentId, params *DecideAppointmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	DecideAppointment(ctx context.Context, appointmentId AppointmentId, body DecideAppointmentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	DecideAppointment(ctx context.Context, appointmentId AppointmentId, params *DecideAppointmentParams, body DecideAppointmentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AppointmentIcs request
+	AppointmentIcs(ctx context.Context, appointmentId AppointmentId, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// UpdateAppointmentResourcesWithBody request with any body
 	UpdateAppointmentResourcesWithBody(ctx context.Context, appointmentId AppointmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	UpdateAppointmentResources(ctx context.Context, appointmentId AppointmentId, body UpdateAppointmentResourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// AuditEvents request
+	AuditEvents(ctx context.Context, params *AuditEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CalendarFeed request
+	CalendarFeed(ctx context.Context, token string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DoctorsTimeslots request
 	DoctorsTimeslots(ctx context.Context, doctorId DoctorId, params *DoctorsTimeslotsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
@@ -505,6 +673,42 @@ func (c *Client) DoctorsCalendar(ctx context.Context, doctorId DoctorId, params
 	return c.Client.Do(req)
 }
 
+func (c *Client) RevokeDoctorCalendarFeed(ctx context.Context, doctorId DoctorId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeDoctorCalendarFeedRequest(c.Server, doctorId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) IssueDoctorCalendarFeed(ctx context.Context, doctorId DoctorId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewIssueDoctorCalendarFeedRequest(c.Server, doctorId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DoctorsBusyIntervals(ctx context.Context, params *DoctorsBusyIntervalsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDoctorsBusyIntervalsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) PatientsCalendar(ctx context.Context, patientId PatientId, params *PatientsCalendarParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewPatientsCalendarRequest(c.Server, patientId, params)
 	if err != nil {
@@ -517,6 +721,42 @@ func (c *Client) PatientsCalendar(ctx context.Context, patientId PatientId, para
 	return c.Client.Do(req)
 }
 
+func (c *Client) ExportPatientAppointments(ctx context.Context, patientId PatientId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportPatientAppointmentsRequest(c.Server, patientId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RevokePatientCalendarFeed(ctx context.Context, patientId PatientId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokePatientCalendarFeedRequest(c.Server, patientId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) IssuePatientCalendarFeed(ctx context.Context, patientId PatientId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewIssuePatientCalendarFeedRequest(c.Server, patientId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) CancelAppointmentWithBody(ctx context.Context, appointmentId AppointmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewCancelAppointmentRequestWithBody(c.Server, appointmentId, contentType, body)
 	if err != nil {
@@ -553,8 +793,20 @@ func (c *Client) AppointmentById(ctx context.Context, appointmentId AppointmentI
 	return c.Client.Do(req)
 }
 
-func (c *Client) RescheduleAppointmentWithBody(ctx context.Context, appointmentId AppointmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRescheduleAppointmentRequestWithBody(c.Server, appointmentId, contentType, body)
+func (c *Client) RescheduleAppointmentWithBody(ctx context.Context, appointmentId AppointmentId, params *RescheduleAppointmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRescheduleAppointmentRequestWithBody(c.Server, appointmentId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RescheduleAppointment(ctx context.Context, appointmentId AppointmentId, params *RescheduleAppointmentParams, body RescheduleAppointmentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRescheduleAppointmentRequest(c.Server, appointmentId, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -565,8 +817,8 @@ func (c *Client) RescheduleAppointmentWithBody(ctx context.Context, appointmentI
 	return c.Client.Do(req)
 }
 
-func (c *Client) RescheduleAppointment(ctx context.Context, appointmentId AppointmentId, body RescheduleAppointmentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRescheduleAppointmentRequest(c.Server, appointmentId, body)
+func (c *Client) DecideAppointmentWithBody(ctx context.Context, appointmentId AppointmentId, params *DecideAppointmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDecideAppointmentRequestWithBody(c.Server, appointmentId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -577,8 +829,8 @@ func (c *Client) RescheduleAppointment(ctx context.Context, appointmentId Appoin
 	return c.Client.Do(req)
 }
 
-func (c *Client) DecideAppointmentWithBody(ctx context.Context, appointmentId AppointmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDecideAppointmentRequestWithBody(c.Server, appointmentId, contentType, body)
+func (c *Client) DecideAppointment(ctx context.Context, appointmentId AppointmentId, params *DecideAppointmentParams, body DecideAppointmentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDecideAppointmentRequest(c.Server, appointmentId, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -589,8 +841,8 @@ func (c *Client) DecideAppointmentWithBody(ctx context.Context, appointmentId Ap
 	return c.Client.Do(req)
 }
 
-func (c *Client) DecideAppointment(ctx context.Context, appointmentId AppointmentId, body DecideAppointmentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDecideAppointmentRequest(c.Server, appointmentId, body)
+func (c *Client) AppointmentIcs(ctx context.Context, appointmentId AppointmentId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAppointmentIcsRequest(c.Server, appointmentId)
 	if err != nil {
 		return nil, err
 	}
@@ -625,6 +877,30 @@ func (c *Client) UpdateAppointmentResources(ctx context.Context, appointmentId A
 	return c.Client.Do(req)
 }
 
+func (c *Client) AuditEvents(ctx context.Context, params *AuditEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuditEventsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CalendarFeed(ctx context.Context, token string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCalendarFeedRequest(c.Server, token)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) DoctorsTimeslots(ctx context.Context, doctorId DoctorId, params *DoctorsTimeslotsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewDoctorsTimeslotsRequest(c.Server, doctorId, params)
 	if err != nil {
@@ -779,13 +1055,13 @@ func NewDoctorsCalendarRequest(server string, doctorId DoctorId, params *Doctors
 	return req, nil
 }
 
-// NewPatientsCalendarRequest generates requests for PatientsCalendar
-func NewPatientsCalendarRequest(server string, patientId PatientId, params *PatientsCalendarParams) (*http.Request, error) {
+// NewRevokeDoctorCalendarFeedRequest generates requests for RevokeDoctorCalendarFeed
+func NewRevokeDoctorCalendarFeedRequest(server string, doctorId DoctorId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "patientId", runtime.ParamLocationPath, patientId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "doctorId", runtime.ParamLocationPath, doctorId)
 	if err != nil {
 		return nil, err
 	}
@@ -795,7 +1071,7 @@ func NewPatientsCalendarRequest(server string, patientId PatientId, params *Pati
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/appointments/patient/%s", pathParam0)
+	operationPath := fmt.Sprintf("/appointments/doctor/%s/feed", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -805,41 +1081,7 @@ func NewPatientsCalendarRequest(server string, patientId PatientId, params *Pati
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, params.From); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		if params.To != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -847,24 +1089,13 @@ func NewPatientsCalendarRequest(server string, patientId PatientId, params *Pati
 	return req, nil
 }
 
-// NewCancelAppointmentRequest calls the generic CancelAppointment builder with application/json body
-func NewCancelAppointmentRequest(server string, appointmentId AppointmentId, body CancelAppointmentJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCancelAppointmentRequestWithBody(server, appointmentId, "application/json", bodyReader)
-}
-
-// NewCancelAppointmentRequestWithBody generates requests for CancelAppointment with any type of body
-func NewCancelAppointmentRequestWithBody(server string, appointmentId AppointmentId, contentType string, body io.Reader) (*http.Request, error) {
+// NewIssueDoctorCalendarFeedRequest generates requests for IssueDoctorCalendarFeed
+func NewIssueDoctorCalendarFeedRequest(server string, doctorId DoctorId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appointmentId", runtime.ParamLocationPath, appointmentId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "doctorId", runtime.ParamLocationPath, doctorId)
 	if err != nil {
 		return nil, err
 	}
@@ -874,7 +1105,7 @@ func NewCancelAppointmentRequestWithBody(server string, appointmentId Appointmen
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/appointments/%s", pathParam0)
+	operationPath := fmt.Sprintf("/appointments/doctor/%s/feed", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -884,33 +1115,24 @@ func NewCancelAppointmentRequestWithBody(server string, appointmentId Appointmen
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewAppointmentByIdRequest generates requests for AppointmentById
-func NewAppointmentByIdRequest(server string, appointmentId AppointmentId) (*http.Request, error) {
+// NewDoctorsBusyIntervalsRequest generates requests for DoctorsBusyIntervals
+func NewDoctorsBusyIntervalsRequest(server string, params *DoctorsBusyIntervalsParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appointmentId", runtime.ParamLocationPath, appointmentId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/appointments/%s", pathParam0)
+	operationPath := fmt.Sprintf("/appointments/doctors/busy")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -920,6 +1142,48 @@ func NewAppointmentByIdRequest(server string, appointmentId AppointmentId) (*htt
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "doctorIds", runtime.ParamLocationQuery, params.DoctorIds); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, params.From); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, params.To); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -928,24 +1192,81 @@ func NewAppointmentByIdRequest(server string, appointmentId AppointmentId) (*htt
 	return req, nil
 }
 
-// NewRescheduleAppointmentRequest calls the generic RescheduleAppointment builder with application/json body
-func NewRescheduleAppointmentRequest(server string, appointmentId AppointmentId, body RescheduleAppointmentJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewPatientsCalendarRequest generates requests for PatientsCalendar
+func NewPatientsCalendarRequest(server string, patientId PatientId, params *PatientsCalendarParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "patientId", runtime.ParamLocationPath, patientId)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewRescheduleAppointmentRequestWithBody(server, appointmentId, "application/json", bodyReader)
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/appointments/patient/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, params.From); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// NewRescheduleAppointmentRequestWithBody generates requests for RescheduleAppointment with any type of body
-func NewRescheduleAppointmentRequestWithBody(server string, appointmentId AppointmentId, contentType string, body io.Reader) (*http.Request, error) {
+// NewExportPatientAppointmentsRequest generates requests for ExportPatientAppointments
+func NewExportPatientAppointmentsRequest(server string, patientId PatientId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appointmentId", runtime.ParamLocationPath, appointmentId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "patientId", runtime.ParamLocationPath, patientId)
 	if err != nil {
 		return nil, err
 	}
@@ -955,7 +1276,7 @@ func NewRescheduleAppointmentRequestWithBody(server string, appointmentId Appoin
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/appointments/%s", pathParam0)
+	operationPath := fmt.Sprintf("/appointments/patient/%s/export", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -965,34 +1286,55 @@ func NewRescheduleAppointmentRequestWithBody(server string, appointmentId Appoin
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewDecideAppointmentRequest calls the generic DecideAppointment builder with application/json body
-func NewDecideAppointmentRequest(server string, appointmentId AppointmentId, body DecideAppointmentJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewRevokePatientCalendarFeedRequest generates requests for RevokePatientCalendarFeed
+func NewRevokePatientCalendarFeedRequest(server string, patientId PatientId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "patientId", runtime.ParamLocationPath, patientId)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewDecideAppointmentRequestWithBody(server, appointmentId, "application/json", bodyReader)
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/appointments/patient/%s/feed", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// NewDecideAppointmentRequestWithBody generates requests for DecideAppointment with any type of body
-func NewDecideAppointmentRequestWithBody(server string, appointmentId AppointmentId, contentType string, body io.Reader) (*http.Request, error) {
+// NewIssuePatientCalendarFeedRequest generates requests for IssuePatientCalendarFeed
+func NewIssuePatientCalendarFeedRequest(server string, patientId PatientId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appointmentId", runtime.ParamLocationPath, appointmentId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "patientId", runtime.ParamLocationPath, patientId)
 	if err != nil {
 		return nil, err
 	}
@@ -1002,7 +1344,7 @@ func NewDecideAppointmentRequestWithBody(server string, appointmentId Appointmen
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/appointments/%s", pathParam0)
+	operationPath := fmt.Sprintf("/appointments/patient/%s/feed", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1012,29 +1354,27 @@ func NewDecideAppointmentRequestWithBody(server string, appointmentId Appointmen
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewUpdateAppointmentResourcesRequest calls the generic UpdateAppointmentResources builder with application/json body
-func NewUpdateAppointmentResourcesRequest(server string, appointmentId AppointmentId, body UpdateAppointmentResourcesJSONRequestBody) (*http.Request, error) {
+// NewCancelAppointmentRequest calls the generic CancelAppointment builder with application/json body
+func NewCancelAppointmentRequest(server string, appointmentId AppointmentId, body CancelAppointmentJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateAppointmentResourcesRequestWithBody(server, appointmentId, "application/json", bodyReader)
+	return NewCancelAppointmentRequestWithBody(server, appointmentId, "application/json", bodyReader)
 }
 
-// NewUpdateAppointmentResourcesRequestWithBody generates requests for UpdateAppointmentResources with any type of body
-func NewUpdateAppointmentResourcesRequestWithBody(server string, appointmentId AppointmentId, contentType string, body io.Reader) (*http.Request, error) {
+// NewCancelAppointmentRequestWithBody generates requests for CancelAppointment with any type of body
+func NewCancelAppointmentRequestWithBody(server string, appointmentId AppointmentId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1049,7 +1389,7 @@ func NewUpdateAppointmentResourcesRequestWithBody(server string, appointmentId A
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/appointments/%s/resources", pathParam0)
+	operationPath := fmt.Sprintf("/appointments/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1059,7 +1399,7 @@ func NewUpdateAppointmentResourcesRequestWithBody(server string, appointmentId A
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -1069,13 +1409,13 @@ func NewUpdateAppointmentResourcesRequestWithBody(server string, appointmentId A
 	return req, nil
 }
 
-// NewDoctorsTimeslotsRequest generates requests for DoctorsTimeslots
-func NewDoctorsTimeslotsRequest(server string, doctorId DoctorId, params *DoctorsTimeslotsParams) (*http.Request, error) {
+// NewAppointmentByIdRequest generates requests for AppointmentById
+func NewAppointmentByIdRequest(server string, appointmentId AppointmentId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "doctorId", runtime.ParamLocationPath, doctorId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appointmentId", runtime.ParamLocationPath, appointmentId)
 	if err != nil {
 		return nil, err
 	}
@@ -1085,7 +1425,7 @@ func NewDoctorsTimeslotsRequest(server string, doctorId DoctorId, params *Doctor
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/timeslots/%s", pathParam0)
+	operationPath := fmt.Sprintf("/appointments/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1095,24 +1435,6 @@ func NewDoctorsTimeslotsRequest(server string, doctorId DoctorId, params *Doctor
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "date", runtime.ParamLocationQuery, params.Date); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1121,89 +1443,536 @@ func NewDoctorsTimeslotsRequest(server string, doctorId DoctorId, params *Doctor
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewRescheduleAppointmentRequest calls the generic RescheduleAppointment builder with application/json body
+func NewRescheduleAppointmentRequest(server string, appointmentId AppointmentId, params *RescheduleAppointmentParams, body RescheduleAppointmentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	bodyReader = bytes.NewReader(buf)
+	return NewRescheduleAppointmentRequestWithBody(server, appointmentId, params, "application/json", bodyReader)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+// NewRescheduleAppointmentRequestWithBody generates requests for RescheduleAppointment with any type of body
+func NewRescheduleAppointmentRequestWithBody(server string, appointmentId AppointmentId, params *RescheduleAppointmentParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appointmentId", runtime.ParamLocationPath, appointmentId)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-}
-
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// RequestAppointmentWithBodyWithResponse request with any body
-	RequestAppointmentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RequestAppointmentResponse, error)
 
-	RequestAppointmentWithResponse(ctx context.Context, body RequestAppointmentJSONRequestBody, reqEditors ...RequestEditorFn) (*RequestAppointmentResponse, error)
+	operationPath := fmt.Sprintf("/appointments/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// AppointmentsByConditionIdWithResponse request
-	AppointmentsByConditionIdWithResponse(ctx context.Context, conditionId ConditionId, reqEditors ...RequestEditorFn) (*AppointmentsByConditionIdResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// DoctorsCalendarWithResponse request
-	DoctorsCalendarWithResponse(ctx context.Context, doctorId DoctorId, params *DoctorsCalendarParams, reqEditors ...RequestEditorFn) (*DoctorsCalendarResponse, error)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// PatientsCalendarWithResponse request
-	PatientsCalendarWithResponse(ctx context.Context, patientId PatientId, params *PatientsCalendarParams, reqEditors ...RequestEditorFn) (*PatientsCalendarResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// CancelAppointmentWithBodyWithResponse request with any body
-	CancelAppointmentWithBodyWithResponse(ctx context.Context, appointmentId AppointmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CancelAppointmentResponse, error)
+	if params != nil {
 
-	CancelAppointmentWithResponse(ctx context.Context, appointmentId AppointmentId, body CancelAppointmentJSONRequestBody, reqEditors ...RequestEditorFn) (*CancelAppointmentResponse, error)
+		if params.IfMatch != nil {
+			var headerParam0 string
 
-	// AppointmentByIdWithResponse request
-	AppointmentByIdWithResponse(ctx context.Context, appointmentId AppointmentId, reqEditors ...RequestEditorFn) (*AppointmentByIdResponse, error)
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
 
-	// RescheduleAppointmentWithBodyWithResponse request with any body
-	RescheduleAppointmentWithBodyWithResponse(ctx context.Context, appointmentId AppointmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RescheduleAppointmentResponse, error)
+			req.Header.Set("If-Match", headerParam0)
+		}
 
-	RescheduleAppointmentWithResponse(ctx context.Context, appointmentId AppointmentId, body RescheduleAppointmentJSONRequestBody, reqEditors ...RequestEditorFn) (*RescheduleAppointmentResponse, error)
+	}
 
-	// DecideAppointmentWithBodyWithResponse request with any body
-	DecideAppointmentWithBodyWithResponse(ctx context.Context, appointmentId AppointmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DecideAppointmentResponse, error)
+	return req, nil
+}
 
-	DecideAppointmentWithResponse(ctx context.Context, appointmentId AppointmentId, body DecideAppointmentJSONRequestBody, reqEditors ...RequestEditorFn) (*DecideAppointmentResponse, error)
+// NewDecideAppointmentRequest calls the generic DecideAppointment builder with application/json body
+func NewDecideAppointmentRequest(server string, appointmentId AppointmentId, params *DecideAppointmentParams, body DecideAppointmentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDecideAppointmentRequestWithBody(server, appointmentId, params, "application/json", bodyReader)
+}
 
-	// UpdateAppointmentResourcesWithBodyWithResponse request with any body
-	UpdateAppointmentResourcesWithBodyWithResponse(ctx context.Context, appointmentId AppointmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAppointmentResourcesResponse, error)
+// NewDecideAppointmentRequestWithBody generates requests for DecideAppointment with any type of body
+func NewDecideAppointmentRequestWithBody(server string, appointmentId AppointmentId, params *DecideAppointmentParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	UpdateAppointmentResourcesWithResponse(ctx context.Context, appointmentId AppointmentId, body UpdateAppointmentResourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAppointmentResourcesResponse, error)
+	var pathParam0 string
 
-	// DoctorsTimeslotsWithResponse request
-	DoctorsTimeslotsWithResponse(ctx context.Context, doctorId DoctorId, params *DoctorsTimeslotsParams, reqEditors ...RequestEditorFn) (*DoctorsTimeslotsResponse, error)
-}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appointmentId", runtime.ParamLocationPath, appointmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/appointments/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewAppointmentIcsRequest generates requests for AppointmentIcs
+func NewAppointmentIcsRequest(server string, appointmentId AppointmentId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appointmentId", runtime.ParamLocationPath, appointmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/appointments/%s/ics", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateAppointmentResourcesRequest calls the generic UpdateAppointmentResources builder with application/json body
+func NewUpdateAppointmentResourcesRequest(server string, appointmentId AppointmentId, body UpdateAppointmentResourcesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateAppointmentResourcesRequestWithBody(server, appointmentId, "application/json", bodyReader)
+}
+
+// NewUpdateAppointmentResourcesRequestWithBody generates requests for UpdateAppointmentResources with any type of body
+func NewUpdateAppointmentResourcesRequestWithBody(server string, appointmentId AppointmentId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appointmentId", runtime.ParamLocationPath, appointmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/appointments/%s/resources", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAuditEventsRequest generates requests for AuditEvents
+func NewAuditEventsRequest(server string, params *AuditEventsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/audit")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.TargetId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "targetId", runtime.ParamLocationQuery, *params.TargetId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Actor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "actor", runtime.ParamLocationQuery, *params.Actor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.AfterSeq != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "afterSeq", runtime.ParamLocationQuery, *params.AfterSeq); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCalendarFeedRequest generates requests for CalendarFeed
+func NewCalendarFeedRequest(server string, token string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "token", runtime.ParamLocationPath, token)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/calendar/%s.ics", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDoctorsTimeslotsRequest generates requests for DoctorsTimeslots
+func NewDoctorsTimeslotsRequest(server string, doctorId DoctorId, params *DoctorsTimeslotsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "doctorId", runtime.ParamLocationPath, doctorId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/timeslots/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "date", runtime.ParamLocationQuery, params.Date); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// RequestAppointmentWithBodyWithResponse request with any body
+	RequestAppointmentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RequestAppointmentResponse, error)
+
+	RequestAppointmentWithResponse(ctx context.Context, body RequestAppointmentJSONRequestBody, reqEditors ...RequestEditorFn) (*RequestAppointmentResponse, error)
+
+	// AppointmentsByConditionIdWithResponse request
+	AppointmentsByConditionIdWithResponse(ctx context.Context, conditionId ConditionId, reqEditors ...RequestEditorFn) (*AppointmentsByConditionIdResponse, error)
+
+	// DoctorsCalendarWithResponse request
+	DoctorsCalendarWithResponse(ctx context.Context, doctorId DoctorId, params *DoctorsCalendarParams, reqEditors ...RequestEditorFn) (*DoctorsCalendarResponse, error)
+
+	// RevokeDoctorCalendarFeedWithResponse request
+	RevokeDoctorCalendarFeedWithResponse(ctx context.Context, doctorId DoctorId, reqEditors ...RequestEditorFn) (*RevokeDoctorCalendarFeedResponse, error)
+
+	// IssueDoctorCalendarFeedWithResponse request
+	IssueDoctorCalendarFeedWithResponse(ctx context.Context, doctorId DoctorId, reqEditors ...RequestEditorFn) (*IssueDoctorCalendarFeedResponse, error)
+
+	// DoctorsBusyIntervalsWithResponse request
+	DoctorsBusyIntervalsWithResponse(ctx context.Context, params *DoctorsBusyIntervalsParams, reqEditors ...RequestEditorFn) (*DoctorsBusyIntervalsResponse, error)
+
+	// PatientsCalendarWithResponse request
+	PatientsCalendarWithResponse(ctx context.Context, patientId PatientId, params *PatientsCalendarParams, reqEditors ...RequestEditorFn) (*PatientsCalendarResponse, error)
+
+	// ExportPatientAppointmentsWithResponse request
+	ExportPatientAppointmentsWithResponse(ctx context.Context, patientId PatientId, reqEditors ...RequestEditorFn) (*ExportPatientAppointmentsResponse, error)
+
+	// RevokePatientCalendarFeedWithResponse request
+	RevokePatientCalendarFeedWithResponse(ctx context.Context, patientId PatientId, reqEditors ...RequestEditorFn) (*RevokePatientCalendarFeedResponse, error)
+
+	// IssuePatientCalendarFeedWithResponse request
+	IssuePatientCalendarFeedWithResponse(ctx context.Context, patientId PatientId, reqEditors ...RequestEditorFn) (*IssuePatientCalendarFeedResponse, error)
+
+	// CancelAppointmentWithBodyWithResponse request with any body
+	CancelAppointmentWithBodyWithResponse(ctx context.Context, appointmentId AppointmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CancelAppointmentResponse, error)
+
+	CancelAppointmentWithResponse(ctx context.Context, appointmentId AppointmentId, body CancelAppointmentJSONRequestBody, reqEditors ...RequestEditorFn) (*CancelAppointmentResponse, error)
+
+	// AppointmentByIdWithResponse request
+	AppointmentByIdWithResponse(ctx context.Context, appointmentId AppointmentId, reqEditors ...RequestEditorFn) (*AppointmentByIdResponse, error)
+
+	// RescheduleAppointmentWithBodyWithResponse request with any body
+	RescheduleAppointmentWithBodyWithResponse(ctx context.Context, appointmentId AppointmentId, params *RescheduleAppointmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RescheduleAppointmentResponse, error)
+
+	RescheduleAppointmentWithResponse(ctx context.Context, appointmentId AppointmentId, params *RescheduleAppointmentParams, body RescheduleAppointmentJSONRequestBody, reqEditors ...RequestEditorFn) (*RescheduleAppointmentResponse, error)
+
+	// DecideAppointmentWithBodyWithResponse request with any body
+	DecideAppointmentWithBodyWithResponse(ctx context.Context, appointmentId AppointmentId, params *DecideAppointmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DecideAppointmentResponse, error)
+
+	DecideAppointmentWithResponse(ctx context.Context, appointmentId AppointmentId, params *DecideAppointmentParams, body DecideAppointmentJSONRequestBody, reqEditors ...RequestEditorFn) (*DecideAppointmentResponse, error)
+
+	// AppointmentIcsWithResponse request
+	AppointmentIcsWithResponse(ctx context.Context, appointmentId AppointmentId, reqEditors ...RequestEditorFn) (*AppointmentIcsResponse, error)
+
+	// UpdateAppointmentResourcesWithBodyWithResponse request with any body
+	UpdateAppointmentResourcesWithBodyWithResponse(ctx context.Context, appointmentId AppointmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAppointmentResourcesResponse, error)
+
+	UpdateAppointmentResourcesWithResponse(ctx context.Context, appointmentId AppointmentId, body UpdateAppointmentResourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAppointmentResourcesResponse, error)
+
+	// AuditEventsWithResponse request
+	AuditEventsWithResponse(ctx context.Context, params *AuditEventsParams, reqEditors ...RequestEditorFn) (*AuditEventsResponse, error)
+
+	// CalendarFeedWithResponse request
+	CalendarFeedWithResponse(ctx context.Context, token string, reqEditors ...RequestEditorFn) (*CalendarFeedResponse, error)
+
+	// DoctorsTimeslotsWithResponse request
+	DoctorsTimeslotsWithResponse(ctx context.Context, doctorId DoctorId, params *DoctorsTimeslotsParams, reqEditors ...RequestEditorFn) (*DoctorsTimeslotsResponse, error)
+}
 
 type RequestAppointmentResponse struct {
 	Body                      []byte
@@ -1274,6 +2043,76 @@ func (r DoctorsCalendarResponse) StatusCode() int {
 	return 0
 }
 
+type RevokeDoctorCalendarFeedResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON404 *externalRef0.NotFoundResponse
+	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokeDoctorCalendarFeedResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeDoctorCalendarFeedResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type IssueDoctorCalendarFeedResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *CalendarFeed
+	ApplicationproblemJSON404 *externalRef0.NotFoundResponse
+	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r IssueDoctorCalendarFeedResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r IssueDoctorCalendarFeedResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DoctorsBusyIntervalsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *DoctorsBusyIntervals
+	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DoctorsBusyIntervalsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DoctorsBusyIntervalsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type PatientsCalendarResponse struct {
 	Body                      []byte
 	HTTPResponse              *http.Response
@@ -1297,6 +2136,76 @@ func (r PatientsCalendarResponse) StatusCode() int {
 	return 0
 }
 
+type ExportPatientAppointmentsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *AppointmentRecordsExport
+	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportPatientAppointmentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportPatientAppointmentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevokePatientCalendarFeedResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON404 *externalRef0.NotFoundResponse
+	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokePatientCalendarFeedResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokePatientCalendarFeedResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type IssuePatientCalendarFeedResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *CalendarFeed
+	ApplicationproblemJSON404 *externalRef0.NotFoundResponse
+	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r IssuePatientCalendarFeedResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r IssuePatientCalendarFeedResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type CancelAppointmentResponse struct {
 	Body                      []byte
 	HTTPResponse              *http.Response
@@ -1304,7 +2213,78 @@ type CancelAppointmentResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r CancelAppointmentResponse) Status() string {
+func (r CancelAppointmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CancelAppointmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AppointmentByIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Appointment
+	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AppointmentByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AppointmentByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RescheduleAppointmentResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Appointment
+	ApplicationproblemJSON412 *externalRef0.PreconditionFailedResponse
+	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RescheduleAppointmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RescheduleAppointmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DecideAppointmentResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Appointment
+	ApplicationproblemJSON412 *externalRef0.PreconditionFailedResponse
+	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DecideAppointmentResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -1312,22 +2292,22 @@ func (r CancelAppointmentResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CancelAppointmentResponse) StatusCode() int {
+func (r DecideAppointmentResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type AppointmentByIdResponse struct {
+type AppointmentIcsResponse struct {
 	Body                      []byte
 	HTTPResponse              *http.Response
-	JSON200                   *Appointment
+	ApplicationproblemJSON404 *externalRef0.NotFoundResponse
 	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r AppointmentByIdResponse) Status() string {
+func (r AppointmentIcsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -1335,14 +2315,14 @@ func (r AppointmentByIdResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r AppointmentByIdResponse) StatusCode() int {
+func (r AppointmentIcsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type RescheduleAppointmentResponse struct {
+type UpdateAppointmentResourcesResponse struct {
 	Body                      []byte
 	HTTPResponse              *http.Response
 	JSON200                   *Appointment
@@ -1350,7 +2330,7 @@ type RescheduleAppointmentResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r RescheduleAppointmentResponse) Status() string {
+func (r UpdateAppointmentResourcesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -1358,22 +2338,23 @@ func (r RescheduleAppointmentResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r RescheduleAppointmentResponse) StatusCode() int {
+func (r UpdateAppointmentResourcesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DecideAppointmentResponse struct {
+type AuditEventsResponse struct {
 	Body                      []byte
 	HTTPResponse              *http.Response
-	JSON200                   *Appointment
+	JSON200                   *externalRef0.AuditEvents
+	ApplicationproblemJSON403 *externalRef0.ForbiddenResponse
 	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r DecideAppointmentResponse) Status() string {
+func (r AuditEventsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -1381,22 +2362,22 @@ func (r DecideAppointmentResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DecideAppointmentResponse) StatusCode() int {
+func (r AuditEventsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateAppointmentResourcesResponse struct {
+type CalendarFeedResponse struct {
 	Body                      []byte
 	HTTPResponse              *http.Response
-	JSON200                   *Appointment
+	ApplicationproblemJSON404 *externalRef0.NotFoundResponse
 	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateAppointmentResourcesResponse) Status() string {
+func (r CalendarFeedResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -1404,7 +2385,7 @@ func (r UpdateAppointmentResourcesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateAppointmentResourcesResponse) StatusCode() int {
+func (r CalendarFeedResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -1460,130 +2441,416 @@ func (c *ClientWithResponses) AppointmentsByConditionIdWithResponse(ctx context.
 	return ParseAppointmentsByConditionIdResponse(rsp)
 }
 
-// DoctorsCalendarWithResponse request returning *DoctorsCalendarResponse
-func (c *ClientWithResponses) DoctorsCalendarWithResponse(ctx context.Context, doctorId DoctorId, params *DoctorsCalendarParams, reqEditors ...RequestEditorFn) (*DoctorsCalendarResponse, error) {
-	rsp, err := c.DoctorsCalendar(ctx, doctorId, params, reqEditors...)
-	if err != nil {
-		return nil, err
+// DoctorsCalendarWithResponse request returning *DoctorsCalendarResponse
+func (c *ClientWithResponses) DoctorsCalendarWithResponse(ctx context.Context, doctorId DoctorId, params *DoctorsCalendarParams, reqEditors ...RequestEditorFn) (*DoctorsCalendarResponse, error) {
+	rsp, err := c.DoctorsCalendar(ctx, doctorId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDoctorsCalendarResponse(rsp)
+}
+
+// RevokeDoctorCalendarFeedWithResponse request returning *RevokeDoctorCalendarFeedResponse
+func (c *ClientWithResponses) RevokeDoctorCalendarFeedWithResponse(ctx context.Context, doctorId DoctorId, reqEditors ...RequestEditorFn) (*RevokeDoctorCalendarFeedResponse, error) {
+	rsp, err := c.RevokeDoctorCalendarFeed(ctx, doctorId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeDoctorCalendarFeedResponse(rsp)
+}
+
+// IssueDoctorCalendarFeedWithResponse request returning *IssueDoctorCalendarFeedResponse
+func (c *ClientWithResponses) IssueDoctorCalendarFeedWithResponse(ctx context.Context, doctorId DoctorId, reqEditors ...RequestEditorFn) (*IssueDoctorCalendarFeedResponse, error) {
+	rsp, err := c.IssueDoctorCalendarFeed(ctx, doctorId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseIssueDoctorCalendarFeedResponse(rsp)
+}
+
+// DoctorsBusyIntervalsWithResponse request returning *DoctorsBusyIntervalsResponse
+func (c *ClientWithResponses) DoctorsBusyIntervalsWithResponse(ctx context.Context, params *DoctorsBusyIntervalsParams, reqEditors ...RequestEditorFn) (*DoctorsBusyIntervalsResponse, error) {
+	rsp, err := c.DoctorsBusyIntervals(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDoctorsBusyIntervalsResponse(rsp)
+}
+
+// PatientsCalendarWithResponse request returning *PatientsCalendarResponse
+func (c *ClientWithResponses) PatientsCalendarWithResponse(ctx context.Context, patientId PatientId, params *PatientsCalendarParams, reqEditors ...RequestEditorFn) (*PatientsCalendarResponse, error) {
+	rsp, err := c.PatientsCalendar(ctx, patientId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatientsCalendarResponse(rsp)
+}
+
+// ExportPatientAppointmentsWithResponse request returning *ExportPatientAppointmentsResponse
+func (c *ClientWithResponses) ExportPatientAppointmentsWithResponse(ctx context.Context, patientId PatientId, reqEditors ...RequestEditorFn) (*ExportPatientAppointmentsResponse, error) {
+	rsp, err := c.ExportPatientAppointments(ctx, patientId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportPatientAppointmentsResponse(rsp)
+}
+
+// RevokePatientCalendarFeedWithResponse request returning *RevokePatientCalendarFeedResponse
+func (c *ClientWithResponses) RevokePatientCalendarFeedWithResponse(ctx context.Context, patientId PatientId, reqEditors ...RequestEditorFn) (*RevokePatientCalendarFeedResponse, error) {
+	rsp, err := c.RevokePatientCalendarFeed(ctx, patientId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokePatientCalendarFeedResponse(rsp)
+}
+
+// IssuePatientCalendarFeedWithResponse request returning *IssuePatientCalendarFeedResponse
+func (c *ClientWithResponses) IssuePatientCalendarFeedWithResponse(ctx context.Context, patientId PatientId, reqEditors ...RequestEditorFn) (*IssuePatientCalendarFeedResponse, error) {
+	rsp, err := c.IssuePatientCalendarFeed(ctx, patientId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseIssuePatientCalendarFeedResponse(rsp)
+}
+
+// CancelAppointmentWithBodyWithResponse request with arbitrary body returning *CancelAppointmentResponse
+func (c *ClientWithResponses) CancelAppointmentWithBodyWithResponse(ctx context.Context, appointmentId AppointmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CancelAppointmentResponse, error) {
+	rsp, err := c.CancelAppointmentWithBody(ctx, appointmentId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelAppointmentResponse(rsp)
+}
+
+func (c *ClientWithResponses) CancelAppointmentWithResponse(ctx context.Context, appointmentId AppointmentId, body CancelAppointmentJSONRequestBody, reqEditors ...RequestEditorFn) (*CancelAppointmentResponse, error) {
+	rsp, err := c.CancelAppointment(ctx, appointmentId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelAppointmentResponse(rsp)
+}
+
+// AppointmentByIdWithResponse request returning *AppointmentByIdResponse
+func (c *ClientWithResponses) AppointmentByIdWithResponse(ctx context.Context, appointmentId AppointmentId, reqEditors ...RequestEditorFn) (*AppointmentByIdResponse, error) {
+	rsp, err := c.AppointmentById(ctx, appointmentId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAppointmentByIdResponse(rsp)
+}
+
+// RescheduleAppointmentWithBodyWithResponse request with arbitrary body returning *RescheduleAppointmentResponse
+func (c *ClientWithResponses) RescheduleAppointmentWithBodyWithResponse(ctx context.Context, appointmentId AppointmentId, params *RescheduleAppointmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RescheduleAppointmentResponse, error) {
+	rsp, err := c.RescheduleAppointmentWithBody(ctx, appointmentId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRescheduleAppointmentResponse(rsp)
+}
+
+func (c *ClientWithResponses) RescheduleAppointmentWithResponse(ctx context.Context, appointmentId AppointmentId, params *RescheduleAppointmentParams, body RescheduleAppointmentJSONRequestBody, reqEditors ...RequestEditorFn) (*RescheduleAppointmentResponse, error) {
+	rsp, err := c.RescheduleAppointment(ctx, appointmentId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRescheduleAppointmentResponse(rsp)
+}
+
+// DecideAppointmentWithBodyWithResponse request with arbitrary body returning *DecideAppointmentResponse
+func (c *ClientWithResponses) DecideAppointmentWithBodyWithResponse(ctx context.Context, appointmentId AppointmentId, params *DecideAppointmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DecideAppointmentResponse, error) {
+	rsp, err := c.DecideAppointmentWithBody(ctx, appointmentId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDecideAppointmentResponse(rsp)
+}
+
+func (c *ClientWithResponses) DecideAppointmentWithResponse(ctx context.Context, appointmentId AppointmentId, params *DecideAppointmentParams, body DecideAppointmentJSONRequestBody, reqEditors ...RequestEditorFn) (*DecideAppointmentResponse, error) {
+	rsp, err := c.DecideAppointment(ctx, appointmentId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDecideAppointmentResponse(rsp)
+}
+
+// AppointmentIcsWithResponse request returning *AppointmentIcsResponse
+func (c *ClientWithResponses) AppointmentIcsWithResponse(ctx context.Context, appointmentId AppointmentId, reqEditors ...RequestEditorFn) (*AppointmentIcsResponse, error) {
+	rsp, err := c.AppointmentIcs(ctx, appointmentId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAppointmentIcsResponse(rsp)
+}
+
+// UpdateAppointmentResourcesWithBodyWithResponse request with arbitrary body returning *UpdateAppointmentResourcesResponse
+func (c *ClientWithResponses) UpdateAppointmentResourcesWithBodyWithResponse(ctx context.Context, appointmentId AppointmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAppointmentResourcesResponse, error) {
+	rsp, err := c.UpdateAppointmentResourcesWithBody(ctx, appointmentId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAppointmentResourcesResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateAppointmentResourcesWithResponse(ctx context.Context, appointmentId AppointmentId, body UpdateAppointmentResourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAppointmentResourcesResponse, error) {
+	rsp, err := c.UpdateAppointmentResources(ctx, appointmentId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAppointmentResourcesResponse(rsp)
+}
+
+// AuditEventsWithResponse request returning *AuditEventsResponse
+func (c *ClientWithResponses) AuditEventsWithResponse(ctx context.Context, params *AuditEventsParams, reqEditors ...RequestEditorFn) (*AuditEventsResponse, error) {
+	rsp, err := c.AuditEvents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuditEventsResponse(rsp)
+}
+
+// CalendarFeedWithResponse request returning *CalendarFeedResponse
+func (c *ClientWithResponses) CalendarFeedWithResponse(ctx context.Context, token string, reqEditors ...RequestEditorFn) (*CalendarFeedResponse, error) {
+	rsp, err := c.CalendarFeed(ctx, token, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCalendarFeedResponse(rsp)
+}
+
+// DoctorsTimeslotsWithResponse request returning *DoctorsTimeslotsResponse
+func (c *ClientWithResponses) DoctorsTimeslotsWithResponse(ctx context.Context, doctorId DoctorId, params *DoctorsTimeslotsParams, reqEditors ...RequestEditorFn) (*DoctorsTimeslotsResponse, error) {
+	rsp, err := c.DoctorsTimeslots(ctx, doctorId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDoctorsTimeslotsResponse(rsp)
+}
+
+// ParseRequestAppointmentResponse parses an HTTP response from a RequestAppointmentWithResponse call
+func ParseRequestAppointmentResponse(rsp *http.Response) (*RequestAppointmentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RequestAppointmentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Appointment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAppointmentsByConditionIdResponse parses an HTTP response from a AppointmentsByConditionIdWithResponse call
+func ParseAppointmentsByConditionIdResponse(rsp *http.Response) (*AppointmentsByConditionIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AppointmentsByConditionIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Appointments
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDoctorsCalendarResponse parses an HTTP response from a DoctorsCalendarWithResponse call
+func ParseDoctorsCalendarResponse(rsp *http.Response) (*DoctorsCalendarResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DoctorsCalendarResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Appointments
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
 	}
-	return ParseDoctorsCalendarResponse(rsp)
-}
 
-// PatientsCalendarWithResponse request returning *PatientsCalendarResponse
-func (c *ClientWithResponses) PatientsCalendarWithResponse(ctx context.Context, patientId PatientId, params *PatientsCalendarParams, reqEditors ...RequestEditorFn) (*PatientsCalendarResponse, error) {
-	rsp, err := c.PatientsCalendar(ctx, patientId, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePatientsCalendarResponse(rsp)
+	return response, nil
 }
 
-// CancelAppointmentWithBodyWithResponse request with arbitrary body returning *CancelAppointmentResponse
-func (c *ClientWithResponses) CancelAppointmentWithBodyWithResponse(ctx context.Context, appointmentId AppointmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CancelAppointmentResponse, error) {
-	rsp, err := c.CancelAppointmentWithBody(ctx, appointmentId, contentType, body, reqEditors...)
+// ParseRevokeDoctorCalendarFeedResponse parses an HTTP response from a RevokeDoctorCalendarFeedWithResponse call
+func ParseRevokeDoctorCalendarFeedResponse(rsp *http.Response) (*RevokeDoctorCalendarFeedResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCancelAppointmentResponse(rsp)
-}
 
-func (c *ClientWithResponses) CancelAppointmentWithResponse(ctx context.Context, appointmentId AppointmentId, body CancelAppointmentJSONRequestBody, reqEditors ...RequestEditorFn) (*CancelAppointmentResponse, error) {
-	rsp, err := c.CancelAppointment(ctx, appointmentId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &RevokeDoctorCalendarFeedResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCancelAppointmentResponse(rsp)
-}
 
-// AppointmentByIdWithResponse request returning *AppointmentByIdResponse
-func (c *ClientWithResponses) AppointmentByIdWithResponse(ctx context.Context, appointmentId AppointmentId, reqEditors ...RequestEditorFn) (*AppointmentByIdResponse, error) {
-	rsp, err := c.AppointmentById(ctx, appointmentId, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAppointmentByIdResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest externalRef0.NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
 
-// RescheduleAppointmentWithBodyWithResponse request with arbitrary body returning *RescheduleAppointmentResponse
-func (c *ClientWithResponses) RescheduleAppointmentWithBodyWithResponse(ctx context.Context, appointmentId AppointmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RescheduleAppointmentResponse, error) {
-	rsp, err := c.RescheduleAppointmentWithBody(ctx, appointmentId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseRescheduleAppointmentResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) RescheduleAppointmentWithResponse(ctx context.Context, appointmentId AppointmentId, body RescheduleAppointmentJSONRequestBody, reqEditors ...RequestEditorFn) (*RescheduleAppointmentResponse, error) {
-	rsp, err := c.RescheduleAppointment(ctx, appointmentId, body, reqEditors...)
+// ParseIssueDoctorCalendarFeedResponse parses an HTTP response from a IssueDoctorCalendarFeedWithResponse call
+func ParseIssueDoctorCalendarFeedResponse(rsp *http.Response) (*IssueDoctorCalendarFeedResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseRescheduleAppointmentResponse(rsp)
-}
 
-// DecideAppointmentWithBodyWithResponse request with arbitrary body returning *DecideAppointmentResponse
-func (c *ClientWithResponses) DecideAppointmentWithBodyWithResponse(ctx context.Context, appointmentId AppointmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DecideAppointmentResponse, error) {
-	rsp, err := c.DecideAppointmentWithBody(ctx, appointmentId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &IssueDoctorCalendarFeedResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDecideAppointmentResponse(rsp)
-}
 
-func (c *ClientWithResponses) DecideAppointmentWithResponse(ctx context.Context, appointmentId AppointmentId, body DecideAppointmentJSONRequestBody, reqEditors ...RequestEditorFn) (*DecideAppointmentResponse, error) {
-	rsp, err := c.DecideAppointment(ctx, appointmentId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CalendarFeed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest externalRef0.NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
 	}
-	return ParseDecideAppointmentResponse(rsp)
+
+	return response, nil
 }
 
-// UpdateAppointmentResourcesWithBodyWithResponse request with arbitrary body returning *UpdateAppointmentResourcesResponse
-func (c *ClientWithResponses) UpdateAppointmentResourcesWithBodyWithResponse(ctx context.Context, appointmentId AppointmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAppointmentResourcesResponse, error) {
-	rsp, err := c.UpdateAppointmentResourcesWithBody(ctx, appointmentId, contentType, body, reqEditors...)
+// ParseDoctorsBusyIntervalsResponse parses an HTTP response from a DoctorsBusyIntervalsWithResponse call
+func ParseDoctorsBusyIntervalsResponse(rsp *http.Response) (*DoctorsBusyIntervalsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateAppointmentResourcesResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateAppointmentResourcesWithResponse(ctx context.Context, appointmentId AppointmentId, body UpdateAppointmentResourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAppointmentResourcesResponse, error) {
-	rsp, err := c.UpdateAppointmentResources(ctx, appointmentId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DoctorsBusyIntervalsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateAppointmentResourcesResponse(rsp)
-}
 
-// DoctorsTimeslotsWithResponse request returning *DoctorsTimeslotsResponse
-func (c *ClientWithResponses) DoctorsTimeslotsWithResponse(ctx context.Context, doctorId DoctorId, params *DoctorsTimeslotsParams, reqEditors ...RequestEditorFn) (*DoctorsTimeslotsResponse, error) {
-	rsp, err := c.DoctorsTimeslots(ctx, doctorId, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DoctorsBusyIntervals
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
 	}
-	return ParseDoctorsTimeslotsResponse(rsp)
+
+	return response, nil
 }
 
-// ParseRequestAppointmentResponse parses an HTTP response from a RequestAppointmentWithResponse call
-func ParseRequestAppointmentResponse(rsp *http.Response) (*RequestAppointmentResponse, error) {
+// ParsePatientsCalendarResponse parses an HTTP response from a PatientsCalendarWithResponse call
+func ParsePatientsCalendarResponse(rsp *http.Response) (*PatientsCalendarResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &RequestAppointmentResponse{
+	response := &PatientsCalendarResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Appointment
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Appointments
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest externalRef0.InternalServerErrorResponse
@@ -1597,22 +2864,22 @@ func ParseRequestAppointmentResponse(rsp *http.Response) (*RequestAppointmentRes
 	return response, nil
 }
 
-// ParseAppointmentsByConditionIdResponse parses an HTTP response from a AppointmentsByConditionIdWithResponse call
-func ParseAppointmentsByConditionIdResponse(rsp *http.Response) (*AppointmentsByConditionIdResponse, error) {
+// ParseExportPatientAppointmentsResponse parses an HTTP response from a ExportPatientAppointmentsWithResponse call
+func ParseExportPatientAppointmentsResponse(rsp *http.Response) (*ExportPatientAppointmentsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AppointmentsByConditionIdResponse{
+	response := &ExportPatientAppointmentsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Appointments
+		var dest AppointmentRecordsExport
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1630,26 +2897,26 @@ func ParseAppointmentsByConditionIdResponse(rsp *http.Response) (*AppointmentsBy
 	return response, nil
 }
 
-// ParseDoctorsCalendarResponse parses an HTTP response from a DoctorsCalendarWithResponse call
-func ParseDoctorsCalendarResponse(rsp *http.Response) (*DoctorsCalendarResponse, error) {
+// ParseRevokePatientCalendarFeedResponse parses an HTTP response from a RevokePatientCalendarFeedWithResponse call
+func ParseRevokePatientCalendarFeedResponse(rsp *http.Response) (*RevokePatientCalendarFeedResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DoctorsCalendarResponse{
+	response := &RevokePatientCalendarFeedResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Appointments
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest externalRef0.NotFoundResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.ApplicationproblemJSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest externalRef0.InternalServerErrorResponse
@@ -1663,26 +2930,33 @@ func ParseDoctorsCalendarResponse(rsp *http.Response) (*DoctorsCalendarResponse,
 	return response, nil
 }
 
-// ParsePatientsCalendarResponse parses an HTTP response from a PatientsCalendarWithResponse call
-func ParsePatientsCalendarResponse(rsp *http.Response) (*PatientsCalendarResponse, error) {
+// ParseIssuePatientCalendarFeedResponse parses an HTTP response from a IssuePatientCalendarFeedWithResponse call
+func ParseIssuePatientCalendarFeedResponse(rsp *http.Response) (*IssuePatientCalendarFeedResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PatientsCalendarResponse{
+	response := &IssuePatientCalendarFeedResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Appointments
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CalendarFeed
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest externalRef0.NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest externalRef0.InternalServerErrorResponse
@@ -1776,6 +3050,13 @@ func ParseRescheduleAppointmentResponse(rsp *http.Response) (*RescheduleAppointm
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest externalRef0.PreconditionFailedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest externalRef0.InternalServerErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -1809,6 +3090,46 @@ func ParseDecideAppointmentResponse(rsp *http.Response) (*DecideAppointmentRespo
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest externalRef0.PreconditionFailedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAppointmentIcsResponse parses an HTTP response from a AppointmentIcsWithResponse call
+func ParseAppointmentIcsResponse(rsp *http.Response) (*AppointmentIcsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AppointmentIcsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest externalRef0.NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest externalRef0.InternalServerErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -1854,6 +3175,79 @@ func ParseUpdateAppointmentResourcesResponse(rsp *http.Response) (*UpdateAppoint
 	return response, nil
 }
 
+// ParseAuditEventsResponse parses an HTTP response from a AuditEventsWithResponse call
+func ParseAuditEventsResponse(rsp *http.Response) (*AuditEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuditEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest externalRef0.AuditEvents
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest externalRef0.ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCalendarFeedResponse parses an HTTP response from a CalendarFeedWithResponse call
+func ParseCalendarFeedResponse(rsp *http.Response) (*CalendarFeedResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CalendarFeedResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest externalRef0.NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseDoctorsTimeslotsResponse parses an HTTP response from a DoctorsTimeslotsWithResponse call
 func ParseDoctorsTimeslotsResponse(rsp *http.Response) (*DoctorsTimeslotsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
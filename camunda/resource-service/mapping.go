@@ -14,6 +14,30 @@ func resourceToMedicine(r Resource) api.Medicine {
 	return api.Medicine{Id: r.Id, Name: r.Name}
 }
 
+func resourceToApiResource(r Resource) api.NewResource {
+	return api.NewResource{Id: &r.Id, Name: r.Name, Type: api.ResourceType(r.Type)}
+}
+
+func reservationToApiResource(r Reservation) api.NewResource {
+	return api.NewResource{
+		Id:   &r.ResourceId,
+		Name: r.ResourceName,
+		Type: api.ResourceType(r.ResourceType),
+	}
+}
+
+func reservationToReservationRecord(r Reservation) api.ReservationRecord {
+	return api.ReservationRecord{
+		Id:            r.Id,
+		AppointmentId: r.AppointmentId,
+		ResourceId:    r.ResourceId,
+		ResourceName:  r.ResourceName,
+		ResourceType:  api.ResourceType(r.ResourceType),
+		Start:         r.StartTime,
+		End:           r.EndTime,
+	}
+}
+
 func dataResourcesToApiResources(resources struct {
 	Medicines  []Resource
 	Facilities []Resource
@@ -2,6 +2,7 @@ package main
 
 import (
 	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"time"
@@ -22,10 +23,18 @@ type resourceServer struct {
 
 func newResourceServer(
 	db mongoResourcesDb,
+	clients *server.Clients,
 	logger *httplog.Logger,
 	opts commonapi.ChiServerOptions,
-) http.Handler {
-	apptClient, _ := appointmentapi.NewClientWithResponses("http://appointment-service:8080/")
+) (http.Handler, error) {
+	apptUrl, apptHttpClient := clients.Client(server.AppointmentService)
+	apptClient, err := appointmentapi.NewClientWithResponses(
+		apptUrl,
+		appointmentapi.WithHTTPClient(apptHttpClient),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("newResourceServer appointment client: %w", err)
+	}
 	srv := resourceServer{db: db, appointmentApi: apptClient}
 
 	middlewares := make([]api.MiddlewareFunc, len(opts.Middlewares))
@@ -40,7 +49,7 @@ func newResourceServer(
 		ErrorHandlerFunc: opts.ErrorHandlerFunc,
 	}
 
-	return api.HandlerWithOptions(srv, mappedOpts)
+	return api.HandlerWithOptions(srv, mappedOpts), nil
 }
 
 func (s resourceServer) CreateResource(w http.ResponseWriter, r *http.Request) {
@@ -52,7 +61,14 @@ func (s resourceServer) CreateResource(w http.ResponseWriter, r *http.Request) {
 
 	resource, err := s.db.CreateResource(r.Context(), req.Name, ResourceType(req.Type))
 	if err != nil {
-		slog.Error(server.UnexpectedError, "error", err.Error(), "where", "CreateResource")
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"CreateResource",
+		)
 		server.EncodeError(w, server.InternalServerError())
 		return
 	}
@@ -73,7 +89,14 @@ func (s resourceServer) GetAvailableResources(
 ) {
 	resources, err := s.db.FindAvailableResourcesAtTime(r.Context(), params.DateTime)
 	if err != nil {
-		slog.Error(server.UnexpectedError, "error", err.Error(), "where", "GetAvailableResources")
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"GetAvailableResources",
+		)
 		server.EncodeError(w, server.InternalServerError())
 		return
 	}
@@ -106,7 +129,8 @@ func (s resourceServer) ReserveAppointmentResources(
 	if req.EquipmentId != nil {
 		resource, err := s.db.ResourceById(ctx, *req.EquipmentId)
 		if err != nil {
-			slog.Error(
+			slog.ErrorContext(
+				r.Context(),
 				"error finding equipment resource",
 				"error", err.Error(), "where", "ReserveAppointmentResources",
 				"appointmentId", appointmentId.String(), "equipmentId", req.EquipmentId.String(),
@@ -120,7 +144,8 @@ func (s resourceServer) ReserveAppointmentResources(
 			resource.Type, reservationStart, reservationEnd,
 		)
 		if err != nil {
-			slog.Error(
+			slog.ErrorContext(
+				r.Context(),
 				"error creating equipment reservation",
 				"error", err.Error(), "where", "ReserveAppointmentResources",
 				"appointmentId", appointmentId.String(), "equipmentId", req.EquipmentId.String(),
@@ -135,7 +160,8 @@ func (s resourceServer) ReserveAppointmentResources(
 	if req.FacilityId != nil {
 		resource, err := s.db.ResourceById(ctx, *req.FacilityId)
 		if err != nil {
-			slog.Error(
+			slog.ErrorContext(
+				r.Context(),
 				"error finding facility resource",
 				"error", err.Error(), "where", "ReserveAppointmentResources",
 				"appointmentId", appointmentId.String(), "facilityId", req.FacilityId.String(),
@@ -149,7 +175,8 @@ func (s resourceServer) ReserveAppointmentResources(
 			resource.Type, reservationStart, reservationEnd,
 		)
 		if err != nil {
-			slog.Error(
+			slog.ErrorContext(
+				r.Context(),
 				"error creating facility reservation",
 				"error", err.Error(), "where", "ReserveAppointmentResources",
 				"appointmentId", appointmentId.String(), "facilityId", req.FacilityId.String(),
@@ -164,7 +191,8 @@ func (s resourceServer) ReserveAppointmentResources(
 	if req.MedicineId != nil {
 		resource, err := s.db.ResourceById(ctx, *req.MedicineId)
 		if err != nil {
-			slog.Error(
+			slog.ErrorContext(
+				r.Context(),
 				"error finding medicine resource",
 				"error", err.Error(), "where", "ReserveAppointmentResources",
 				"appointmentId", appointmentId.String(), "medicineId", req.MedicineId.String(),
@@ -177,7 +205,8 @@ func (s resourceServer) ReserveAppointmentResources(
 			resource.Type, reservationStart, reservationEnd,
 		)
 		if err != nil {
-			slog.Error(
+			slog.ErrorContext(
+				r.Context(),
 				"error creating medicine reservation",
 				"error", err.Error(), "where", "ReserveAppointmentResources",
 				"appointmentId", appointmentId.String(), "medicineId", req.MedicineId.String(),
@@ -197,7 +226,8 @@ func (s resourceServer) ReserveAppointmentResources(
 			MedicineId:  reservedMedicineId,
 		}
 
-		slog.Info(
+		slog.InfoContext(
+			r.Context(),
 			"Attempting to update appointment resources",
 			"appointmentId", appointmentId.String(),
 			"facilityId", reservedFacilityId,
@@ -213,26 +243,30 @@ func (s resourceServer) ReserveAppointmentResources(
 
 		if apptUpdateErr != nil {
 			// Log failure but don't necessarily fail the resource reservation itself
-			slog.Error(
+			slog.ErrorContext(
+				r.Context(),
 				"failed to call update appointment resources endpoint",
 				"error", apptUpdateErr.Error(), "where", "ReserveAppointmentResources",
 				"appointmentId", appointmentId.String(),
 			)
 		} else if apptUpdateResp.StatusCode() != http.StatusOK {
 			// Log failure but don't necessarily fail the resource reservation itself
-			slog.Error(
+			slog.ErrorContext(
+				r.Context(),
 				"failed to update appointment resources",
 				"status", apptUpdateResp.StatusCode(), "body", string(apptUpdateResp.Body),
 				"where", "ReserveAppointmentResources", "appointmentId", appointmentId.String(),
 			)
 		} else {
-			slog.Info(
+			slog.InfoContext(
+				r.Context(),
 				"Successfully updated appointment resources",
 				"appointmentId", appointmentId.String(),
 			)
 		}
 	} else {
-		slog.Info(
+		slog.InfoContext(
+			r.Context(),
 			"No resources requested for reservation, skipping appointment update call",
 			"appointmentId", appointmentId.String(),
 		)
@@ -242,6 +276,64 @@ func (s resourceServer) ReserveAppointmentResources(
 	w.WriteHeader(http.StatusNoContent)
 }
 
+func (s resourceServer) MoveAppointmentReservations(
+	w http.ResponseWriter,
+	r *http.Request,
+	appointmentId api.AppointmentId,
+) {
+	req, decodeErr := server.Decode[api.MoveAppointmentReservationsJSONBody](w, r)
+	if decodeErr != nil {
+		server.EncodeError(w, decodeErr)
+		return
+	}
+
+	// Reservations last 1 hour from the appointment start, same as when reserving
+	conflicts, err := s.db.MoveReservations(
+		r.Context(),
+		appointmentId,
+		req.Start,
+		req.Start.Add(time.Hour),
+	)
+	if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error", err.Error(), "where", "MoveAppointmentReservations",
+			"appointmentId", appointmentId.String(),
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+
+	res := api.ReservationsMove{Conflicts: server.Map(conflicts, reservationToApiResource)}
+	server.Encode(w, http.StatusOK, res)
+}
+
+// ExportAppointmentsReservations implements api.ServerInterface.
+func (s resourceServer) ExportAppointmentsReservations(w http.ResponseWriter, r *http.Request) {
+	req, decodeErr := server.Decode[api.ExportAppointmentsReservationsJSONBody](w, r)
+	if decodeErr != nil {
+		server.EncodeError(w, decodeErr)
+		return
+	}
+
+	reservations, err := s.db.ReservationsByAppointmentIds(r.Context(), req.AppointmentIds)
+	if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error", err.Error(), "where", "ExportAppointmentsReservations",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+
+	res := api.ReservationRecordsExport{
+		Reservations: server.Map(reservations, reservationToReservationRecord),
+	}
+	server.Encode(w, http.StatusOK, res)
+}
+
 func handlErr(err error) *server.ApiError {
 	if errors.Is(err, ErrNotFound) {
 		apiErr := &server.ApiError{
@@ -280,3 +372,22 @@ func (s resourceServer) GetResourceById(
 
 	server.Encode(w, http.StatusOK, resource)
 }
+
+// GetResourcesBatch implements api.ServerInterface.
+func (s resourceServer) GetResourcesBatch(w http.ResponseWriter, r *http.Request) {
+	req, decodeErr := server.Decode[api.GetResourcesBatchJSONRequestBody](w, r)
+	if decodeErr != nil {
+		server.EncodeError(w, decodeErr)
+		return
+	}
+
+	resources, err := s.db.ResourcesByIds(r.Context(), req.Ids)
+	if err != nil {
+		server.EncodeError(w, handlErr(err))
+		return
+	}
+
+	server.Encode(w, http.StatusOK, api.Resources{
+		Resources: server.Map(resources, resourceToApiResource),
+	})
+}
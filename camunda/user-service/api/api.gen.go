@@ -14,8 +14,12 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	externalRef0 "github.com/Nesquiko/aass/common/server/api"
+	externalRef1 "github.com/Nesquiko/aass/user-service/appointment-api"
+	externalRef2 "github.com/Nesquiko/aass/user-service/medical-api"
+	externalRef3 "github.com/Nesquiko/aass/user-service/resources-api"
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
 	"github.com/oapi-codegen/runtime"
@@ -70,6 +74,14 @@ type DoctorRegistration struct {
 	Specialization SpecializationEnum `json:"specialization"`
 }
 
+// DoctorSearchResult A doctor matching the search, with their earliest free slot in the searched window.
+type DoctorSearchResult struct {
+	Doctor Doctor `json:"doctor"`
+
+	// NextFreeSlot Start of the doctor's earliest free slot, missing if the doctor is fully booked in the window.
+	NextFreeSlot *time.Time `json:"nextFreeSlot,omitempty"`
+}
+
 // Patient defines model for Patient.
 type Patient struct {
 	Email     openapi_types.Email `json:"email"`
@@ -79,6 +91,30 @@ type Patient struct {
 	Role      UserRole            `json:"role"`
 }
 
+// PatientDataExport Complete machine-readable bundle of all data held about a patient,
+// including records which were deleted but are still retained.
+type PatientDataExport struct {
+	Appointments  []externalRef1.AppointmentRecord  `json:"appointments"`
+	Conditions    []externalRef2.ConditionRecord    `json:"conditions"`
+	ExportedAt    time.Time                         `json:"exportedAt"`
+	Patient       PatientRecord                     `json:"patient"`
+	Prescriptions []externalRef2.PrescriptionRecord `json:"prescriptions"`
+	Reservations  []externalRef3.ReservationRecord  `json:"reservations"`
+}
+
+// PatientRecord defines model for PatientRecord.
+type PatientRecord struct {
+	// DeletedAt When the patient's personal data were erased.
+	DeletedAt *time.Time          `json:"deletedAt,omitempty"`
+	Email     openapi_types.Email `json:"email"`
+	FirstName string              `json:"firstName"`
+	Id        openapi_types.UUID  `json:"id"`
+	LastName  string              `json:"lastName"`
+
+	// RetainUntil Until when are the clinical records of an erased patient retained.
+	RetainUntil *time.Time `json:"retainUntil,omitempty"`
+}
+
 // PatientRegistration defines model for PatientRegistration.
 type PatientRegistration struct {
 	Email     openapi_types.Email `json:"email"`
@@ -109,11 +145,31 @@ type DoctorId = openapi_types.UUID
 // PatientId defines model for patientId.
 type PatientId = openapi_types.UUID
 
+// Specialization Medical specialization of a doctor.
+type Specialization = SpecializationEnum
+
+// WindowFrom defines model for windowFrom.
+type WindowFrom = time.Time
+
+// WindowTo defines model for windowTo.
+type WindowTo = time.Time
+
+// DoctorSearchResults defines model for DoctorSearchResults.
+type DoctorSearchResults struct {
+	Doctors []DoctorSearchResult `json:"doctors"`
+}
+
 // Doctors defines model for Doctors.
 type Doctors struct {
 	Doctors []Doctor `json:"doctors"`
 }
 
+// UsersBatch defines model for UsersBatch.
+type UsersBatch struct {
+	Doctors  []Doctor  `json:"doctors"`
+	Patients []Patient `json:"patients"`
+}
+
 // LoginUserJSONBody defines parameters for LoginUser.
 type LoginUserJSONBody struct {
 	// Email User's email address.
@@ -121,12 +177,27 @@ type LoginUserJSONBody struct {
 	Role  UserRole            `json:"role"`
 }
 
+// SearchDoctorsParams defines parameters for SearchDoctors.
+type SearchDoctorsParams struct {
+	// Specialization Only doctors with this specialization are returned.
+	Specialization *Specialization `form:"specialization,omitempty" json:"specialization,omitempty"`
+
+	// From Start of the searched window, defaults to now.
+	From *WindowFrom `form:"from,omitempty" json:"from,omitempty"`
+
+	// To End of the searched window, defaults to 14 days after its start. The window can span at most 31 days.
+	To *WindowTo `form:"to,omitempty" json:"to,omitempty"`
+}
+
 // LoginUserJSONRequestBody defines body for LoginUser for application/json ContentType.
 type LoginUserJSONRequestBody LoginUserJSONBody
 
 // RegisterUserJSONRequestBody defines body for RegisterUser for application/json ContentType.
 type RegisterUserJSONRequestBody = Registration
 
+// GetUsersBatchJSONRequestBody defines body for GetUsersBatch for application/json ContentType.
+type GetUsersBatchJSONRequestBody = externalRef0.BatchIds
+
 // AsPatientRegistration returns the union data inside the Registration as a PatientRegistration
 func (t Registration) AsPatientRegistration() (PatientRegistration, error) {
 	var body PatientRegistration
@@ -316,12 +387,24 @@ type ServerInterface interface {
 	// Get doctors
 	// (GET /doctors)
 	GetDoctors(w http.ResponseWriter, r *http.Request)
+	// Search doctors
+	// (GET /doctors/search)
+	SearchDoctors(w http.ResponseWriter, r *http.Request, params SearchDoctorsParams)
 	// Get doctor by ID
 	// (GET /doctors/{doctorId})
 	GetDoctorById(w http.ResponseWriter, r *http.Request, doctorId DoctorId)
+	// Erase patient
+	// (DELETE /patients/{patientId})
+	ErasePatient(w http.ResponseWriter, r *http.Request, patientId PatientId)
 	// Get patient by ID
 	// (GET /patients/{patientId})
 	GetPatientById(w http.ResponseWriter, r *http.Request, patientId PatientId)
+	// Export patient data
+	// (GET /patients/{patientId}/export)
+	ExportPatientData(w http.ResponseWriter, r *http.Request, patientId PatientId)
+	// Get users by IDs
+	// (POST /users/batch)
+	GetUsersBatch(w http.ResponseWriter, r *http.Request)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
@@ -346,18 +429,42 @@ func (_ Unimplemented) GetDoctors(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Search doctors
+// (GET /doctors/search)
+func (_ Unimplemented) SearchDoctors(w http.ResponseWriter, r *http.Request, params SearchDoctorsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get doctor by ID
 // (GET /doctors/{doctorId})
 func (_ Unimplemented) GetDoctorById(w http.ResponseWriter, r *http.Request, doctorId DoctorId) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Erase patient
+// (DELETE /patients/{patientId})
+func (_ Unimplemented) ErasePatient(w http.ResponseWriter, r *http.Request, patientId PatientId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get patient by ID
 // (GET /patients/{patientId})
 func (_ Unimplemented) GetPatientById(w http.ResponseWriter, r *http.Request, patientId PatientId) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Export patient data
+// (GET /patients/{patientId}/export)
+func (_ Unimplemented) ExportPatientData(w http.ResponseWriter, r *http.Request, patientId PatientId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get users by IDs
+// (POST /users/batch)
+func (_ Unimplemented) GetUsersBatch(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -374,8 +481,8 @@ func (siw *ServerInterfaceWrapper) LoginUser(w http.ResponseWriter, r *http.Requ
 		siw.Handler.LoginUser(w, r)
 	}))
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
 	handler.ServeHTTP(w, r)
@@ -388,8 +495,8 @@ func (siw *ServerInterfaceWrapper) RegisterUser(w http.ResponseWriter, r *http.R
 		siw.Handler.RegisterUser(w, r)
 	}))
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
 	handler.ServeHTTP(w, r)
@@ -402,8 +509,51 @@ func (siw *ServerInterfaceWrapper) GetDoctors(w http.ResponseWriter, r *http.Req
 		siw.Handler.GetDoctors(w, r)
 	}))
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SearchDoctors operation middleware
+func (siw *ServerInterfaceWrapper) SearchDoctors(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchDoctorsParams
+
+	// ------------- Optional query parameter "specialization" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "specialization", r.URL.Query(), &params.Specialization)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "specialization", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SearchDoctors(w, r, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
 	handler.ServeHTTP(w, r)
@@ -427,8 +577,33 @@ func (siw *ServerInterfaceWrapper) GetDoctorById(w http.ResponseWriter, r *http.
 		siw.Handler.GetDoctorById(w, r, doctorId)
 	}))
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ErasePatient operation middleware
+func (siw *ServerInterfaceWrapper) ErasePatient(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "patientId" -------------
+	var patientId PatientId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "patientId", chi.URLParam(r, "patientId"), &patientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "patientId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ErasePatient(w, r, patientId)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
 	handler.ServeHTTP(w, r)
@@ -452,8 +627,47 @@ func (siw *ServerInterfaceWrapper) GetPatientById(w http.ResponseWriter, r *http
 		siw.Handler.GetPatientById(w, r, patientId)
 	}))
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportPatientData operation middleware
+func (siw *ServerInterfaceWrapper) ExportPatientData(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "patientId" -------------
+	var patientId PatientId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "patientId", chi.URLParam(r, "patientId"), &patientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "patientId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportPatientData(w, r, patientId)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUsersBatch operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersBatch(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersBatch(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
 	handler.ServeHTTP(w, r)
@@ -581,12 +795,24 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/doctors", wrapper.GetDoctors)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/doctors/search", wrapper.SearchDoctors)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/doctors/{doctorId}", wrapper.GetDoctorById)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/patients/{patientId}", wrapper.ErasePatient)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/patients/{patientId}", wrapper.GetPatientById)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/patients/{patientId}/export", wrapper.ExportPatientData)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/batch", wrapper.GetUsersBatch)
+	})
 
 	return r
 }
@@ -594,35 +820,65 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9RZbW8TPxL/KpbvpAPdZpNC+4fm1RVaUE7AVSl9harKtSdZl117sb0tocp3P429j3kO",
-	"LQf3bjc79sz8/Jsn54FyneVagXKWDh9ozgzLwIHxb0Jzp81I+Gew3MjcSa3okH5OgBRKfiuASAHKyYkE",
-	"Q55dXo5OnxM9IS4BElbHNKLwnWV5CnRIb17wl+IQjnqTv9ir3uvjwUHvxcvDo95fr14fD9gNFzA5oBGV",
-	"qCNnLqERVSzDlbUtETXwrZAGBB06U0BELU8gY2jkRJuMOTqkRSFR0s1yXGudkWpK5/MIN5Wg3M/6VC5/",
-	"Kqcaax7j1RwX21wrC/7YTj1U/pFr5UA5fGR5nkrO0Nn+rUWPH1o6cqNzME5C6+D9o3SQ+Ye/G5jQIf1b",
-	"v2FMP6y3/aCRzmvbmDFsRoNllVtf6m2vajl9cwvcBR+6p3FRcA7WToo0nREDzki4A0FSaR0eRrlVjDq5",
-	"zjKtri2YOzDXLJfX4ZeezkHh60g5MIqlF17izBhtxiVgG0DKjb5JIftnBVZ94LhCoPWy3DcOqmPAnSl6",
-	"4phM6ZCeKFKor0rfKxJEiBchmvPCICgRtY65wtLh0WAQUSedZ1RlcGcVutoc2Kbj2AqIh+A0WLkC+xO1",
-	"YGdMLgCIzYHLieQk2EwQBTLRhgR/8TBqE1tE9Lim6X8mdPhls93nIRzoPFokpFfNUvmDBRM373PRkT5T",
-	"RbZExYUNlxl5NY9K+8cwldaZWvNevnQWL/tldCDUpp0uLZgxyiG4T42DNyDaDY7qeJbSBWSe7q1MFX5Z",
-	"SlURnUhj3Sef/R6Wv0qxQ76LaMo27LEfpAtweH2NjS1VUe2TV7CMUI3PIl/WYNXUDwX3cWHB/Kv8KeY6",
-	"QzP2A7PZ7986UTSimVQfQE1dQocHW0Bs1p5q2L70URBX3qxBeS26i7AKiSkrk4qVOSZjeY4G1vVrc7Xq",
-	"7Fc3BvuFc3W2swBkMH4eUa3gESlie6XtLsHYXBHqSw3ORxCSY1HpyGI1Ze1mza/9Qm1hpuChmTLrjAas",
-	"STrVqBnxAiGZM5JLhjJCsqnS1lXvoITmRqpmwRQUGJZe54ZxJ1EzYK3kzAjZSAlA0jfvCoqWUq1468W4",
-	"RKMZwR4744m3yL8a1t61s4dLwCDDGtIvGr9EeCTzU3BuZ549Fbd25FPgUB2ymBFKFlTmRpV/Vyvg2avf",
-	"wAoqhCcAS89buTG0vQtdoGNKIEN+gCh7krLZIM/G796S48OjV8+Rtd0kG/qzFcVB1DYsfaoasVb9kd7x",
-	"UhKbvSmEJje0aQ8r5ot2pgtiUbCmVlAbsboLlmqicetUcij703JY+Dj67KmMjWXiXG6H/T4CbHVhOMTa",
-	"TPvlIttH2cZQf7K+l5QcyMn5iEb0DowNGB/Eg3jgmRVOiw7py3gQHwayJh6SPitc0scYCiVNW98GIOY+",
-	"ieA8RT/gZx8qAQew7o0Wsx3mkDIUW7PHh0qVLbKMmRkd0rMgVKYq4o0hpR50iKUFtCosFSZOdGFhoayG",
-	"4lXRuTUTblBZSmzXecsUxEav0VkF03zeaeXXNAjdSEBc/2GJ/0qYEAas7Y6htzpRsVjSvb2NeJJyXtft",
-	"knIezXGN1NZ5z1OUG/CTN0utHyw83vHScLw48L4YDPYadrc5uso+7w+x9VSKI5GzxILFMCJc668SCFMC",
-	"h9XCKEuwpWuNRhE9HBzsMW7+b0a9S4WRrY1PsD0yUncslaKkmTYEj5Vwnd1gzUNHn3m3lHZkogslnnvP",
-	"jsIBrDK0Pqj+o8Z0HzF1XHqyhHiNqGNTi0w8KVxCr1AwZCvjm6RQuFcnrHEp8VM5a7fz6XZqy/iH+lsX",
-	"NSS9aS3ZhfsHv5z7Hu4KThCtKEhnMRmXfHcJYAAzByJwPwR7Sf3jP476b7WapJI70iMnweB76ZJwzWf0",
-	"nRRQxQFLDTAxI/BdWmd/C+MrqhJGFNx7c1dTv3V1N4UVnH8PrrofXJ1FN7tUrf0NELwHV137tVyvDOp4",
-	"33+orovnLSC6xz8u7xQDcasA9MNQfdFVtho3M5SRZvl6GONzDb5vZv5at32jvqZnb0T69S33/OoX1riq",
-	"6d/51lV0slQZ0Yd/XER/0o68w6pEeuRzUl9YNg6MTonQYH358sEc/14iI7NGp2vZXPaLtv9Q/1HwOD5X",
-	"feyehC6HyZ9idPMXxy+l9Hm7td6J0xUW/7+krjz4c1jd4leH1uXpeF7jIr9ZoE/X2TMlch3G7jDo9j1t",
-	"yn3qUdjXu3lUvzdVqf6pVjm/mv83AAD//3FtiYDmHAAA",
+	"H4sIAAAAAAAC/+w8e2/bOPJfhdDvB2yLkx9pk3abvy5t2sKH7W6RpLi92xQBLY5tthKpklRSb+DvfhhS",
+	"D8qSbDlx2yzu/ottkvN+cGaY2yCSSSoFCKOD49sgpYomYEDZT0xGRqoJs3+DjhRPDZciOA4uFkAywb9k",
+	"QDgDYfiMgyKPPnyYnD4mckbMAojbPQzCAL7SJI0hOA6mT6Kn7BCOBrNn9Png5xfjg8GTp4dHg2fPf34x",
+	"ptOIwewgCAOOMFJqFkEYCJrgzhKXMFDwJeMKWHBsVAZhoKMFJBSRnEmVUBMcB1nGcaVZprhXG8XFPFit",
+	"QjyUgzB3pSnfvi+iKmzuR5VOIeI05n9SR8s6ab+JeJnLQ5MbbhbELLgm9W2EKiAKTKYEsGGB8JcM1LLC",
+	"eA2Sj+b/K5gFx8H/jSqVGrlf9ei8tu21yBKL9w0XTN68UTJp4nxuqDIF3zVQFS2AEbcjJAxmNIuNJkYS",
+	"IW+60J3h0a28ZNTAwPAEWhnqwFzIJlqvBeuF1MEhYXSpCZ0ZUIQbTTQSNCSoZm4DiaggOqWCUEMSqQ15",
+	"emA3dVFj5O60rFCzdCqFBmvTp1YLzi3qZ6ARXfw6ksKAMPgnTdOYR1ZQo0/aqVMFNFUyBWU4eB7C/skN",
+	"JHqbHjShB6sSZ6oUXQarlW8Lf5QgPpbr5PQTRMbRVhfOaa7iiorPwMh0iXLiigBVMQdtyEwBEB1LE5JZ",
+	"FsdLMpUSVxa2gSYQU22GiNZpRdx3Zc9eWXKeRRFo7ahVYBSHa2Ak5toaV36UpfeDBqVfUhMtHgDJpavu",
+	"f9R7t2Er+8qDw31wciYzwYrAoAkVrMbUSCaJFFca1DWoK5ryK/fNQKYg8ONEGFCCxud2xWulpDrLDXaD",
+	"GFIlpzEkfyvEUUYj3MGQEJ6fO3Sgh4AnI8lgKI+D4+BEkEx8FvJGELeE2CVERlGmkFVhoA01mQ6Oj8bj",
+	"MDDcIICgQLi2C0ntFwq2MsSy4NRh2SKGE7GG55Cco1FjhJnxiDicCXKBzKQijl4URomi5wjxLxrHv82C",
+	"4z/6ate6yjej7x1Coa+gawc2lfNj6Z3OYM61USXknWipbW7SpaRTqE0noc84w3WtWcg9+WARCHdhRy2y",
+	"NGL3SW6ZJEEnx8XcC+NhkRe1xwvCRVvMx0jd5v/6ez0BX80bBXAeS7MlCXJH/6Rbw1nCtUaKuL+WcF2P",
+	"czkVFfI986Fm9GkRQxgUNtKICpBYn+PlLO6bBqwwmHGlza824blt/spZj4w4DGK64Yzd9HqNeguvwtED",
+	"FZY0WQAb+HNKDX39NZWqReKvJPpxAyShqKIwUEAZncZApplgMaAy0DgmjBpKFhAzQqcyM4QWISi8FFxE",
+	"ccZQGxREUjFNbhY8WpAbUEAY4PGMTHGTAqINj2OMZ5Rj3n8pGipN01RyYZKdgrG3aYBe3T8E1DWPoHT4",
+	"J9VPZxbftmwgkoJx5FF/FBJgPKKxBZ//vQ76VXFqN2CwggJ2Yvrm3GXq0jNhqWCnqtKFvdL53ju4m1QF",
+	"uI/uBl2BlpmKQFv45ac1BM6qo7vgrxmax/eKoTU9WOdXWNfUNXo22GOOUTOXdaZy0mKm/1yA8CsCP2mS",
+	"gtJS0Nw0ra2BotrdpfvpzcNyk9YlfBCGx0367dfkBrmATgQ5EcVcoO6VTgc9lch5UPCpcjR3Cz7b3e9G",
+	"OdeTpY4YVVV2BNwMMw3q7/lXw0gmPt79pFOd9w+5EEEYJFz8AmJuFsHxwRapVHtPJWzfeq/QVlDTwd7O",
+	"qLbOVsZRUxIuaJ4JJTRNEUEvPdqUFtXO85zpLrlsIdulY6RDfhUGUsA98uPtKV19CyamLXluw5reOa+9",
+	"XpBDA/LLqHbvH4HO1Bwsa+ZUGyVBGFAylggZ+QWMU6N4xCmuYZzOhdSm+AyCyUhxUW2YgwBF46tU0chY",
+	"3woqCIOIKsarVQxQ6avPAjIPqBSR90GZhUQ0HD56GS0sRvajov6ptTPMAmxeWSn9OvINhUdl3ofO9daz",
+	"felWT31yOlSa7PFtqQVVSGwk5BV77pWFNTykt/uUGrjgzkf1i20RFRHEcZ4C0Lxu0bUM2MvlHlNM/5pa",
+	"ZhCTfkGSgeA03oCy3ybZehoIthvf0EGnSZ5N7jv1fl0e3pIOzmjEY14If9+g37jTl22Qe6YvNtnlAr4F",
+	"eu+Ks7srkz0lrro1p6ivfYur1Lk7u0T/W8C4WKbNNMJywe9sea27Ng9S2USOasmXtmTjXsxo7fnZKqIw",
+	"ReEwL7Z45/qxFwkFbWx1tHRVeVeGZe7vKL/CW9pBcGD1oOav3ZPHvshF3KQOAWylaZ7FVF1FC4g+28h+",
+	"c1VFl5mMY3lzlaUoPiEyTBQWS435iqVVYK2tyNWuaRRxUXzCPEWYq4gqcEWFCFim/JKeNlfXXHNz3+D1",
+	"2neSdSacQapAu9o8KfSUlF6VFDfWZimPs7Z7z3qDGGvMyNzyxHpzGL6kg/H4yWD2HJ4N2FF0OJg+pU+C",
+	"cLvfEPkVoI4A5hyFPDtAfoiNotq2Jd65IhL5/e3gqN/dykK9p+GVrr2nMPJAs9ybLIoD63yZ0WgwHh8M",
+	"6JPp00F0yI4G8Gz2fD+iaIf47mxCzjNugLz8ftx/50XFPtwvoujeuF8cWOdFAmwwHj8dvKA/TwfPo2ds",
+	"cASHs/1wvx3iiaCgzQIMj8jv//r395PAndP1rb0x252dsJZINinlYEMYfkDpkRkY26+ghkgRwZB8yHt+",
+	"3NtAFVwK/ZmnadUjKKYG2irCnNVTwu2ZGv06cYsPxuPy9/bCHx7eJoCdGod4W2Euzafxew95N1zT6LII",
+	"hrfdPzEyKFV1DcmjszevyIvDo+ePm1bhGq1tF4ISB9NMBm3+0DZ/NCkHS/JFIaGaoLmSKY0+F3L5fXDm",
+	"fh5MGFkAZWCLAxtyy1I6XJhqJRcG5uA6766ze7vFPtyy0NFdAijJbZPZHUrwzbqcYP1vSz1vDqKr6Llb",
+	"am9HenYYK9qYJgtXZnNn3pGZLXX+Tbf43jdgrw7eTw75CMSv0rTz+b9SqI7sNtHetYmyB+F+C0kUBEx2",
+	"W76hFeEWXPS4yfZmZXXiPoReZ3yNBWsErpGzR+Wo8ahIPMoiSVnQWQZ+Velj68wgFzM7/BjzCPJJJGde",
+	"wbvJhb3axcFxsDAm1cejEeKQ545SzUf5Jj3CtVV8sWVMkuNNTt5P8MIISrvwdzAcD8e4OicoOA6eDsfD",
+	"Q2dQC6vdI5qZxQgLxq5/I7WVGRoALSp6wS/4M4IKynD7UrJlj5m2PHX05jh+KUDpLEmoWuIAqFtUTFhY",
+	"ZIqIbW/AcQZeOylgariQmYa1HpLr1ORg/NHkDSDzFdthfqIChkp2wEyLCvSqNrTV0Q1by/o12AkU/JVQ",
+	"xhRoXc+6P8mFGLIG7O09s730rsomVa5ylptnJae2DvlZFY0U2OSYxtpeaiy/h40Z7fXR2ifj8U6Dk9sI",
+	"bcPP0kN0OYqIw29GEw0azYhEUn7mYIcQ3SS3JhlSVA3BhcHh+GCHwcLvM9T3QaBlS2Uz8AGZiGsac5ar",
+	"mVQExUoimUzz4hJ5ZMkS0rj5y8eWsqPxuAvRUlCjew1kWosp7dIqi7PXMDB0rlETTzKzCD7iQuetlO0I",
+	"gup2WGf5ijv5rH7yqbcluwany1sPKr3ytvTR/YNvrvuW3QU7gXlWEC+H5CzXdzuKoIAaYE73nbHnqv/i",
+	"wan+KylmMY8MGZATh3AxiEhSJa85g8IOaKyAsiWBr1wb/UM0vlBVQomAG4tuu+p7Y+BzaNH5t2CK8fp2",
+	"L7qZpGLvD2DBWzDFgLdHeoFQjfqRmxb1mLBWd7A/gy7OwwcL629y0JNT8dnqddLxouFSLGSm4qWbVEX1",
+	"qc15hug50wwNAt/D+G0A7UYI3bGXwvulyNNc+acuPId3JT//1VhH97taMqpT2NkH93Z4z4R6r76Qwerj",
+	"3VWr/kDGuo7xg3MdLykjeW5DBv7DIo4ZWmqWGDqNlCSWYv5D/IVjY297uS1ahatOmznLH144R18ELDsp",
+	"Uz4BcKdUxtJ41TcMwi5/9HI5YTurdIF2p8rtJS4WEyG9n6awWlTPI+Dhg1PjX6Uhb2y7yilxLseKgMkp",
+	"YRK0Tfds8Bv+WMePmjU57dTm4i3Q6LasBK2qcdKWJ4WKalifH117cEouqg84a6rkjMeAhk6FFMsEE+fw",
+	"Utws8Fun9Y15zEfV7GxIaqOzNsz4YeHxpchfg9phzbK1E8PcHojKg+EpBcUla4sRlqj3ZdNjN3sq+dZm",
+	"UIdNDr7vM4T7l9P+4rr/MNTfCpRUbaxC93Peo/KHd3fZBa07+uwc+J2c9kYl25/Xfu9XW3q57YIXf12/",
+	"/bA0Fx23p181z+1pb5frHkH5VKdVvd1LHt36Ksdz4ZiFxzFEVRoex0WSrcNLQbEpr7mYx51vf0JSvewp",
+	"nvHUXvhciq4XPWvO2aLsvUZ64MbjPZjaZkbFs5HKiqih/zOh+zp/y9UaT7uNKNOoIdPiIXlR9OoKCm1P",
+	"p6sayJxfw9qwQj7JcClaRxkEW3ty4l7159MNbdbwFoz39P3bFOD6T3asVqvtxbYe0vdI+mtcX6UkCRXL",
+	"mjRtAlfOXP6w6GE12sUO3ab3oX8DwN32VOdIG/8+xGbZZQttZB1ofmLZZLOVtFVYfq7qXeVXJfDVx9V/",
+	"BgDot+inx0cAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -668,6 +924,24 @@ func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
 		}
 		res[rawPath] = rawFunc
 	}
+	for rawPath, rawFunc := range externalRef1.PathToRawSpec(path.Join(path.Dir(pathToFile), "../appointment-api/appointmentservice-openapi.yaml")) {
+		if _, ok := res[rawPath]; ok {
+			// it is not possible to compare functions in golang, so always overwrite the old value
+		}
+		res[rawPath] = rawFunc
+	}
+	for rawPath, rawFunc := range externalRef2.PathToRawSpec(path.Join(path.Dir(pathToFile), "../medical-api/medicalservice-openapi.yaml")) {
+		if _, ok := res[rawPath]; ok {
+			// it is not possible to compare functions in golang, so always overwrite the old value
+		}
+		res[rawPath] = rawFunc
+	}
+	for rawPath, rawFunc := range externalRef3.PathToRawSpec(path.Join(path.Dir(pathToFile), "../resources-api/resourceservice-openapi.yaml")) {
+		if _, ok := res[rawPath]; ok {
+			// it is not possible to compare functions in golang, so always overwrite the old value
+		}
+		res[rawPath] = rawFunc
+	}
 	return res
 }
 
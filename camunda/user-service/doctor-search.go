@@ -0,0 +1,185 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Nesquiko/aass/common/server"
+	commonapi "github.com/Nesquiko/aass/common/server/api"
+	"github.com/Nesquiko/aass/user-service/api"
+	appointmentapi "github.com/Nesquiko/aass/user-service/appointment-api"
+)
+
+const (
+	// firstSlotHour and lastSlotHour bound the hourly slots in which doctors
+	// take appointments, same as the appointment service's timeslots.
+	firstSlotHour = 8
+	lastSlotHour  = 13
+	slotDuration  = time.Hour
+
+	searchWindowDefault = 14 * 24 * time.Hour
+	searchWindowMax     = 31 * 24 * time.Hour
+)
+
+// SearchDoctors implements api.ServerInterface.
+func (u userServer) SearchDoctors(
+	w http.ResponseWriter,
+	r *http.Request,
+	params api.SearchDoctorsParams,
+) {
+	ctx := r.Context()
+	now := time.Now()
+	from := now
+	if params.From != nil {
+		from = *params.From
+	}
+	to := from.Add(searchWindowDefault)
+	if params.To != nil {
+		to = *params.To
+	}
+
+	if !to.After(from) {
+		server.EncodeError(w, searchValidationError(
+			"Empty search window",
+			"The end of the search window must be after its start",
+		))
+		return
+	}
+	if to.Sub(from) > searchWindowMax {
+		server.EncodeError(w, searchValidationError(
+			"Search window too long",
+			fmt.Sprintf("The search window can span at most %d days", searchWindowMax/(24*time.Hour)),
+		))
+		return
+	}
+
+	var specialization *string
+	if params.Specialization != nil {
+		specialization = server.AsPtr(string(*params.Specialization))
+	}
+	doctors, err := u.db.DoctorsBySpecialization(ctx, specialization)
+	if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"SearchDoctors",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+	if len(doctors) == 0 {
+		server.Encode(w, http.StatusOK, api.DoctorSearchResults{Doctors: []api.DoctorSearchResult{}})
+		return
+	}
+
+	busyResp, err := u.apptApi.DoctorsBusyIntervalsWithResponse(
+		ctx,
+		&appointmentapi.DoctorsBusyIntervalsParams{
+			DoctorIds: server.Map(doctors, func(d Doctor) uuid.UUID { return d.Id }),
+			From:      from,
+			To:        to,
+		},
+	)
+	if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"SearchDoctors busy intervals",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+	if busyResp.JSON200 == nil {
+		slog.ErrorContext(
+			r.Context(),
+			"failed to get doctors' busy intervals",
+			"status",
+			busyResp.StatusCode(),
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+
+	busy := make(map[uuid.UUID][]appointmentapi.Interval, len(busyResp.JSON200.Doctors))
+	for _, doctor := range busyResp.JSON200.Doctors {
+		busy[doctor.DoctorId] = doctor.Busy
+	}
+
+	if from.Before(now) {
+		from = now
+	}
+	results := make([]api.DoctorSearchResult, len(doctors))
+	for i, doctor := range doctors {
+		results[i] = api.DoctorSearchResult{
+			Doctor:       dataDoctorToApiDoctor(doctor),
+			NextFreeSlot: nextFreeSlot(busy[doctor.Id], from, to),
+		}
+	}
+
+	slices.SortStableFunc(results, func(x, y api.DoctorSearchResult) int {
+		switch {
+		case x.NextFreeSlot == nil && y.NextFreeSlot == nil:
+			return 0
+		case x.NextFreeSlot == nil:
+			return 1
+		case y.NextFreeSlot == nil:
+			return -1
+		}
+		return x.NextFreeSlot.Compare(*y.NextFreeSlot)
+	})
+
+	server.Encode(w, http.StatusOK, api.DoctorSearchResults{Doctors: results})
+}
+
+// nextFreeSlot returns the start of the first hourly slot, which starts at or
+// after from, ends by to and doesn't overlap any busy interval.
+func nextFreeSlot(busy []appointmentapi.Interval, from time.Time, to time.Time) *time.Time {
+	from = from.In(time.Local)
+	day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.Local)
+	for ; day.Before(to); day = day.AddDate(0, 0, 1) {
+		for hour := firstSlotHour; hour <= lastSlotHour; hour++ {
+			start := time.Date(day.Year(), day.Month(), day.Day(), hour, 0, 0, 0, time.Local)
+			end := start.Add(slotDuration)
+			if start.Before(from) {
+				continue
+			}
+			if end.After(to) {
+				return nil
+			}
+
+			free := true
+			for _, interval := range busy {
+				if interval.Start.Before(end) && interval.End.After(start) {
+					free = false
+					break
+				}
+			}
+			if free {
+				return &start
+			}
+		}
+	}
+	return nil
+}
+
+func searchValidationError(title, detail string) *server.ApiError {
+	return &server.ApiError{
+		ErrorDetail: commonapi.ErrorDetail{
+			Code:   "invalid.search",
+			Title:  title,
+			Detail: detail,
+			Status: http.StatusBadRequest,
+		},
+	}
+}
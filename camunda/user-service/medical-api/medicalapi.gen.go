@@ -0,0 +1,2603 @@
+// Package medicalapi provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
+package medicalapi
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	externalRef0 "github.com/Nesquiko/aass/common/server/api"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Defines values for AppointmentStatus.
+const (
+	Cancelled AppointmentStatus = "cancelled"
+	Completed AppointmentStatus = "completed"
+	Denied    AppointmentStatus = "denied"
+	Requested AppointmentStatus = "requested"
+	Scheduled AppointmentStatus = "scheduled"
+)
+
+// Defines values for AppointmentType.
+const (
+	AnnualPhysical  AppointmentType = "annual_physical"
+	Consultation    AppointmentType = "consultation"
+	FollowUp        AppointmentType = "follow_up"
+	NewPatient      AppointmentType = "new_patient"
+	Procedure       AppointmentType = "procedure"
+	RegularCheck    AppointmentType = "regular_check"
+	SpecialistVisit AppointmentType = "specialist_visit"
+	UrgentCare      AppointmentType = "urgent_care"
+	Vaccination     AppointmentType = "vaccination"
+)
+
+// AppointmentDisplay Represents an appointment view.
+type AppointmentDisplay struct {
+	// AppointmentDateTime The date time of the appointment.
+	AppointmentDateTime time.Time `json:"appointmentDateTime"`
+	DoctorName          string    `json:"doctorName"`
+
+	// Id Unique identifier for the appointment.
+	Id          openapi_types.UUID `json:"id"`
+	PatientName string             `json:"patientName"`
+
+	// Status The current status of the appointment.
+	Status AppointmentStatus `json:"status"`
+
+	// Type The type of the appointment.
+	Type AppointmentType `json:"type"`
+}
+
+// AppointmentStatus The current status of the appointment.
+type AppointmentStatus string
+
+// AppointmentType The type of the appointment.
+type AppointmentType string
+
+// Condition defines model for Condition.
+type Condition struct {
+	Appointments    []AppointmentDisplay  `json:"appointments"`
+	AppointmentsIds *[]openapi_types.UUID `json:"appointmentsIds,omitempty"`
+	End             *time.Time            `json:"end,omitempty"`
+	Id              *openapi_types.UUID   `json:"id,omitempty"`
+	Name            string                `json:"name"`
+	Start           time.Time             `json:"start"`
+
+	// Version Version of the condition, sent back as its ETag.
+	Version int64 `json:"version"`
+}
+
+// ConditionDisplay Basic info about a patient's condition.
+type ConditionDisplay struct {
+	AppointmentsIds *[]openapi_types.UUID `json:"appointmentsIds,omitempty"`
+	End             *time.Time            `json:"end,omitempty"`
+	Id              *openapi_types.UUID   `json:"id,omitempty"`
+	Name            string                `json:"name"`
+	Start           time.Time             `json:"start"`
+}
+
+// ConditionRecord defines model for ConditionRecord.
+type ConditionRecord struct {
+	End       *time.Time         `json:"end,omitempty"`
+	Id        openapi_types.UUID `json:"id"`
+	Name      string             `json:"name"`
+	PatientId openapi_types.UUID `json:"patientId"`
+	Start     time.Time          `json:"start"`
+}
+
+// MedicalRecordsExport defines model for MedicalRecordsExport.
+type MedicalRecordsExport struct {
+	Conditions    []ConditionRecord    `json:"conditions"`
+	Prescriptions []PrescriptionRecord `json:"prescriptions"`
+}
+
+// NewCondition defines model for NewCondition.
+type NewCondition struct {
+	AppointmentsIds *[]openapi_types.UUID `json:"appointmentsIds,omitempty"`
+	End             *time.Time            `json:"end,omitempty"`
+	Id              *openapi_types.UUID   `json:"id,omitempty"`
+	Name            string                `json:"name"`
+	PatientId       openapi_types.UUID    `json:"patientId"`
+	Start           time.Time             `json:"start"`
+}
+
+// NewPrescription defines model for NewPrescription.
+type NewPrescription struct {
+	AppointmentId *openapi_types.UUID `json:"appointmentId,omitempty"`
+	DoctorsNote   *string             `json:"doctorsNote,omitempty"`
+	End           time.Time           `json:"end"`
+	Name          string              `json:"name"`
+	PatientId     openapi_types.UUID  `json:"patientId"`
+	Start         time.Time           `json:"start"`
+}
+
+// Prescription defines model for Prescription.
+type Prescription struct {
+	// Appointment Represents an appointment view.
+	Appointment   *AppointmentDisplay `json:"appointment,omitempty"`
+	AppointmentId *openapi_types.UUID `json:"appointmentId,omitempty"`
+	DoctorsNote   *string             `json:"doctorsNote,omitempty"`
+	End           time.Time           `json:"end"`
+	Id            *openapi_types.UUID `json:"id,omitempty"`
+	Name          string              `json:"name"`
+	Start         time.Time           `json:"start"`
+
+	// Version Version of the prescription, sent back as its ETag.
+	Version int64 `json:"version"`
+}
+
+// PrescriptionDisplay Basic info about a patient's condition.
+type PrescriptionDisplay struct {
+	AppointmentId *openapi_types.UUID `json:"appointmentId,omitempty"`
+	End           time.Time           `json:"end"`
+	Id            *openapi_types.UUID `json:"id,omitempty"`
+	Name          string              `json:"name"`
+	Start         time.Time           `json:"start"`
+}
+
+// PrescriptionRecord defines model for PrescriptionRecord.
+type PrescriptionRecord struct {
+	AppointmentId *openapi_types.UUID `json:"appointmentId,omitempty"`
+	DeletedAt     *time.Time          `json:"deletedAt,omitempty"`
+	DoctorsNote   *string             `json:"doctorsNote,omitempty"`
+	End           time.Time           `json:"end"`
+	Id            openapi_types.UUID  `json:"id"`
+	Name          string              `json:"name"`
+	PatientId     openapi_types.UUID  `json:"patientId"`
+	Start         time.Time           `json:"start"`
+}
+
+// UpdateCondition defines model for UpdateCondition.
+type UpdateCondition struct {
+	End       *time.Time          `json:"end"`
+	Name      *string             `json:"name,omitempty"`
+	PatientId *openapi_types.UUID `json:"patientId,omitempty"`
+	Start     *time.Time          `json:"start,omitempty"`
+}
+
+// UpdatePrescription defines model for UpdatePrescription.
+type UpdatePrescription struct {
+	AppointmentId *openapi_types.UUID `json:"appointmentId,omitempty"`
+	DoctorsNote   *string             `json:"doctorsNote,omitempty"`
+	End           *time.Time          `json:"end,omitempty"`
+	Name          *string             `json:"name,omitempty"`
+	PatientId     *openapi_types.UUID `json:"patientId,omitempty"`
+	Start         *time.Time          `json:"start,omitempty"`
+}
+
+// AppointmentId defines model for appointmentId.
+type AppointmentId = openapi_types.UUID
+
+// ConditionId defines model for conditionId.
+type ConditionId = openapi_types.UUID
+
+// From defines model for from.
+type From = openapi_types.Date
+
+// PatientId defines model for patientId.
+type PatientId = openapi_types.UUID
+
+// PrescriptionId defines model for prescriptionId.
+type PrescriptionId = openapi_types.UUID
+
+// To defines model for to.
+type To = openapi_types.Date
+
+// Conditions defines model for Conditions.
+type Conditions struct {
+	Conditions []ConditionDisplay `json:"conditions"`
+}
+
+// Prescriptions defines model for Prescriptions.
+type Prescriptions struct {
+	Prescriptions []PrescriptionDisplay `json:"prescriptions"`
+}
+
+// AuditEventsParams defines parameters for AuditEvents.
+type AuditEventsParams struct {
+	// TargetId Only events performed on the entity with this ID.
+	TargetId *externalRef0.AuditTargetId `form:"targetId,omitempty" json:"targetId,omitempty"`
+
+	// Actor Only events performed by this actor.
+	Actor *externalRef0.AuditActor `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// From Only events created at or after this instant.
+	From *externalRef0.AuditFrom `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only events created at or before this instant.
+	To *externalRef0.AuditTo `form:"to,omitempty" json:"to,omitempty"`
+
+	// AfterSeq Only events after this position in the audit chain.
+	AfterSeq *externalRef0.AuditAfterSeq `form:"afterSeq,omitempty" json:"afterSeq,omitempty"`
+
+	// Limit Maximum number of returned events, 100 if not set.
+	Limit *externalRef0.AuditLimit `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ConditionsInDateRangeParams defines parameters for ConditionsInDateRange.
+type ConditionsInDateRangeParams struct {
+	// From The specific day form which to retrieve resources.
+	From From `form:"from" json:"from"`
+
+	// To The specific day to which to retrieve resources.
+	To *To `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// UpdateConditionParams defines parameters for UpdateCondition.
+type UpdateConditionParams struct {
+	// IfMatch ETags of the resource the update is based on, separated by commas. The
+	// update is applied only if the resource is at one of them, `*` or no
+	// header at all applies it at any version. ETags are compared strongly, a
+	// weak ETag never matches.
+	IfMatch *externalRef0.IfMatch `json:"If-Match,omitempty"`
+}
+
+// PrescriptionsInDateRangeParams defines parameters for PrescriptionsInDateRange.
+type PrescriptionsInDateRangeParams struct {
+	// From The specific day form which to retrieve resources.
+	From From `form:"from" json:"from"`
+
+	// To The specific day to which to retrieve resources.
+	To *To `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// UpdatePrescriptionParams defines parameters for UpdatePrescription.
+type UpdatePrescriptionParams struct {
+	// IfMatch ETags of the resource the update is based on, separated by commas. The
+	// update is applied only if the resource is at one of them, `*` or no
+	// header at all applies it at any version. ETags are compared strongly, a
+	// weak ETag never matches.
+	IfMatch *externalRef0.IfMatch `json:"If-Match,omitempty"`
+}
+
+// CreatePatientConditionJSONRequestBody defines body for CreatePatientCondition for application/json ContentType.
+type CreatePatientConditionJSONRequestBody = NewCondition
+
+// GetConditionsBatchJSONRequestBody defines body for GetConditionsBatch for application/json ContentType.
+type GetConditionsBatchJSONRequestBody = externalRef0.BatchIds
+
+// UpdateConditionJSONRequestBody defines body for UpdateCondition for application/json ContentType.
+type UpdateConditionJSONRequestBody = UpdateCondition
+
+// CreatePrescriptionJSONRequestBody defines body for CreatePrescription for application/json ContentType.
+type CreatePrescriptionJSONRequestBody = NewPrescription
+
+// GetPrescriptionsByAppointmentIdsJSONRequestBody defines body for GetPrescriptionsByAppointmentIds for application/json ContentType.
+type GetPrescriptionsByAppointmentIdsJSONRequestBody = externalRef0.BatchIds
+
+// UpdatePrescriptionJSONRequestBody defines body for UpdatePrescription for application/json ContentType.
+type UpdatePrescriptionJSONRequestBody = UpdatePrescription
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// AuditEvents request
+	AuditEvents(ctx context.Context, params *AuditEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreatePatientConditionWithBody request with any body
+	CreatePatientConditionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreatePatientCondition(ctx context.Context, body CreatePatientConditionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetConditionsBatchWithBody request with any body
+	GetConditionsBatchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	GetConditionsBatch(ctx context.Context, body GetConditionsBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ConditionsInDateRange request
+	ConditionsInDateRange(ctx context.Context, patientId PatientId, params *ConditionsInDateRangeParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportPatientMedicalRecords request
+	ExportPatientMedicalRecords(ctx context.Context, patientId PatientId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ConditionDetail request
+	ConditionDetail(ctx context.Context, conditionId ConditionId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateConditionWithBody request with any body
+	UpdateConditionWithBody(ctx context.Context, conditionId ConditionId, params *UpdateConditionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateCondition(ctx context.Context, conditionId ConditionId, params *UpdateConditionParams, body UpdateConditionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreatePrescriptionWithBody request with any body
+	CreatePrescriptionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreatePrescription(ctx context.Context, body CreatePrescriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPrescriptionsByAppointmentIdsWithBody request with any body
+	GetPrescriptionsByAppointmentIdsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	GetPrescriptionsByAppointmentIds(ctx context.Context, body GetPrescriptionsByAppointmentIdsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPrescriptionsByAppointmentId request
+	GetPrescriptionsByAppointmentId(ctx context.Context, appointmentId AppointmentId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PrescriptionsInDateRange request
+	PrescriptionsInDateRange(ctx context.Context, patientId PatientId, params *PrescriptionsInDateRangeParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeletePrescription request
+	DeletePrescription(ctx context.Context, prescriptionId PrescriptionId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PrescriptionDetail request
+	PrescriptionDetail(ctx context.Context, prescriptionId PrescriptionId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdatePrescriptionWithBody request with any body
+	UpdatePrescriptionWithBody(ctx context.Context, prescriptionId PrescriptionId, params *UpdatePrescriptionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdatePrescription(ctx context.Context, prescriptionId PrescriptionId, params *UpdatePrescriptionParams, body UpdatePrescriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) AuditEvents(ctx context.Context, params *AuditEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuditEventsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePatientConditionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePatientConditionRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePatientCondition(ctx context.Context, body CreatePatientConditionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePatientConditionRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetConditionsBatchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetConditionsBatchRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetConditionsBatch(ctx context.Context, body GetConditionsBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetConditionsBatchRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ConditionsInDateRange(ctx context.Context, patientId PatientId, params *ConditionsInDateRangeParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConditionsInDateRangeRequest(c.Server, patientId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportPatientMedicalRecords(ctx context.Context, patientId PatientId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportPatientMedicalRecordsRequest(c.Server, patientId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ConditionDetail(ctx context.Context, conditionId ConditionId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConditionDetailRequest(c.Server, conditionId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateConditionWithBody(ctx context.Context, conditionId ConditionId, params *UpdateConditionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateConditionRequestWithBody(c.Server, conditionId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateCondition(ctx context.Context, conditionId ConditionId, params *UpdateConditionParams, body UpdateConditionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateConditionRequest(c.Server, conditionId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePrescriptionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePrescriptionRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePrescription(ctx context.Context, body CreatePrescriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePrescriptionRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPrescriptionsByAppointmentIdsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPrescriptionsByAppointmentIdsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPrescriptionsByAppointmentIds(ctx context.Context, body GetPrescriptionsByAppointmentIdsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPrescriptionsByAppointmentIdsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPrescriptionsByAppointmentId(ctx context.Context, appointmentId AppointmentId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPrescriptionsByAppointmentIdRequest(c.Server, appointmentId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PrescriptionsInDateRange(ctx context.Context, patientId PatientId, params *PrescriptionsInDateRangeParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPrescriptionsInDateRangeRequest(c.Server, patientId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeletePrescription(ctx context.Context, prescriptionId PrescriptionId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePrescriptionRequest(c.Server, prescriptionId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PrescriptionDetail(ctx context.Context, prescriptionId PrescriptionId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPrescriptionDetailRequest(c.Server, prescriptionId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdatePrescriptionWithBody(ctx context.Context, prescriptionId PrescriptionId, params *UpdatePrescriptionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePrescriptionRequestWithBody(c.Server, prescriptionId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdatePrescription(ctx context.Context, prescriptionId PrescriptionId, params *UpdatePrescriptionParams, body UpdatePrescriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePrescriptionRequest(c.Server, prescriptionId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewAuditEventsRequest generates requests for AuditEvents
+func NewAuditEventsRequest(server string, params *AuditEventsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/audit")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.TargetId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "targetId", runtime.ParamLocationQuery, *params.TargetId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Actor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "actor", runtime.ParamLocationQuery, *params.Actor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.AfterSeq != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "afterSeq", runtime.ParamLocationQuery, *params.AfterSeq); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreatePatientConditionRequest calls the generic CreatePatientCondition builder with application/json body
+func NewCreatePatientConditionRequest(server string, body CreatePatientConditionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePatientConditionRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreatePatientConditionRequestWithBody generates requests for CreatePatientCondition with any type of body
+func NewCreatePatientConditionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/conditions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetConditionsBatchRequest calls the generic GetConditionsBatch builder with application/json body
+func NewGetConditionsBatchRequest(server string, body GetConditionsBatchJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewGetConditionsBatchRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewGetConditionsBatchRequestWithBody generates requests for GetConditionsBatch with any type of body
+func NewGetConditionsBatchRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/conditions/batch")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewConditionsInDateRangeRequest generates requests for ConditionsInDateRange
+func NewConditionsInDateRangeRequest(server string, patientId PatientId, params *ConditionsInDateRangeParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "patientId", runtime.ParamLocationPath, patientId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/conditions/patient/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, params.From); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportPatientMedicalRecordsRequest generates requests for ExportPatientMedicalRecords
+func NewExportPatientMedicalRecordsRequest(server string, patientId PatientId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "patientId", runtime.ParamLocationPath, patientId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/conditions/patient/%s/export", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewConditionDetailRequest generates requests for ConditionDetail
+func NewConditionDetailRequest(server string, conditionId ConditionId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "conditionId", runtime.ParamLocationPath, conditionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/conditions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateConditionRequest calls the generic UpdateCondition builder with application/json body
+func NewUpdateConditionRequest(server string, conditionId ConditionId, params *UpdateConditionParams, body UpdateConditionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateConditionRequestWithBody(server, conditionId, params, "application/json", bodyReader)
+}
+
+// NewUpdateConditionRequestWithBody generates requests for UpdateCondition with any type of body
+func NewUpdateConditionRequestWithBody(server string, conditionId ConditionId, params *UpdateConditionParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "conditionId", runtime.ParamLocationPath, conditionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/conditions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewCreatePrescriptionRequest calls the generic CreatePrescription builder with application/json body
+func NewCreatePrescriptionRequest(server string, body CreatePrescriptionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePrescriptionRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreatePrescriptionRequestWithBody generates requests for CreatePrescription with any type of body
+func NewCreatePrescriptionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/prescriptions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPrescriptionsByAppointmentIdsRequest calls the generic GetPrescriptionsByAppointmentIds builder with application/json body
+func NewGetPrescriptionsByAppointmentIdsRequest(server string, body GetPrescriptionsByAppointmentIdsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewGetPrescriptionsByAppointmentIdsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewGetPrescriptionsByAppointmentIdsRequestWithBody generates requests for GetPrescriptionsByAppointmentIds with any type of body
+func NewGetPrescriptionsByAppointmentIdsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/prescriptions/appointment/batch")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPrescriptionsByAppointmentIdRequest generates requests for GetPrescriptionsByAppointmentId
+func NewGetPrescriptionsByAppointmentIdRequest(server string, appointmentId AppointmentId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appointmentId", runtime.ParamLocationPath, appointmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/prescriptions/appointment/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPrescriptionsInDateRangeRequest generates requests for PrescriptionsInDateRange
+func NewPrescriptionsInDateRangeRequest(server string, patientId PatientId, params *PrescriptionsInDateRangeParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "patientId", runtime.ParamLocationPath, patientId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/prescriptions/patient/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, params.From); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeletePrescriptionRequest generates requests for DeletePrescription
+func NewDeletePrescriptionRequest(server string, prescriptionId PrescriptionId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "prescriptionId", runtime.ParamLocationPath, prescriptionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/prescriptions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPrescriptionDetailRequest generates requests for PrescriptionDetail
+func NewPrescriptionDetailRequest(server string, prescriptionId PrescriptionId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "prescriptionId", runtime.ParamLocationPath, prescriptionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/prescriptions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdatePrescriptionRequest calls the generic UpdatePrescription builder with application/json body
+func NewUpdatePrescriptionRequest(server string, prescriptionId PrescriptionId, params *UpdatePrescriptionParams, body UpdatePrescriptionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdatePrescriptionRequestWithBody(server, prescriptionId, params, "application/json", bodyReader)
+}
+
+// NewUpdatePrescriptionRequestWithBody generates requests for UpdatePrescription with any type of body
+func NewUpdatePrescriptionRequestWithBody(server string, prescriptionId PrescriptionId, params *UpdatePrescriptionParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "prescriptionId", runtime.ParamLocationPath, prescriptionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/prescriptions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// AuditEventsWithResponse request
+	AuditEventsWithResponse(ctx context.Context, params *AuditEventsParams, reqEditors ...RequestEditorFn) (*AuditEventsResponse, error)
+
+	// CreatePatientConditionWithBodyWithResponse request with any body
+	CreatePatientConditionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePatientConditionResponse, error)
+
+	CreatePatientConditionWithResponse(ctx context.Context, body CreatePatientConditionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePatientConditionResponse, error)
+
+	// GetConditionsBatchWithBodyWithResponse request with any body
+	GetConditionsBatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GetConditionsBatchResponse, error)
+
+	GetConditionsBatchWithResponse(ctx context.Context, body GetConditionsBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*GetConditionsBatchResponse, error)
+
+	// ConditionsInDateRangeWithResponse request
+	ConditionsInDateRangeWithResponse(ctx context.Context, patientId PatientId, params *ConditionsInDateRangeParams, reqEditors ...RequestEditorFn) (*ConditionsInDateRangeResponse, error)
+
+	// ExportPatientMedicalRecordsWithResponse request
+	ExportPatientMedicalRecordsWithResponse(ctx context.Context, patientId PatientId, reqEditors ...RequestEditorFn) (*ExportPatientMedicalRecordsResponse, error)
+
+	// ConditionDetailWithResponse request
+	ConditionDetailWithResponse(ctx context.Context, conditionId ConditionId, reqEditors ...RequestEditorFn) (*ConditionDetailResponse, error)
+
+	// UpdateConditionWithBodyWithResponse request with any body
+	UpdateConditionWithBodyWithResponse(ctx context.Context, conditionId ConditionId, params *UpdateConditionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateConditionResponse, error)
+
+	UpdateConditionWithResponse(ctx context.Context, conditionId ConditionId, params *UpdateConditionParams, body UpdateConditionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateConditionResponse, error)
+
+	// CreatePrescriptionWithBodyWithResponse request with any body
+	CreatePrescriptionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePrescriptionResponse, error)
+
+	CreatePrescriptionWithResponse(ctx context.Context, body CreatePrescriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePrescriptionResponse, error)
+
+	// GetPrescriptionsByAppointmentIdsWithBodyWithResponse request with any body
+	GetPrescriptionsByAppointmentIdsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GetPrescriptionsByAppointmentIdsResponse, error)
+
+	GetPrescriptionsByAppointmentIdsWithResponse(ctx context.Context, body GetPrescriptionsByAppointmentIdsJSONRequestBody, reqEditors ...RequestEditorFn) (*GetPrescriptionsByAppointmentIdsResponse, error)
+
+	// GetPrescriptionsByAppointmentIdWithResponse request
+	GetPrescriptionsByAppointmentIdWithResponse(ctx context.Context, appointmentId AppointmentId, reqEditors ...RequestEditorFn) (*GetPrescriptionsByAppointmentIdResponse, error)
+
+	// PrescriptionsInDateRangeWithResponse request
+	PrescriptionsInDateRangeWithResponse(ctx context.Context, patientId PatientId, params *PrescriptionsInDateRangeParams, reqEditors ...RequestEditorFn) (*PrescriptionsInDateRangeResponse, error)
+
+	// DeletePrescriptionWithResponse request
+	DeletePrescriptionWithResponse(ctx context.Context, prescriptionId PrescriptionId, reqEditors ...RequestEditorFn) (*DeletePrescriptionResponse, error)
+
+	// PrescriptionDetailWithResponse request
+	PrescriptionDetailWithResponse(ctx context.Context, prescriptionId PrescriptionId, reqEditors ...RequestEditorFn) (*PrescriptionDetailResponse, error)
+
+	// UpdatePrescriptionWithBodyWithResponse request with any body
+	UpdatePrescriptionWithBodyWithResponse(ctx context.Context, prescriptionId PrescriptionId, params *UpdatePrescriptionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePrescriptionResponse, error)
+
+	UpdatePrescriptionWithResponse(ctx context.Context, prescriptionId PrescriptionId, params *UpdatePrescriptionParams, body UpdatePrescriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePrescriptionResponse, error)
+}
+
+type AuditEventsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *externalRef0.AuditEvents
+	ApplicationproblemJSON403 *externalRef0.ForbiddenResponse
+	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AuditEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuditEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreatePatientConditionResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *ConditionDisplay
+	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePatientConditionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePatientConditionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetConditionsBatchResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Conditions
+	ApplicationproblemJSON400 *externalRef0.ErrorDetail
+	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetConditionsBatchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetConditionsBatchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConditionsInDateRangeResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Conditions
+	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ConditionsInDateRangeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConditionsInDateRangeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportPatientMedicalRecordsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *MedicalRecordsExport
+	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportPatientMedicalRecordsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportPatientMedicalRecordsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConditionDetailResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Condition
+	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ConditionDetailResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConditionDetailResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateConditionResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Condition
+	ApplicationproblemJSON412 *externalRef0.PreconditionFailedResponse
+	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateConditionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateConditionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreatePrescriptionResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *Prescription
+	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePrescriptionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePrescriptionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPrescriptionsByAppointmentIdsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Prescriptions
+	ApplicationproblemJSON400 *externalRef0.ErrorDetail
+	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPrescriptionsByAppointmentIdsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPrescriptionsByAppointmentIdsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPrescriptionsByAppointmentIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Prescriptions
+	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPrescriptionsByAppointmentIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPrescriptionsByAppointmentIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PrescriptionsInDateRangeResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Prescriptions
+	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PrescriptionsInDateRangeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PrescriptionsInDateRangeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeletePrescriptionResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeletePrescriptionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeletePrescriptionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PrescriptionDetailResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Prescription
+	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PrescriptionDetailResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PrescriptionDetailResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdatePrescriptionResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Prescription
+	ApplicationproblemJSON412 *externalRef0.PreconditionFailedResponse
+	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdatePrescriptionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdatePrescriptionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// AuditEventsWithResponse request returning *AuditEventsResponse
+func (c *ClientWithResponses) AuditEventsWithResponse(ctx context.Context, params *AuditEventsParams, reqEditors ...RequestEditorFn) (*AuditEventsResponse, error) {
+	rsp, err := c.AuditEvents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuditEventsResponse(rsp)
+}
+
+// CreatePatientConditionWithBodyWithResponse request with arbitrary body returning *CreatePatientConditionResponse
+func (c *ClientWithResponses) CreatePatientConditionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePatientConditionResponse, error) {
+	rsp, err := c.CreatePatientConditionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePatientConditionResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreatePatientConditionWithResponse(ctx context.Context, body CreatePatientConditionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePatientConditionResponse, error) {
+	rsp, err := c.CreatePatientCondition(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePatientConditionResponse(rsp)
+}
+
+// GetConditionsBatchWithBodyWithResponse request with arbitrary body returning *GetConditionsBatchResponse
+func (c *ClientWithResponses) GetConditionsBatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GetConditionsBatchResponse, error) {
+	rsp, err := c.GetConditionsBatchWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetConditionsBatchResponse(rsp)
+}
+
+func (c *ClientWithResponses) GetConditionsBatchWithResponse(ctx context.Context, body GetConditionsBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*GetConditionsBatchResponse, error) {
+	rsp, err := c.GetConditionsBatch(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetConditionsBatchResponse(rsp)
+}
+
+// ConditionsInDateRangeWithResponse request returning *ConditionsInDateRangeResponse
+func (c *ClientWithResponses) ConditionsInDateRangeWithResponse(ctx context.Context, patientId PatientId, params *ConditionsInDateRangeParams, reqEditors ...RequestEditorFn) (*ConditionsInDateRangeResponse, error) {
+	rsp, err := c.ConditionsInDateRange(ctx, patientId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConditionsInDateRangeResponse(rsp)
+}
+
+// ExportPatientMedicalRecordsWithResponse request returning *ExportPatientMedicalRecordsResponse
+func (c *ClientWithResponses) ExportPatientMedicalRecordsWithResponse(ctx context.Context, patientId PatientId, reqEditors ...RequestEditorFn) (*ExportPatientMedicalRecordsResponse, error) {
+	rsp, err := c.ExportPatientMedicalRecords(ctx, patientId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportPatientMedicalRecordsResponse(rsp)
+}
+
+// ConditionDetailWithResponse request returning *ConditionDetailResponse
+func (c *ClientWithResponses) ConditionDetailWithResponse(ctx context.Context, conditionId ConditionId, reqEditors ...RequestEditorFn) (*ConditionDetailResponse, error) {
+	rsp, err := c.ConditionDetail(ctx, conditionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConditionDetailResponse(rsp)
+}
+
+// UpdateConditionWithBodyWithResponse request with arbitrary body returning *UpdateConditionResponse
+func (c *ClientWithResponses) UpdateConditionWithBodyWithResponse(ctx context.Context, conditionId ConditionId, params *UpdateConditionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateConditionResponse, error) {
+	rsp, err := c.UpdateConditionWithBody(ctx, conditionId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateConditionResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateConditionWithResponse(ctx context.Context, conditionId ConditionId, params *UpdateConditionParams, body UpdateConditionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateConditionResponse, error) {
+	rsp, err := c.UpdateCondition(ctx, conditionId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateConditionResponse(rsp)
+}
+
+// CreatePrescriptionWithBodyWithResponse request with arbitrary body returning *CreatePrescriptionResponse
+func (c *ClientWithResponses) CreatePrescriptionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePrescriptionResponse, error) {
+	rsp, err := c.CreatePrescriptionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePrescriptionResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreatePrescriptionWithResponse(ctx context.Context, body CreatePrescriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePrescriptionResponse, error) {
+	rsp, err := c.CreatePrescription(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePrescriptionResponse(rsp)
+}
+
+// GetPrescriptionsByAppointmentIdsWithBodyWithResponse request with arbitrary body returning *GetPrescriptionsByAppointmentIdsResponse
+func (c *ClientWithResponses) GetPrescriptionsByAppointmentIdsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GetPrescriptionsByAppointmentIdsResponse, error) {
+	rsp, err := c.GetPrescriptionsByAppointmentIdsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPrescriptionsByAppointmentIdsResponse(rsp)
+}
+
+func (c *ClientWithResponses) GetPrescriptionsByAppointmentIdsWithResponse(ctx context.Context, body GetPrescriptionsByAppointmentIdsJSONRequestBody, reqEditors ...RequestEditorFn) (*GetPrescriptionsByAppointmentIdsResponse, error) {
+	rsp, err := c.GetPrescriptionsByAppointmentIds(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPrescriptionsByAppointmentIdsResponse(rsp)
+}
+
+// GetPrescriptionsByAppointmentIdWithResponse request returning *GetPrescriptionsByAppointmentIdResponse
+func (c *ClientWithResponses) GetPrescriptionsByAppointmentIdWithResponse(ctx context.Context, appointmentId AppointmentId, reqEditors ...RequestEditorFn) (*GetPrescriptionsByAppointmentIdResponse, error) {
+	rsp, err := c.GetPrescriptionsByAppointmentId(ctx, appointmentId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPrescriptionsByAppointmentIdResponse(rsp)
+}
+
+// PrescriptionsInDateRangeWithResponse request returning *PrescriptionsInDateRangeResponse
+func (c *ClientWithResponses) PrescriptionsInDateRangeWithResponse(ctx context.Context, patientId PatientId, params *PrescriptionsInDateRangeParams, reqEditors ...RequestEditorFn) (*PrescriptionsInDateRangeResponse, error) {
+	rsp, err := c.PrescriptionsInDateRange(ctx, patientId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePrescriptionsInDateRangeResponse(rsp)
+}
+
+// DeletePrescriptionWithResponse request returning *DeletePrescriptionResponse
+func (c *ClientWithResponses) DeletePrescriptionWithResponse(ctx context.Context, prescriptionId PrescriptionId, reqEditors ...RequestEditorFn) (*DeletePrescriptionResponse, error) {
+	rsp, err := c.DeletePrescription(ctx, prescriptionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeletePrescriptionResponse(rsp)
+}
+
+// PrescriptionDetailWithResponse request returning *PrescriptionDetailResponse
+func (c *ClientWithResponses) PrescriptionDetailWithResponse(ctx context.Context, prescriptionId PrescriptionId, reqEditors ...RequestEditorFn) (*PrescriptionDetailResponse, error) {
+	rsp, err := c.PrescriptionDetail(ctx, prescriptionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePrescriptionDetailResponse(rsp)
+}
+
+// UpdatePrescriptionWithBodyWithResponse request with arbitrary body returning *UpdatePrescriptionResponse
+func (c *ClientWithResponses) UpdatePrescriptionWithBodyWithResponse(ctx context.Context, prescriptionId PrescriptionId, params *UpdatePrescriptionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePrescriptionResponse, error) {
+	rsp, err := c.UpdatePrescriptionWithBody(ctx, prescriptionId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePrescriptionResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdatePrescriptionWithResponse(ctx context.Context, prescriptionId PrescriptionId, params *UpdatePrescriptionParams, body UpdatePrescriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePrescriptionResponse, error) {
+	rsp, err := c.UpdatePrescription(ctx, prescriptionId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePrescriptionResponse(rsp)
+}
+
+// ParseAuditEventsResponse parses an HTTP response from a AuditEventsWithResponse call
+func ParseAuditEventsResponse(rsp *http.Response) (*AuditEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuditEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest externalRef0.AuditEvents
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest externalRef0.ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreatePatientConditionResponse parses an HTTP response from a CreatePatientConditionWithResponse call
+func ParseCreatePatientConditionResponse(rsp *http.Response) (*CreatePatientConditionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreatePatientConditionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ConditionDisplay
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetConditionsBatchResponse parses an HTTP response from a GetConditionsBatchWithResponse call
+func ParseGetConditionsBatchResponse(rsp *http.Response) (*GetConditionsBatchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetConditionsBatchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Conditions
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.ErrorDetail
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseConditionsInDateRangeResponse parses an HTTP response from a ConditionsInDateRangeWithResponse call
+func ParseConditionsInDateRangeResponse(rsp *http.Response) (*ConditionsInDateRangeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ConditionsInDateRangeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Conditions
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportPatientMedicalRecordsResponse parses an HTTP response from a ExportPatientMedicalRecordsWithResponse call
+func ParseExportPatientMedicalRecordsResponse(rsp *http.Response) (*ExportPatientMedicalRecordsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportPatientMedicalRecordsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MedicalRecordsExport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseConditionDetailResponse parses an HTTP response from a ConditionDetailWithResponse call
+func ParseConditionDetailResponse(rsp *http.Response) (*ConditionDetailResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ConditionDetailResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Condition
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateConditionResponse parses an HTTP response from a UpdateConditionWithResponse call
+func ParseUpdateConditionResponse(rsp *http.Response) (*UpdateConditionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateConditionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Condition
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest externalRef0.PreconditionFailedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreatePrescriptionResponse parses an HTTP response from a CreatePrescriptionWithResponse call
+func ParseCreatePrescriptionResponse(rsp *http.Response) (*CreatePrescriptionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreatePrescriptionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Prescription
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPrescriptionsByAppointmentIdsResponse parses an HTTP response from a GetPrescriptionsByAppointmentIdsWithResponse call
+func ParseGetPrescriptionsByAppointmentIdsResponse(rsp *http.Response) (*GetPrescriptionsByAppointmentIdsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPrescriptionsByAppointmentIdsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Prescriptions
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.ErrorDetail
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPrescriptionsByAppointmentIdResponse parses an HTTP response from a GetPrescriptionsByAppointmentIdWithResponse call
+func ParseGetPrescriptionsByAppointmentIdResponse(rsp *http.Response) (*GetPrescriptionsByAppointmentIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPrescriptionsByAppointmentIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Prescriptions
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePrescriptionsInDateRangeResponse parses an HTTP response from a PrescriptionsInDateRangeWithResponse call
+func ParsePrescriptionsInDateRangeResponse(rsp *http.Response) (*PrescriptionsInDateRangeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PrescriptionsInDateRangeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Prescriptions
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeletePrescriptionResponse parses an HTTP response from a DeletePrescriptionWithResponse call
+func ParseDeletePrescriptionResponse(rsp *http.Response) (*DeletePrescriptionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeletePrescriptionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePrescriptionDetailResponse parses an HTTP response from a PrescriptionDetailWithResponse call
+func ParsePrescriptionDetailResponse(rsp *http.Response) (*PrescriptionDetailResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PrescriptionDetailResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Prescription
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdatePrescriptionResponse parses an HTTP response from a UpdatePrescriptionWithResponse call
+func ParseUpdatePrescriptionResponse(rsp *http.Response) (*UpdatePrescriptionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdatePrescriptionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Prescription
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest externalRef0.PreconditionFailedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/+w8a2/buJZ/hdAucO/syo+kSTv1t7RppwGmnaJNZxYYBwktHducSKRKUk49gf/7gg9J",
+	"lETbcuw2vcV8c2zy8LxfPMx9ELE0YxSoFMHoPpgDjoHrjxFLU0avBfAF8GuckWvzTY9lQNWfry7xTC2M",
+	"QUScZJIwGoyC34ELwihiUyTngDgIlvMIQiQZmgASQCUiFF1Me2+xjOZqHZEC5VmMJfSDMBDRHFKsAMtl",
+	"BsEoEJITOgtWq1UYZJjjFKRFEWcZI1SmQOVF3Eblcg4op+RzDojEQCWZEuDo358+XZz/VODngFCHwxec",
+	"Zok6NT6B0+lT/Kw3+Tl63hseHT/pnZw+fdb7+fkQT6IYpkfHT4IwIOqgDMt5EAYUp2pnHasw4PA5Jxzi",
+	"YCR5Di6BU8ZTLINRkOdErWwSHG4XwlkeE3kWScbb9P9GkyWChZItyoCr0yBGkyWScyIQVpv6BQmfc+BL",
+	"hwYNcZMwOuM2lcA/wufN6GG1yuCVMUHUCqUlWkIKCormmNC12BZneJlLqHx6EoRBSihJ8zQYDUtOEyph",
+	"BnwHcl5zlm4mJeKAJcQIS8S4SxihQmIq1xExVZC9BCjL6EmSwh4q8itJiWwj/hZ/UTxBNE8nwJVRcJA5",
+	"pxBbckJ0NBwiMkWUSSRgLfaJhu+inxrQwehoOBw63D/ag/uXmM/Aa+t+XWdGhZTtyyW6I3JuRHFxvo4O",
+	"WZzwNez0ku2iOhOYMg6ddEeyr6I5F1Pto9tIK9cvmi5e/2H8OCICTbDQEgiRAOW2pfE96ggs+uhyDmNa",
+	"rcZZlhC9PlkqdasBVr9LxCjYI9MQ3fzPjeIRZWNqYpZagZPEAhKISP0NXaKFiUh9ZLDGHBQSGeYQIyE5",
+	"o7NkGSI8pneAb/UiRGEBHKWKeBD9MS3Ybo6q+F5Esa2eksbapz0kSmFUbj9UhHLx2S8+Tb3uUJEkMojI",
+	"lEQoxkukAKG7OYnmKg3gIDmBRSVgUSfseHh80hs+6x2dbnaVHRBX6uVFPMOS7JE22O11vCfH0RMllJ6W",
+	"ys/Ph0e94ycnp72nzyqR+AVSYbOfODJeUfIwXXMhHErdGljtR6JkHfRNsgNq2ybn6kFxpegTGaMCdJL6",
+	"sjA2m1VTCVTa7DUhEVY/Df4SipB755iMswy4JFBsc4AQCan+8N8cpsEo+K9BlcUPDAgxKM89JyJL8DJY",
+	"lbhiztXfK1cSf7pnXJVL2eQviKQhq870j3kUgRDTPEmWJZNjlBAhlSpV0Prq5PeODuzDiKwJpxMv3NO7",
+	"sqN+0gE4UgOomdIta3i1KKqzB/IMSgCdmLUDVm0mhgGFLxuS/o8gVXhPGYci65myJGF3IcqwMGFboJsi",
+	"o79RFjwDqZzumCrQKMMzMCG5leF78kpXopYPe4jS1CIGTjcJvmZ8QuIY6AfrEzbIMeNskkD6v2157iWu",
+	"V5wzfg4Sk8RHaokh6qmkDEU4SYAjIui/dEbF7iBWUrBZtQ5/SruwCRGd8kgqgVOcfNQrND4PYEfpstWO",
+	"uBC0gts3R/dBQQ4UgZrYUXBGUU5vKbujyCxBegliUZRzpRVhICSWuQhGp6pIkUQmOq+zgGu7FKnfRiRn",
+	"tIFnH30EJ8QZnJHigkqtkKG3o0a+51D65teYJBB/t6rpoooMrlZJy9LgDgvVG6AzlcwTaqsQncQ7Xaa+",
+	"9gUWLYX1WdWjKeJBy1V9AOWxTXuCuo0itCBwp5KIupt1VpxjCZckBX+qomseSVJY04TqUr6FQcwiyfg7",
+	"bE5p/Uw86d+nVuqntOcQbbAteVuZb69FtzDDzQrliO2j2VAGoM4bL9XyZmjQSPsEWONznYwSaYtCO66E",
+	"QRthr0ZoK6eyMOw1vUmq2id/arxBSO29IkwjSBIo+hVxbj4rFiRg1sRACcTBlStWd21LFk1meVFWu7Yi",
+	"OssTzK+jOUS3QRhQuLu2DNQqo2LLdZ4pzlOa4+Q6my8FiXCiCaAiT6T2PkEYLHAUEVr8lfMZUHkdYQ7G",
+	"CiOIc/1Zu0isMq7rBRFEBlce+srMWFttkvw2DUZ/7pxM3683/+65lscPeVIq28HY2mwvnWVo+uwTHN2q",
+	"dIpIoV1i/wFJU42sCpW2sl+5nF3rVF9gQSJE6JQhPGG5VCWnUYl/iXqjYz13L+I6g7c6nyY/gcZd22SF",
+	"I22ewQHHqnVXlLCtbXSDp+Nyhy5dy03R0vlw6XU6pRQ+QMR47CkI9qe/O721TstWMHtzx22lbOXUW4iV",
+	"vzF8Eq++ZIzLNrv2Kb6tCDxKuH8luw72+ro+3FrVhsE7uPs6DnIXRWjW4uVWv995B3cuY9oibN0UblVE",
+	"E/bFOyb9ar2TDX0HxlGzBYN+uJGv9Z5Nd03w91o2CORhMXKbfLrGTNcgDhg2N8dJH4++RqjsqFc/UDw0",
+	"xGzT5nWB8QFuAnSafSa7M/CgnuVHic7r5fZJ3xHWQlL3bIbmSYInCeyult+OMZsJ/yeutbnXYlW3lvVL",
+	"3SfyRASc5GCuvwShswTQlEASFxfwmMblGIcdR1Gt4Eh7X/SWCLVpTG/M8huUAqZCrzVgVItKgDSDN2A3",
+	"hig1G22v224bUyL1Bg4pW5gbcyJNu7shebVrAy3V+Q7uUdmzNch22l/OITgAHi4Ec28wuve0PKnkS6el",
+	"ADTu6XEA03VP2MzKCPiCRNBHrxbA7ezEmEaYcwKG73MsysGyjEMMEQjBeIgE0xMBKYtVH1W3ExDjhtk4",
+	"GVMNP8PC9vjRhAO+NTDNAJJPEJH05hl/zLERZcwohMU0k26h34zz4fBJZMZS9Gfom68WwCfmi5t6H652",
+	"L2uCjs+SsH8S7KLq+Fn+5gI4upszZ1jGFbAHtB1O2SnSkelUsyg2vhsn72us2/8Wypp0+1JDaW7VGW6Y",
+	"3i0szZdGwZUX6wcejVZ65LnCenPWOz59WmqZHtAx+kJolOSxtuqMw+INFvMbLzPV3t9x4mvS/jEHObcG",
+	"KyTjEJuT7DSK/h4WZRYobdPNHjFhLAFMd0gNCkTbmLwpCdRp8oKw3BIaIkgzuSzbx1PChR7Q8RJrG5a+",
+	"gYSL8+KAN5eX75FdaS/vI5wLq5f6VC9w4btlfF/MELJptd0zUBgiHXqUwLBER50S/bCaEdtAjh05q7RO",
+	"uwJ3Mq2/vW3uy6CEHnMs5jMN7CB059YqQ3WEa5XZ1byrh/jwF0oLbQNunY/RDWz1h7JzNAWlt2aojSq3",
+	"/cneyBFnA+YwpuKWZBnEhZyKKQqf0yW7tgBT/OXCLD4aDrc0TBTwBzHHvc5a6/ZMItpwKRLTGPOY/A2x",
+	"vfqzd3ro3x9ev0TPT06f/dSu9Mw16L2vKilw2MkY42qkTy8KkU5dipLYyuX/eh/Mz72LGJmJuP6arE7m",
+	"dekQKquVrkWZe9f7LQZgloWGbuf6xZLrv9hXBbQCnZAI7CWnHex5e3GpLxOSYBTMpczEaDBQojRXi33G",
+	"ZwO7SQzU2gpR3Th8iRP0lkScfTQJiUBn7y+cFvkoOOoP+0O1zSpIMAqe9If9E1MIzTVvBtodqU8z8ORF",
+	"vxIhRW3uoAw5Ng9CjMfAyyBHeHOCekxrHo9QPUKh4WCJUiYkutHjuzfFZIO+Yi2HLYyPFGNaJZI37pDH",
+	"TStGqECAPoCSYiT19MCY4jgllAjJsWRchK6aoTQXEqkEbllcY0zJLFc06V1IslugCIsxvTnL5Zxx8rfO",
+	"3UboBWAOHJnkSS8rsiftNMo5BaXugTtNU39KsKalVS0Z7DifvAoPBNGM9h8MnB5fPxi0S3ZAQq02HQ6i",
+	"GXlfXTXm8Y6Hw3W5Z7lusNNk1ioMToZPDgC0PSy0CoPTg+C7aQRHz0bkaYr5srASVW3prGKmrMN8F1yp",
+	"hYP6bUjGhPZadUt7qVOQ96YJULVuyujzgsXLnQbaNlUKtQsLzxTJuY2kzUtS+yLJpkv91lzqqqU4RwfD",
+	"uX1d0sa7XIO4blcWmCLhDKcpPytzbhsOxYqKRnc06FtrktECd4C9oETVDWU329Gzl84IakPZBpPiKUKh",
+	"cs1pHTOk57TGRfHiA9CMLKCRcTbS0TFt5KPIpqN95FxAtLXIrKVMjqmp/iD2xZ5foLID8cK+Gfga1tA9",
+	"g1+tVttVvoPGOELTnnD43c2RvcAxsjmrmh9jDKWqDeQK/A54mY9A/Cjm8gtIV60mS3RxLjoahzWlwX3Z",
+	"eF05KWXDOZfbLqiac/qgWyi7JkTlQV0C9rRj2iHZA+N1XQe/uaOrpEaoGe/jlqkPFN4AylkEb1lQOH3Q",
+	"LdDKv6petdsqbLxXCasO1Zjaq6vaemFbL9r/SZIkiCuron6nZgYmbJivz1Lso09rNOAg7tE78eEbwU0S",
+	"lJq1NmiJ1tufR9A0g7BzD9zA0dE4Syl6Q4RkfNlWu3vnGVgHb2Hd6+6FU3nI15XsxhzwZTMhCkL3rXvx",
+	"lN0H3y4bdHsPv1o9glY0qVvnd5TsbBJVF3LzjnUvIR+gfisenhqFOXyS1KTXozFmiZtK68sCEXTMmX40",
+	"nT45Oj6ATm94fPAIdmNk7BYomyJ2a2BvY+3rLP56dW/tlO2lby0zeMTqdxva7u8bi99HrWrredOWytYf",
+	"jWsgBs4wR/dqt44FFoJFRDNK176qzGFTpwR2zhhTTz0cttvJuv27pqZ1JSVeLM/ccRTxw1S4NSr/KXL3",
+	"LXLrKjtZ1t5W1WveXc3mvjYQtdpUP1n7wf73uW1Lqp7fuehW7O3vah87Z1k12h5YKLdU+TtUgB3lv0vv",
+	"o0b+j9P+eHSp1hBY2wTpIs37+n+LWBnb1fNOLWme6+8bqdaOcqyd5mP/Sdt7mHPjx2C0ObpmQRsZHG63",
+	"gwcW9ts5N3ychPFHrPA9BG4R+8ZK/6Am8x9T72/ToqLkN4V++//hfNPS/7tT+R+4AdDZm+rdGqqxlMZ/",
+	"RaOxTmPKoaKBVmgLrhw7qt1VVMNIjcOcn8zt++pq9f8DAGldyTmuUwAA",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	for rawPath, rawFunc := range externalRef0.PathToRawSpec(path.Join(path.Dir(pathToFile), "../../common/server/api/common-openapi.yaml")) {
+		if _, ok := res[rawPath]; ok {
+			// it is not possible to compare functions in golang, so always overwrite the old value
+		}
+		res[rawPath] = rawFunc
+	}
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
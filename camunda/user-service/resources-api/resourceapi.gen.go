@@ -0,0 +1,1238 @@
+// Package resourceapi provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
+package resourceapi
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	externalRef0 "github.com/Nesquiko/aass/common/server/api"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Defines values for ResourceType.
+const (
+	ResourceTypeEquipment ResourceType = "equipment"
+	ResourceTypeFacility  ResourceType = "facility"
+	ResourceTypeMedicine  ResourceType = "medicine"
+)
+
+// AvailableResources Lists of available resources (facilities, equipment, medicine) for a specific date and time slot.
+type AvailableResources struct {
+	// Equipment List of available equipment.
+	Equipment []Equipment `json:"equipment"`
+
+	// Facilities List of available facilities.
+	Facilities []Facility `json:"facilities"`
+
+	// Medicine List of available medicine (assuming medicine can be 'allocated' or has limited stock per slot).
+	Medicine []Medicine `json:"medicine"`
+}
+
+// Equipment Represents a required equipment resource.
+type Equipment struct {
+	// Id Unique identifier for the equipment.
+	Id openapi_types.UUID `json:"id"`
+
+	// Name Name of the equipment.
+	Name string `json:"name"`
+}
+
+// Facility Represents a required facility resource.
+type Facility struct {
+	// Id Unique identifier for the facility.
+	Id openapi_types.UUID `json:"id"`
+
+	// Name Name of the facility.
+	Name string `json:"name"`
+}
+
+// Medicine Represents a required medicine resource.
+type Medicine struct {
+	// Id Unique identifier for the medicine.
+	Id openapi_types.UUID `json:"id"`
+
+	// Name Name of the medicine.
+	Name string `json:"name"`
+}
+
+// NewResource Represents a resource.
+type NewResource struct {
+	Id *openapi_types.UUID `json:"id,omitempty"`
+
+	// Name Name of the medicine.
+	Name string       `json:"name"`
+	Type ResourceType `json:"type"`
+}
+
+// ReservationRecord defines model for ReservationRecord.
+type ReservationRecord struct {
+	AppointmentId openapi_types.UUID `json:"appointmentId"`
+	End           time.Time          `json:"end"`
+	Id            openapi_types.UUID `json:"id"`
+	ResourceId    openapi_types.UUID `json:"resourceId"`
+	ResourceName  string             `json:"resourceName"`
+	ResourceType  ResourceType       `json:"resourceType"`
+	Start         time.Time          `json:"start"`
+}
+
+// ReservationRecordsExport defines model for ReservationRecordsExport.
+type ReservationRecordsExport struct {
+	Reservations []ReservationRecord `json:"reservations"`
+}
+
+// ReservationsMove Result of moving reservations of an appointment to a new time.
+type ReservationsMove struct {
+	// Conflicts Resources taken by other appointments in the new slot. Empty if the reservations were moved.
+	Conflicts []NewResource `json:"conflicts"`
+}
+
+// ResourceType defines model for ResourceType.
+type ResourceType string
+
+// AppointmentId defines model for appointmentId.
+type AppointmentId = openapi_types.UUID
+
+// DateTime defines model for date-time.
+type DateTime = time.Time
+
+// ResourceId defines model for resourceId.
+type ResourceId = openapi_types.UUID
+
+// GetAvailableResourcesParams defines parameters for GetAvailableResources.
+type GetAvailableResourcesParams struct {
+	DateTime DateTime `form:"date-time" json:"date-time"`
+}
+
+// ExportAppointmentsReservationsJSONBody defines parameters for ExportAppointmentsReservations.
+type ExportAppointmentsReservationsJSONBody struct {
+	AppointmentIds []openapi_types.UUID `json:"appointmentIds"`
+}
+
+// ReserveAppointmentResourcesJSONBody defines parameters for ReserveAppointmentResources.
+type ReserveAppointmentResourcesJSONBody struct {
+	EquipmentId *openapi_types.UUID `json:"equipmentId,omitempty"`
+	FacilityId  *openapi_types.UUID `json:"facilityId,omitempty"`
+	MedicineId  *openapi_types.UUID `json:"medicineId,omitempty"`
+	Start       time.Time           `json:"start"`
+}
+
+// MoveAppointmentReservationsJSONBody defines parameters for MoveAppointmentReservations.
+type MoveAppointmentReservationsJSONBody struct {
+	Start time.Time `json:"start"`
+}
+
+// CreateResourceJSONRequestBody defines body for CreateResource for application/json ContentType.
+type CreateResourceJSONRequestBody = NewResource
+
+// ExportAppointmentsReservationsJSONRequestBody defines body for ExportAppointmentsReservations for application/json ContentType.
+type ExportAppointmentsReservationsJSONRequestBody ExportAppointmentsReservationsJSONBody
+
+// ReserveAppointmentResourcesJSONRequestBody defines body for ReserveAppointmentResources for application/json ContentType.
+type ReserveAppointmentResourcesJSONRequestBody ReserveAppointmentResourcesJSONBody
+
+// MoveAppointmentReservationsJSONRequestBody defines body for MoveAppointmentReservations for application/json ContentType.
+type MoveAppointmentReservationsJSONRequestBody MoveAppointmentReservationsJSONBody
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// CreateResourceWithBody request with any body
+	CreateResourceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateResource(ctx context.Context, body CreateResourceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAvailableResources request
+	GetAvailableResources(ctx context.Context, params *GetAvailableResourcesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportAppointmentsReservationsWithBody request with any body
+	ExportAppointmentsReservationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ExportAppointmentsReservations(ctx context.Context, body ExportAppointmentsReservationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReserveAppointmentResourcesWithBody request with any body
+	ReserveAppointmentResourcesWithBody(ctx context.Context, appointmentId AppointmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ReserveAppointmentResources(ctx context.Context, appointmentId AppointmentId, body ReserveAppointmentResourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MoveAppointmentReservationsWithBody request with any body
+	MoveAppointmentReservationsWithBody(ctx context.Context, appointmentId AppointmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	MoveAppointmentReservations(ctx context.Context, appointmentId AppointmentId, body MoveAppointmentReservationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetResourceById request
+	GetResourceById(ctx context.Context, resourceId ResourceId, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) CreateResourceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateResourceRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateResource(ctx context.Context, body CreateResourceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateResourceRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAvailableResources(ctx context.Context, params *GetAvailableResourcesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAvailableResourcesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportAppointmentsReservationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportAppointmentsReservationsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportAppointmentsReservations(ctx context.Context, body ExportAppointmentsReservationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportAppointmentsReservationsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReserveAppointmentResourcesWithBody(ctx context.Context, appointmentId AppointmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReserveAppointmentResourcesRequestWithBody(c.Server, appointmentId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReserveAppointmentResources(ctx context.Context, appointmentId AppointmentId, body ReserveAppointmentResourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReserveAppointmentResourcesRequest(c.Server, appointmentId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MoveAppointmentReservationsWithBody(ctx context.Context, appointmentId AppointmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMoveAppointmentReservationsRequestWithBody(c.Server, appointmentId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MoveAppointmentReservations(ctx context.Context, appointmentId AppointmentId, body MoveAppointmentReservationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMoveAppointmentReservationsRequest(c.Server, appointmentId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetResourceById(ctx context.Context, resourceId ResourceId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetResourceByIdRequest(c.Server, resourceId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewCreateResourceRequest calls the generic CreateResource builder with application/json body
+func NewCreateResourceRequest(server string, body CreateResourceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateResourceRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateResourceRequestWithBody generates requests for CreateResource with any type of body
+func NewCreateResourceRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/resources")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAvailableResourcesRequest generates requests for GetAvailableResources
+func NewGetAvailableResourcesRequest(server string, params *GetAvailableResourcesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/resources/available")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "date-time", runtime.ParamLocationQuery, params.DateTime); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportAppointmentsReservationsRequest calls the generic ExportAppointmentsReservations builder with application/json body
+func NewExportAppointmentsReservationsRequest(server string, body ExportAppointmentsReservationsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewExportAppointmentsReservationsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewExportAppointmentsReservationsRequestWithBody generates requests for ExportAppointmentsReservations with any type of body
+func NewExportAppointmentsReservationsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/resources/reservations/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewReserveAppointmentResourcesRequest calls the generic ReserveAppointmentResources builder with application/json body
+func NewReserveAppointmentResourcesRequest(server string, appointmentId AppointmentId, body ReserveAppointmentResourcesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReserveAppointmentResourcesRequestWithBody(server, appointmentId, "application/json", bodyReader)
+}
+
+// NewReserveAppointmentResourcesRequestWithBody generates requests for ReserveAppointmentResources with any type of body
+func NewReserveAppointmentResourcesRequestWithBody(server string, appointmentId AppointmentId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appointmentId", runtime.ParamLocationPath, appointmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/resources/reserve/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewMoveAppointmentReservationsRequest calls the generic MoveAppointmentReservations builder with application/json body
+func NewMoveAppointmentReservationsRequest(server string, appointmentId AppointmentId, body MoveAppointmentReservationsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewMoveAppointmentReservationsRequestWithBody(server, appointmentId, "application/json", bodyReader)
+}
+
+// NewMoveAppointmentReservationsRequestWithBody generates requests for MoveAppointmentReservations with any type of body
+func NewMoveAppointmentReservationsRequestWithBody(server string, appointmentId AppointmentId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appointmentId", runtime.ParamLocationPath, appointmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/resources/reserve/%s/move", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetResourceByIdRequest generates requests for GetResourceById
+func NewGetResourceByIdRequest(server string, resourceId ResourceId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/resources/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// CreateResourceWithBodyWithResponse request with any body
+	CreateResourceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateResourceResponse, error)
+
+	CreateResourceWithResponse(ctx context.Context, body CreateResourceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateResourceResponse, error)
+
+	// GetAvailableResourcesWithResponse request
+	GetAvailableResourcesWithResponse(ctx context.Context, params *GetAvailableResourcesParams, reqEditors ...RequestEditorFn) (*GetAvailableResourcesResponse, error)
+
+	// ExportAppointmentsReservationsWithBodyWithResponse request with any body
+	ExportAppointmentsReservationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExportAppointmentsReservationsResponse, error)
+
+	ExportAppointmentsReservationsWithResponse(ctx context.Context, body ExportAppointmentsReservationsJSONRequestBody, reqEditors ...RequestEditorFn) (*ExportAppointmentsReservationsResponse, error)
+
+	// ReserveAppointmentResourcesWithBodyWithResponse request with any body
+	ReserveAppointmentResourcesWithBodyWithResponse(ctx context.Context, appointmentId AppointmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReserveAppointmentResourcesResponse, error)
+
+	ReserveAppointmentResourcesWithResponse(ctx context.Context, appointmentId AppointmentId, body ReserveAppointmentResourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*ReserveAppointmentResourcesResponse, error)
+
+	// MoveAppointmentReservationsWithBodyWithResponse request with any body
+	MoveAppointmentReservationsWithBodyWithResponse(ctx context.Context, appointmentId AppointmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MoveAppointmentReservationsResponse, error)
+
+	MoveAppointmentReservationsWithResponse(ctx context.Context, appointmentId AppointmentId, body MoveAppointmentReservationsJSONRequestBody, reqEditors ...RequestEditorFn) (*MoveAppointmentReservationsResponse, error)
+
+	// GetResourceByIdWithResponse request
+	GetResourceByIdWithResponse(ctx context.Context, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetResourceByIdResponse, error)
+}
+
+type CreateResourceResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *NewResource
+	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateResourceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateResourceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAvailableResourcesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *AvailableResources
+	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAvailableResourcesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAvailableResourcesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportAppointmentsReservationsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ReservationRecordsExport
+	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportAppointmentsReservationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportAppointmentsReservationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReserveAppointmentResourcesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON404 *externalRef0.ErrorDetail
+	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ReserveAppointmentResourcesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReserveAppointmentResourcesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MoveAppointmentReservationsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ReservationsMove
+	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r MoveAppointmentReservationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MoveAppointmentReservationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetResourceByIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *NewResource
+	ApplicationproblemJSON404 *externalRef0.ErrorDetail
+	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetResourceByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetResourceByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CreateResourceWithBodyWithResponse request with arbitrary body returning *CreateResourceResponse
+func (c *ClientWithResponses) CreateResourceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateResourceResponse, error) {
+	rsp, err := c.CreateResourceWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateResourceResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateResourceWithResponse(ctx context.Context, body CreateResourceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateResourceResponse, error) {
+	rsp, err := c.CreateResource(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateResourceResponse(rsp)
+}
+
+// GetAvailableResourcesWithResponse request returning *GetAvailableResourcesResponse
+func (c *ClientWithResponses) GetAvailableResourcesWithResponse(ctx context.Context, params *GetAvailableResourcesParams, reqEditors ...RequestEditorFn) (*GetAvailableResourcesResponse, error) {
+	rsp, err := c.GetAvailableResources(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAvailableResourcesResponse(rsp)
+}
+
+// ExportAppointmentsReservationsWithBodyWithResponse request with arbitrary body returning *ExportAppointmentsReservationsResponse
+func (c *ClientWithResponses) ExportAppointmentsReservationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExportAppointmentsReservationsResponse, error) {
+	rsp, err := c.ExportAppointmentsReservationsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportAppointmentsReservationsResponse(rsp)
+}
+
+func (c *ClientWithResponses) ExportAppointmentsReservationsWithResponse(ctx context.Context, body ExportAppointmentsReservationsJSONRequestBody, reqEditors ...RequestEditorFn) (*ExportAppointmentsReservationsResponse, error) {
+	rsp, err := c.ExportAppointmentsReservations(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportAppointmentsReservationsResponse(rsp)
+}
+
+// ReserveAppointmentResourcesWithBodyWithResponse request with arbitrary body returning *ReserveAppointmentResourcesResponse
+func (c *ClientWithResponses) ReserveAppointmentResourcesWithBodyWithResponse(ctx context.Context, appointmentId AppointmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReserveAppointmentResourcesResponse, error) {
+	rsp, err := c.ReserveAppointmentResourcesWithBody(ctx, appointmentId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReserveAppointmentResourcesResponse(rsp)
+}
+
+func (c *ClientWithResponses) ReserveAppointmentResourcesWithResponse(ctx context.Context, appointmentId AppointmentId, body ReserveAppointmentResourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*ReserveAppointmentResourcesResponse, error) {
+	rsp, err := c.ReserveAppointmentResources(ctx, appointmentId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReserveAppointmentResourcesResponse(rsp)
+}
+
+// MoveAppointmentReservationsWithBodyWithResponse request with arbitrary body returning *MoveAppointmentReservationsResponse
+func (c *ClientWithResponses) MoveAppointmentReservationsWithBodyWithResponse(ctx context.Context, appointmentId AppointmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MoveAppointmentReservationsResponse, error) {
+	rsp, err := c.MoveAppointmentReservationsWithBody(ctx, appointmentId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMoveAppointmentReservationsResponse(rsp)
+}
+
+func (c *ClientWithResponses) MoveAppointmentReservationsWithResponse(ctx context.Context, appointmentId AppointmentId, body MoveAppointmentReservationsJSONRequestBody, reqEditors ...RequestEditorFn) (*MoveAppointmentReservationsResponse, error) {
+	rsp, err := c.MoveAppointmentReservations(ctx, appointmentId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMoveAppointmentReservationsResponse(rsp)
+}
+
+// GetResourceByIdWithResponse request returning *GetResourceByIdResponse
+func (c *ClientWithResponses) GetResourceByIdWithResponse(ctx context.Context, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetResourceByIdResponse, error) {
+	rsp, err := c.GetResourceById(ctx, resourceId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetResourceByIdResponse(rsp)
+}
+
+// ParseCreateResourceResponse parses an HTTP response from a CreateResourceWithResponse call
+func ParseCreateResourceResponse(rsp *http.Response) (*CreateResourceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateResourceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest NewResource
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAvailableResourcesResponse parses an HTTP response from a GetAvailableResourcesWithResponse call
+func ParseGetAvailableResourcesResponse(rsp *http.Response) (*GetAvailableResourcesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAvailableResourcesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AvailableResources
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportAppointmentsReservationsResponse parses an HTTP response from a ExportAppointmentsReservationsWithResponse call
+func ParseExportAppointmentsReservationsResponse(rsp *http.Response) (*ExportAppointmentsReservationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportAppointmentsReservationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReservationRecordsExport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReserveAppointmentResourcesResponse parses an HTTP response from a ReserveAppointmentResourcesWithResponse call
+func ParseReserveAppointmentResourcesResponse(rsp *http.Response) (*ReserveAppointmentResourcesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReserveAppointmentResourcesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest externalRef0.ErrorDetail
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseMoveAppointmentReservationsResponse parses an HTTP response from a MoveAppointmentReservationsWithResponse call
+func ParseMoveAppointmentReservationsResponse(rsp *http.Response) (*MoveAppointmentReservationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MoveAppointmentReservationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReservationsMove
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetResourceByIdResponse parses an HTTP response from a GetResourceByIdWithResponse call
+func ParseGetResourceByIdResponse(rsp *http.Response) (*GetResourceByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetResourceByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NewResource
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest externalRef0.ErrorDetail
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/8xabVPcOBL+K126q9qkzp4xMEAy30hCUlN1cFtAqnK3UFmN1Wa0sSUjyZA5av77leT3",
+	"sYcxCwf7cUyru/X0e4t7EsoklQKF0WR6T1KqaIIGlftF01RyYRIUZsbsB4Y6VDw1XAoyJRcLhEzwmwyB",
+	"MxSGRxwVvPn6dfbpLcgIzAKhwWJEPII/aZLGSKaETXA/OqCH/vxd+N4Pdnb3/Mn+waH/7n1A5yHDaGd3",
+	"j3iEW0EpNQviEUETe7KtlUcU3mRcISNTozL0iA4XmFCrbiRVQg2ZkizjltIsU8tAG8XFNVmtPMKoQd9w",
+	"y/c+l3WToVrWwmqCQYKa5F1pCrXMVIh/FszyfBvJiIZ+EOz4dHe+54cTtu/jQXTYj11Dg6cAl98llUKj",
+	"c5RQJokU3zWqW1Tfacq/5198maKwP2fCoBI0PncUx0pJdVYwyM8Lg8IUPhfzkFpUxqmS8xiTf/yhLUT3",
+	"9Z3tCWY14gXfUS56hJYz8QhDQ3lMpuRIQCZ+CHknICcBRwIyDDNl7+4RbajJNJnuB4FHDDcO1FLh1imy",
+	"aoL0d4URmZK/jesQGud/1eOtgDgIPuVaOjzb3nAk1vQcwTki6BRDHvEQcp3BogCRVJDfV4+cmxVKWB2P",
+	"bimP6TzGs8Lwuut5/+TaaOtitCSuHE3Dm4iGPOaGo/bA+ktq486DBBkPucC3TjytNbMRAFQwsFEAOpYu",
+	"7lMlU1SG5/IrPv3KtHWpiC0fbjDR28A/rtivKtelStGl/V3fZ4jwmnqw9M/5kWWf8BK1IaJLWnhDtc4S",
+	"Lq7rTyEVMEf4hcaxDKlB9gtIBQuqIeYJN8hAGxn+gBSVM8HbwdqflBp2tF81E8ZvTRy9hkEbd7yqOMj5",
+	"Hxg6axxvtvwZpgq1VQYolJJq67eSX9udeE86/dpJpdZPbRJt+VOdRfEm9YNg148O8cBn++HEn+/RXeJt",
+	"y4Vlal1X4JQmWObtDSK/xkZRLTPB4ISGC2vYb1/8/Q2lo4beqeGk9kFc+d9AhAtDLp8N4JLhwCr1DPj2",
+	"Szw5m8F5xg3ChydCerIxbPshreL0uSAtGbYvmCDzg2DPf0/fzf3D8ID5+ziJngfSfolHgqI2CzQ8hG//",
+	"/s8TYT3Fu7IsbUX2YRzXL6yQsn+JeFl2Ni8HQPnh4SxbXvvC0m4GrWDWh90Z2t7CtUlnGErlYGhD0+ne",
+	"t7oFCja0m/U2IN8ha/e8g8lPCwNtJLh4NMyu01PmUf36mlW6o0ernW6ovqZoKTvHeJBB9fHPVCrTtauq",
+	"Kd3vQXW9w35rgW+J2aKxPpG3vTGss9i1NYm8tQ1Mk6f9TEVzQAQjgYLAO9c7dmM9lCKKeWh0r6SiYTX0",
+	"BwqYL0GaBaomew1cuNC2ElxjCsdJapbAq+mqVu4OFVqtkQ1unZrpbBu49VU2INvycRRZYk9VrVXVxi5b",
+	"zddVT0A9ahKxWYMxbiGg8a8N8PM02sb83FDBqGL8v8iKaaUYQ+DN2eeP8H6yf/i2z4qsP7JZpUNP0N9k",
+	"qHuXEDNWT8eOyAOqQVt3mtPwR2nzb/5Z/md/xmCBlKEa9eWfchhs5AguTE3JhcFrdJNgMSreb0kcOZmX",
+	"37sSUF23a3/LgItIWtYxD7GYkYsJ/mR2QTySqZhMycKYVE/HY2vKokBKdT0uDumxpa0VdW3MRxrDCQ+V",
+	"tJM4twFz9OuMeOQWlc4B3RkFo8AeKxyETMneKBhNrCGpWThsxqo5TqZSuzRlrezCx9qJfFRITTV3ksqI",
+	"HyRbPjDzl7P+sDm7FXPdMfoU76rewWaXOULo1OquP9ZXGrvBzktpmSPFKk0t+PtBsIlrpeb4SWsXq4fO",
+	"koSqZaVDrYJHDL3W1n/rzcGVPVKbflzNqlbTa+zxgS9oejYQXmvN+Fv/LWuScV2kV1cdKwXPZqUeTXuM",
+	"dZ6FIWodZXFsRyajON4i692dlN17sRvB1k7kFWz8Bc1GPWmt2yDbN4vlGOtOpUgF69XZZEpowFtUy2ad",
+	"7dkSaw8yjQzuFiggZ2w7BxrHl4JRQ2GBMQM6l5kBCik13M7Vl4J4a76Xt09HDc7NhuUJ+eiBLrvdj21t",
+	"dh9sEdYY99eJbTns+aJjY3/at72M406rt27mV4mAXOVuG9rQ6xHej+P7lpFWm4thjh42vPHPp8OWzCIl",
+	"PocnV33kwFGtbEEHkpfN60Dyp8xq+dn+iOnMDVUuKprXAZ3BpJvi1sqCszZrbC7yJCva72Erj0yCyQMm",
+	"W38AeZnHh3OZ1OXBA9maouCOavGLgcjuLV8jhotQ0usFrIXtU6J4nBTDbH8xs6OutvVoW4qzDaf9JAXC",
+	"Qmb5Jh6cb7qKZuB39+P30aVoliag5egJUsRuPG0WzkajCFxDpBDXB1vvUrjp945rHKSplagwRmorr3u8",
+	"abw26kvx0FCdn7UF3p7VUI22fUX5RK4nwWZF/mvkwZfJPHY0cdSNx9317uTFK3u+x3k4TzZ3Iy45VI6T",
+	"71EU5gS1H7xGlsij9DH7pkEp477e+K0aU0+n3XVTgXZ2LXciVnz9TFqFcPnAumw9r0rVeGGdL4Eb3f3v",
+	"gFEnvL5g1Vd8WLql5ONCqr7d/3fU2jIQb5ixKszq1+6/ZgE9lQY+u3c9Hy5aA2B1hdknYBI1CGkAf3L9",
+	"ehNhpdJ8CbNPm6LAHnPscj9q3/dYMBdU1WJq7NynYFStrmqGq6vV/wYAfi80enokAAA=",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	for rawPath, rawFunc := range externalRef0.PathToRawSpec(path.Join(path.Dir(pathToFile), "../../common/server/api/common-openapi.yaml")) {
+		if _, ok := res[rawPath]; ok {
+			// it is not possible to compare functions in golang, so always overwrite the old value
+		}
+		res[rawPath] = rawFunc
+	}
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
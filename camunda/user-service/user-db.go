@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/google/uuid"
 	"go.mongodb.org/mongo-driver/v2/bson"
@@ -29,8 +30,16 @@ type Patient struct {
 	Email     string    `bson:"email"     json:"email"`
 	FirstName string    `bson:"firstName" json:"firstName"`
 	LastName  string    `bson:"lastName"  json:"lastName"`
+
+	// DeletedAt is set when the patient's personal data were erased, the
+	// record itself is kept, so that clinical records still reference it.
+	DeletedAt   *time.Time `bson:"deletedAt,omitempty"   json:"deletedAt,omitempty"`
+	RetainUntil *time.Time `bson:"retainUntil,omitempty" json:"retainUntil,omitempty"`
 }
 
+// AnonymizedPatientName replaces first and last name of an erased patient.
+const AnonymizedPatientName = "Anonymized"
+
 type Doctor struct {
 	Id             uuid.UUID `bson:"_id"            json:"id"`
 	Email          string    `bson:"email"          json:"email"`
@@ -66,6 +75,20 @@ func newMongoUserDb(ctx context.Context, uri string, db string) (mongoUserDb, er
 		)
 	}
 
+	indexModel = mongo.IndexModel{
+		Keys:    bson.D{{Key: "specialization", Value: 1}},
+		Options: options.Index().SetName("idx_doctor_specialization"),
+	}
+	_, err = doctorsColl.Indexes().CreateOne(ctx, indexModel)
+	if err != nil {
+		slog.WarnContext(
+			ctx,
+			"Could not ensure doctor specialization index (may already exist)",
+			"error",
+			err,
+		)
+	}
+
 	patientsColl := mongoDb.Collection(patiensCollection)
 	indexModel = mongo.IndexModel{
 		Keys:    bson.D{{Key: "email", Value: 1}},
@@ -88,6 +111,10 @@ func (db mongoUserDb) Disconnect(ctx context.Context) error {
 	return db.patients.Database().Client().Disconnect(ctx)
 }
 
+func (db mongoUserDb) Ping(ctx context.Context) error {
+	return db.patients.Database().Client().Ping(ctx, nil)
+}
+
 func (db *mongoUserDb) FindPatientById(ctx context.Context, id uuid.UUID) (Patient, error) {
 	filter := bson.M{"_id": id}
 	var patient Patient
@@ -137,6 +164,38 @@ func (db *mongoUserDb) FindPatientByEmail(ctx context.Context, email string) (Pa
 	return patient, nil
 }
 
+// AnonymizePatient erases personal fields of the patient and marks it as
+// deleted. The email is replaced by a unique placeholder, so that the unique
+// index holds and the original address can be registered again.
+func (db *mongoUserDb) AnonymizePatient(
+	ctx context.Context,
+	id uuid.UUID,
+	retainUntil time.Time,
+) (Patient, error) {
+	filter := bson.M{"_id": id, "deletedAt": nil}
+	update := bson.M{
+		"$set": bson.M{
+			"email":       fmt.Sprintf("anonymized-%s@invalid", id),
+			"firstName":   AnonymizedPatientName,
+			"lastName":    AnonymizedPatientName,
+			"deletedAt":   time.Now(),
+			"retainUntil": retainUntil,
+		},
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var patient Patient
+	err := db.patients.FindOneAndUpdate(ctx, filter, update, opts).Decode(&patient)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return Patient{}, ErrNotFound
+		}
+		return Patient{}, fmt.Errorf("AnonymizePatient failed: %w", err)
+	}
+
+	return patient, nil
+}
+
 func (m *mongoUserDb) CreateDoctor(ctx context.Context, doctor Doctor) (Doctor, error) {
 	doctor.Id = uuid.New()
 
@@ -187,8 +246,20 @@ func (m *mongoUserDb) DoctorByEmail(ctx context.Context, email string) (Doctor,
 }
 
 func (m *mongoUserDb) GetAllDoctors(ctx context.Context) ([]Doctor, error) {
+	return m.DoctorsBySpecialization(ctx, nil)
+}
+
+// DoctorsBySpecialization returns doctors with the specialization, or all
+// doctors if it is nil, sorted by their name.
+func (m *mongoUserDb) DoctorsBySpecialization(
+	ctx context.Context,
+	specialization *string,
+) ([]Doctor, error) {
 	doctors := make([]Doctor, 0)
 	filter := bson.M{}
+	if specialization != nil {
+		filter["specialization"] = *specialization
+	}
 	findOptions := options.Find().SetSort(
 		bson.D{{Key: "lastName", Value: 1}, {Key: "firstName", Value: 1}},
 	)
@@ -198,23 +269,70 @@ func (m *mongoUserDb) GetAllDoctors(ctx context.Context) ([]Doctor, error) {
 		if errors.Is(err, mongo.ErrNoDocuments) {
 			return doctors, nil
 		}
-		return nil, fmt.Errorf("GetAllDoctors find failed: %w", err)
+		return nil, fmt.Errorf("DoctorsBySpecialization find failed: %w", err)
 	}
 
 	defer func() {
 		if cerr := cursor.Close(ctx); cerr != nil {
-			slog.Warn("Failed to close doctors cursor", "error", cerr.Error())
+			slog.WarnContext(ctx, "Failed to close doctors cursor", "error", cerr.Error())
 		}
 	}()
 
 	if err = cursor.All(ctx, &doctors); err != nil {
-		slog.Error("Failed to decode doctor documents from cursor", "error", err)
-		return nil, fmt.Errorf("GetAllDoctors decode failed: %w", err)
+		slog.ErrorContext(ctx, "Failed to decode doctor documents from cursor", "error", err)
+		return nil, fmt.Errorf("DoctorsBySpecialization decode failed: %w", err)
 	}
 
 	if err = cursor.Err(); err != nil {
-		slog.Error("Doctors cursor iteration error", "error", err)
-		return nil, fmt.Errorf("GetAllDoctors cursor error: %w", err)
+		slog.ErrorContext(ctx, "Doctors cursor iteration error", "error", err)
+		return nil, fmt.Errorf("DoctorsBySpecialization cursor error: %w", err)
+	}
+
+	return doctors, nil
+}
+
+// PatientsByIds returns patients with the ids whose personal data were not
+// erased, unknown ids are skipped.
+func (db *mongoUserDb) PatientsByIds(ctx context.Context, ids []uuid.UUID) ([]Patient, error) {
+	patients := make([]Patient, 0, len(ids))
+	filter := bson.M{"_id": bson.M{"$in": ids}, "deletedAt": nil}
+
+	cursor, err := db.patients.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("PatientsByIds find failed: %w", err)
+	}
+
+	defer func() {
+		if cerr := cursor.Close(ctx); cerr != nil {
+			slog.WarnContext(ctx, "Failed to close patients by ids cursor", "error", cerr.Error())
+		}
+	}()
+
+	if err = cursor.All(ctx, &patients); err != nil {
+		return nil, fmt.Errorf("PatientsByIds decode failed: %w", err)
+	}
+
+	return patients, nil
+}
+
+// DoctorsByIds returns doctors with the ids, unknown ids are skipped.
+func (m *mongoUserDb) DoctorsByIds(ctx context.Context, ids []uuid.UUID) ([]Doctor, error) {
+	doctors := make([]Doctor, 0, len(ids))
+	filter := bson.M{"_id": bson.M{"$in": ids}}
+
+	cursor, err := m.doctors.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("DoctorsByIds find failed: %w", err)
+	}
+
+	defer func() {
+		if cerr := cursor.Close(ctx); cerr != nil {
+			slog.WarnContext(ctx, "Failed to close doctors by ids cursor", "error", cerr.Error())
+		}
+	}()
+
+	if err = cursor.All(ctx, &doctors); err != nil {
+		return nil, fmt.Errorf("DoctorsByIds decode failed: %w", err)
 	}
 
 	return doctors, nil
@@ -5,22 +5,66 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"time"
 
 	"github.com/go-chi/httplog/v2"
+	"github.com/google/uuid"
 
 	"github.com/Nesquiko/aass/common/server"
 	commonapi "github.com/Nesquiko/aass/common/server/api"
 	"github.com/Nesquiko/aass/user-service/api"
+	appointmentapi "github.com/Nesquiko/aass/user-service/appointment-api"
+	medicalapi "github.com/Nesquiko/aass/user-service/medical-api"
+	resourceapi "github.com/Nesquiko/aass/user-service/resources-api"
 )
 
-type userServer struct{ db mongoUserDb }
+type userServer struct {
+	db          mongoUserDb
+	medicalApi  *medicalapi.ClientWithResponses
+	apptApi     *appointmentapi.ClientWithResponses
+	resourceApi *resourceapi.ClientWithResponses
+}
+
+// recordsRetentionYears is for how long are clinical records of an erased
+// patient retained.
+const recordsRetentionYears = 10
 
 func newUserServer(
 	db mongoUserDb,
+	clients *server.Clients,
 	logger *httplog.Logger,
 	opts commonapi.ChiServerOptions,
-) http.Handler {
-	srv := userServer{db: db}
+) (http.Handler, error) {
+	medicalUrl, medicalHttpClient := clients.Client(server.MedicalService)
+	medicalClient, err := medicalapi.NewClientWithResponses(
+		medicalUrl,
+		medicalapi.WithHTTPClient(medicalHttpClient),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("newUserServer medical client: %w", err)
+	}
+	apptUrl, apptHttpClient := clients.Client(server.AppointmentService)
+	apptClient, err := appointmentapi.NewClientWithResponses(
+		apptUrl,
+		appointmentapi.WithHTTPClient(apptHttpClient),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("newUserServer appointment client: %w", err)
+	}
+	resourceUrl, resourceHttpClient := clients.Client(server.ResourceService)
+	resourceClient, err := resourceapi.NewClientWithResponses(
+		resourceUrl,
+		resourceapi.WithHTTPClient(resourceHttpClient),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("newUserServer resource client: %w", err)
+	}
+	srv := userServer{
+		db:          db,
+		medicalApi:  medicalClient,
+		apptApi:     apptClient,
+		resourceApi: resourceClient,
+	}
 
 	middlewares := make([]api.MiddlewareFunc, len(opts.Middlewares))
 	for i, mid := range opts.Middlewares {
@@ -34,7 +78,7 @@ func newUserServer(
 		ErrorHandlerFunc: opts.ErrorHandlerFunc,
 	}
 
-	return api.HandlerWithOptions(srv, mappedOpts)
+	return api.HandlerWithOptions(srv, mappedOpts), nil
 }
 
 // GetDoctorById implements api.ServerInterface.
@@ -53,7 +97,14 @@ func (u userServer) GetDoctorById(w http.ResponseWriter, r *http.Request, doctor
 			server.EncodeError(w, apiErr)
 			return
 		}
-		slog.Error(server.UnexpectedError, "error", err.Error(), "where", "GetDoctorById")
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"GetDoctorById",
+		)
 		server.EncodeError(w, server.InternalServerError())
 		return
 	}
@@ -65,7 +116,14 @@ func (u userServer) GetDoctorById(w http.ResponseWriter, r *http.Request, doctor
 func (u userServer) GetDoctors(w http.ResponseWriter, r *http.Request) {
 	doctors, err := u.db.GetAllDoctors(r.Context())
 	if err != nil {
-		slog.Error(server.UnexpectedError, "error", err.Error(), "where", "GetDoctors")
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"GetDoctors",
+		)
 		server.EncodeError(w, server.InternalServerError())
 		return
 	}
@@ -84,6 +142,9 @@ func (u userServer) GetPatientById(
 	patientId api.PatientId,
 ) {
 	patient, err := u.db.FindPatientById(r.Context(), patientId)
+	if err == nil && patient.DeletedAt != nil {
+		err = ErrNotFound
+	}
 	if err != nil {
 		if errors.Is(err, ErrNotFound) {
 			apiErr := &server.ApiError{
@@ -97,7 +158,14 @@ func (u userServer) GetPatientById(
 			server.EncodeError(w, apiErr)
 			return
 		}
-		slog.Error(server.UnexpectedError, "error", err.Error(), "where", "GetPatientById")
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"GetPatientById",
+		)
 		server.EncodeError(w, server.InternalServerError())
 		return
 	}
@@ -105,6 +173,193 @@ func (u userServer) GetPatientById(
 	server.Encode(w, http.StatusOK, dataPatientToApiPatient(patient))
 }
 
+// GetUsersBatch implements api.ServerInterface.
+func (u userServer) GetUsersBatch(w http.ResponseWriter, r *http.Request) {
+	req, decodeErr := server.Decode[api.GetUsersBatchJSONRequestBody](w, r)
+	if decodeErr != nil {
+		server.EncodeError(w, decodeErr)
+		return
+	}
+
+	patients, err := u.db.PatientsByIds(r.Context(), req.Ids)
+	if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"GetUsersBatch patients",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+
+	doctors, err := u.db.DoctorsByIds(r.Context(), req.Ids)
+	if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"GetUsersBatch doctors",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+
+	server.Encode(w, http.StatusOK, api.UsersBatch{
+		Patients: server.Map(patients, dataPatientToApiPatient),
+		Doctors:  server.Map(doctors, dataDoctorToApiDoctor),
+	})
+}
+
+// ErasePatient implements api.ServerInterface.
+func (u userServer) ErasePatient(w http.ResponseWriter, r *http.Request, patientId api.PatientId) {
+	retainUntil := time.Now().AddDate(recordsRetentionYears, 0, 0)
+
+	_, err := u.db.AnonymizePatient(r.Context(), patientId, retainUntil)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			server.EncodeError(w, server.NotFoundId("Patient", patientId))
+			return
+		}
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"ErasePatient",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ExportPatientData implements api.ServerInterface.
+func (u userServer) ExportPatientData(
+	w http.ResponseWriter,
+	r *http.Request,
+	patientId api.PatientId,
+) {
+	ctx := r.Context()
+	patient, err := u.db.FindPatientById(ctx, patientId)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			server.EncodeError(w, server.NotFoundId("Patient", patientId))
+			return
+		}
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"ExportPatientData",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+
+	medicalResp, err := u.medicalApi.ExportPatientMedicalRecordsWithResponse(ctx, patientId)
+	if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"ExportPatientData medical",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+	if medicalResp.JSON200 == nil {
+		slog.ErrorContext(
+			r.Context(),
+			"failed to export medical records",
+			"status",
+			medicalResp.StatusCode(),
+			"patientId",
+			patientId.String(),
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+
+	apptResp, err := u.apptApi.ExportPatientAppointmentsWithResponse(ctx, patientId)
+	if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"ExportPatientData appointments",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+	if apptResp.JSON200 == nil {
+		slog.ErrorContext(
+			r.Context(),
+			"failed to export appointments",
+			"status",
+			apptResp.StatusCode(),
+			"patientId",
+			patientId.String(),
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+
+	appointmentIds := make([]uuid.UUID, len(apptResp.JSON200.Appointments))
+	for i, appt := range apptResp.JSON200.Appointments {
+		appointmentIds[i] = appt.Id
+	}
+	resResp, err := u.resourceApi.ExportAppointmentsReservationsWithResponse(
+		ctx,
+		resourceapi.ExportAppointmentsReservationsJSONRequestBody{AppointmentIds: appointmentIds},
+	)
+	if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"ExportPatientData reservations",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+	if resResp.JSON200 == nil {
+		slog.ErrorContext(
+			r.Context(),
+			"failed to export reservations",
+			"status",
+			resResp.StatusCode(),
+			"patientId",
+			patientId.String(),
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+
+	server.Encode(w, http.StatusOK, api.PatientDataExport{
+		ExportedAt:    time.Now(),
+		Patient:       dataPatientToPatientRecord(patient),
+		Conditions:    medicalResp.JSON200.Conditions,
+		Prescriptions: medicalResp.JSON200.Prescriptions,
+		Appointments:  apptResp.JSON200.Appointments,
+		Reservations:  resResp.JSON200.Reservations,
+	})
+}
+
 // LoginUser implements api.ServerInterface.
 func (u userServer) LoginUser(w http.ResponseWriter, r *http.Request) {
 	req, decodeErr := server.Decode[api.LoginUserJSONBody](w, r)
@@ -127,7 +382,16 @@ func (u userServer) LoginUser(w http.ResponseWriter, r *http.Request) {
 			server.EncodeError(w, apiErr)
 			return
 		} else if err != nil {
-			slog.Error(server.UnexpectedError, "error", err.Error(), "where", "GetPatientById", "role", "doctor")
+			slog.ErrorContext(
+				r.Context(),
+				server.UnexpectedError,
+				"error",
+				err.Error(),
+				"where",
+				"GetPatientById",
+				"role",
+				"doctor",
+			)
 			server.EncodeError(w, server.InternalServerError())
 			return
 		}
@@ -149,7 +413,16 @@ func (u userServer) LoginUser(w http.ResponseWriter, r *http.Request) {
 		server.EncodeError(w, apiErr)
 		return
 	} else if err != nil {
-		slog.Error(server.UnexpectedError, "error", err.Error(), "where", "GetPatientById", "role", "patient")
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"GetPatientById",
+			"role",
+			"patient",
+		)
 		server.EncodeError(w, server.InternalServerError())
 		return
 	}
@@ -189,7 +462,16 @@ func (u userServer) RegisterUser(w http.ResponseWriter, r *http.Request) {
 			server.EncodeError(w, apiErr)
 			return
 		} else if err != nil {
-			slog.Error(server.UnexpectedError, "error", err.Error(), "where", "RegisterUser", "role", "doctor")
+			slog.ErrorContext(
+				r.Context(),
+				server.UnexpectedError,
+				"error",
+				err.Error(),
+				"where",
+				"RegisterUser",
+				"role",
+				"doctor",
+			)
 			server.EncodeError(w, server.InternalServerError())
 			return
 		}
@@ -215,7 +497,16 @@ func (u userServer) RegisterUser(w http.ResponseWriter, r *http.Request) {
 		server.EncodeError(w, apiErr)
 		return
 	} else if err != nil {
-		slog.Error(server.UnexpectedError, "error", err.Error(), "where", "RegisterUser", "role", "patient")
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"RegisterUser",
+			"role",
+			"patient",
+		)
 		server.EncodeError(w, server.InternalServerError())
 		return
 	}
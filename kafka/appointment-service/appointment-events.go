@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/IBM/sarama"
+
+	"github.com/Nesquiko/aass/appointment-service/api"
+	"github.com/Nesquiko/aass/common/audit"
+	"github.com/Nesquiko/aass/common/server"
+)
+
+// Topics of appointment transitions other than scheduling, their messages
+// are AppointmentEvent values.
+const (
+	AppointmentDeniedTopic      = "appointment-denied"
+	AppointmentCancelledTopic   = "appointment-cancelled"
+	AppointmentRescheduledTopic = "appointment-rescheduled"
+)
+
+// AppointmentEvent is the appointment after a transition and who caused it.
+type AppointmentEvent struct {
+	Appointment api.Appointment `json:"appointment"`
+	// Actor is the identifier of the user who caused the transition.
+	Actor string `json:"actor"`
+}
+
+// publishAppointmentEvent sends the transition of the appointment to the
+// topic. The transition already happened, so failures are only logged.
+func (a appointmentServer) publishAppointmentEvent(
+	ctx context.Context,
+	topic string,
+	apptData Appointment,
+) {
+	apiAppt, apiErr := a.mapDataApptToApiAppt(ctx, apptData)
+	if apiErr != nil {
+		slog.ErrorContext(ctx, "Failed to map appointment event", "error", apiErr, "topic", topic)
+		return
+	}
+
+	eventValue, err := json.Marshal(AppointmentEvent{
+		Appointment: apiAppt,
+		Actor:       audit.MetaFrom(ctx).Actor,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to marshal appointment event", "error", err, "topic", topic)
+		return
+	}
+
+	msg := &sarama.ProducerMessage{
+		Topic: topic,
+		Key:   sarama.StringEncoder(apiAppt.Id.String()),
+		Value: sarama.ByteEncoder(eventValue),
+	}
+	if _, _, err = server.SendMessage(ctx, a.kafkaProducer, msg); err != nil {
+		slog.ErrorContext(ctx, "Failed to send appointment event to Kafka",
+			"error", err,
+			"appointmentId", apiAppt.Id,
+			"topic", topic,
+		)
+		return
+	}
+	slog.InfoContext(ctx, "Successfully sent appointment event to Kafka",
+		"appointmentId", apiAppt.Id,
+		"topic", topic,
+	)
+}
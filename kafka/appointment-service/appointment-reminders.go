@@ -0,0 +1,31 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+)
+
+// scheduleReminders replaces reminders of the appointment with ones before
+// its current time, they are sent by the reminder-worker. The appointment
+// was already changed, so failures are only logged.
+func (a appointmentServer) scheduleReminders(ctx context.Context, appt Appointment) {
+	err := a.db.reminders.Schedule(ctx, appt.Id, appt.AppointmentDateTime)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to schedule reminders",
+			"appointmentId", appt.Id,
+			"error", err,
+		)
+	}
+}
+
+func (a appointmentServer) cancelReminders(ctx context.Context, appointmentId uuid.UUID) {
+	err := a.db.reminders.Cancel(ctx, appointmentId)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to cancel reminders",
+			"appointmentId", appointmentId,
+			"error", err,
+		)
+	}
+}
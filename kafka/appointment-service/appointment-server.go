@@ -17,28 +17,65 @@ import (
 
 	"github.com/Nesquiko/aass/appointment-service/api"
 	medicalapi "github.com/Nesquiko/aass/appointment-service/medical-api"
+	resourceapi "github.com/Nesquiko/aass/appointment-service/resources-api"
 	userapi "github.com/Nesquiko/aass/appointment-service/user-api"
+	"github.com/Nesquiko/aass/common/audit"
 	"github.com/Nesquiko/aass/common/server"
 	commonapi "github.com/Nesquiko/aass/common/server/api"
 )
 
+const (
+	auditActionAppointmentDecide = "appointment.decide"
+	auditActionAppointmentCancel = "appointment.cancel"
+	auditActionRecordsExport     = "appointments.export"
+)
+
 type appointmentServer struct {
 	db            mongoAppointmentDb
 	medicalApi    *medicalapi.ClientWithResponses
+	resourceApi   *resourceapi.ClientWithResponses
 	userApi       *userapi.ClientWithResponses
 	kafka         sarama.Client
 	kafkaProducer sarama.SyncProducer
+	readModel     *readModel
 }
 
 const AppointmentScheduledTopic = "appointment-scheduled"
 
 func newAppointmentServer(
 	db mongoAppointmentDb,
+	clients *server.Clients,
 	logger *httplog.Logger,
 	opts commonapi.ChiServerOptions,
-) http.Handler {
-	medicalClient, _ := medicalapi.NewClientWithResponses("http://medical-service:8080/")
-	userClient, _ := userapi.NewClientWithResponses("http://user-service:8080/")
+) (http.Handler, error) {
+	medicalUrl, medicalHttpClient := clients.Client(server.MedicalService)
+	medicalClient, err := medicalapi.NewClientWithResponses(
+		medicalUrl,
+		medicalapi.WithHTTPClient(medicalHttpClient),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("newAppointmentServer medical client: %w", err)
+	}
+	resourceUrl, resourceHttpClient := clients.Client(server.ResourceService)
+	resourceClient, err := resourceapi.NewClientWithResponses(
+		resourceUrl,
+		resourceapi.WithHTTPClient(resourceHttpClient),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("newAppointmentServer resource client: %w", err)
+	}
+	userUrl, userHttpClient := clients.Client(server.UserService)
+	userClient, err := userapi.NewClientWithResponses(
+		userUrl,
+		userapi.WithHTTPClient(userHttpClient),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("newAppointmentServer user client: %w", err)
+	}
+	readModelCfg, err := loadReadModelConfig(serviceEnvPrefix)
+	if err != nil {
+		return nil, fmt.Errorf("newAppointmentServer: %w", err)
+	}
 
 	kafkaClient, err := server.InitKafka(AppointmentScheduledTopic)
 	if err != nil {
@@ -55,15 +92,19 @@ func newAppointmentServer(
 	srv := appointmentServer{
 		db:            db,
 		medicalApi:    medicalClient,
+		resourceApi:   resourceClient,
 		userApi:       userClient,
 		kafka:         kafkaClient,
 		kafkaProducer: kafkaProducer,
+		readModel:     newReadModel(readModelCfg),
 	}
+	go srv.syncEvery(context.Background(), readModelCfg.SyncInterval)
 
-	middlewares := make([]api.MiddlewareFunc, len(opts.Middlewares))
+	middlewares := make([]api.MiddlewareFunc, len(opts.Middlewares), len(opts.Middlewares)+1)
 	for i, mid := range opts.Middlewares {
 		middlewares[i] = api.MiddlewareFunc(mid)
 	}
+	middlewares = append(middlewares, staleFieldsMiddleware)
 
 	mappedOpts := api.ChiServerOptions{
 		BaseURL:          opts.BaseURL,
@@ -78,7 +119,7 @@ func newAppointmentServer(
 		[]string{"resource-reserved"},
 	)
 
-	return api.HandlerWithOptions(srv, mappedOpts)
+	return api.HandlerWithOptions(srv, mappedOpts), nil
 }
 
 // AppointmentById implements api.ServerInterface.
@@ -93,7 +134,14 @@ func (a appointmentServer) AppointmentById(
 		server.EncodeError(w, server.NotFoundId("Appointment", appointmentId))
 		return
 	} else if err != nil {
-		slog.Error(server.UnexpectedError, "error", err.Error(), "where", "AppointmentById db")
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"AppointmentById db",
+		)
 		server.EncodeError(w, server.InternalServerError())
 		return
 	}
@@ -104,6 +152,7 @@ func (a appointmentServer) AppointmentById(
 		return
 	}
 
+	server.SetETag(w, apptData.Version)
 	server.Encode(w, http.StatusOK, apiAppt)
 }
 
@@ -116,7 +165,8 @@ func (a appointmentServer) AppointmentsByConditionId(
 	ctx := r.Context()
 	apptsData, err := a.db.AppointmentsByConditionId(ctx, conditionId)
 	if err != nil {
-		slog.Error(
+		slog.ErrorContext(
+			r.Context(),
 			server.UnexpectedError,
 			"error",
 			err.Error(),
@@ -127,14 +177,10 @@ func (a appointmentServer) AppointmentsByConditionId(
 		return
 	}
 
-	apiAppts := make([]api.AppointmentDisplay, 0, len(apptsData))
-	for _, apptData := range apptsData {
-		apiApptDisplay, apiErr := a.mapDataApptToApiApptDisplay(ctx, apptData)
-		if apiErr != nil {
-			server.EncodeError(w, apiErr)
-			return
-		}
-		apiAppts = append(apiAppts, apiApptDisplay)
+	apiAppts, apiErr := a.mapDataApptsToApiApptDisplays(ctx, apptsData)
+	if apiErr != nil {
+		server.EncodeError(w, apiErr)
+		return
 	}
 
 	server.Encode(w, http.StatusOK, api.Appointments{Appointments: &apiAppts})
@@ -153,12 +199,30 @@ func (a appointmentServer) CancelAppointment(
 		return
 	}
 
-	err := a.db.CancelAppointment(ctx, appointmentId, string(req.By), req.Reason)
+	before, err := a.db.AppointmentById(ctx, appointmentId)
+	if errors.Is(err, ErrNotFound) {
+		server.EncodeError(w, server.NotFoundId("Appointment", appointmentId))
+		return
+	} else if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"CancelAppointment get appt db",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+
+	err = a.db.CancelAppointment(ctx, appointmentId, string(req.By), req.Reason)
 	if errors.Is(err, ErrNotFound) {
 		server.EncodeError(w, server.NotFoundId("Appointment", appointmentId))
 		return
 	} else if err != nil {
-		slog.Error(
+		slog.ErrorContext(
+			r.Context(),
 			server.UnexpectedError,
 			"error",
 			err.Error(),
@@ -169,6 +233,37 @@ func (a appointmentServer) CancelAppointment(
 		return
 	}
 
+	after, err := a.db.AppointmentById(ctx, appointmentId)
+	if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"CancelAppointment get cancelled appt db",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+	appointmentsCancelled.Inc()
+	a.cancelReminders(ctx, appointmentId)
+	a.publishAppointmentEvent(ctx, AppointmentCancelledTopic, after)
+
+	err = a.db.audit.Record(ctx, auditActionAppointmentCancel, appointmentId, before, after)
+	if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"CancelAppointment audit",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+
 	w.WriteHeader(http.StatusNoContent)
 }
 
@@ -177,20 +272,28 @@ func (a appointmentServer) DecideAppointment(
 	w http.ResponseWriter,
 	r *http.Request,
 	appointmentId api.AppointmentId,
+	params api.DecideAppointmentParams,
 ) {
 	ctx := r.Context()
+	versions, preconditionErr := server.IfMatchVersions(params.IfMatch)
+	if preconditionErr != nil {
+		server.EncodeError(w, preconditionErr)
+		return
+	}
+
 	req, decodeErr := server.Decode[api.AppointmentDecision](w, r)
 	if decodeErr != nil {
 		server.EncodeError(w, decodeErr)
 		return
 	}
 
-	_, err := a.db.AppointmentById(ctx, appointmentId)
+	apptData, err := a.db.AppointmentById(ctx, appointmentId)
 	if errors.Is(err, ErrNotFound) {
 		server.EncodeError(w, server.NotFoundId("Appointment", appointmentId))
 		return
 	} else if err != nil {
-		slog.Error(
+		slog.ErrorContext(
+			r.Context(),
 			server.UnexpectedError,
 			"error",
 			err.Error(),
@@ -200,6 +303,11 @@ func (a appointmentServer) DecideAppointment(
 		server.EncodeError(w, server.InternalServerError())
 		return
 	}
+	version, preconditionErr := server.MatchVersion(apptData.Version, versions)
+	if preconditionErr != nil {
+		server.EncodeError(w, preconditionErr)
+		return
+	}
 
 	var updatedApptData Appointment
 
@@ -218,6 +326,7 @@ func (a appointmentServer) DecideAppointment(
 		updatedApptData, err = a.db.DecideAppointment(
 			ctx,
 			appointmentId,
+			version,
 			string(req.Action),
 			nil,
 			resources,
@@ -238,6 +347,7 @@ func (a appointmentServer) DecideAppointment(
 		updatedApptData, err = a.db.DecideAppointment(
 			ctx,
 			appointmentId,
+			version,
 			string(req.Action),
 			req.Reason,
 			nil,
@@ -254,8 +364,12 @@ func (a appointmentServer) DecideAppointment(
 		return
 	}
 
-	if err != nil {
-		slog.Error(
+	if errors.Is(err, ErrVersionMismatch) {
+		server.EncodeError(w, server.PreconditionFailed())
+		return
+	} else if err != nil {
+		slog.ErrorContext(
+			r.Context(),
 			server.UnexpectedError,
 			"error",
 			err.Error(),
@@ -265,6 +379,35 @@ func (a appointmentServer) DecideAppointment(
 		server.EncodeError(w, server.InternalServerError())
 		return
 	}
+	observeDecision(apptData, req.Action == api.Accept, time.Now())
+	if req.Action == api.Accept {
+		a.scheduleReminders(ctx, updatedApptData)
+	} else {
+		a.cancelReminders(ctx, appointmentId)
+	}
+	if req.Action == api.Reject {
+		a.publishAppointmentEvent(ctx, AppointmentDeniedTopic, updatedApptData)
+	}
+
+	err = a.db.audit.Record(
+		ctx,
+		auditActionAppointmentDecide,
+		appointmentId,
+		apptData,
+		updatedApptData,
+	)
+	if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"DecideAppointment audit",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
 
 	apiAppt, apiErr := a.mapDataApptToApiAppt(ctx, updatedApptData)
 	if apiErr != nil {
@@ -275,7 +418,12 @@ func (a appointmentServer) DecideAppointment(
 	if req.Action == api.Accept {
 		eventValue, marshalErr := mapApiApptToKafkaMessageValue(&apiAppt)
 		if marshalErr != nil {
-			slog.Error("Failed to marshal appointment event", "error", marshalErr)
+			slog.ErrorContext(
+				r.Context(),
+				"Failed to marshal appointment event",
+				"error",
+				marshalErr,
+			)
 		} else {
 			msg := &sarama.ProducerMessage{
 				Topic: AppointmentScheduledTopic,
@@ -283,14 +431,14 @@ func (a appointmentServer) DecideAppointment(
 				Value: sarama.ByteEncoder(eventValue),
 			}
 
-			_, _, sendErr := a.kafkaProducer.SendMessage(msg)
+			_, _, sendErr := server.SendMessage(ctx, a.kafkaProducer, msg)
 			if sendErr != nil {
-				slog.Error("Failed to send appointment scheduled event to Kafka",
+				slog.ErrorContext(ctx, "Failed to send appointment scheduled event to Kafka",
 					"error", sendErr,
 					"appointmentId", appointmentId,
 				)
 			} else {
-				slog.Info("Successfully sent appointment scheduled event to Kafka",
+				slog.InfoContext(ctx, "Successfully sent appointment scheduled event to Kafka",
 					"appointmentId", apiAppt.Id,
 					"value", string(eventValue),
 					"topic", AppointmentScheduledTopic,
@@ -299,6 +447,7 @@ func (a appointmentServer) DecideAppointment(
 		}
 	}
 
+	server.SetETag(w, updatedApptData.Version)
 	server.Encode(w, http.StatusOK, apiAppt)
 }
 
@@ -317,19 +466,22 @@ func (a appointmentServer) DoctorsCalendar(
 
 	apptsData, err := a.db.AppointmentsByDoctorId(ctx, doctorId, params.From.Time, to)
 	if err != nil {
-		slog.Error(server.UnexpectedError, "error", err.Error(), "where", "DoctorsCalendar db")
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"DoctorsCalendar db",
+		)
 		server.EncodeError(w, server.InternalServerError())
 		return
 	}
 
-	apiAppts := make([]api.AppointmentDisplay, 0, len(apptsData))
-	for _, apptData := range apptsData {
-		apiApptDisplay, apiErr := a.mapDataApptToApiApptDisplay(ctx, apptData)
-		if apiErr != nil {
-			server.EncodeError(w, apiErr)
-			return
-		}
-		apiAppts = append(apiAppts, apiApptDisplay)
+	apiAppts, apiErr := a.mapDataApptsToApiApptDisplays(ctx, apptsData)
+	if apiErr != nil {
+		server.EncodeError(w, apiErr)
+		return
 	}
 
 	server.Encode(w, http.StatusOK, api.Appointments{Appointments: &apiAppts})
@@ -347,7 +499,8 @@ func (a appointmentServer) DoctorsTimeslots(
 
 	apptsData, err := a.db.AppointmentsByDoctorIdAndDate(ctx, doctorId, date)
 	if err != nil {
-		slog.Error(
+		slog.ErrorContext(
+			r.Context(),
 			server.UnexpectedError,
 			"error",
 			err.Error(),
@@ -388,6 +541,37 @@ func (a appointmentServer) DoctorsTimeslots(
 	server.Encode(w, http.StatusOK, api.DoctorTimeslots{Slots: allSlots})
 }
 
+// DoctorsBusyIntervals implements api.ServerInterface.
+func (a appointmentServer) DoctorsBusyIntervals(
+	w http.ResponseWriter,
+	r *http.Request,
+	params api.DoctorsBusyIntervalsParams,
+) {
+	busy, err := a.db.BusyIntervalsByDoctorIds(r.Context(), params.DoctorIds, params.From, params.To)
+	if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"DoctorsBusyIntervals",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+
+	doctors := make([]api.DoctorBusyIntervals, len(params.DoctorIds))
+	for i, doctorId := range params.DoctorIds {
+		doctors[i] = api.DoctorBusyIntervals{
+			DoctorId: doctorId,
+			Busy:     server.Map(busy[doctorId], dataIntervalToApiInterval),
+		}
+	}
+
+	server.Encode(w, http.StatusOK, api.DoctorsBusyIntervals{Doctors: doctors})
+}
+
 // PatientsCalendar implements api.ServerInterface.
 func (a appointmentServer) PatientsCalendar(
 	w http.ResponseWriter,
@@ -403,24 +587,65 @@ func (a appointmentServer) PatientsCalendar(
 
 	apptsData, err := a.db.AppointmentsByPatientId(ctx, patientId, params.From.Time, to)
 	if err != nil {
-		slog.Error(server.UnexpectedError, "error", err.Error(), "where", "PatientsCalendar db")
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"PatientsCalendar db",
+		)
 		server.EncodeError(w, server.InternalServerError())
 		return
 	}
 
-	apiAppts := make([]api.AppointmentDisplay, 0, len(apptsData))
-	for _, apptData := range apptsData {
-		apiApptDisplay, apiErr := a.mapDataApptToApiApptDisplay(ctx, apptData)
-		if apiErr != nil {
-			server.EncodeError(w, apiErr)
-			return
-		}
-		apiAppts = append(apiAppts, apiApptDisplay)
+	apiAppts, apiErr := a.mapDataApptsToApiApptDisplays(ctx, apptsData)
+	if apiErr != nil {
+		server.EncodeError(w, apiErr)
+		return
 	}
 
 	server.Encode(w, http.StatusOK, api.Appointments{Appointments: &apiAppts})
 }
 
+// ExportPatientAppointments implements api.ServerInterface.
+func (a appointmentServer) ExportPatientAppointments(
+	w http.ResponseWriter,
+	r *http.Request,
+	patientId api.PatientId,
+) {
+	apptsData, err := a.db.AllAppointmentsByPatientId(r.Context(), patientId)
+	if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"ExportPatientAppointments",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+
+	err = a.db.audit.Record(r.Context(), auditActionRecordsExport, patientId, nil, nil)
+	if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"ExportPatientAppointments audit",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+	server.Encode(w, http.StatusOK, api.AppointmentRecordsExport{
+		Appointments: server.Map(apptsData, dataApptToApptRecord),
+	})
+}
+
 // RequestAppointment implements api.ServerInterface.
 func (a appointmentServer) RequestAppointment(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -432,7 +657,14 @@ func (a appointmentServer) RequestAppointment(w http.ResponseWriter, r *http.Req
 
 	res, patientErr := a.userApi.GetPatientByIdWithResponse(ctx, req.PatientId)
 	if patientErr != nil || res.StatusCode() != http.StatusOK {
-		slog.Error("failed to get patient", "error", patientErr, "status", res.StatusCode())
+		slog.ErrorContext(
+			r.Context(),
+			"failed to get patient",
+			"error",
+			patientErr,
+			"status",
+			res.StatusCode(),
+		)
 		if patientErr != nil && res.ApplicationproblemJSON404 != nil {
 			server.EncodeError(w, server.NotFoundId("Patient", req.PatientId))
 			return
@@ -443,7 +675,14 @@ func (a appointmentServer) RequestAppointment(w http.ResponseWriter, r *http.Req
 
 	res2, doctorErr := a.userApi.GetDoctorByIdWithResponse(ctx, req.DoctorId)
 	if doctorErr != nil || res2.StatusCode() != http.StatusOK {
-		slog.Error("failed to get doctor", "error", doctorErr, "status", res2.StatusCode())
+		slog.ErrorContext(
+			r.Context(),
+			"failed to get doctor",
+			"error",
+			doctorErr,
+			"status",
+			res2.StatusCode(),
+		)
 		if doctorErr != nil && res2.ApplicationproblemJSON404 != nil {
 			server.EncodeError(w, server.NotFoundId("Doctor", req.DoctorId))
 			return
@@ -455,7 +694,8 @@ func (a appointmentServer) RequestAppointment(w http.ResponseWriter, r *http.Req
 	if req.ConditionId != nil {
 		res3, conditionErr := a.medicalApi.ConditionDetailWithResponse(ctx, *req.ConditionId)
 		if conditionErr != nil || res3.StatusCode() != http.StatusOK {
-			slog.Error(
+			slog.ErrorContext(
+				r.Context(),
 				"failed to get condition",
 				"error",
 				conditionErr,
@@ -501,7 +741,8 @@ func (a appointmentServer) RequestAppointment(w http.ResponseWriter, r *http.Req
 		})
 		return
 	} else if err != nil {
-		slog.Error(
+		slog.ErrorContext(
+			r.Context(),
 			server.UnexpectedError,
 			"error",
 			err.Error(),
@@ -511,6 +752,8 @@ func (a appointmentServer) RequestAppointment(w http.ResponseWriter, r *http.Req
 		server.EncodeError(w, server.InternalServerError())
 		return
 	}
+	appointmentsRequested.Inc()
+	a.scheduleReminders(ctx, createdApptData)
 
 	apiAppt, apiErr := a.mapDataApptToApiAppt(ctx, createdApptData)
 	if apiErr != nil {
@@ -526,22 +769,68 @@ func (a appointmentServer) RescheduleAppointment(
 	w http.ResponseWriter,
 	r *http.Request,
 	appointmentId api.AppointmentId,
+	params api.RescheduleAppointmentParams,
 ) {
 	ctx := r.Context()
+	versions, preconditionErr := server.IfMatchVersions(params.IfMatch)
+	if preconditionErr != nil {
+		server.EncodeError(w, preconditionErr)
+		return
+	}
+
 	req, decodeErr := server.Decode[api.AppointmentReschedule](w, r)
 	if decodeErr != nil {
 		server.EncodeError(w, decodeErr)
 		return
 	}
+	// the reschedule is versioned, the versions from If-Match are resolved to
+	// the current one
+	apptData, err := a.db.AppointmentById(ctx, appointmentId)
+	if errors.Is(err, ErrNotFound) {
+		server.EncodeError(w, server.NotFoundId("Appointment", appointmentId))
+		return
+	} else if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"RescheduleAppointment get appt db",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+	version, preconditionErr := server.MatchVersion(apptData.Version, versions)
+	if preconditionErr != nil {
+		server.EncodeError(w, preconditionErr)
+		return
+	}
 
-	updatedApptData, err := a.db.RescheduleAppointment(
-		ctx,
-		appointmentId,
-		req.NewAppointmentDateTime,
-	)
+	keepReservations := req.KeepReservations != nil && *req.KeepReservations
+	var updatedApptData Appointment
+	var conflicts []api.ReservationConflict
+	if keepReservations {
+		updatedApptData, conflicts, err = a.rescheduleKeepingReservations(
+			ctx,
+			appointmentId,
+			version,
+			req.NewAppointmentDateTime,
+		)
+	} else {
+		updatedApptData, err = a.db.RescheduleAppointment(
+			ctx,
+			appointmentId,
+			version,
+			req.NewAppointmentDateTime,
+		)
+	}
 	if errors.Is(err, ErrNotFound) {
 		server.EncodeError(w, server.NotFoundId("Appointment", appointmentId))
 		return
+	} else if errors.Is(err, ErrVersionMismatch) {
+		server.EncodeError(w, server.PreconditionFailed())
+		return
 	} else if errors.Is(err, ErrDoctorUnavailable) {
 		server.EncodeError(w, &server.ApiError{
 			ErrorDetail: commonapi.ErrorDetail{
@@ -553,7 +842,8 @@ func (a appointmentServer) RescheduleAppointment(
 		})
 		return
 	} else if err != nil {
-		slog.Error(
+		slog.ErrorContext(
+			r.Context(),
 			server.UnexpectedError,
 			"error",
 			err.Error(),
@@ -563,16 +853,95 @@ func (a appointmentServer) RescheduleAppointment(
 		server.EncodeError(w, server.InternalServerError())
 		return
 	}
+	a.scheduleReminders(ctx, updatedApptData)
+
+	a.publishAppointmentEvent(ctx, AppointmentRescheduledTopic, updatedApptData)
 
 	apiAppt, apiErr := a.mapDataApptToApiAppt(ctx, updatedApptData)
 	if apiErr != nil {
 		server.EncodeError(w, apiErr)
 		return
 	}
+	if keepReservations {
+		apiAppt.ReservationConflicts = &conflicts
+	}
 
+	server.SetETag(w, updatedApptData.Version)
 	server.Encode(w, http.StatusOK, apiAppt)
 }
 
+// rescheduleKeepingReservations moves the appointment to newDateTime keeping
+// its status and asks the resource service to move its reservations along.
+// If some resource is taken at the new time, the resource service releases
+// all of the reservations and the appointment is sent back to the requested
+// state without resources, the taken ones are returned as conflicts.
+func (a appointmentServer) rescheduleKeepingReservations(
+	ctx context.Context,
+	appointmentId uuid.UUID,
+	version int64,
+	newDateTime time.Time,
+) (Appointment, []api.ReservationConflict, error) {
+	conflicts := make([]api.ReservationConflict, 0)
+	appt, err := a.db.RescheduleAppointmentKeepingStatus(
+		ctx,
+		appointmentId,
+		version,
+		newDateTime,
+	)
+	if err != nil {
+		return Appointment{}, nil, fmt.Errorf("rescheduleKeepingReservations: %w", err)
+	}
+	if len(appt.Facilities) == 0 && len(appt.Equipment) == 0 && len(appt.Medicines) == 0 {
+		return appt, conflicts, nil
+	}
+
+	moveResp, err := a.resourceApi.MoveAppointmentReservationsWithResponse(
+		ctx,
+		appointmentId,
+		resourceapi.MoveAppointmentReservationsJSONRequestBody{Start: newDateTime},
+	)
+	if err != nil {
+		return Appointment{}, nil, fmt.Errorf(
+			"rescheduleKeepingReservations move reservations api call: %w",
+			err,
+		)
+	} else if moveResp.JSON200 == nil {
+		return Appointment{}, nil, fmt.Errorf(
+			"rescheduleKeepingReservations move reservations failed with status %d: %s",
+			moveResp.StatusCode(),
+			string(moveResp.Body),
+		)
+	}
+	if len(moveResp.JSON200.Conflicts) == 0 {
+		return appt, conflicts, nil
+	}
+
+	for _, res := range moveResp.JSON200.Conflicts {
+		conflicts = append(conflicts, api.ReservationConflict{
+			Id:   *res.Id,
+			Name: res.Name,
+			Type: api.ResourceType(res.Type),
+		})
+	}
+
+	appt, err = a.db.RescheduleAppointment(ctx, appointmentId, appt.Version, newDateTime)
+	if err != nil {
+		return Appointment{}, nil, fmt.Errorf("rescheduleKeepingReservations: %w", err)
+	}
+	appt, err = a.db.UpdateAppointmentResources(
+		ctx,
+		appointmentId,
+		[]Resource{},
+		[]Resource{},
+		[]Resource{},
+	)
+	if err != nil {
+		return Appointment{}, nil, fmt.Errorf("rescheduleKeepingReservations: %w", err)
+	}
+
+	return appt, conflicts, nil
+}
+
 // UpdateAppointmentResources implements api.ServerInterface.
 func (a appointmentServer) UpdateAppointmentResources(
 	w http.ResponseWriter,
@@ -592,7 +961,8 @@ func (a appointmentServer) UpdateAppointmentResources(
 		server.EncodeError(w, server.NotFoundId("Appointment", appointmentId))
 		return
 	} else if err != nil {
-		slog.Error(
+		slog.ErrorContext(
+			r.Context(),
 			server.UnexpectedError,
 			"error",
 			err.Error(),
@@ -656,7 +1026,8 @@ func (a appointmentServer) UpdateAppointmentResources(
 			server.EncodeError(w, server.NotFoundId("Appointment", appointmentId))
 			return
 		}
-		slog.Error(
+		slog.ErrorContext(
+			r.Context(),
 			server.UnexpectedError,
 			"error",
 			err.Error(),
@@ -701,16 +1072,14 @@ type ReservedResources struct {
 	Medicine  *ReservedResource `json:"medicine,omitempty"`
 }
 
-func (s appointmentServer) resourcesReservedConsumer(value []byte) {
-	ctx := context.Background()
+func (s appointmentServer) resourcesReservedConsumer(ctx context.Context, value []byte) error {
 	var reserved ReservedResources
 	dec := json.NewDecoder(bytes.NewReader(value))
 	err := dec.Decode(&reserved)
 	if err != nil {
-		slog.Error("Consumer error decoding value", "error", err.Error())
-		return
+		return fmt.Errorf("resourcesReservedConsumer decoding value: %w", err)
 	}
-	slog.Info("Consuming reserved resources event", "reserved", reserved)
+	slog.InfoContext(ctx, "Consuming reserved resources event", "reserved", reserved)
 
 	equipment := []Resource{}
 	facilities := []Resource{}
@@ -748,6 +1117,28 @@ func (s appointmentServer) resourcesReservedConsumer(value []byte) {
 		medicine,
 	)
 	if err != nil {
-		slog.Error("Error during updating appointment resources", "error", err.Error())
+		return fmt.Errorf("resourcesReservedConsumer updating appointment resources: %w", err)
+	}
+	return nil
+}
+
+// AuditEvents implements api.ServerInterface.
+func (a appointmentServer) AuditEvents(
+	w http.ResponseWriter,
+	r *http.Request,
+	params api.AuditEventsParams,
+) {
+	filter := audit.Filter{
+		TargetId: params.TargetId,
+		Actor:    params.Actor,
+		From:     params.From,
+		To:       params.To,
+	}
+	if params.AfterSeq != nil {
+		filter.AfterSeq = *params.AfterSeq
+	}
+	if params.Limit != nil {
+		filter.Limit = *params.Limit
 	}
+	server.AuditEvents(w, r, a.db.audit, filter)
 }
@@ -11,6 +11,8 @@ import (
 	"github.com/Nesquiko/aass/common/server"
 )
 
+const serviceEnvPrefix = "APPOINTMENTSERVICE"
+
 func main() {
 	ctx := context.Background()
 
@@ -23,7 +25,7 @@ func main() {
 	var dbProvider server.MongoDbProvider[mongoAppointmentDb] = newMongoAppointmentDb
 	var serverProvider server.ServerProvider[mongoAppointmentDb] = newAppointmentServer
 
-	if err := server.Run(ctx, "appointment-service", "APPOINTMENTSERVICE", spec, serverProvider, dbProvider); err != nil {
+	if err := server.Run(ctx, "appointment-service", serviceEnvPrefix, spec, serverProvider, dbProvider); err != nil {
 		fmt.Fprintf(os.Stderr, "%s\n", err)
 		os.Exit(1)
 	}
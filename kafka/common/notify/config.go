@@ -0,0 +1,88 @@
+package notify
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	SenderLog     = "log"
+	SenderFile    = "file"
+	SenderSmtp    = "smtp"
+	SenderWebhook = "webhook"
+)
+
+type Config struct {
+	// Sender delivers notifications, one of Sender* values.
+	Sender       string        `mapstructure:"sender"`
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	MaxAttempts  int           `mapstructure:"max_attempts"`
+	// File is where the file sender appends notifications.
+	File string `mapstructure:"file"`
+
+	Smtp struct {
+		Host     string `mapstructure:"host"`
+		Port     string `mapstructure:"port"`
+		User     string `mapstructure:"user"`
+		Password string `mapstructure:"password"`
+		From     string `mapstructure:"from"`
+	} `mapstructure:"smtp"`
+
+	Webhook struct {
+		Url string `mapstructure:"url"`
+	} `mapstructure:"webhook"`
+}
+
+// LoadConfig reads the `<envPrefix>_NOTIFY_*` environment variables.
+func LoadConfig(envPrefix string) (Config, error) {
+	v := viper.New()
+
+	v.SetEnvPrefix(envPrefix)
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	v.AutomaticEnv()
+
+	v.SetDefault("notify.sender", SenderLog)
+	v.SetDefault("notify.poll_interval", 10*time.Second)
+	v.SetDefault("notify.max_attempts", 5)
+	v.SetDefault("notify.file", "notifications.jsonl")
+	v.SetDefault("notify.smtp.host", "")
+	v.SetDefault("notify.smtp.port", "587")
+	v.SetDefault("notify.smtp.user", "")
+	v.SetDefault("notify.smtp.password", "")
+	v.SetDefault("notify.smtp.from", "")
+	v.SetDefault("notify.webhook.url", "")
+
+	var cfg struct {
+		Notify Config `mapstructure:"notify"`
+	}
+	err := v.Unmarshal(&cfg)
+	if err != nil {
+		return Config{}, fmt.Errorf("LoadConfig failed to unmarshal config: %w", err)
+	}
+
+	return cfg.Notify, nil
+}
+
+func NewSender(cfg Config) (Sender, error) {
+	switch cfg.Sender {
+	case SenderLog:
+		return LogSender{}, nil
+	case SenderFile:
+		return NewFileSender(cfg.File), nil
+	case SenderSmtp:
+		return NewSmtpSender(
+			cfg.Smtp.Host,
+			cfg.Smtp.Port,
+			cfg.Smtp.User,
+			cfg.Smtp.Password,
+			cfg.Smtp.From,
+		), nil
+	case SenderWebhook:
+		return NewWebhookSender(cfg.Webhook.Url), nil
+	default:
+		return nil, fmt.Errorf("NewSender unknown sender %q", cfg.Sender)
+	}
+}
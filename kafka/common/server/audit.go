@@ -0,0 +1,64 @@
+package server
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/Nesquiko/aass/common/audit"
+	"github.com/Nesquiko/aass/common/server/api"
+)
+
+// AuditEvents serves a page of the audit log of a service, only to
+// administrators. The page is limited to audit.PageLimit events, if the
+// filter doesn't limit it.
+func AuditEvents(w http.ResponseWriter, r *http.Request, log audit.Log, filter audit.Filter) {
+	if !audit.MetaFrom(r.Context()).Admin {
+		EncodeError(w, Forbidden("Only administrators can read the audit log."))
+		return
+	}
+
+	limit := filter.Limit
+	if limit == 0 {
+		limit = audit.PageLimit
+	}
+	// one more, to tell whether there is a next page
+	filter.Limit = limit + 1
+
+	events, err := log.Events(r.Context(), filter)
+	if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"AuditEvents",
+		)
+		EncodeError(w, InternalServerError())
+		return
+	}
+
+	page := api.AuditEvents{}
+	if len(events) > limit {
+		events = events[:limit]
+		page.NextAfterSeq = &events[limit-1].Seq
+	}
+	page.Events = make([]api.AuditEvent, len(events))
+	for i, event := range events {
+		page.Events[i], err = audit.EventToApi(event)
+		if err != nil {
+			slog.ErrorContext(
+				r.Context(),
+				UnexpectedError,
+				"error",
+				err.Error(),
+				"where",
+				"AuditEvents map",
+			)
+			EncodeError(w, InternalServerError())
+			return
+		}
+	}
+
+	Encode(w, http.StatusOK, page)
+}
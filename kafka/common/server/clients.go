@@ -0,0 +1,254 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	chi_middleware "github.com/go-chi/chi/v5/middleware"
+)
+
+// Upstream is a service called by other services.
+type Upstream string
+
+const (
+	UserService        Upstream = "user-service"
+	MedicalService     Upstream = "medical-service"
+	ResourceService    Upstream = "resource-service"
+	AppointmentService Upstream = "appointment-service"
+)
+
+// ErrCircuitOpen is returned for calls to an upstream which failed too many
+// times in a row, until its cooldown passes.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+type ClientsConfig struct {
+	UserServiceUrl        string `mapstructure:"user_service_url"`
+	MedicalServiceUrl     string `mapstructure:"medical_service_url"`
+	ResourceServiceUrl    string `mapstructure:"resource_service_url"`
+	AppointmentServiceUrl string `mapstructure:"appointment_service_url"`
+
+	// Timeout limits a whole call to an upstream, including its retries.
+	Timeout time.Duration `mapstructure:"timeout"`
+	// MaxRetries is how many times an idempotent call is retried after
+	// a network error or an unavailable upstream.
+	MaxRetries int `mapstructure:"max_retries"`
+	// RetryBackoff is the base delay before a retry, doubled with each
+	// attempt and jittered.
+	RetryBackoff time.Duration `mapstructure:"retry_backoff"`
+	// BreakerThreshold is after how many failed calls in a row calls to the
+	// upstream are rejected, zero disables the breaker.
+	BreakerThreshold int `mapstructure:"breaker_threshold"`
+	// BreakerCooldown is how long calls are rejected before one is let
+	// through to probe the upstream.
+	BreakerCooldown time.Duration `mapstructure:"breaker_cooldown"`
+}
+
+// Clients hands out HTTP clients for calls to other services, all callers of
+// an upstream share one client and so its circuit breaker.
+type Clients struct {
+	urls    map[Upstream]string
+	clients map[Upstream]*http.Client
+
+	mu sync.Mutex
+	// checked holds the upstreams whose readiness is already checked
+	checked map[Upstream]bool
+}
+
+func NewClients(cfg ClientsConfig) (*Clients, error) {
+	urls := map[Upstream]string{
+		UserService:        cfg.UserServiceUrl,
+		MedicalService:     cfg.MedicalServiceUrl,
+		ResourceService:    cfg.ResourceServiceUrl,
+		AppointmentService: cfg.AppointmentServiceUrl,
+	}
+
+	clients := make(map[Upstream]*http.Client, len(urls))
+	for upstream, rawUrl := range urls {
+		if _, err := url.ParseRequestURI(rawUrl); err != nil {
+			return nil, fmt.Errorf("NewClients invalid url of %s: %w", upstream, err)
+		}
+
+		clients[upstream] = &http.Client{
+			Timeout: cfg.Timeout,
+			Transport: &upstreamTransport{
+				upstream:   upstream,
+				next:       traceTransport(upstream, http.DefaultTransport),
+				breaker:    &breaker{threshold: cfg.BreakerThreshold, cooldown: cfg.BreakerCooldown},
+				maxRetries: cfg.MaxRetries,
+				backoff:    cfg.RetryBackoff,
+			},
+		}
+	}
+
+	return &Clients{urls: urls, clients: clients, checked: make(map[Upstream]bool)}, nil
+}
+
+// Client returns the base URL of the upstream and the client to call it with.
+// The readiness of the service includes the readiness of every upstream it
+// got a client for.
+func (c *Clients) Client(upstream Upstream) (string, *http.Client) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.checked[upstream] {
+		RegisterHealthCheck(UpstreamCheck(upstream, c.urls[upstream]))
+		c.checked[upstream] = true
+	}
+	return c.urls[upstream], c.clients[upstream]
+}
+
+// upstreamTransport propagates the request id of the incoming request and
+// retries idempotent calls, as long as the circuit breaker allows it.
+type upstreamTransport struct {
+	upstream   Upstream
+	next       http.RoundTripper
+	breaker    *breaker
+	maxRetries int
+	backoff    time.Duration
+}
+
+func (t *upstreamTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	if id := chi_middleware.GetReqID(req.Context()); id != "" {
+		req.Header.Set(chi_middleware.RequestIDHeader, id)
+	}
+
+	attempts := 1
+	if isIdempotent(req.Method) && (req.Body == nil || req.GetBody != nil) {
+		attempts += t.maxRetries
+	}
+
+	for attempt := 1; ; attempt++ {
+		if !t.breaker.allow(time.Now()) {
+			return nil, fmt.Errorf("%s: %w", t.upstream, ErrCircuitOpen)
+		}
+
+		res, err := t.next.RoundTrip(req)
+		if errors.Is(req.Context().Err(), context.Canceled) {
+			// the caller gave up, which says nothing about the upstream
+			t.breaker.release()
+			return res, err
+		}
+
+		t.breaker.record(err == nil && res.StatusCode < http.StatusInternalServerError, time.Now())
+		if attempt >= attempts || !shouldRetry(res, err) || req.Context().Err() != nil {
+			return res, err
+		}
+
+		if res != nil {
+			_, _ = io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+		if err := sleep(req.Context(), t.retryDelay(attempt)); err != nil {
+			return nil, err
+		}
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("%s: failed to rewind request body: %w", t.upstream, err)
+			}
+			req.Body = body
+		}
+	}
+}
+
+// retryDelay returns the exponential backoff before the retry following the
+// attempt, with half of it jittered so callers don't retry in lockstep.
+func (t *upstreamTransport) retryDelay(attempt int) time.Duration {
+	delay := t.backoff << (attempt - 1)
+	if delay <= 0 {
+		return 0
+	}
+	return delay/2 + rand.N(delay/2+1)
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func shouldRetry(res *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch res.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// breaker opens after threshold failed calls in a row. Once the cooldown
+// passes it lets a single call through, which closes it again on success.
+type breaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+func (b *breaker) allow(now time.Time) bool {
+	if b.threshold <= 0 {
+		return true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.failures < b.threshold {
+		return true
+	}
+	if now.Before(b.openUntil) || b.probing {
+		return false
+	}
+	b.probing = true
+	return true
+}
+
+func (b *breaker) record(ok bool, now time.Time) {
+	if b.threshold <= 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+	if ok {
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.failures >= b.threshold {
+		b.openUntil = now.Add(b.cooldown)
+	}
+}
+
+// release lets another call probe the upstream, when the probing one was
+// given up by its caller.
+func (b *breaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log/slog"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -13,6 +14,10 @@ type ServerConfig struct {
 		Host     string `mapstructure:"host"`
 		Port     string `mapstructure:"port"`
 		Timezone string `mapstructure:"timezone"`
+		// TrustedProxies are the IPs and CIDRs of the proxies in front of the
+		// service, e.g. the gateway, whose X-Real-IP header names the client.
+		// If empty, the client is the peer of the connection.
+		TrustedProxies []string `mapstructure:"trusted_proxies"`
 	} `mapstructure:"app"`
 
 	Log struct {
@@ -26,6 +31,20 @@ type ServerConfig struct {
 		Password string `mapstructure:"password"`
 		Db       string `mapstructure:"db"`
 	} `mapstructure:"mongo"`
+
+	Audit struct {
+		// AdminToken authorizes administrators to read the audit log, if
+		// empty nobody can read it.
+		AdminToken string `mapstructure:"admin_token"`
+	} `mapstructure:"audit"`
+
+	Clients ClientsConfig `mapstructure:"clients"`
+
+	Tracing TracingConfig `mapstructure:"tracing"`
+
+	RateLimit RateLimitConfig `mapstructure:"rate_limit"`
+
+	Idempotency IdempotencyConfig `mapstructure:"idempotency"`
 }
 
 func (c ServerConfig) MongoURI() string {
@@ -49,12 +68,36 @@ func LoadConfig(envPrefix string) (*ServerConfig, error) {
 	v.SetDefault("app.host", "")
 	v.SetDefault("app.port", "")
 	v.SetDefault("app.timezone", "")
+	v.SetDefault("app.trusted_proxies", []string{})
 	v.SetDefault("log.level", "")
 	v.SetDefault("mongo.host", "")
 	v.SetDefault("mongo.port", "")
 	v.SetDefault("mongo.db", "")
 	v.SetDefault("mongo.user", "")
 	v.SetDefault("mongo.password", "")
+	v.SetDefault("audit.admin_token", "")
+	v.SetDefault("clients.user_service_url", "http://user-service:8080/")
+	v.SetDefault("clients.medical_service_url", "http://medical-service:8080/")
+	v.SetDefault("clients.resource_service_url", "http://resource-service:8080/")
+	v.SetDefault("clients.appointment_service_url", "http://appointment-service:8080/")
+	v.SetDefault("clients.timeout", 5*time.Second)
+	v.SetDefault("clients.max_retries", 2)
+	v.SetDefault("clients.retry_backoff", 100*time.Millisecond)
+	v.SetDefault("clients.breaker_threshold", 5)
+	v.SetDefault("clients.breaker_cooldown", 30*time.Second)
+	v.SetDefault("tracing.exporter", NoExporter)
+	v.SetDefault("tracing.otlp_endpoint", "jaeger:4318")
+	v.SetDefault("tracing.otlp_insecure", true)
+	v.SetDefault("tracing.sample_ratio", 1.0)
+	v.SetDefault("rate_limit.enabled", true)
+	v.SetDefault("rate_limit.store", MemoryRateLimitStore)
+	v.SetDefault("rate_limit.default", "300/1m")
+	v.SetDefault("rate_limit.routes", []string{
+		"POST /auth/register=10/1h",
+		"POST /auth/login=10/1m",
+		"POST /appointments=30/1h",
+	})
+	v.SetDefault("idempotency.ttl", 24*time.Hour)
 
 	var cfg ServerConfig
 	err := v.Unmarshal(&cfg)
@@ -0,0 +1,326 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	chi_middleware "github.com/go-chi/chi/v5/middleware"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/Nesquiko/aass/common/server/api"
+)
+
+const (
+	// IdempotencyKeyHeader lets clients retry a POST without repeating its
+	// effects, the first response to the key is replayed to the retries.
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader marks a replayed response.
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+	// maxIdempotentBodySize caps the body of a request with an idempotency
+	// key, which is read whole to hash it.
+	maxIdempotentBodySize = 1 << 20
+)
+
+const (
+	IdempotencyInvalidKeyCode   = "idempotency.invalid-key"
+	IdempotencyInProgressCode   = "idempotency.in-progress"
+	IdempotencyKeyReusedCode    = "idempotency.key-reused"
+	IdempotencyBodyTooLargeCode = "idempotency.body-too-large"
+)
+
+type IdempotencyConfig struct {
+	// Ttl is how long the first response to an idempotency key is replayed to
+	// the retries.
+	Ttl time.Duration `mapstructure:"ttl"`
+}
+
+const idempotencyKeysCollection = "idempotency_keys"
+
+// idempotencyRecord is the first response to a request with an idempotency
+// key. Keys are scoped by the principal making the request, the IP of the
+// client. RequestHash
+// identifies the request, so that a key reused for another request is
+// rejected. Status is zero while the first request is in flight.
+type idempotencyRecord struct {
+	Principal   string    `bson:"principal"`
+	Key         string    `bson:"key"`
+	RequestHash string    `bson:"requestHash"`
+	Status      int       `bson:"status"`
+	ContentType string    `bson:"contentType,omitempty"`
+	Location    string    `bson:"location,omitempty"`
+	Body        []byte    `bson:"body,omitempty"`
+	ExpiresAt   time.Time `bson:"expiresAt"`
+}
+
+// Idempotency stores the first response to a POST with an idempotency key in
+// the idempotency_keys collection and replays it to the retries.
+type Idempotency struct {
+	records *mongo.Collection
+	ttl     time.Duration
+	proxies TrustedProxies
+}
+
+func NewIdempotency(
+	ctx context.Context,
+	db *mongo.Database,
+	ttl time.Duration,
+	proxies TrustedProxies,
+) (*Idempotency, error) {
+	records := db.Collection(idempotencyKeysCollection)
+	_, err := records.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "principal", Value: 1}, {Key: "key", Value: 1}},
+			Options: options.Index().
+				SetUnique(true).
+				SetName("idx_idempotency_key_principal_key_unique"),
+		},
+		{
+			Keys: bson.D{{Key: "expiresAt", Value: 1}},
+			Options: options.Index().
+				SetExpireAfterSeconds(0).
+				SetName("idx_idempotency_key_expiresAt_ttl"),
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("NewIdempotency failed to create indexes: %w", err)
+	}
+	return &Idempotency{records: records, ttl: ttl, proxies: proxies}, nil
+}
+
+func idempotencyFilter(principal string, key string) bson.M {
+	return bson.M{"principal": principal, "key": key}
+}
+
+// reserve stores the record of the first request with the key. If the key is
+// reserved and not expired, the existing record is returned instead.
+func (i *Idempotency) reserve(
+	ctx context.Context,
+	record idempotencyRecord,
+	now time.Time,
+) (*idempotencyRecord, error) {
+	// the TTL monitor drops expired records only once a minute
+	expired := idempotencyFilter(record.Principal, record.Key)
+	expired["expiresAt"] = bson.M{"$lte": now}
+	_, err := i.records.DeleteOne(ctx, expired)
+	if err != nil {
+		return nil, fmt.Errorf("reserve: failed to delete expired document: %w", err)
+	}
+
+	_, err = i.records.InsertOne(ctx, record)
+	if mongo.IsDuplicateKeyError(err) {
+		var existing idempotencyRecord
+		err = i.records.FindOne(ctx, idempotencyFilter(record.Principal, record.Key)).
+			Decode(&existing)
+		if err != nil {
+			return nil, fmt.Errorf("reserve: failed to find document: %w", err)
+		}
+		return &existing, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("reserve: failed to insert document: %w", err)
+	}
+
+	return nil, nil
+}
+
+func (i *Idempotency) complete(ctx context.Context, record idempotencyRecord) error {
+	update := bson.M{"$set": bson.M{
+		"status":      record.Status,
+		"contentType": record.ContentType,
+		"location":    record.Location,
+		"body":        record.Body,
+	}}
+	_, err := i.records.UpdateOne(ctx, idempotencyFilter(record.Principal, record.Key), update)
+	if err != nil {
+		return fmt.Errorf("complete: failed to update document: %w", err)
+	}
+	return nil
+}
+
+// release drops the key, so that the request can be retried.
+func (i *Idempotency) release(ctx context.Context, principal string, key string) error {
+	_, err := i.records.DeleteOne(ctx, idempotencyFilter(principal, key))
+	if err != nil {
+		return fmt.Errorf("release: failed to delete document: %w", err)
+	}
+	return nil
+}
+
+// requestHash identifies the request, a key reused for a request with another
+// path or body is rejected.
+func requestHash(r *http.Request, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(r.Method + " " + r.URL.RequestURI() + "\n"))
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// Middleware stores the first response to a POST with an idempotency key,
+// keyed by the principal and the key, and replays it to the retries. A retry
+// while the first request is in flight is rejected with 409, and a key reused
+// for another request with 422. Server errors aren't stored, so that the
+// request can be retried. If the database fails, the request is handled as if
+// it had no key. A nil Idempotency stores nothing.
+//
+// The principal is the IP of the client. Users aren't authenticated, scoping
+// keys by the user a request claims to be made for would let anyone replay the
+// responses of another user.
+func (i *Idempotency) Middleware(next http.Handler) http.Handler {
+	if i == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(IdempotencyKeyHeader)
+		if r.Method != http.MethodPost || key == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			EncodeError(w, &ApiError{
+				ErrorDetail: api.ErrorDetail{
+					Code:   IdempotencyInvalidKeyCode,
+					Title:  "Bad Request",
+					Detail: "Idempotency key can be at most 255 characters long.",
+					Status: http.StatusBadRequest,
+				},
+			})
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxIdempotentBodySize))
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			EncodeError(w, &ApiError{
+				ErrorDetail: api.ErrorDetail{
+					Code:   IdempotencyBodyTooLargeCode,
+					Title:  "Request Entity Too Large",
+					Detail: "Request with an idempotency key can be at most 1 MiB large.",
+					Status: http.StatusRequestEntityTooLarge,
+				},
+			})
+			return
+		} else if err != nil {
+			EncodeError(w, InternalServerError())
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		now := time.Now()
+		record := idempotencyRecord{
+			Principal:   i.proxies.ClientIp(r),
+			Key:         key,
+			RequestHash: requestHash(r, body),
+			ExpiresAt:   now.Add(i.ttl),
+		}
+		existing, err := i.reserve(r.Context(), record, now)
+		if err != nil {
+			slog.ErrorContext(
+				r.Context(),
+				UnexpectedError,
+				slog.String("error", err.Error()),
+				slog.String("where", "Idempotency"),
+			)
+			next.ServeHTTP(w, r)
+			return
+		} else if existing != nil {
+			replayIdempotent(w, *existing, record.RequestHash)
+			return
+		}
+
+		ww := chi_middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		var response bytes.Buffer
+		ww.Tee(&response)
+
+		// the response is stored even if the client is gone, its retry gets it
+		ctx := context.WithoutCancel(r.Context())
+
+		// a panicking handler releases the key, otherwise the retries would be
+		// rejected as in flight until the key expires
+		defer func() {
+			if p := recover(); p != nil {
+				if err := i.release(ctx, record.Principal, record.Key); err != nil {
+					slog.ErrorContext(
+						r.Context(),
+						UnexpectedError,
+						slog.String("error", err.Error()),
+						slog.String("where", "Idempotency"),
+					)
+				}
+				panic(p)
+			}
+		}()
+		next.ServeHTTP(ww, r)
+
+		status := ww.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+		if status >= http.StatusInternalServerError {
+			err = i.release(ctx, record.Principal, record.Key)
+		} else {
+			record.Status = status
+			record.ContentType = ww.Header().Get(ContentType)
+			record.Location = ww.Header().Get("Location")
+			record.Body = response.Bytes()
+			err = i.complete(ctx, record)
+		}
+		if err != nil {
+			slog.ErrorContext(
+				r.Context(),
+				UnexpectedError,
+				slog.String("error", err.Error()),
+				slog.String("where", "Idempotency"),
+			)
+		}
+	})
+}
+
+func replayIdempotent(w http.ResponseWriter, record idempotencyRecord, requestHash string) {
+	switch {
+	case record.RequestHash != requestHash:
+		EncodeError(w, &ApiError{
+			ErrorDetail: api.ErrorDetail{
+				Code:   IdempotencyKeyReusedCode,
+				Title:  "Unprocessable Entity",
+				Detail: "Idempotency key was already used for another request.",
+				Status: http.StatusUnprocessableEntity,
+			},
+		})
+	case record.Status == 0:
+		EncodeError(w, &ApiError{
+			ErrorDetail: api.ErrorDetail{
+				Code:   IdempotencyInProgressCode,
+				Title:  "Conflict",
+				Detail: "A request with the idempotency key is still being processed.",
+				Status: http.StatusConflict,
+			},
+		})
+	default:
+		if record.ContentType != "" {
+			w.Header().Set(ContentType, record.ContentType)
+		}
+		if record.Location != "" {
+			w.Header().Set("Location", record.Location)
+		}
+		w.Header().Set(IdempotentReplayedHeader, "true")
+		w.WriteHeader(record.Status)
+		if _, err := w.Write(record.Body); err != nil {
+			slog.Error(
+				UnexpectedError,
+				slog.String("where", "replayIdempotent"),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+}
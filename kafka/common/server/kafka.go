@@ -7,11 +7,19 @@ import (
 	"log/slog"
 	"os"
 	"os/signal"
+	"strconv"
 	"sync"
 	"syscall"
 	"time"
 
 	"github.com/IBM/sarama"
+	chi_middleware "github.com/go-chi/chi/v5/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const KafkaBrokerAddress = "kafka:9092"
@@ -38,10 +46,26 @@ func InitKafka(kafkaTopicName string) (sarama.Client, error) {
 		slog.Error("Error ensuring Kafka topic exists", "topic", kafkaTopicName, "error", err)
 		return nil, fmt.Errorf("error ensuring Kafka topic exists: %w", err)
 	}
+	RegisterHealthCheck(KafkaCheck(kafkaClient))
 
 	return kafkaClient, nil
 }
 
+// KafkaCheck refreshes the cluster metadata of the client, which fails when no
+// broker is reachable.
+func KafkaCheck(client sarama.Client) HealthCheck {
+	return HealthCheck{Name: "kafka", Check: func(ctx context.Context) error {
+		refreshed := make(chan error, 1)
+		go func() { refreshed <- client.RefreshMetadata() }()
+		select {
+		case err := <-refreshed:
+			return err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}}
+}
+
 func createTopicIfNotExists(
 	topic string,
 	numPartitions int32,
@@ -73,13 +97,84 @@ func createTopicIfNotExists(
 	return nil
 }
 
+const kafkaTracerName = "github.com/Nesquiko/aass/common/server/kafka"
+
+// SendMessage sends the message within a producer span, the trace context and
+// request id are propagated to consumers in the message's headers.
+func SendMessage(
+	ctx context.Context,
+	producer sarama.SyncProducer,
+	msg *sarama.ProducerMessage,
+) (int32, int64, error) {
+	ctx, span := otel.Tracer(kafkaTracerName).Start(
+		ctx,
+		"publish "+msg.Topic,
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKafka,
+			semconv.MessagingOperationTypePublish,
+			semconv.MessagingDestinationName(msg.Topic),
+		),
+	)
+	defer span.End()
+
+	otel.GetTextMapPropagator().Inject(ctx, producerMessageCarrier{msg: msg})
+	if id := chi_middleware.GetReqID(ctx); id != "" {
+		producerMessageCarrier{msg: msg}.Set(chi_middleware.RequestIDHeader, id)
+	}
+	partition, offset, err := producer.SendMessage(msg)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return partition, offset, err
+}
+
+var (
+	kafkaConsumerLag = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "kafka_consumer_lag",
+		Help: "Messages of the partition not yet consumed by the consumer group.",
+	}, []string{"group", "topic", "partition"})
+	kafkaProcessingErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "kafka_processing_errors_total",
+		Help: "Consumed messages which the consumer failed to process.",
+	}, []string{"group", "topic"})
+)
+
 type Consumer struct {
 	ready   chan bool
-	consume func(value []byte)
+	group   string
+	consume func(ctx context.Context, topic string, value []byte) error
 }
 
-func NewConsumer(client sarama.Client, consume func(value []byte), topics []string) {
-	cg, err := sarama.NewConsumerGroupFromClient("consumers", client)
+const defaultConsumerGroup = "consumers"
+
+func NewConsumer(
+	client sarama.Client,
+	consume func(ctx context.Context, value []byte) error,
+	topics []string,
+) {
+	NewGroupConsumer(
+		client,
+		defaultConsumerGroup,
+		func(ctx context.Context, _ string, value []byte) error { return consume(ctx, value) },
+		topics,
+	)
+}
+
+// NewGroupConsumer consumes topics as a member of the consumer group,
+// consume is called with the topic of each message. Each group receives
+// every message of the topics, so services which must all see the same
+// messages need their own groups. The context passed to consume continues
+// the trace of the message's producer. A message consume failed to process
+// is logged and counted, but not redelivered.
+func NewGroupConsumer(
+	client sarama.Client,
+	group string,
+	consume func(ctx context.Context, topic string, value []byte) error,
+	topics []string,
+) {
+	cg, err := sarama.NewConsumerGroupFromClient(group, client)
 	if err != nil {
 		slog.Error("NewConsumer can't create consumer group", "error", err.Error())
 		os.Exit(1)
@@ -87,6 +182,7 @@ func NewConsumer(client sarama.Client, consume func(value []byte), topics []stri
 
 	consumer := Consumer{
 		ready:   make(chan bool),
+		group:   group,
 		consume: consume,
 	}
 
@@ -172,10 +268,124 @@ func (consumer *Consumer) ConsumeClaim(
 				"timestamp", message.Timestamp,
 				"topic", message.Topic,
 			)
-			consumer.consume(message.Value)
+			consumer.process(session.Context(), message)
 			session.MarkMessage(message, "")
+			kafkaConsumerLag.WithLabelValues(
+				consumer.group,
+				message.Topic,
+				strconv.Itoa(int(message.Partition)),
+			).Set(float64(claim.HighWaterMarkOffset() - message.Offset - 1))
 		case <-session.Context().Done():
 			return nil
 		}
 	}
 }
+
+// process consumes the message within a consumer span, a child of the
+// producer's span, and records a failure to process it.
+func (consumer *Consumer) process(ctx context.Context, message *sarama.ConsumerMessage) {
+	carrier := consumerMessageCarrier{msg: message}
+	ctx = otel.GetTextMapPropagator().Extract(ctx, carrier)
+	if id := carrier.Get(chi_middleware.RequestIDHeader); validRequestId(id) {
+		ctx = withRequestId(ctx, id)
+	}
+	ctx, span := otel.Tracer(kafkaTracerName).Start(
+		ctx,
+		"process "+message.Topic,
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKafka,
+			semconv.MessagingOperationTypeDeliver,
+			semconv.MessagingDestinationName(message.Topic),
+			semconv.MessagingKafkaConsumerGroup(consumer.group),
+		),
+	)
+	defer span.End()
+
+	if err := consumer.consume(ctx, message.Topic, message.Value); err != nil {
+		slog.ErrorContext(
+			ctx,
+			"Consumer failed to process message",
+			"error", err.Error(),
+			"topic", message.Topic,
+			"offset", message.Offset,
+		)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		kafkaProcessingErrors.WithLabelValues(consumer.group, message.Topic).Inc()
+	}
+}
+
+// producerMessageCarrier adapts headers of a produced message to
+// propagation.TextMapCarrier.
+type producerMessageCarrier struct {
+	msg *sarama.ProducerMessage
+}
+
+func (c producerMessageCarrier) Get(key string) string {
+	for _, h := range c.msg.Headers {
+		if string(h.Key) == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
+
+func (c producerMessageCarrier) Set(key, value string) {
+	for i, h := range c.msg.Headers {
+		if string(h.Key) == key {
+			c.msg.Headers[i].Value = []byte(value)
+			return
+		}
+	}
+	c.msg.Headers = append(c.msg.Headers, sarama.RecordHeader{
+		Key:   []byte(key),
+		Value: []byte(value),
+	})
+}
+
+func (c producerMessageCarrier) Keys() []string {
+	keys := make([]string, len(c.msg.Headers))
+	for i, h := range c.msg.Headers {
+		keys[i] = string(h.Key)
+	}
+	return keys
+}
+
+// consumerMessageCarrier adapts headers of a consumed message to
+// propagation.TextMapCarrier.
+type consumerMessageCarrier struct {
+	msg *sarama.ConsumerMessage
+}
+
+func (c consumerMessageCarrier) Get(key string) string {
+	for _, h := range c.msg.Headers {
+		if h != nil && string(h.Key) == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
+
+func (c consumerMessageCarrier) Set(key, value string) {
+	for _, h := range c.msg.Headers {
+		if h != nil && string(h.Key) == key {
+			h.Value = []byte(value)
+			return
+		}
+	}
+	c.msg.Headers = append(c.msg.Headers, &sarama.RecordHeader{
+		Key:   []byte(key),
+		Value: []byte(value),
+	})
+}
+
+func (c consumerMessageCarrier) Keys() []string {
+	keys := make([]string, 0, len(c.msg.Headers))
+	for _, h := range c.msg.Headers {
+		if h != nil {
+			keys = append(keys, string(h.Key))
+		}
+	}
+	return keys
+}
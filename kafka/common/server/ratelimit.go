@@ -0,0 +1,373 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/Nesquiko/aass/common/server/api"
+)
+
+const (
+	// MemoryRateLimitStore keeps the buckets in the replica, each replica
+	// limits clients on its own.
+	MemoryRateLimitStore = "memory"
+	// MongoRateLimitStore keeps the buckets in the database of the service,
+	// its replicas share the limits.
+	MongoRateLimitStore = "mongo"
+)
+
+type RateLimitConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// Store is one of MemoryRateLimitStore or MongoRateLimitStore.
+	Store string `mapstructure:"store"`
+	// Default limits each client on routes without their own limit, N
+	// requests per period, e.g. `300/1m`.
+	Default string `mapstructure:"default"`
+	// Routes are the limits of specific routes, e.g. `POST /auth/login=10/1m`.
+	Routes []string `mapstructure:"routes"`
+}
+
+// RateLimit allows Burst requests at once, the bucket of a client is then
+// refilled with Burst tokens per Period.
+type RateLimit struct {
+	Burst  int
+	Period time.Duration
+}
+
+// ParseRateLimit parses a limit of N requests per period, e.g. `300/1m`.
+func ParseRateLimit(limit string) (RateLimit, error) {
+	burst, period, ok := strings.Cut(limit, "/")
+	if !ok {
+		return RateLimit{}, fmt.Errorf("rate limit %q isn't in the N/period format", limit)
+	}
+	n, err := strconv.Atoi(burst)
+	if err != nil || n < 1 {
+		return RateLimit{}, fmt.Errorf("rate limit %q must allow at least 1 request", limit)
+	}
+	d, err := time.ParseDuration(period)
+	if err != nil || d <= 0 {
+		return RateLimit{}, fmt.Errorf("rate limit %q must have a positive period", limit)
+	}
+	return RateLimit{Burst: n, Period: d}, nil
+}
+
+// refillRate is how many tokens are added to a bucket per second.
+func (l RateLimit) refillRate() float64 {
+	return float64(l.Burst) / l.Period.Seconds()
+}
+
+// take refills the bucket, which had tokens at updatedAt, up to now and takes
+// a token from it. It returns the tokens left and, when the bucket had no whole
+// token to take, how long until it has one.
+func (l RateLimit) take(
+	tokens float64,
+	updatedAt time.Time,
+	now time.Time,
+) (float64, time.Duration) {
+	elapsed := max(now.Sub(updatedAt).Seconds(), 0)
+	tokens = math.Min(float64(l.Burst), tokens+elapsed*l.refillRate())
+	if tokens >= 1 {
+		return tokens - 1, 0
+	}
+	return tokens, l.retryAfter(tokens)
+}
+
+func (l RateLimit) retryAfter(tokens float64) time.Duration {
+	return time.Duration(math.Ceil((1 - tokens) / l.refillRate() * float64(time.Second)))
+}
+
+// RateLimitStore keeps the token buckets of clients. TakeRateLimitToken takes
+// a token from the bucket of key, a missing bucket is full. When the bucket is
+// empty, it returns how long until a token is available.
+type RateLimitStore interface {
+	TakeRateLimitToken(
+		ctx context.Context,
+		key string,
+		limit RateLimit,
+		now time.Time,
+	) (time.Duration, error)
+}
+
+// sweepInterval is how often the memory store drops buckets of idle clients.
+const sweepInterval = time.Minute
+
+type memoryBucket struct {
+	tokens    float64
+	updatedAt time.Time
+	expiresAt time.Time
+}
+
+type memoryRateLimitStore struct {
+	mu      sync.Mutex
+	buckets map[string]memoryBucket
+	sweptAt time.Time
+}
+
+func NewMemoryRateLimitStore() RateLimitStore {
+	return &memoryRateLimitStore{buckets: make(map[string]memoryBucket)}
+}
+
+func (s *memoryRateLimitStore) TakeRateLimitToken(
+	_ context.Context,
+	key string,
+	limit RateLimit,
+	now time.Time,
+) (time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if now.Sub(s.sweptAt) >= sweepInterval {
+		for key, bucket := range s.buckets {
+			if bucket.expiresAt.Before(now) {
+				delete(s.buckets, key)
+			}
+		}
+		s.sweptAt = now
+	}
+
+	bucket, ok := s.buckets[key]
+	if !ok {
+		bucket = memoryBucket{tokens: float64(limit.Burst), updatedAt: now}
+	}
+	tokens, retryAfter := limit.take(bucket.tokens, bucket.updatedAt, now)
+	s.buckets[key] = memoryBucket{
+		tokens:    tokens,
+		updatedAt: now,
+		expiresAt: now.Add(limit.Period),
+	}
+	return retryAfter, nil
+}
+
+const rateLimitBucketsCollection = "rate_limit_buckets"
+
+type mongoRateLimitStore struct {
+	buckets *mongo.Collection
+}
+
+// NewMongoRateLimitStore keeps the buckets in the rate_limit_buckets
+// collection of db. A bucket expires when it's surely full again, so buckets
+// of idle clients are dropped.
+func NewMongoRateLimitStore(ctx context.Context, db *mongo.Database) (RateLimitStore, error) {
+	buckets := db.Collection(rateLimitBucketsCollection)
+	_, err := buckets.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "expiresAt", Value: 1}},
+		Options: options.Index().
+			SetExpireAfterSeconds(0).
+			SetName("idx_rate_limit_bucket_expiresAt_ttl"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("NewMongoRateLimitStore failed to create index: %w", err)
+	}
+	return &mongoRateLimitStore{buckets: buckets}, nil
+}
+
+// TakeRateLimitToken refills the bucket and takes from it atomically, so
+// replicas sharing the database share the limit.
+func (s *mongoRateLimitStore) TakeRateLimitToken(
+	ctx context.Context,
+	key string,
+	limit RateLimit,
+	now time.Time,
+) (time.Duration, error) {
+	elapsedSeconds := bson.M{"$divide": bson.A{
+		bson.M{"$subtract": bson.A{now, bson.M{"$ifNull": bson.A{"$updatedAt", now}}}},
+		1000,
+	}}
+	refilled := bson.M{"$min": bson.A{
+		float64(limit.Burst),
+		bson.M{"$add": bson.A{
+			bson.M{"$ifNull": bson.A{"$tokens", float64(limit.Burst)}},
+			bson.M{"$multiply": bson.A{elapsedSeconds, limit.refillRate()}},
+		}},
+	}}
+	pipeline := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{"tokens": refilled}}},
+		{{Key: "$set", Value: bson.M{
+			"taken":     bson.M{"$gte": bson.A{"$tokens", 1}},
+			"updatedAt": now,
+			"expiresAt": now.Add(limit.Period),
+		}}},
+		{{Key: "$set", Value: bson.M{
+			"tokens": bson.M{"$cond": bson.A{
+				"$taken",
+				bson.M{"$subtract": bson.A{"$tokens", 1}},
+				"$tokens",
+			}},
+		}}},
+	}
+
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	var bucket struct {
+		Tokens float64 `bson:"tokens"`
+		Taken  bool    `bson:"taken"`
+	}
+	err := s.buckets.FindOneAndUpdate(ctx, bson.M{"_id": key}, pipeline, opts).Decode(&bucket)
+	if mongo.IsDuplicateKeyError(err) {
+		// a concurrent request created the bucket first
+		err = s.buckets.FindOneAndUpdate(ctx, bson.M{"_id": key}, pipeline, opts).Decode(&bucket)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("TakeRateLimitToken: failed to update bucket: %w", err)
+	}
+
+	if bucket.Taken {
+		return 0, nil
+	}
+	return limit.retryAfter(bucket.Tokens), nil
+}
+
+// defaultRoute keys the buckets of routes without their own limit, a client
+// shares one bucket among all of them.
+const defaultRoute = "*"
+
+// RateLimiter limits the requests of each client per route. A client is the
+// IP of the caller, users aren't authenticated, so the user a request claims
+// to be made for can't key the limit, rotating the claim would lift it.
+type RateLimiter struct {
+	store        RateLimitStore
+	proxies      TrustedProxies
+	defaultLimit RateLimit
+	// routes are keyed by the method and the route pattern, e.g.
+	// `POST /auth/login`.
+	routes map[string]RateLimit
+}
+
+func NewRateLimiter(
+	cfg RateLimitConfig,
+	store RateLimitStore,
+	proxies TrustedProxies,
+) (*RateLimiter, error) {
+	defaultLimit, err := ParseRateLimit(cfg.Default)
+	if err != nil {
+		return nil, fmt.Errorf("NewRateLimiter: default: %w", err)
+	}
+	routes := make(map[string]RateLimit, len(cfg.Routes))
+	for _, route := range cfg.Routes {
+		pattern, limit, ok := strings.Cut(route, "=")
+		if !ok {
+			return nil, fmt.Errorf(
+				"NewRateLimiter: route %q isn't in the route=limit format",
+				route,
+			)
+		}
+		routes[strings.TrimSpace(pattern)], err = ParseRateLimit(strings.TrimSpace(limit))
+		if err != nil {
+			return nil, fmt.Errorf("NewRateLimiter: route %q: %w", pattern, err)
+		}
+	}
+
+	return &RateLimiter{
+		store:        store,
+		proxies:      proxies,
+		defaultLimit: defaultLimit,
+		routes:       routes,
+	}, nil
+}
+
+// newRateLimiter returns nil if rate limiting is disabled.
+func newRateLimiter(
+	ctx context.Context,
+	cfg RateLimitConfig,
+	db *mongo.Database,
+	proxies TrustedProxies,
+) (*RateLimiter, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	var store RateLimitStore
+	switch cfg.Store {
+	case MemoryRateLimitStore:
+		store = NewMemoryRateLimitStore()
+	case MongoRateLimitStore:
+		var err error
+		store, err = NewMongoRateLimitStore(ctx, db)
+		if err != nil {
+			return nil, fmt.Errorf("newRateLimiter: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("newRateLimiter: unknown store %q", cfg.Store)
+	}
+
+	return NewRateLimiter(cfg, store, proxies)
+}
+
+// Middleware responds with 429 instead of calling the next handler when the
+// client ran out of requests. The route pattern must be resolved, so it must
+// run after routing.
+//
+// Every request is limited, whether it was forwarded by the gateway or not, a
+// call of another service is limited by the IP of that service. If the store
+// fails, the request is let through, the limiter must not take the service
+// down with it. A nil limiter limits nothing.
+func (l *RateLimiter) Middleware(next http.Handler) http.Handler {
+	if l == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := r.Method + " " + chi.RouteContext(r.Context()).RoutePattern()
+		limit, ok := l.routes[route]
+		if !ok {
+			route, limit = defaultRoute, l.defaultLimit
+		}
+
+		client := "ip:" + l.proxies.ClientIp(r)
+		retryAfter, err := l.store.TakeRateLimitToken(
+			r.Context(),
+			route+"|"+client,
+			limit,
+			time.Now(),
+		)
+		if err != nil {
+			slog.ErrorContext(
+				r.Context(),
+				UnexpectedError,
+				slog.String("error", err.Error()),
+				slog.String("where", "RateLimiter"),
+			)
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if retryAfter > 0 {
+			slog.WarnContext(
+				r.Context(),
+				"rate limit exceeded",
+				slog.String("route", route),
+				slog.String("client", client),
+			)
+			encodeRateLimited(w, retryAfter)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+const RateLimitExceededCode = "rate-limit.exceeded"
+
+// encodeRateLimited sets the Retry-After header in whole seconds, rounded up
+// so that a client waiting for it finds a token.
+func encodeRateLimited(w http.ResponseWriter, retryAfter time.Duration) {
+	seconds := int(math.Ceil(retryAfter.Seconds()))
+	w.Header().Set("Retry-After", strconv.Itoa(max(seconds, 1)))
+	EncodeError(w, &ApiError{
+		ErrorDetail: api.ErrorDetail{
+			Code:   RateLimitExceededCode,
+			Title:  "Too Many Requests",
+			Detail: "Too many requests, retry later.",
+			Status: http.StatusTooManyRequests,
+		},
+	})
+}
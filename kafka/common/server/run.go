@@ -17,6 +17,7 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/httplog/v2"
 
+	"github.com/Nesquiko/aass/common/mongodb"
 	"github.com/Nesquiko/aass/common/server/api"
 )
 
@@ -24,9 +25,20 @@ type Disconnecter interface {
 	Disconnect(ctx context.Context) error
 }
 
+// Database is the storage of a service, pinged to check its readiness.
+type Database interface {
+	Disconnecter
+	Ping(ctx context.Context) error
+}
+
 type (
-	MongoDbProvider[DB Disconnecter] = func(ctx context.Context, uri string, db string) (DB, error)
-	ServerProvider[DB Disconnecter]  = func(db DB, logger *httplog.Logger, opts api.ChiServerOptions) http.Handler
+	MongoDbProvider[DB Database] = func(ctx context.Context, uri string, db string) (DB, error)
+	ServerProvider[DB Database]  = func(
+		db DB,
+		clients *Clients,
+		logger *httplog.Logger,
+		opts api.ChiServerOptions,
+	) (http.Handler, error)
 )
 
 type ApiError struct {
@@ -37,7 +49,7 @@ func (e *ApiError) Error() string {
 	return fmt.Sprintf("error %q, status %d", e.Title, e.Status)
 }
 
-func Run[DB Disconnecter](
+func Run[DB Database](
 	ctx context.Context,
 	serviceName string,
 	serviceEnvPrefix string,
@@ -56,6 +68,19 @@ func Run[DB Disconnecter](
 
 	httpLogger := SetupLogger(serviceName, cfg.Log.Level)
 
+	shutdownTracing, err := SetupTracing(ctx, serviceName, cfg.Tracing)
+	if err != nil {
+		slog.Error("failed to setup tracing", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	defer func() {
+		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer shutdownCancel()
+		if err := shutdownTracing(shutdownCtx); err != nil {
+			slog.Error("error flushing spans", slog.String("error", err.Error()))
+		}
+	}()
+
 	loc, err := time.LoadLocation(cfg.App.Timezone)
 	if err != nil {
 		slog.Error("failed to load timezone", slog.String("error", err.Error()))
@@ -69,8 +94,51 @@ func Run[DB Disconnecter](
 		slog.Error("failed to connect to database", slog.String("error", err.Error()))
 		os.Exit(1)
 	}
+	RegisterHealthCheck(MongoCheck(db.Ping))
+
+	clients, err := NewClients(cfg.Clients)
+	if err != nil {
+		slog.Error("failed to create clients", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+
+	// rate limits and idempotency keys are kept next to the data of the
+	// service
+	serverDb, err := mongodb.ConnectMongo(ctx, cfg.MongoURI(), cfg.Mongo.Db)
+	if err != nil {
+		slog.Error("failed to connect to database", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	proxies, err := ParseTrustedProxies(cfg.App.TrustedProxies)
+	if err != nil {
+		slog.Error("invalid trusted proxies", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	limiter, err := newRateLimiter(ctx, cfg.RateLimit, serverDb, proxies)
+	if err != nil {
+		slog.Error("failed to create rate limiter", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	idempotency, err := NewIdempotency(ctx, serverDb, cfg.Idempotency.Ttl, proxies)
+	if err != nil {
+		slog.Error("failed to create idempotency store", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
 
-	srv := NewServer(apiSpec, db, httpLogger, serverProvider)
+	srv, err := NewServer(
+		apiSpec,
+		db,
+		clients,
+		httpLogger,
+		serverProvider,
+		cfg.Audit.AdminToken,
+		limiter,
+		idempotency,
+	)
+	if err != nil {
+		slog.Error("failed to create server", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
 	httpServer := &http.Server{
 		Addr:    net.JoinHostPort(cfg.App.Host, cfg.App.Port),
 		Handler: srv,
@@ -103,18 +171,26 @@ func SetupLogger(name string, logLevel slog.Level) *httplog.Logger {
 	logger := httplog.NewLogger(name, httplog.Options{
 		LogLevel: slog.Level(logLevel),
 	})
-	slog.SetDefault(logger.Logger)
+	slog.SetDefault(slog.New(requestIdHandler{logger.Logger.Handler()}))
 	return logger
 }
 
-func NewServer[DB Disconnecter](
+func NewServer[DB Database](
 	spec *openapi3.T,
 	db DB,
+	clients *Clients,
 	middlewareLogger *httplog.Logger,
 	serverProvider ServerProvider[DB],
-) http.Handler {
+	auditAdminToken string,
+	limiter *RateLimiter,
+	idempotency *Idempotency,
+) (http.Handler, error) {
 	r := chi.NewMux()
 	r.Use(Heartbeat())
+	r.Use(Health())
+	r.Use(Metrics())
+	r.Use(RequestId)
+	r.Use(observeRequest)
 	r.Use(OptionsMiddleware)
 
 	spec.Servers = nil
@@ -123,7 +199,13 @@ func NewServer[DB Disconnecter](
 		ErrorHandler: validationErrorHandler,
 	}
 
-	serverMiddlewares := Middleware(middlewareLogger, validationOpts)
+	serverMiddlewares := Middleware(
+		middlewareLogger,
+		validationOpts,
+		auditAdminToken,
+		limiter,
+		idempotency,
+	)
 	apiMiddlewares := make([]api.MiddlewareFunc, len(serverMiddlewares))
 	for i, mw := range serverMiddlewares {
 		apiMiddlewares[i] = api.MiddlewareFunc(mw)
@@ -138,21 +220,24 @@ func NewServer[DB Disconnecter](
 
 			switch {
 			case errors.As(err, &invalidParamErr):
-				slog.Warn(
+				slog.WarnContext(
+					r.Context(),
 					"invalid path param",
 					slog.String("error", err.Error()),
 					slog.String("where", "ErrorHandlerFunc"),
 				)
 				EncodeError(w, fromInvalidParamErr(invalidParamErr, chi.URLParam(r, "id")))
 			case errors.As(err, &requiredParamError):
-				slog.Warn(
+				slog.WarnContext(
+					r.Context(),
 					"missing required path param",
 					slog.String("error", err.Error()),
 					slog.String("where", "ErrorHandlerFunc"),
 				)
 				EncodeError(w, fromRequiredParamErr(requiredParamError))
 			default:
-				slog.Error(
+				slog.ErrorContext(
+					r.Context(),
 					"unexpected error handling in ErrorHandlerFunc",
 					slog.String("error", err.Error()),
 				)
@@ -161,7 +246,11 @@ func NewServer[DB Disconnecter](
 		},
 	}
 
-	return serverProvider(db, middlewareLogger, opts)
+	handler, err := serverProvider(db, clients, middlewareLogger, opts)
+	if err != nil {
+		return nil, err
+	}
+	return traceHandler(handler), nil
 }
 
 func validationErrorHandler(w http.ResponseWriter, message string, statusCode int) {
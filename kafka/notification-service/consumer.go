@@ -0,0 +1,120 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/Nesquiko/aass/common/notify"
+	appointmentapi "github.com/Nesquiko/aass/notification-service/appointment-api"
+)
+
+// Topics published by the appointment-service. Scheduled appointments are
+// sent as they are, other transitions as appointmentEvent values.
+const (
+	appointmentScheduledTopic   = "appointment-scheduled"
+	appointmentDeniedTopic      = "appointment-denied"
+	appointmentCancelledTopic   = "appointment-cancelled"
+	appointmentRescheduledTopic = "appointment-rescheduled"
+)
+
+type appointmentEvent struct {
+	Appointment appointmentapi.Appointment `json:"appointment"`
+	Actor       string                     `json:"actor"`
+}
+
+type appointmentConsumer struct {
+	store notify.MongoStore
+}
+
+// consume queues a notification for each participant who should learn
+// about the appointment's transition.
+func (c appointmentConsumer) consume(ctx context.Context, topic string, value []byte) error {
+	var event appointmentEvent
+	var err error
+	if topic == appointmentScheduledTopic {
+		err = decode(value, &event.Appointment)
+	} else {
+		err = decode(value, &event)
+	}
+	if err != nil {
+		return fmt.Errorf("consume decoding value: %w", err)
+	}
+
+	appt := event.Appointment
+	doctor := participant{
+		id:      appt.Doctor.Id.String(),
+		name:    "Dr. " + appt.Doctor.FirstName + " " + appt.Doctor.LastName,
+		address: string(appt.Doctor.Email),
+	}
+	patient := participant{
+		id:      appt.Patient.Id.String(),
+		name:    appt.Patient.FirstName + " " + appt.Patient.LastName,
+		address: string(appt.Patient.Email),
+	}
+
+	switch topic {
+	case appointmentScheduledTopic:
+		c.queue(ctx, appt, notify.KindAppointmentAccepted, nil, patient, doctor)
+	case appointmentDeniedTopic:
+		c.queue(ctx, appt, notify.KindAppointmentDenied, appt.DenialReason, patient, doctor)
+	case appointmentCancelledTopic:
+		recipient, with := doctor, patient
+		if appt.CanceledBy != nil && *appt.CanceledBy == appointmentapi.UserRoleDoctor {
+			recipient, with = patient, doctor
+		}
+		c.queue(ctx, appt, notify.KindAppointmentCancelled, appt.CancellationReason, recipient, with)
+	case appointmentRescheduledTopic:
+		// the participant who rescheduled already knows about it
+		if event.Actor != doctor.id {
+			c.queue(ctx, appt, notify.KindAppointmentRescheduled, nil, doctor, patient)
+		}
+		if event.Actor != patient.id {
+			c.queue(ctx, appt, notify.KindAppointmentRescheduled, nil, patient, doctor)
+		}
+	default:
+		slog.WarnContext(ctx, "Consumer received message of unknown topic", "topic", topic)
+	}
+	return nil
+}
+
+type participant struct {
+	id      string
+	name    string
+	address string
+}
+
+// queue stores the notification for the recipient, failures are only
+// logged, because the transition already happened.
+func (c appointmentConsumer) queue(
+	ctx context.Context,
+	appt appointmentapi.Appointment,
+	kind notify.Kind,
+	reason *string,
+	recipient participant,
+	with participant,
+) {
+	err := c.store.Queue(ctx, appt.Id, notify.Event{
+		Kind:             kind,
+		RecipientName:    recipient.name,
+		RecipientAddress: recipient.address,
+		With:             with.name,
+		Start:            appt.AppointmentDateTime,
+		Reason:           reason,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to queue notification",
+			"error", err.Error(),
+			"appointmentId", appt.Id,
+			"kind", kind,
+		)
+		return
+	}
+	slog.InfoContext(ctx, "Queued notification", "appointmentId", appt.Id, "kind", kind)
+}
+
+func decode(value []byte, dst any) error {
+	return json.NewDecoder(bytes.NewReader(value)).Decode(dst)
+}
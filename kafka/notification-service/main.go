@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"os"
+	"os/signal"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/Nesquiko/aass/common/mongodb"
+	"github.com/Nesquiko/aass/common/notify"
+	"github.com/Nesquiko/aass/common/server"
+)
+
+const (
+	serviceName      = "notification-service"
+	serviceEnvPrefix = "NOTIFICATIONSERVICE"
+)
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	cfg, err := server.LoadConfig(serviceEnvPrefix)
+	if err != nil {
+		slog.Error("failed to read config", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	server.SetupLogger(serviceName, cfg.Log.Level)
+
+	shutdownTracing, err := server.SetupTracing(ctx, serviceName, cfg.Tracing)
+	if err != nil {
+		slog.Error("failed to setup tracing", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	defer shutdownTracing(context.Background())
+
+	go server.ServeMonitoring(ctx, net.JoinHostPort(cfg.App.Host, cfg.App.Port))
+
+	loc, err := time.LoadLocation(cfg.App.Timezone)
+	if err != nil {
+		slog.Error("failed to load timezone", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	slog.Info("loaded timezone", slog.String("tz", loc.String()))
+	time.Local = loc
+
+	notifyCfg, err := notify.LoadConfig(serviceEnvPrefix)
+	if err != nil {
+		slog.Error("failed to read notify config", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	sender, err := notify.NewSender(notifyCfg)
+	if err != nil {
+		slog.Error("failed to create notification sender", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+
+	db, err := mongodb.ConnectMongo(ctx, cfg.MongoURI(), cfg.Mongo.Db)
+	if err != nil {
+		slog.Error("failed to connect to database", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	defer db.Client().Disconnect(context.Background())
+	server.RegisterHealthCheck(server.MongoCheck(func(ctx context.Context) error {
+		return db.Client().Ping(ctx, nil)
+	}))
+
+	store := notify.NewMongoStore(ctx, db)
+	dispatcher := notify.NewDispatcher(
+		store,
+		sender,
+		notifyCfg.PollInterval,
+		notifyCfg.MaxAttempts,
+	)
+	go dispatcher.Run(ctx)
+	slog.Info("started notification dispatcher", slog.String("sender", notifyCfg.Sender))
+
+	kafkaClient, err := server.InitKafka(appointmentScheduledTopic)
+	if err != nil {
+		slog.Error("Error while creating kafka client", "error", err.Error())
+		os.Exit(1)
+	}
+
+	consumer := appointmentConsumer{store: store}
+	server.NewGroupConsumer(
+		kafkaClient,
+		serviceName,
+		consumer.consume,
+		[]string{
+			appointmentScheduledTopic,
+			appointmentDeniedTopic,
+			appointmentCancelledTopic,
+			appointmentRescheduledTopic,
+		},
+	)
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"os"
+	"os/signal"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/Nesquiko/aass/common/mongodb"
+	"github.com/Nesquiko/aass/common/notify"
+	"github.com/Nesquiko/aass/common/reminders"
+	"github.com/Nesquiko/aass/common/server"
+	appointmentapi "github.com/Nesquiko/aass/reminder-worker/appointment-api"
+)
+
+const (
+	serviceName      = "reminder-worker"
+	serviceEnvPrefix = "REMINDERWORKER"
+)
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	cfg, err := server.LoadConfig(serviceEnvPrefix)
+	if err != nil {
+		slog.Error("failed to read config", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	server.SetupLogger(serviceName, cfg.Log.Level)
+
+	shutdownTracing, err := server.SetupTracing(ctx, serviceName, cfg.Tracing)
+	if err != nil {
+		slog.Error("failed to setup tracing", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	defer shutdownTracing(context.Background())
+
+	go server.ServeMonitoring(ctx, net.JoinHostPort(cfg.App.Host, cfg.App.Port))
+
+	loc, err := time.LoadLocation(cfg.App.Timezone)
+	if err != nil {
+		slog.Error("failed to load timezone", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	slog.Info("loaded timezone", slog.String("tz", loc.String()))
+	time.Local = loc
+
+	remindersCfg, err := reminders.LoadConfig(serviceEnvPrefix)
+	if err != nil {
+		slog.Error("failed to read reminders config", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+
+	db, err := mongodb.ConnectMongo(ctx, cfg.MongoURI(), cfg.Mongo.Db)
+	if err != nil {
+		slog.Error("failed to connect to database", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	defer db.Client().Disconnect(context.Background())
+	server.RegisterHealthCheck(server.MongoCheck(func(ctx context.Context) error {
+		return db.Client().Ping(ctx, nil)
+	}))
+
+	notifications := notify.NewMongoStore(ctx, db)
+
+	clients, err := server.NewClients(cfg.Clients)
+	if err != nil {
+		slog.Error("failed to create clients", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	apptUrl, apptHttpClient := clients.Client(server.AppointmentService)
+	appointmentClient, err := appointmentapi.NewClientWithResponses(
+		apptUrl,
+		appointmentapi.WithHTTPClient(apptHttpClient),
+	)
+	if err != nil {
+		slog.Error("failed to create appointment client", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	reminder := appointmentReminder{
+		appointments:  appointmentClient,
+		notifications: notifications,
+	}
+
+	scheduler := reminders.NewScheduler(
+		reminders.NewMongoStore(ctx, db, remindersCfg.Offsets),
+		reminder.remind,
+		remindersCfg.PollInterval,
+		remindersCfg.Lease,
+	)
+	slog.Info("started reminder scheduler")
+	scheduler.Run(ctx)
+}
@@ -31,9 +31,10 @@ const ResourceReservedTopic = "resource-reserved"
 
 func newResourceServer(
 	db mongoResourcesDb,
+	clients *server.Clients,
 	logger *httplog.Logger,
 	opts commonapi.ChiServerOptions,
-) http.Handler {
+) (http.Handler, error) {
 	kafkaClient, err := server.InitKafka(ResourceReservedTopic)
 	if err != nil {
 		slog.Error("Error while creating kafka client", "error", err.Error())
@@ -71,7 +72,7 @@ func newResourceServer(
 		[]string{"appointment-scheduled"},
 	)
 
-	return api.HandlerWithOptions(srv, mappedOpts)
+	return api.HandlerWithOptions(srv, mappedOpts), nil
 }
 
 func (s resourceServer) CreateResource(w http.ResponseWriter, r *http.Request) {
@@ -83,7 +84,14 @@ func (s resourceServer) CreateResource(w http.ResponseWriter, r *http.Request) {
 
 	resource, err := s.db.CreateResource(r.Context(), req.Name, ResourceType(req.Type))
 	if err != nil {
-		slog.Error(server.UnexpectedError, "error", err.Error(), "where", "CreateResource")
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"CreateResource",
+		)
 		server.EncodeError(w, server.InternalServerError())
 		return
 	}
@@ -104,7 +112,14 @@ func (s resourceServer) GetAvailableResources(
 ) {
 	resources, err := s.db.FindAvailableResourcesAtTime(r.Context(), params.DateTime)
 	if err != nil {
-		slog.Error(server.UnexpectedError, "error", err.Error(), "where", "GetAvailableResources")
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"GetAvailableResources",
+		)
 		server.EncodeError(w, server.InternalServerError())
 		return
 	}
@@ -145,7 +160,8 @@ func (s resourceServer) reserveAppointmentResources(
 	if req.EquipmentId != nil {
 		resource, err := s.db.ResourceById(ctx, *req.EquipmentId)
 		if err != nil {
-			slog.Error(
+			slog.ErrorContext(
+				ctx,
 				"error finding equipment resource",
 				"error", err.Error(), "where", "ReserveAppointmentResources",
 				"appointmentId", appointmentId.String(), "equipmentId", req.EquipmentId.String(),
@@ -158,7 +174,8 @@ func (s resourceServer) reserveAppointmentResources(
 			resource.Type, reservationStart, reservationEnd,
 		)
 		if err != nil {
-			slog.Error(
+			slog.ErrorContext(
+				ctx,
 				"error creating equipment reservation",
 				"error", err.Error(), "where", "ReserveAppointmentResources",
 				"appointmentId", appointmentId.String(), "equipmentId", req.EquipmentId.String(),
@@ -171,7 +188,8 @@ func (s resourceServer) reserveAppointmentResources(
 	if req.FacilityId != nil {
 		resource, err := s.db.ResourceById(ctx, *req.FacilityId)
 		if err != nil {
-			slog.Error(
+			slog.ErrorContext(
+				ctx,
 				"error finding facility resource",
 				"error", err.Error(), "where", "ReserveAppointmentResources",
 				"appointmentId", appointmentId.String(), "facilityId", req.FacilityId.String(),
@@ -184,7 +202,8 @@ func (s resourceServer) reserveAppointmentResources(
 			resource.Type, reservationStart, reservationEnd,
 		)
 		if err != nil {
-			slog.Error(
+			slog.ErrorContext(
+				ctx,
 				"error creating facility reservation",
 				"error", err.Error(), "where", "ReserveAppointmentResources",
 				"appointmentId", appointmentId.String(), "facilityId", req.FacilityId.String(),
@@ -197,7 +216,8 @@ func (s resourceServer) reserveAppointmentResources(
 	if req.MedicineId != nil {
 		resource, err := s.db.ResourceById(ctx, *req.MedicineId)
 		if err != nil {
-			slog.Error(
+			slog.ErrorContext(
+				ctx,
 				"error finding medicine resource",
 				"error", err.Error(), "where", "ReserveAppointmentResources",
 				"appointmentId", appointmentId.String(), "medicineId", req.MedicineId.String(),
@@ -209,7 +229,8 @@ func (s resourceServer) reserveAppointmentResources(
 			resource.Type, reservationStart, reservationEnd,
 		)
 		if err != nil {
-			slog.Error(
+			slog.ErrorContext(
+				ctx,
 				"error creating medicine reservation",
 				"error", err.Error(), "where", "ReserveAppointmentResources",
 				"appointmentId", appointmentId.String(), "medicineId", req.MedicineId.String(),
@@ -221,6 +242,64 @@ func (s resourceServer) reserveAppointmentResources(
 	return nil
 }
 
+func (s resourceServer) MoveAppointmentReservations(
+	w http.ResponseWriter,
+	r *http.Request,
+	appointmentId api.AppointmentId,
+) {
+	req, decodeErr := server.Decode[api.MoveAppointmentReservationsJSONBody](w, r)
+	if decodeErr != nil {
+		server.EncodeError(w, decodeErr)
+		return
+	}
+
+	// Reservations last 1 hour from the appointment start, same as when reserving
+	conflicts, err := s.db.MoveReservations(
+		r.Context(),
+		appointmentId,
+		req.Start,
+		req.Start.Add(time.Hour),
+	)
+	if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error", err.Error(), "where", "MoveAppointmentReservations",
+			"appointmentId", appointmentId.String(),
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+
+	res := api.ReservationsMove{Conflicts: server.Map(conflicts, reservationToApiResource)}
+	server.Encode(w, http.StatusOK, res)
+}
+
+// ExportAppointmentsReservations implements api.ServerInterface.
+func (s resourceServer) ExportAppointmentsReservations(w http.ResponseWriter, r *http.Request) {
+	req, decodeErr := server.Decode[api.ExportAppointmentsReservationsJSONBody](w, r)
+	if decodeErr != nil {
+		server.EncodeError(w, decodeErr)
+		return
+	}
+
+	reservations, err := s.db.ReservationsByAppointmentIds(r.Context(), req.AppointmentIds)
+	if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error", err.Error(), "where", "ExportAppointmentsReservations",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+
+	res := api.ReservationRecordsExport{
+		Reservations: server.Map(reservations, reservationToReservationRecord),
+	}
+	server.Encode(w, http.StatusOK, res)
+}
+
 func handlErr(err error) *server.ApiError {
 	if errors.Is(err, ErrNotFound) {
 		apiErr := &server.ApiError{
@@ -260,14 +339,31 @@ func (s resourceServer) GetResourceById(
 	server.Encode(w, http.StatusOK, resource)
 }
 
-func (s resourceServer) appointmentScheduledConsumer(value []byte) {
-	ctx := context.Background()
+// GetResourcesBatch implements api.ServerInterface.
+func (s resourceServer) GetResourcesBatch(w http.ResponseWriter, r *http.Request) {
+	req, decodeErr := server.Decode[api.GetResourcesBatchJSONRequestBody](w, r)
+	if decodeErr != nil {
+		server.EncodeError(w, decodeErr)
+		return
+	}
+
+	resources, err := s.db.ResourcesByIds(r.Context(), req.Ids)
+	if err != nil {
+		server.EncodeError(w, handlErr(err))
+		return
+	}
+
+	server.Encode(w, http.StatusOK, api.Resources{
+		Resources: server.Map(resources, resourceToApiResource),
+	})
+}
+
+func (s resourceServer) appointmentScheduledConsumer(ctx context.Context, value []byte) error {
 	var appt appointmentapi.Appointment
 	dec := json.NewDecoder(bytes.NewReader(value))
 	err := dec.Decode(&appt)
 	if err != nil {
-		slog.Error("Consumer error decoding value", "error", err.Error())
-		return
+		return fmt.Errorf("appointmentScheduledConsumer decoding value: %w", err)
 	}
 
 	req := api.ReserveAppointmentResourcesJSONBody{
@@ -283,10 +379,9 @@ func (s resourceServer) appointmentScheduledConsumer(value []byte) {
 		req.FacilityId = &((*appt.Facilities)[0].Id)
 	}
 
-	err = s.reserveAppointmentResources(context.Background(), appt.Id, req)
+	err = s.reserveAppointmentResources(ctx, appt.Id, req)
 	if err != nil {
-		slog.Error("Consumer error reserving resources", "error", err.Error())
-		return
+		return fmt.Errorf("appointmentScheduledConsumer reserving resources: %w", err)
 	}
 
 	reserved := ReservedResources{
@@ -320,28 +415,27 @@ func (s resourceServer) appointmentScheduledConsumer(value []byte) {
 		}
 	}
 
-	slog.Info("Emitting reserved event", "reserved", reserved)
+	slog.InfoContext(ctx, "Emitting reserved event", "reserved", reserved)
 	eventValue, marshalErr := mapResourcesToKafkaMessageValue(reserved)
 	if marshalErr != nil {
-		slog.Error("Failed to marshal appointment event", "error", marshalErr)
-	} else {
-		msg := &sarama.ProducerMessage{
-			Topic: ResourceReservedTopic,
-			Key:   sarama.StringEncoder(appt.Id.String()),
-			Value: sarama.ByteEncoder(eventValue),
-		}
+		return fmt.Errorf("appointmentScheduledConsumer marshal reserved event: %w", marshalErr)
+	}
+	msg := &sarama.ProducerMessage{
+		Topic: ResourceReservedTopic,
+		Key:   sarama.StringEncoder(appt.Id.String()),
+		Value: sarama.ByteEncoder(eventValue),
+	}
 
-		partition, offset, sendErr := s.kafkaProducer.SendMessage(msg)
-		if sendErr != nil {
-			slog.Error("Failed to send reserved resources event to Kafka", "error", sendErr)
-		} else {
-			slog.Info("Successfully sent appointment scheduled event to Kafka",
-				"topic", ResourceReservedTopic,
-				"partition", partition,
-				"offset", offset,
-			)
-		}
+	partition, offset, sendErr := server.SendMessage(ctx, s.kafkaProducer, msg)
+	if sendErr != nil {
+		return fmt.Errorf("appointmentScheduledConsumer send reserved event: %w", sendErr)
 	}
+	slog.InfoContext(ctx, "Successfully sent appointment scheduled event to Kafka",
+		"topic", ResourceReservedTopic,
+		"partition", partition,
+		"offset", offset,
+	)
+	return nil
 }
 
 type ReservedResource struct {
@@ -27,6 +27,17 @@ func dataPatientToApiPatient(p Patient) api.Patient {
 	}
 }
 
+func dataPatientToPatientRecord(p Patient) api.PatientRecord {
+	return api.PatientRecord{
+		Id:          p.Id,
+		Email:       types.Email(p.Email),
+		FirstName:   p.FirstName,
+		LastName:    p.LastName,
+		DeletedAt:   p.DeletedAt,
+		RetainUntil: p.RetainUntil,
+	}
+}
+
 func patientRegToDataPatient(p api.PatientRegistration) Patient {
 	return Patient{
 		Email:     string(p.Email),
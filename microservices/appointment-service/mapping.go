@@ -4,223 +4,496 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
-	"time"
+	"slices"
+	"sync"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/Nesquiko/aass/appointment-service/api"
+	medicalapi "github.com/Nesquiko/aass/appointment-service/medical-api"
 	resourceapi "github.com/Nesquiko/aass/appointment-service/resources-api"
+	userapi "github.com/Nesquiko/aass/appointment-service/user-api"
 	"github.com/Nesquiko/aass/common/server"
 )
 
+const (
+	// batchSize is the most ids fetched from another service in one request.
+	batchSize = 100
+	// fetchConcurrency is the most requests to other services in flight while
+	// mapping appointments.
+	fetchConcurrency = 8
+)
+
+// apptRelations holds entities owned by other services which appointments
+// reference, keyed by their ids.
+type apptRelations struct {
+	patients       map[uuid.UUID]userapi.Patient
+	doctors        map[uuid.UUID]userapi.Doctor
+	conditions     map[uuid.UUID]medicalapi.ConditionDisplay
+	conditionAppts map[uuid.UUID][]uuid.UUID
+	prescriptions  map[uuid.UUID][]medicalapi.PrescriptionDisplay
+	resources      map[uuid.UUID]resourceapi.NewResource
+	// stale has ids of users and resources which couldn't be fetched, their
+	// last-known versions come from the read model.
+	stale map[uuid.UUID]struct{}
+}
+
+// fetchRelations fetches patients and doctors of the appointments, and if
+// details is set also their conditions, prescriptions and resources. Ids are
+// fetched in batches, concurrently. Users and resources which couldn't be
+// fetched are taken from the read model, other missing details are logged and
+// left out.
+func (a appointmentServer) fetchRelations(
+	ctx context.Context,
+	appts []Appointment,
+	details bool,
+) apptRelations {
+	rel := apptRelations{
+		patients:       make(map[uuid.UUID]userapi.Patient),
+		doctors:        make(map[uuid.UUID]userapi.Doctor),
+		conditions:     make(map[uuid.UUID]medicalapi.ConditionDisplay),
+		conditionAppts: make(map[uuid.UUID][]uuid.UUID),
+		prescriptions:  make(map[uuid.UUID][]medicalapi.PrescriptionDisplay),
+		resources:      make(map[uuid.UUID]resourceapi.NewResource),
+		stale:          make(map[uuid.UUID]struct{}),
+	}
+
+	var userIds, apptIds, conditionIds, resourceIds []uuid.UUID
+	for _, appt := range appts {
+		userIds = append(userIds, appt.PatientId, appt.DoctorId)
+		apptIds = append(apptIds, appt.Id)
+		if appt.ConditionId != nil {
+			conditionIds = append(conditionIds, *appt.ConditionId)
+		}
+		for _, res := range slices.Concat(appt.Facilities, appt.Equipment, appt.Medicines) {
+			resourceIds = append(resourceIds, res.Id)
+		}
+	}
+
+	// fetches log their failures and never return an error
+	var g errgroup.Group
+	g.SetLimit(fetchConcurrency)
+	var mu sync.Mutex
+
+	for chunk := range slices.Chunk(uniqueIds(userIds), batchSize) {
+		g.Go(func() error {
+			res, err := a.userApi.GetUsersBatchWithResponse(
+				ctx,
+				userapi.GetUsersBatchJSONRequestBody{Ids: chunk},
+			)
+			if err == nil && res.JSON200 == nil {
+				err = fmt.Errorf("unexpected status %d", res.StatusCode())
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				slog.WarnContext(
+					ctx,
+					"failed to get users for mapping, using read model",
+					"error",
+					err,
+				)
+				for _, id := range chunk {
+					rel.stale[id] = struct{}{}
+					if p, ok := a.readModel.patients.get(id); ok {
+						rel.patients[id] = p
+					}
+					if d, ok := a.readModel.doctors.get(id); ok {
+						rel.doctors[id] = d
+					}
+				}
+				return nil
+			}
+
+			for _, p := range res.JSON200.Patients {
+				rel.patients[p.Id] = p
+				a.readModel.patients.put(p.Id, p)
+			}
+			for _, d := range res.JSON200.Doctors {
+				rel.doctors[d.Id] = d
+				a.readModel.doctors.put(d.Id, d)
+			}
+			return nil
+		})
+	}
+
+	if !details {
+		_ = g.Wait()
+		return rel
+	}
+
+	for chunk := range slices.Chunk(uniqueIds(conditionIds), batchSize) {
+		g.Go(func() error {
+			res, err := a.medicalApi.GetConditionsBatchWithResponse(
+				ctx,
+				medicalapi.GetConditionsBatchJSONRequestBody{Ids: chunk},
+			)
+			if err != nil {
+				slog.WarnContext(ctx, "failed to get conditions for mapping", "error", err)
+				return nil
+			} else if res.JSON200 == nil {
+				slog.WarnContext(
+					ctx,
+					"failed to get conditions for mapping",
+					"status",
+					res.StatusCode(),
+				)
+				return nil
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			for _, c := range res.JSON200.Conditions {
+				rel.conditions[*c.Id] = c
+			}
+			return nil
+		})
+		g.Go(func() error {
+			ids, err := a.db.AppointmentIdsByConditionIds(ctx, chunk)
+			if err != nil {
+				slog.WarnContext(
+					ctx,
+					"failed to get appointments of conditions for mapping",
+					"error",
+					err,
+				)
+				return nil
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			for conditionId, apptIds := range ids {
+				rel.conditionAppts[conditionId] = apptIds
+			}
+			return nil
+		})
+	}
+
+	for chunk := range slices.Chunk(apptIds, batchSize) {
+		g.Go(func() error {
+			res, err := a.medicalApi.GetPrescriptionsByAppointmentIdsWithResponse(
+				ctx,
+				medicalapi.GetPrescriptionsByAppointmentIdsJSONRequestBody{Ids: chunk},
+			)
+			if err != nil {
+				slog.WarnContext(ctx, "failed to get prescriptions for mapping", "error", err)
+				return nil
+			} else if res.JSON200 == nil {
+				slog.WarnContext(
+					ctx,
+					"failed to get prescriptions for mapping",
+					"status",
+					res.StatusCode(),
+				)
+				return nil
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			for _, id := range chunk {
+				rel.prescriptions[id] = []medicalapi.PrescriptionDisplay{}
+			}
+			for _, p := range res.JSON200.Prescriptions {
+				if p.AppointmentId != nil {
+					rel.prescriptions[*p.AppointmentId] = append(
+						rel.prescriptions[*p.AppointmentId],
+						p,
+					)
+				}
+			}
+			return nil
+		})
+	}
+
+	for chunk := range slices.Chunk(uniqueIds(resourceIds), batchSize) {
+		g.Go(func() error {
+			res, err := a.resourceApi.GetResourcesBatchWithResponse(
+				ctx,
+				resourceapi.GetResourcesBatchJSONRequestBody{Ids: chunk},
+			)
+			if err == nil && res.JSON200 == nil {
+				err = fmt.Errorf("unexpected status %d", res.StatusCode())
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				slog.WarnContext(
+					ctx,
+					"failed to get resources for mapping, using read model",
+					"error",
+					err,
+				)
+				for _, id := range chunk {
+					rel.stale[id] = struct{}{}
+					if r, ok := a.readModel.resources.get(id); ok {
+						rel.resources[id] = r
+					}
+				}
+				return nil
+			}
+
+			for _, r := range res.JSON200.Resources {
+				rel.resources[*r.Id] = r
+				a.readModel.resources.put(*r.Id, r)
+			}
+			return nil
+		})
+	}
+
+	_ = g.Wait()
+	return rel
+}
+
 func (a appointmentServer) mapDataApptToApiAppt(
 	ctx context.Context,
 	apptData Appointment,
 ) (api.Appointment, *server.ApiError) {
-	patientResp, patientErr := a.userApi.GetPatientByIdWithResponse(ctx, apptData.PatientId)
-	if patientErr != nil || patientResp.JSON200 == nil {
-		slog.Error(
-			"failed to get patient details for mapping",
-			"error",
-			patientErr,
-			"status",
-			patientResp.StatusCode(),
-		)
-		return api.Appointment{}, server.InternalServerError()
-	}
-
-	doctorResp, doctorErr := a.userApi.GetDoctorByIdWithResponse(ctx, apptData.DoctorId)
-	if doctorErr != nil || doctorResp.JSON200 == nil {
-		slog.Error(
-			"failed to get doctor details for mapping",
-			"error",
-			doctorErr,
-			"status",
-			doctorResp.StatusCode(),
-		)
-		return api.Appointment{}, server.InternalServerError()
-	}
-
-	var conditionDisplay *api.ConditionDisplay = nil
-	if apptData.ConditionId != nil {
-		condResp, condErr := a.medicalApi.ConditionDetailWithResponse(ctx, *apptData.ConditionId)
-		if condErr != nil || condResp.JSON200 == nil {
-			// Log error but don't fail the whole request if condition is missing
-			slog.Warn(
-				"failed to get condition details for mapping",
-				"error",
-				condErr,
-				"status",
-				condResp.StatusCode(),
-				"conditionId",
-				apptData.ConditionId.String(),
+	appts, apiErr := a.mapDataApptsToApiAppts(ctx, []Appointment{apptData})
+	if apiErr != nil {
+		return api.Appointment{}, apiErr
+	}
+	return appts[0], nil
+}
+
+func (a appointmentServer) mapDataApptsToApiAppts(
+	ctx context.Context,
+	apptsData []Appointment,
+) ([]api.Appointment, *server.ApiError) {
+	rel := a.fetchRelations(ctx, apptsData, true)
+
+	appts := make([]api.Appointment, len(apptsData))
+	for i, apptData := range apptsData {
+		patient, patientOk := rel.patients[apptData.PatientId]
+		doctor, doctorOk := rel.doctors[apptData.DoctorId]
+		if !patientOk || !doctorOk {
+			slog.ErrorContext(
+				ctx,
+				"missing users for mapping",
+				"patientId",
+				apptData.PatientId.String(),
+				"doctorId",
+				apptData.DoctorId.String(),
 			)
-		} else {
-			conditionDisplay = &api.ConditionDisplay{
-				Id:              condResp.JSON200.Id,
-				Name:            condResp.JSON200.Name,
-				Start:           condResp.JSON200.Start,
-				End:             condResp.JSON200.End,
-				AppointmentsIds: condResp.JSON200.AppointmentsIds,
+			return nil, server.InternalServerError()
+		}
+		rel.markStaleField(ctx, "patient", apptData.PatientId)
+		rel.markStaleField(ctx, "doctor", apptData.DoctorId)
+		rel.markStaleField(ctx, "facilities", resourceIds(apptData.Facilities)...)
+		rel.markStaleField(ctx, "equipment", resourceIds(apptData.Equipment)...)
+		rel.markStaleField(ctx, "medicine", resourceIds(apptData.Medicines)...)
+
+		var conditionDisplay *api.ConditionDisplay = nil
+		if apptData.ConditionId != nil {
+			if cond, ok := rel.conditions[*apptData.ConditionId]; ok {
+				conditionDisplay = &api.ConditionDisplay{
+					Id:              cond.Id,
+					Name:            cond.Name,
+					Start:           cond.Start,
+					End:             cond.End,
+					AppointmentsIds: server.AsPtr(rel.conditionAppts[*cond.Id]),
+				}
 			}
 		}
+
+		var prescriptionsDisplay *[]api.PrescriptionDisplay = nil
+		if prescs, ok := rel.prescriptions[apptData.Id]; ok {
+			prescriptionsDisplay = server.AsPtr(server.Map(
+				prescs,
+				func(p medicalapi.PrescriptionDisplay) api.PrescriptionDisplay {
+					return api.PrescriptionDisplay{
+						Id:            p.Id,
+						Name:          p.Name,
+						Start:         p.Start,
+						End:           p.End,
+						AppointmentId: p.AppointmentId,
+					}
+				},
+			))
+		}
+
+		var facilities *[]api.Facility = nil
+		if len(apptData.Facilities) > 0 {
+			facilities = server.AsPtr(server.Map(
+				apptData.Facilities,
+				func(r Resource) api.Facility {
+					return api.Facility{Id: r.Id, Name: rel.resourceName(r)}
+				},
+			))
+		}
+
+		var equipment *[]api.Equipment = nil
+		if len(apptData.Equipment) > 0 {
+			equipment = server.AsPtr(server.Map(
+				apptData.Equipment,
+				func(r Resource) api.Equipment {
+					return api.Equipment{Id: r.Id, Name: rel.resourceName(r)}
+				},
+			))
+		}
+
+		var medicine *[]api.Medicine = nil
+		if len(apptData.Medicines) > 0 {
+			medicine = server.AsPtr(server.Map(
+				apptData.Medicines,
+				func(r Resource) api.Medicine {
+					return api.Medicine{Id: r.Id, Name: rel.resourceName(r)}
+				},
+			))
+		}
+
+		var canceledBy *api.UserRole = nil
+		if apptData.CancelledBy != nil {
+			role := api.UserRole(*apptData.CancelledBy)
+			canceledBy = &role
+		}
+
+		appts[i] = api.Appointment{
+			Id:                  apptData.Id,
+			Version:             apptData.Version,
+			AppointmentDateTime: apptData.AppointmentDateTime,
+			Type:                api.AppointmentType(apptData.Type),
+			Condition:           conditionDisplay,
+			Status:              api.AppointmentStatus(apptData.Status),
+			Reason:              apptData.Reason,
+			CancellationReason:  apptData.CancellationReason,
+			CanceledBy:          canceledBy,
+			DenialReason:        apptData.DenialReason,
+			Prescriptions:       prescriptionsDisplay,
+			Patient: api.Patient{
+				Id:        patient.Id,
+				FirstName: patient.FirstName,
+				LastName:  patient.LastName,
+				Email:     patient.Email,
+				Role:      api.UserRole(patient.Role),
+			},
+			Doctor: api.Doctor{
+				Id:             doctor.Id,
+				FirstName:      doctor.FirstName,
+				LastName:       doctor.LastName,
+				Email:          doctor.Email,
+				Role:           api.UserRole(doctor.Role),
+				Specialization: api.SpecializationEnum(doctor.Specialization),
+			},
+			Facilities: facilities,
+			Equipment:  equipment,
+			Medicine:   medicine,
+		}
 	}
 
-	var prescriptionsDisplay *[]api.PrescriptionDisplay = nil
-	prescResp, prescErr := a.medicalApi.GetPrescriptionsByAppointmentIdWithResponse(
-		ctx,
-		apptData.Id,
-	)
-	if prescErr != nil || prescResp.JSON200 == nil {
-		// Log error but don't fail the whole request if prescriptions are missing
-		slog.Warn(
-			"failed to get prescriptions for mapping",
-			"error",
-			prescErr,
-			"status",
-			prescResp.StatusCode(),
-			"appointmentId",
-			apptData.Id.String(),
-		)
-	} else if prescResp.JSON200.Prescriptions != nil {
-		prescs := make([]api.PrescriptionDisplay, len(prescResp.JSON200.Prescriptions))
-		for i, p := range prescResp.JSON200.Prescriptions {
-			prescs[i] = api.PrescriptionDisplay{
-				Id:            p.Id,
-				Name:          p.Name,
-				Start:         p.Start,
-				End:           p.End,
-				AppointmentId: p.AppointmentId,
-			}
+	return appts, nil
+}
+
+func (a appointmentServer) mapDataApptsToApiApptDisplays(
+	ctx context.Context,
+	apptsData []Appointment,
+) ([]api.AppointmentDisplay, *server.ApiError) {
+	rel := a.fetchRelations(ctx, apptsData, false)
+
+	appts := make([]api.AppointmentDisplay, len(apptsData))
+	for i, apptData := range apptsData {
+		patient, patientOk := rel.patients[apptData.PatientId]
+		doctor, doctorOk := rel.doctors[apptData.DoctorId]
+		if !patientOk || !doctorOk {
+			slog.ErrorContext(
+				ctx,
+				"missing users for display mapping",
+				"patientId",
+				apptData.PatientId.String(),
+				"doctorId",
+				apptData.DoctorId.String(),
+			)
+			return nil, server.InternalServerError()
 		}
-		prescriptionsDisplay = &prescs
-	}
-
-	apiPatient := api.Patient{
-		Id:        patientResp.JSON200.Id,
-		FirstName: patientResp.JSON200.FirstName,
-		LastName:  patientResp.JSON200.LastName,
-		Email:     patientResp.JSON200.Email,
-		Role:      api.UserRole(patientResp.JSON200.Role),
-	}
-
-	apiDoctor := api.Doctor{
-		Id:             doctorResp.JSON200.Id,
-		FirstName:      doctorResp.JSON200.FirstName,
-		LastName:       doctorResp.JSON200.LastName,
-		Email:          doctorResp.JSON200.Email,
-		Role:           api.UserRole(doctorResp.JSON200.Role),
-		Specialization: api.SpecializationEnum(doctorResp.JSON200.Specialization),
-	}
-
-	var facilities *[]api.Facility = nil
-	if len(apptData.Facilities) > 0 {
-		f := make([]api.Facility, len(apptData.Facilities))
-		for i, res := range apptData.Facilities {
-			r, _ := a.resourceApi.GetResourceByIdWithResponse(ctx, res.Id)
-			if r.JSON200 == nil {
-				slog.Error("nil facility resources by ID response", "id", res.Id)
-				continue
-			}
+		rel.markStaleField(ctx, "patientName", apptData.PatientId)
+		rel.markStaleField(ctx, "doctorName", apptData.DoctorId)
 
-			f[i] = api.Facility{Id: res.Id, Name: r.JSON200.Name}
+		appts[i] = api.AppointmentDisplay{
+			Id:                  apptData.Id,
+			AppointmentDateTime: apptData.AppointmentDateTime,
+			DoctorName:          fmt.Sprintf("%s %s", doctor.FirstName, doctor.LastName),
+			PatientName:         fmt.Sprintf("%s %s", patient.FirstName, patient.LastName),
+			Status:              api.AppointmentStatus(apptData.Status),
+			Type:                api.AppointmentType(apptData.Type),
 		}
-		facilities = &f
 	}
 
-	var equipment *[]api.Equipment = nil
-	if len(apptData.Equipment) > 0 {
-		e := make([]api.Equipment, len(apptData.Equipment))
-		for i, res := range apptData.Equipment {
-			r, _ := a.resourceApi.GetResourceByIdWithResponse(ctx, res.Id)
-			if r.JSON200 == nil {
-				slog.Error("nil equipment resources by ID response", "id", res.Id)
-				continue
-			}
-			e[i] = api.Equipment{Id: res.Id, Name: r.JSON200.Name}
+	return appts, nil
+}
+
+// markStaleField marks the field of the response as stale, if any of the ids
+// were taken from the read model.
+func (rel apptRelations) markStaleField(ctx context.Context, field string, ids ...uuid.UUID) {
+	for _, id := range ids {
+		if _, ok := rel.stale[id]; ok {
+			markStale(ctx, field)
+			return
 		}
-		equipment = &e
 	}
+}
 
-	var medicine *[]api.Medicine = nil
-	if len(apptData.Medicines) > 0 {
-		m := make([]api.Medicine, len(apptData.Medicines))
-		for i, res := range apptData.Medicines {
-			r, _ := a.resourceApi.GetResourceByIdWithResponse(ctx, res.Id)
-			if r.JSON200 == nil {
-				slog.Error("nil medicine resources by ID response", "id", res.Id)
-				continue
-			}
-			m[i] = api.Medicine{Id: res.Id, Name: r.JSON200.Name}
+// resourceName returns the current or last-known name of the resource, or the
+// one stored with the appointment if neither is available.
+func (rel apptRelations) resourceName(r Resource) string {
+	if res, ok := rel.resources[r.Id]; ok {
+		return res.Name
+	}
+	return r.Name
+}
+
+func resourceIds(resources []Resource) []uuid.UUID {
+	return server.Map(resources, func(r Resource) uuid.UUID { return r.Id })
+}
+
+func uniqueIds(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]struct{}, len(ids))
+	unique := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			unique = append(unique, id)
 		}
-		medicine = &m
-	}
-
-	var canceledBy *api.UserRole = nil
-	if apptData.CancelledBy != nil {
-		role := api.UserRole(*apptData.CancelledBy)
-		canceledBy = &role
-	}
-
-	return api.Appointment{
-		Id:                  apptData.Id,
-		AppointmentDateTime: apptData.AppointmentDateTime,
-		Type:                api.AppointmentType(apptData.Type),
-		Condition:           conditionDisplay,
-		Status:              api.AppointmentStatus(apptData.Status),
-		Reason:              apptData.Reason,
-		CancellationReason:  apptData.CancellationReason,
-		CanceledBy:          canceledBy,
-		DenialReason:        apptData.DenialReason,
-		Prescriptions:       prescriptionsDisplay,
-		Patient:             apiPatient,
-		Doctor:              apiDoctor,
-		Facilities:          facilities,
-		Equipment:           equipment,
-		Medicine:            medicine,
-	}, nil
+	}
+	return unique
 }
 
-func (a appointmentServer) mapDataApptToApiApptDisplay(
-	ctx context.Context,
-	apptData Appointment,
-) (api.AppointmentDisplay, *server.ApiError) {
-	patientResp, patientErr := a.userApi.GetPatientByIdWithResponse(ctx, apptData.PatientId)
-	if patientErr != nil || patientResp.JSON200 == nil {
-		slog.Error(
-			"failed to get patient details for display mapping",
-			"error",
-			patientErr,
-			"status",
-			patientResp.StatusCode(),
-		)
-		return api.AppointmentDisplay{}, server.InternalServerError()
-	}
-
-	doctorResp, doctorErr := a.userApi.GetDoctorByIdWithResponse(ctx, apptData.DoctorId)
-	if doctorErr != nil || doctorResp.JSON200 == nil {
-		slog.Error(
-			"failed to get doctor details for display mapping",
-			"error",
-			doctorErr,
-			"status",
-			doctorResp.StatusCode(),
-		)
-		return api.AppointmentDisplay{}, server.InternalServerError()
-	}
-
-	return api.AppointmentDisplay{
-		Id:                  apptData.Id,
-		AppointmentDateTime: apptData.AppointmentDateTime,
-		DoctorName: fmt.Sprintf(
-			"%s %s",
-			doctorResp.JSON200.FirstName,
-			doctorResp.JSON200.LastName,
-		),
-		PatientName: fmt.Sprintf(
-			"%s %s",
-			patientResp.JSON200.FirstName,
-			patientResp.JSON200.LastName,
-		),
-		Status: api.AppointmentStatus(apptData.Status),
-		Type:   api.AppointmentType(apptData.Type),
-	}, nil
+func dataApptToApptRecord(a Appointment) api.AppointmentRecord {
+	record := api.AppointmentRecord{
+		Id:                  a.Id,
+		PatientId:           a.PatientId,
+		DoctorId:            a.DoctorId,
+		AppointmentDateTime: a.AppointmentDateTime,
+		EndTime:             a.EndTime,
+		Type:                api.AppointmentType(a.Type),
+		Status:              api.AppointmentStatus(a.Status),
+		Reason:              a.Reason,
+		ConditionId:         a.ConditionId,
+		CancellationReason:  a.CancellationReason,
+		CancelledBy:         (*api.UserRole)(a.CancelledBy),
+		DenialReason:        a.DenialReason,
+	}
+
+	if len(a.Facilities) > 0 {
+		record.Facilities = server.AsPtr(server.Map(a.Facilities, func(r Resource) api.Facility {
+			return api.Facility{Id: r.Id, Name: r.Name}
+		}))
+	}
+	if len(a.Equipment) > 0 {
+		record.Equipment = server.AsPtr(server.Map(a.Equipment, func(r Resource) api.Equipment {
+			return api.Equipment{Id: r.Id, Name: r.Name}
+		}))
+	}
+	if len(a.Medicines) > 0 {
+		record.Medicine = server.AsPtr(server.Map(a.Medicines, func(r Resource) api.Medicine {
+			return api.Medicine{Id: r.Id, Name: r.Name}
+		}))
+	}
+
+	return record
+}
+
+func dataIntervalToApiInterval(i Interval) api.Interval {
+	return api.Interval{Start: i.Start, End: i.End}
 }
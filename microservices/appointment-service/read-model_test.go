@@ -0,0 +1,259 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	resourceapi "github.com/Nesquiko/aass/appointment-service/resources-api"
+	userapi "github.com/Nesquiko/aass/appointment-service/user-api"
+)
+
+func TestLruCache(t *testing.T) {
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+
+	// step puts the value under key, removes the key, waits, or gets the key
+	// expecting want, or a miss if want is empty
+	type step struct {
+		put    bool
+		remove bool
+		wait   time.Duration
+		key    uuid.UUID
+		value  string
+		want   string
+	}
+	put := func(key uuid.UUID, value string) step { return step{put: true, key: key, value: value} }
+	get := func(key uuid.UUID, want string) step { return step{key: key, want: want} }
+
+	tests := []struct {
+		name       string
+		maxEntries int
+		maxAge     time.Duration
+		steps      []step
+	}{
+		{
+			name:       "put value is served",
+			maxEntries: 2,
+			maxAge:     time.Hour,
+			steps:      []step{put(a, "a"), get(a, "a"), get(b, "")},
+		},
+		{
+			name:       "put replaces value",
+			maxEntries: 2,
+			maxAge:     time.Hour,
+			steps:      []step{put(a, "a"), put(a, "a2"), get(a, "a2")},
+		},
+		{
+			name:       "removed value is invalidated",
+			maxEntries: 2,
+			maxAge:     time.Hour,
+			steps:      []step{put(a, "a"), {remove: true, key: a}, get(a, "")},
+		},
+		{
+			name:       "value older than max age is invalidated",
+			maxEntries: 2,
+			maxAge:     time.Millisecond,
+			steps:      []step{put(a, "a"), {wait: 5 * time.Millisecond}, get(a, "")},
+		},
+		{
+			name:       "least recently put is evicted",
+			maxEntries: 2,
+			maxAge:     time.Hour,
+			steps: []step{
+				put(a, "a"),
+				put(b, "b"),
+				put(c, "c"),
+				get(a, ""),
+				get(b, "b"),
+				get(c, "c"),
+			},
+		},
+		{
+			name:       "get keeps value from eviction",
+			maxEntries: 2,
+			maxAge:     time.Hour,
+			steps: []step{
+				put(a, "a"),
+				put(b, "b"),
+				get(a, "a"),
+				put(c, "c"),
+				get(a, "a"),
+				get(b, ""),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache := newLruCache[string](tt.maxEntries, tt.maxAge)
+			for i, s := range tt.steps {
+				switch {
+				case s.put:
+					cache.put(s.key, s.value)
+				case s.remove:
+					cache.remove(s.key)
+				case s.wait > 0:
+					time.Sleep(s.wait)
+				default:
+					got, ok := cache.get(s.key)
+					if ok != (s.want != "") || got != s.want {
+						t.Errorf("step %d: get = %q, %v, want %q", i, got, ok, s.want)
+					}
+				}
+			}
+			if keys := cache.keys(); len(keys) > tt.maxEntries {
+				t.Errorf("cache has %d keys, want at most %d", len(keys), tt.maxEntries)
+			}
+		})
+	}
+}
+
+func TestSyncReadModel(t *testing.T) {
+	patient := userapi.Patient{
+		Id:        uuid.New(),
+		Email:     "jane@example.com",
+		FirstName: "Jane",
+		LastName:  "Doe",
+	}
+	doctor := userapi.Doctor{
+		Id:        uuid.New(),
+		Email:     "john@example.com",
+		FirstName: "John",
+		LastName:  "Roe",
+	}
+	resourceId := uuid.New()
+	resource := resourceapi.NewResource{Id: &resourceId, Name: "X-ray"}
+
+	renamed := patient
+	renamed.LastName = "Smith"
+	renamedResource := resource
+	renamedResource.Name = "MRI"
+
+	tests := []struct {
+		name string
+		// upstream users and resources, nil ones respond with 500
+		users     *userapi.UsersBatch
+		resources *resourceapi.Resources
+
+		wantPatient  *userapi.Patient
+		wantDoctor   *userapi.Doctor
+		wantResource *resourceapi.NewResource
+	}{
+		{
+			name:  "refreshes changed entries",
+			users: &userapi.UsersBatch{Patients: []userapi.Patient{renamed}},
+			resources: &resourceapi.Resources{
+				Resources: []resourceapi.NewResource{renamedResource},
+			},
+			wantPatient:  &renamed,
+			wantResource: &renamedResource,
+		},
+		{
+			name:       "evicts entries which no longer exist",
+			users:      &userapi.UsersBatch{Doctors: []userapi.Doctor{doctor}},
+			resources:  &resourceapi.Resources{},
+			wantDoctor: &doctor,
+		},
+		{
+			name:         "keeps entries while upstreams are down",
+			wantPatient:  &patient,
+			wantDoctor:   &doctor,
+			wantResource: &resource,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := func(w http.ResponseWriter, r *http.Request) {
+				var body any
+				if r.URL.Path == "/users/batch" && tt.users != nil {
+					body = tt.users
+				} else if r.URL.Path == "/resources/batch" && tt.resources != nil {
+					body = tt.resources
+				} else {
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(body)
+			}
+			upstream := httptest.NewServer(http.HandlerFunc(handler))
+			defer upstream.Close()
+
+			userClient, err := userapi.NewClientWithResponses(upstream.URL)
+			if err != nil {
+				t.Fatalf("user client: %v", err)
+			}
+			resourceClient, err := resourceapi.NewClientWithResponses(upstream.URL)
+			if err != nil {
+				t.Fatalf("resource client: %v", err)
+			}
+			srv := appointmentServer{
+				userApi:     userClient,
+				resourceApi: resourceClient,
+				readModel:   newReadModel(readModelConfig{MaxAge: time.Hour, MaxEntries: 10}),
+			}
+			srv.readModel.patients.put(patient.Id, patient)
+			srv.readModel.doctors.put(doctor.Id, doctor)
+			srv.readModel.resources.put(resourceId, resource)
+
+			srv.syncReadModel(context.Background())
+
+			gotPatient, ok := srv.readModel.patients.get(patient.Id)
+			if ok != (tt.wantPatient != nil) || ok && gotPatient != *tt.wantPatient {
+				t.Errorf("patient = %+v, %v, want %+v", gotPatient, ok, tt.wantPatient)
+			}
+			gotDoctor, ok := srv.readModel.doctors.get(doctor.Id)
+			if ok != (tt.wantDoctor != nil) || ok && gotDoctor != *tt.wantDoctor {
+				t.Errorf("doctor = %+v, %v, want %+v", gotDoctor, ok, tt.wantDoctor)
+			}
+			gotResource, ok := srv.readModel.resources.get(resourceId)
+			if ok != (tt.wantResource != nil) || ok && gotResource.Name != tt.wantResource.Name {
+				t.Errorf("resource = %+v, %v, want %+v", gotResource, ok, tt.wantResource)
+			}
+		})
+	}
+}
+
+func TestStaleFieldsMiddleware(t *testing.T) {
+	tests := []struct {
+		name   string
+		stale  [][]string
+		header string
+	}{
+		{name: "nothing stale"},
+		{name: "one field", stale: [][]string{{"patient"}}, header: "patient"},
+		{
+			name:   "fields are listed once",
+			stale:  [][]string{{"patient", "doctor"}, {"doctor"}, {"facilities"}},
+			header: "patient,doctor,facilities",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				for _, fields := range tt.stale {
+					markStale(r.Context(), fields...)
+				}
+				_, _ = w.Write([]byte("{}"))
+			})
+
+			w := httptest.NewRecorder()
+			staleFieldsMiddleware(next).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+			if got := w.Header().Get(StaleFieldsHeader); got != tt.header {
+				t.Errorf("%s = %q, want %q", StaleFieldsHeader, got, tt.header)
+			}
+			if !slices.Equal(w.Body.Bytes(), []byte("{}")) {
+				t.Errorf("body = %q, want %q", w.Body.String(), "{}")
+			}
+		})
+	}
+}
@@ -74,9 +74,36 @@ type NewResource struct {
 	Type ResourceType `json:"type"`
 }
 
+// ReservationRecord defines model for ReservationRecord.
+type ReservationRecord struct {
+	AppointmentId openapi_types.UUID `json:"appointmentId"`
+	End           time.Time          `json:"end"`
+	Id            openapi_types.UUID `json:"id"`
+	ResourceId    openapi_types.UUID `json:"resourceId"`
+	ResourceName  string             `json:"resourceName"`
+	ResourceType  ResourceType       `json:"resourceType"`
+	Start         time.Time          `json:"start"`
+}
+
+// ReservationRecordsExport defines model for ReservationRecordsExport.
+type ReservationRecordsExport struct {
+	Reservations []ReservationRecord `json:"reservations"`
+}
+
+// ReservationsMove Result of moving reservations of an appointment to a new time.
+type ReservationsMove struct {
+	// Conflicts Resources taken by other appointments in the new slot. Empty if the reservations were moved.
+	Conflicts []NewResource `json:"conflicts"`
+}
+
 // ResourceType defines model for ResourceType.
 type ResourceType string
 
+// Resources defines model for Resources.
+type Resources struct {
+	Resources []NewResource `json:"resources"`
+}
+
 // AppointmentId defines model for appointmentId.
 type AppointmentId = openapi_types.UUID
 
@@ -91,6 +118,11 @@ type GetAvailableResourcesParams struct {
 	DateTime DateTime `form:"date-time" json:"date-time"`
 }
 
+// ExportAppointmentsReservationsJSONBody defines parameters for ExportAppointmentsReservations.
+type ExportAppointmentsReservationsJSONBody struct {
+	AppointmentIds []openapi_types.UUID `json:"appointmentIds"`
+}
+
 // ReserveAppointmentResourcesJSONBody defines parameters for ReserveAppointmentResources.
 type ReserveAppointmentResourcesJSONBody struct {
 	EquipmentId *openapi_types.UUID `json:"equipmentId,omitempty"`
@@ -99,12 +131,26 @@ type ReserveAppointmentResourcesJSONBody struct {
 	Start       time.Time           `json:"start"`
 }
 
+// MoveAppointmentReservationsJSONBody defines parameters for MoveAppointmentReservations.
+type MoveAppointmentReservationsJSONBody struct {
+	Start time.Time `json:"start"`
+}
+
 // CreateResourceJSONRequestBody defines body for CreateResource for application/json ContentType.
 type CreateResourceJSONRequestBody = NewResource
 
+// GetResourcesBatchJSONRequestBody defines body for GetResourcesBatch for application/json ContentType.
+type GetResourcesBatchJSONRequestBody = externalRef0.BatchIds
+
+// ExportAppointmentsReservationsJSONRequestBody defines body for ExportAppointmentsReservations for application/json ContentType.
+type ExportAppointmentsReservationsJSONRequestBody ExportAppointmentsReservationsJSONBody
+
 // ReserveAppointmentResourcesJSONRequestBody defines body for ReserveAppointmentResources for application/json ContentType.
 type ReserveAppointmentResourcesJSONRequestBody ReserveAppointmentResourcesJSONBody
 
+// MoveAppointmentReservationsJSONRequestBody defines body for MoveAppointmentReservations for application/json ContentType.
+type MoveAppointmentReservationsJSONRequestBody MoveAppointmentReservationsJSONBody
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -186,11 +232,26 @@ type ClientInterface interface {
 	// GetAvailableResources request
 	GetAvailableResources(ctx context.Context, params *GetAvailableResourcesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetResourcesBatchWithBody request with any body
+	GetResourcesBatchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	GetResourcesBatch(ctx context.Context, body GetResourcesBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportAppointmentsReservationsWithBody request with any body
+	ExportAppointmentsReservationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ExportAppointmentsReservations(ctx context.Context, body ExportAppointmentsReservationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ReserveAppointmentResourcesWithBody request with any body
 	ReserveAppointmentResourcesWithBody(ctx context.Context, appointmentId AppointmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	ReserveAppointmentResources(ctx context.Context, appointmentId AppointmentId, body ReserveAppointmentResourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// MoveAppointmentReservationsWithBody request with any body
+	MoveAppointmentReservationsWithBody(ctx context.Context, appointmentId AppointmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	MoveAppointmentReservations(ctx context.Context, appointmentId AppointmentId, body MoveAppointmentReservationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetResourceById request
 	GetResourceById(ctx context.Context, resourceId ResourceId, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
@@ -231,6 +292,54 @@ func (c *Client) GetAvailableResources(ctx context.Context, params *GetAvailable
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetResourcesBatchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetResourcesBatchRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetResourcesBatch(ctx context.Context, body GetResourcesBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetResourcesBatchRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportAppointmentsReservationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportAppointmentsReservationsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportAppointmentsReservations(ctx context.Context, body ExportAppointmentsReservationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportAppointmentsReservationsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) ReserveAppointmentResourcesWithBody(ctx context.Context, appointmentId AppointmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewReserveAppointmentResourcesRequestWithBody(c.Server, appointmentId, contentType, body)
 	if err != nil {
@@ -255,6 +364,30 @@ func (c *Client) ReserveAppointmentResources(ctx context.Context, appointmentId
 	return c.Client.Do(req)
 }
 
+func (c *Client) MoveAppointmentReservationsWithBody(ctx context.Context, appointmentId AppointmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMoveAppointmentReservationsRequestWithBody(c.Server, appointmentId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MoveAppointmentReservations(ctx context.Context, appointmentId AppointmentId, body MoveAppointmentReservationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMoveAppointmentReservationsRequest(c.Server, appointmentId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetResourceById(ctx context.Context, resourceId ResourceId, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetResourceByIdRequest(c.Server, resourceId)
 	if err != nil {
@@ -352,6 +485,86 @@ func NewGetAvailableResourcesRequest(server string, params *GetAvailableResource
 	return req, nil
 }
 
+// NewGetResourcesBatchRequest calls the generic GetResourcesBatch builder with application/json body
+func NewGetResourcesBatchRequest(server string, body GetResourcesBatchJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewGetResourcesBatchRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewGetResourcesBatchRequestWithBody generates requests for GetResourcesBatch with any type of body
+func NewGetResourcesBatchRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/resources/batch")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewExportAppointmentsReservationsRequest calls the generic ExportAppointmentsReservations builder with application/json body
+func NewExportAppointmentsReservationsRequest(server string, body ExportAppointmentsReservationsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewExportAppointmentsReservationsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewExportAppointmentsReservationsRequestWithBody generates requests for ExportAppointmentsReservations with any type of body
+func NewExportAppointmentsReservationsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/resources/reservations/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewReserveAppointmentResourcesRequest calls the generic ReserveAppointmentResources builder with application/json body
 func NewReserveAppointmentResourcesRequest(server string, appointmentId AppointmentId, body ReserveAppointmentResourcesJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
@@ -399,6 +612,53 @@ func NewReserveAppointmentResourcesRequestWithBody(server string, appointmentId
 	return req, nil
 }
 
+// NewMoveAppointmentReservationsRequest calls the generic MoveAppointmentReservations builder with application/json body
+func NewMoveAppointmentReservationsRequest(server string, appointmentId AppointmentId, body MoveAppointmentReservationsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewMoveAppointmentReservationsRequestWithBody(server, appointmentId, "application/json", bodyReader)
+}
+
+// NewMoveAppointmentReservationsRequestWithBody generates requests for MoveAppointmentReservations with any type of body
+func NewMoveAppointmentReservationsRequestWithBody(server string, appointmentId AppointmentId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appointmentId", runtime.ParamLocationPath, appointmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/resources/reserve/%s/move", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewGetResourceByIdRequest generates requests for GetResourceById
 func NewGetResourceByIdRequest(server string, resourceId ResourceId) (*http.Request, error) {
 	var err error
@@ -484,11 +744,26 @@ type ClientWithResponsesInterface interface {
 	// GetAvailableResourcesWithResponse request
 	GetAvailableResourcesWithResponse(ctx context.Context, params *GetAvailableResourcesParams, reqEditors ...RequestEditorFn) (*GetAvailableResourcesResponse, error)
 
+	// GetResourcesBatchWithBodyWithResponse request with any body
+	GetResourcesBatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GetResourcesBatchResponse, error)
+
+	GetResourcesBatchWithResponse(ctx context.Context, body GetResourcesBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*GetResourcesBatchResponse, error)
+
+	// ExportAppointmentsReservationsWithBodyWithResponse request with any body
+	ExportAppointmentsReservationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExportAppointmentsReservationsResponse, error)
+
+	ExportAppointmentsReservationsWithResponse(ctx context.Context, body ExportAppointmentsReservationsJSONRequestBody, reqEditors ...RequestEditorFn) (*ExportAppointmentsReservationsResponse, error)
+
 	// ReserveAppointmentResourcesWithBodyWithResponse request with any body
 	ReserveAppointmentResourcesWithBodyWithResponse(ctx context.Context, appointmentId AppointmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReserveAppointmentResourcesResponse, error)
 
 	ReserveAppointmentResourcesWithResponse(ctx context.Context, appointmentId AppointmentId, body ReserveAppointmentResourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*ReserveAppointmentResourcesResponse, error)
 
+	// MoveAppointmentReservationsWithBodyWithResponse request with any body
+	MoveAppointmentReservationsWithBodyWithResponse(ctx context.Context, appointmentId AppointmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MoveAppointmentReservationsResponse, error)
+
+	MoveAppointmentReservationsWithResponse(ctx context.Context, appointmentId AppointmentId, body MoveAppointmentReservationsJSONRequestBody, reqEditors ...RequestEditorFn) (*MoveAppointmentReservationsResponse, error)
+
 	// GetResourceByIdWithResponse request
 	GetResourceByIdWithResponse(ctx context.Context, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetResourceByIdResponse, error)
 }
@@ -539,6 +814,53 @@ func (r GetAvailableResourcesResponse) StatusCode() int {
 	return 0
 }
 
+type GetResourcesBatchResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Resources
+	ApplicationproblemJSON400 *externalRef0.ErrorDetail
+	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetResourcesBatchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetResourcesBatchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportAppointmentsReservationsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ReservationRecordsExport
+	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportAppointmentsReservationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportAppointmentsReservationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type ReserveAppointmentResourcesResponse struct {
 	Body                      []byte
 	HTTPResponse              *http.Response
@@ -562,6 +884,29 @@ func (r ReserveAppointmentResourcesResponse) StatusCode() int {
 	return 0
 }
 
+type MoveAppointmentReservationsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ReservationsMove
+	ApplicationproblemJSON500 *externalRef0.InternalServerErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r MoveAppointmentReservationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MoveAppointmentReservationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type GetResourceByIdResponse struct {
 	Body                      []byte
 	HTTPResponse              *http.Response
@@ -612,6 +957,40 @@ func (c *ClientWithResponses) GetAvailableResourcesWithResponse(ctx context.Cont
 	return ParseGetAvailableResourcesResponse(rsp)
 }
 
+// GetResourcesBatchWithBodyWithResponse request with arbitrary body returning *GetResourcesBatchResponse
+func (c *ClientWithResponses) GetResourcesBatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GetResourcesBatchResponse, error) {
+	rsp, err := c.GetResourcesBatchWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetResourcesBatchResponse(rsp)
+}
+
+func (c *ClientWithResponses) GetResourcesBatchWithResponse(ctx context.Context, body GetResourcesBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*GetResourcesBatchResponse, error) {
+	rsp, err := c.GetResourcesBatch(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetResourcesBatchResponse(rsp)
+}
+
+// ExportAppointmentsReservationsWithBodyWithResponse request with arbitrary body returning *ExportAppointmentsReservationsResponse
+func (c *ClientWithResponses) ExportAppointmentsReservationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExportAppointmentsReservationsResponse, error) {
+	rsp, err := c.ExportAppointmentsReservationsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportAppointmentsReservationsResponse(rsp)
+}
+
+func (c *ClientWithResponses) ExportAppointmentsReservationsWithResponse(ctx context.Context, body ExportAppointmentsReservationsJSONRequestBody, reqEditors ...RequestEditorFn) (*ExportAppointmentsReservationsResponse, error) {
+	rsp, err := c.ExportAppointmentsReservations(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportAppointmentsReservationsResponse(rsp)
+}
+
 // ReserveAppointmentResourcesWithBodyWithResponse request with arbitrary body returning *ReserveAppointmentResourcesResponse
 func (c *ClientWithResponses) ReserveAppointmentResourcesWithBodyWithResponse(ctx context.Context, appointmentId AppointmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReserveAppointmentResourcesResponse, error) {
 	rsp, err := c.ReserveAppointmentResourcesWithBody(ctx, appointmentId, contentType, body, reqEditors...)
@@ -629,6 +1008,23 @@ func (c *ClientWithResponses) ReserveAppointmentResourcesWithResponse(ctx contex
 	return ParseReserveAppointmentResourcesResponse(rsp)
 }
 
+// MoveAppointmentReservationsWithBodyWithResponse request with arbitrary body returning *MoveAppointmentReservationsResponse
+func (c *ClientWithResponses) MoveAppointmentReservationsWithBodyWithResponse(ctx context.Context, appointmentId AppointmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MoveAppointmentReservationsResponse, error) {
+	rsp, err := c.MoveAppointmentReservationsWithBody(ctx, appointmentId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMoveAppointmentReservationsResponse(rsp)
+}
+
+func (c *ClientWithResponses) MoveAppointmentReservationsWithResponse(ctx context.Context, appointmentId AppointmentId, body MoveAppointmentReservationsJSONRequestBody, reqEditors ...RequestEditorFn) (*MoveAppointmentReservationsResponse, error) {
+	rsp, err := c.MoveAppointmentReservations(ctx, appointmentId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMoveAppointmentReservationsResponse(rsp)
+}
+
 // GetResourceByIdWithResponse request returning *GetResourceByIdResponse
 func (c *ClientWithResponses) GetResourceByIdWithResponse(ctx context.Context, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetResourceByIdResponse, error) {
 	rsp, err := c.GetResourceById(ctx, resourceId, reqEditors...)
@@ -704,6 +1100,79 @@ func ParseGetAvailableResourcesResponse(rsp *http.Response) (*GetAvailableResour
 	return response, nil
 }
 
+// ParseGetResourcesBatchResponse parses an HTTP response from a GetResourcesBatchWithResponse call
+func ParseGetResourcesBatchResponse(rsp *http.Response) (*GetResourcesBatchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetResourcesBatchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Resources
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.ErrorDetail
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportAppointmentsReservationsResponse parses an HTTP response from a ExportAppointmentsReservationsWithResponse call
+func ParseExportAppointmentsReservationsResponse(rsp *http.Response) (*ExportAppointmentsReservationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportAppointmentsReservationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReservationRecordsExport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseReserveAppointmentResourcesResponse parses an HTTP response from a ReserveAppointmentResourcesWithResponse call
 func ParseReserveAppointmentResourcesResponse(rsp *http.Response) (*ReserveAppointmentResourcesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -737,6 +1206,39 @@ func ParseReserveAppointmentResourcesResponse(rsp *http.Response) (*ReserveAppoi
 	return response, nil
 }
 
+// ParseMoveAppointmentReservationsResponse parses an HTTP response from a MoveAppointmentReservationsWithResponse call
+func ParseMoveAppointmentReservationsResponse(rsp *http.Response) (*MoveAppointmentReservationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MoveAppointmentReservationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReservationsMove
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.InternalServerErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetResourceByIdResponse parses an HTTP response from a GetResourceByIdWithResponse call
 func ParseGetResourceByIdResponse(rsp *http.Response) (*GetResourceByIdResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
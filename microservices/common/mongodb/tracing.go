@@ -0,0 +1,67 @@
+package mongodb
+
+import (
+	"context"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/v2/event"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/Nesquiko/aass/common/mongodb"
+
+// tracingMonitor starts a client span for every command sent to mongo as part
+// of a trace, commands issued outside of one aren't traced.
+type tracingMonitor struct {
+	tracer trace.Tracer
+	// spans holds the span of each command in flight by its commandKey
+	spans sync.Map
+}
+
+type commandKey struct {
+	connectionId string
+	requestId    int64
+}
+
+func (m *tracingMonitor) started(ctx context.Context, evt *event.CommandStartedEvent) {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return
+	}
+
+	name := evt.CommandName
+	attrs := []attribute.KeyValue{
+		semconv.DBSystemMongoDB,
+		semconv.DBNamespace(evt.DatabaseName),
+		semconv.DBOperationName(evt.CommandName),
+	}
+	if collection, ok := evt.Command.Lookup(evt.CommandName).StringValueOK(); ok {
+		name += " " + collection
+		attrs = append(attrs, semconv.DBCollectionName(collection))
+	}
+
+	_, span := m.tracer.Start(
+		ctx,
+		name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+	m.spans.Store(commandKey{connectionId: evt.ConnectionID, requestId: evt.RequestID}, span)
+}
+
+func (m *tracingMonitor) finished(evt event.CommandFinishedEvent, err error) {
+	key := commandKey{connectionId: evt.ConnectionID, requestId: evt.RequestID}
+	s, ok := m.spans.LoadAndDelete(key)
+	if !ok {
+		return
+	}
+
+	span := s.(trace.Span)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
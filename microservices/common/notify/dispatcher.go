@@ -0,0 +1,108 @@
+package notify
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+const (
+	// how many due notifications are delivered in one round
+	dispatchBatchSize = 50
+
+	retryBackoffBase = 30 * time.Second
+	retryBackoffMax  = time.Hour
+)
+
+// Store persists notifications and their delivery state.
+type Store interface {
+	DueNotifications(ctx context.Context, now time.Time, limit int) ([]Notification, error)
+	UpdateNotification(ctx context.Context, notification Notification) error
+}
+
+// Dispatcher periodically delivers due notifications. Failed deliveries are
+// retried with an exponential backoff, until maxAttempts is reached, then the
+// notification is marked as failed.
+type Dispatcher struct {
+	store       Store
+	sender      Sender
+	interval    time.Duration
+	maxAttempts int
+}
+
+func NewDispatcher(store Store, sender Sender, interval time.Duration, maxAttempts int) Dispatcher {
+	return Dispatcher{store: store, sender: sender, interval: interval, maxAttempts: maxAttempts}
+}
+
+// Run delivers notifications until ctx is done.
+func (d Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		d.dispatch(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d Dispatcher) dispatch(ctx context.Context) {
+	due, err := d.store.DueNotifications(ctx, time.Now(), dispatchBatchSize)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to find due notifications", "error", err.Error())
+		return
+	}
+
+	for _, notification := range due {
+		d.deliver(ctx, notification)
+	}
+}
+
+func (d Dispatcher) deliver(ctx context.Context, n Notification) {
+	err := d.sender.Send(ctx, Message{To: n.Recipient, Subject: n.Subject, Body: n.Body})
+	n.Attempts++
+	now := time.Now()
+
+	if err == nil {
+		n.Status = NotificationStatusSent
+		n.LastError = ""
+		n.SentAt = &now
+	} else {
+		n.LastError = err.Error()
+		if n.Attempts >= d.maxAttempts {
+			n.Status = NotificationStatusFailed
+		} else {
+			n.NextAttemptAt = now.Add(retryBackoff(n.Attempts))
+		}
+		slog.WarnContext(
+			ctx,
+			"failed to deliver notification",
+			"error", err.Error(),
+			"notificationId", n.Id.String(),
+			"attempts", n.Attempts,
+			"status", n.Status,
+		)
+	}
+
+	if err := d.store.UpdateNotification(ctx, n); err != nil {
+		slog.ErrorContext(
+			ctx,
+			"failed to update notification",
+			"error", err.Error(),
+			"notificationId", n.Id.String(),
+		)
+	}
+}
+
+// retryBackoff returns how long to wait before the next delivery attempt
+// after the given number of failed ones.
+func retryBackoff(attempts int) time.Duration {
+	backoff := retryBackoffBase
+	for i := 1; i < attempts && backoff < retryBackoffMax; i++ {
+		backoff *= 2
+	}
+	return min(backoff, retryBackoffMax)
+}
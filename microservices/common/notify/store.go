@@ -0,0 +1,139 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const notificationsCollection = "notifications"
+
+type NotificationStatus string
+
+const (
+	NotificationStatusPending NotificationStatus = "pending"
+	NotificationStatusSent    NotificationStatus = "sent"
+	// NotificationStatusFailed is final, the notification won't be retried.
+	NotificationStatusFailed NotificationStatus = "failed"
+)
+
+// Notification is a rendered message about an appointment waiting for, or
+// already after, its delivery. Pending notifications are delivered once
+// their NextAttemptAt passes.
+type Notification struct {
+	Id            uuid.UUID          `bson:"_id"                 json:"id"`
+	AppointmentId uuid.UUID          `bson:"appointmentId"       json:"appointmentId"`
+	Kind          Kind               `bson:"kind"                json:"kind"`
+	Recipient     string             `bson:"recipient"           json:"recipient"`
+	Subject       string             `bson:"subject"             json:"subject"`
+	Body          string             `bson:"body"                json:"body"`
+	Status        NotificationStatus `bson:"status"              json:"status"`
+	Attempts      int                `bson:"attempts"            json:"attempts"`
+	LastError     string             `bson:"lastError,omitempty" json:"lastError,omitempty"`
+	CreatedAt     time.Time          `bson:"createdAt"           json:"createdAt"`
+	NextAttemptAt time.Time          `bson:"nextAttemptAt"       json:"nextAttemptAt"`
+	SentAt        *time.Time         `bson:"sentAt,omitempty"    json:"sentAt,omitempty"`
+}
+
+// MongoStore keeps notifications in the notifications collection of the
+// service's database.
+type MongoStore struct {
+	notifications *mongo.Collection
+}
+
+func NewMongoStore(ctx context.Context, db *mongo.Database) MongoStore {
+	notifications := db.Collection(notificationsCollection)
+	_, err := notifications.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "status", Value: 1}, {Key: "nextAttemptAt", Value: 1}},
+		Options: options.Index().SetName("idx_notification_status_nextAttemptAt"),
+	})
+	if err != nil {
+		slog.WarnContext(
+			ctx,
+			"Could not ensure notification index (may already exist)",
+			"error",
+			err,
+		)
+	}
+
+	return MongoStore{notifications: notifications}
+}
+
+// Queue renders the event and stores the message for delivery by a
+// Dispatcher.
+func (s MongoStore) Queue(ctx context.Context, appointmentId uuid.UUID, event Event) error {
+	msg, err := Render(event)
+	if err != nil {
+		return fmt.Errorf("Queue: %w", err)
+	}
+
+	now := time.Now()
+	_, err = s.notifications.InsertOne(ctx, Notification{
+		Id:            uuid.New(),
+		AppointmentId: appointmentId,
+		Kind:          event.Kind,
+		Recipient:     msg.To,
+		Subject:       msg.Subject,
+		Body:          msg.Body,
+		Status:        NotificationStatusPending,
+		CreatedAt:     now,
+		NextAttemptAt: now,
+	})
+	if err != nil {
+		return fmt.Errorf("Queue: failed to insert document: %w", err)
+	}
+
+	return nil
+}
+
+// DueNotifications returns at most limit pending notifications whose next
+// attempt is due at now, the longest waiting first.
+func (s MongoStore) DueNotifications(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+) ([]Notification, error) {
+	filter := bson.M{
+		"status":        NotificationStatusPending,
+		"nextAttemptAt": bson.M{"$lte": now},
+	}
+	opts := options.Find().SetSort(bson.M{"nextAttemptAt": 1}).SetLimit(int64(limit))
+
+	cursor, err := s.notifications.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("DueNotifications: failed to find documents: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var notifications []Notification
+	if err = cursor.All(ctx, &notifications); err != nil {
+		return nil, fmt.Errorf("DueNotifications: failed to decode documents: %w", err)
+	}
+
+	return notifications, nil
+}
+
+// UpdateNotification stores the delivery state of the notification.
+func (s MongoStore) UpdateNotification(ctx context.Context, n Notification) error {
+	filter := bson.M{"_id": n.Id}
+	update := bson.M{"$set": bson.M{
+		"status":        n.Status,
+		"attempts":      n.Attempts,
+		"lastError":     n.LastError,
+		"nextAttemptAt": n.NextAttemptAt,
+		"sentAt":        n.SentAt,
+	}}
+
+	_, err := s.notifications.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("UpdateNotification: failed to update document: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package reminders
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+type Config struct {
+	// Offsets are how long before appointments patients are reminded,
+	// e.g. `24h,2h`.
+	Offsets      []time.Duration `mapstructure:"offsets"`
+	PollInterval time.Duration   `mapstructure:"poll_interval"`
+	// Lease is how long a worker may take to queue a reminder before
+	// another one takes it over.
+	Lease time.Duration `mapstructure:"lease"`
+}
+
+// LoadConfig reads the `<envPrefix>_REMINDERS_*` environment variables.
+func LoadConfig(envPrefix string) (Config, error) {
+	v := viper.New()
+
+	v.SetEnvPrefix(envPrefix)
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	v.AutomaticEnv()
+
+	v.SetDefault("reminders.offsets", []time.Duration{24 * time.Hour, 2 * time.Hour})
+	v.SetDefault("reminders.poll_interval", 30*time.Second)
+	v.SetDefault("reminders.lease", 5*time.Minute)
+
+	var cfg struct {
+		Reminders Config `mapstructure:"reminders"`
+	}
+	err := v.Unmarshal(&cfg)
+	if err != nil {
+		return Config{}, fmt.Errorf("LoadConfig failed to unmarshal config: %w", err)
+	}
+
+	return cfg.Reminders, nil
+}
@@ -0,0 +1,89 @@
+package reminders
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// how many due reminders are handled in one round
+const batchSize = 50
+
+// RemindFunc queues the reminder of the job's appointment.
+type RemindFunc func(ctx context.Context, job Job) error
+
+// Scheduler periodically claims due jobs and reminds about them. If a
+// scheduler dies while holding a lease, or remind fails, the job is retried
+// by any scheduler after the lease expires.
+type Scheduler struct {
+	store    MongoStore
+	remind   RemindFunc
+	owner    string
+	interval time.Duration
+	lease    time.Duration
+}
+
+func NewScheduler(
+	store MongoStore,
+	remind RemindFunc,
+	interval time.Duration,
+	lease time.Duration,
+) Scheduler {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+	return Scheduler{
+		store:    store,
+		remind:   remind,
+		owner:    fmt.Sprintf("%s-%s", host, uuid.NewString()),
+		interval: interval,
+		lease:    lease,
+	}
+}
+
+// Run reminds about due jobs until ctx is done.
+func (s Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.remindDue(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s Scheduler) remindDue(ctx context.Context) {
+	for range batchSize {
+		job, err := s.store.Claim(ctx, time.Now(), s.owner, s.lease)
+		if errors.Is(err, ErrNoDueJob) {
+			return
+		} else if err != nil {
+			slog.ErrorContext(ctx, "failed to claim due reminder", "error", err.Error())
+			return
+		}
+
+		if err := s.remind(ctx, job); err != nil {
+			slog.ErrorContext(
+				ctx,
+				"failed to queue reminder",
+				"error", err.Error(),
+				"appointmentId", job.AppointmentId.String(),
+			)
+			continue
+		}
+
+		if err := s.store.Complete(ctx, job.Id, s.owner); err != nil {
+			slog.ErrorContext(ctx, "failed to complete reminder", "error", err.Error())
+		}
+	}
+}
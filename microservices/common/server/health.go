@@ -0,0 +1,182 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	livenessPath  = "/healthz"
+	readinessPath = "/readyz"
+	// shallowParam asks for a readiness report without checking upstreams,
+	// services check their upstreams shallowly, so that services calling each
+	// other don't check each other in a loop.
+	shallowParam = "shallow"
+
+	defaultCheckTimeout = 2 * time.Second
+)
+
+// HealthCheck checks one dependency of the service on readiness.
+type HealthCheck struct {
+	Name string
+	// Timeout limits the check, defaultCheckTimeout if zero.
+	Timeout time.Duration
+	Check   func(ctx context.Context) error
+	// upstream checks don't make the service unready when failing, it can
+	// still serve requests which don't need the upstream.
+	upstream bool
+}
+
+type healthRegistry struct {
+	mu     sync.RWMutex
+	checks []HealthCheck
+}
+
+var health healthRegistry
+
+// RegisterHealthCheck adds the check to the readiness report of the service.
+func RegisterHealthCheck(check HealthCheck) {
+	health.mu.Lock()
+	defer health.mu.Unlock()
+	health.checks = append(health.checks, check)
+}
+
+// MongoCheck pings the mongo server.
+func MongoCheck(ping func(ctx context.Context) error) HealthCheck {
+	return HealthCheck{Name: "mongo", Check: ping}
+}
+
+// UpstreamCheck checks the readiness of the upstream service at baseUrl.
+func UpstreamCheck(upstream Upstream, baseUrl string) HealthCheck {
+	url := strings.TrimSuffix(baseUrl, "/") + readinessPath + "?" + shallowParam + "=true"
+	return HealthCheck{Name: string(upstream), Check: httpCheck(url), upstream: true}
+}
+
+// httpCheck passes when a GET of the url answers with 200 OK.
+func httpCheck(url string) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return err
+		}
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		defer res.Body.Close()
+		if res.StatusCode != http.StatusOK {
+			return fmt.Errorf("unexpected status %d", res.StatusCode)
+		}
+		return nil
+	}
+}
+
+const (
+	statusUp   = "up"
+	statusDown = "down"
+
+	statusReady    = "ready"
+	statusDegraded = "degraded"
+	statusUnready  = "unready"
+)
+
+type checkReport struct {
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	Upstream   bool   `json:"upstream,omitempty"`
+	Error      string `json:"error,omitempty"`
+	DurationMs int64  `json:"durationMs"`
+}
+
+type readinessReport struct {
+	// Status is statusReady, statusDegraded when only upstreams are down, or
+	// statusUnready.
+	Status string        `json:"status"`
+	Checks []checkReport `json:"checks"`
+}
+
+// ready runs the checks concurrently, each limited by its timeout.
+func ready(ctx context.Context, shallow bool) readinessReport {
+	health.mu.RLock()
+	checks := make([]HealthCheck, 0, len(health.checks))
+	for _, check := range health.checks {
+		if shallow && check.upstream {
+			continue
+		}
+		checks = append(checks, check)
+	}
+	health.mu.RUnlock()
+
+	reports := make([]checkReport, len(checks))
+	var wg sync.WaitGroup
+	for i, check := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			timeout := check.Timeout
+			if timeout == 0 {
+				timeout = defaultCheckTimeout
+			}
+			checkCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			start := time.Now()
+			err := check.Check(checkCtx)
+			reports[i] = checkReport{
+				Name:       check.Name,
+				Status:     statusUp,
+				Upstream:   check.upstream,
+				DurationMs: time.Since(start).Milliseconds(),
+			}
+			if err != nil {
+				reports[i].Status = statusDown
+				reports[i].Error = err.Error()
+			}
+		}()
+	}
+	wg.Wait()
+
+	report := readinessReport{Status: statusReady, Checks: reports}
+	for _, check := range reports {
+		if check.Status == statusUp {
+			continue
+		}
+		if !check.Upstream {
+			report.Status = statusUnready
+			break
+		}
+		report.Status = statusDegraded
+	}
+	return report
+}
+
+// Health serves the liveness of the service on livenessPath, and its
+// readiness, a report of the registered checks, on readinessPath. The service
+// is unready if any of its own dependencies is down.
+func Health() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet {
+				next.ServeHTTP(w, r)
+				return
+			}
+			switch r.URL.Path {
+			case livenessPath:
+				Encode(w, http.StatusOK, map[string]string{"status": "alive"})
+			case readinessPath:
+				report := ready(r.Context(), r.URL.Query().Get(shallowParam) == "true")
+				status := http.StatusOK
+				if report.Status == statusUnready {
+					status = http.StatusServiceUnavailable
+				}
+				Encode(w, status, report)
+			default:
+				next.ServeHTTP(w, r)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Nesquiko/aass/common/server/api"
+)
+
+func TestReplayIdempotent(t *testing.T) {
+	const hash = "request-hash"
+
+	tests := []struct {
+		name            string
+		record          idempotencyRecord
+		wantStatus      int
+		wantCode        string
+		wantBody        string
+		wantLocation    string
+		wantReplayedHdr string
+	}{
+		{
+			name: "completed request is replayed",
+			record: idempotencyRecord{
+				RequestHash: hash,
+				Status:      http.StatusCreated,
+				ContentType: "application/json",
+				Location:    "/appointments/1",
+				Body:        []byte(`{"id":"1"}`),
+			},
+			wantStatus:      http.StatusCreated,
+			wantBody:        `{"id":"1"}`,
+			wantLocation:    "/appointments/1",
+			wantReplayedHdr: "true",
+		},
+		{
+			name:       "request in flight is a conflict",
+			record:     idempotencyRecord{RequestHash: hash},
+			wantStatus: http.StatusConflict,
+			wantCode:   IdempotencyInProgressCode,
+		},
+		{
+			name: "key reused for another request",
+			record: idempotencyRecord{
+				RequestHash: "another-request-hash",
+				Status:      http.StatusCreated,
+			},
+			wantStatus: http.StatusUnprocessableEntity,
+			wantCode:   IdempotencyKeyReusedCode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			replayIdempotent(w, tt.record, hash)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+			if got := w.Header().Get(IdempotentReplayedHeader); got != tt.wantReplayedHdr {
+				t.Errorf("%s = %q, want %q", IdempotentReplayedHeader, got, tt.wantReplayedHdr)
+			}
+			if got := w.Header().Get("Location"); got != tt.wantLocation {
+				t.Errorf("Location = %q, want %q", got, tt.wantLocation)
+			}
+			if tt.wantCode != "" {
+				var detail api.ErrorDetail
+				if err := json.NewDecoder(w.Body).Decode(&detail); err != nil {
+					t.Fatalf("decoding error detail: %v", err)
+				}
+				if detail.Code != tt.wantCode {
+					t.Errorf("code = %q, want %q", detail.Code, tt.wantCode)
+				}
+			} else if got := w.Body.String(); got != tt.wantBody {
+				t.Errorf("body = %q, want %q", got, tt.wantBody)
+			}
+		})
+	}
+}
+
+// TestIdempotencyMiddlewareBypass covers requests handled before the
+// idempotency key is reserved, no database is needed for them.
+func TestIdempotencyMiddlewareBypass(t *testing.T) {
+	tests := []struct {
+		name        string
+		idempotency *Idempotency
+		method      string
+		key         string
+		wantStatus  int
+		wantHandled bool
+	}{
+		{
+			name:        "nil idempotency stores nothing",
+			method:      http.MethodPost,
+			key:         "key",
+			wantStatus:  http.StatusCreated,
+			wantHandled: true,
+		},
+		{
+			name:        "request without key",
+			idempotency: &Idempotency{},
+			method:      http.MethodPost,
+			wantStatus:  http.StatusCreated,
+			wantHandled: true,
+		},
+		{
+			name:        "key of other than POST is ignored",
+			idempotency: &Idempotency{},
+			method:      http.MethodPut,
+			key:         "key",
+			wantStatus:  http.StatusCreated,
+			wantHandled: true,
+		},
+		{
+			name:        "too long key",
+			idempotency: &Idempotency{},
+			method:      http.MethodPost,
+			key:         strings.Repeat("k", maxIdempotencyKeyLength+1),
+			wantStatus:  http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handled := false
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				handled = true
+				w.WriteHeader(http.StatusCreated)
+			})
+
+			r := httptest.NewRequest(tt.method, "/appointments", strings.NewReader("{}"))
+			if tt.key != "" {
+				r.Header.Set(IdempotencyKeyHeader, tt.key)
+			}
+			w := httptest.NewRecorder()
+			tt.idempotency.Middleware(next).ServeHTTP(w, r)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+			if handled != tt.wantHandled {
+				t.Errorf("handled = %v, want %v", handled, tt.wantHandled)
+			}
+		})
+	}
+}
+
+func TestRequestHash(t *testing.T) {
+	hash := func(method string, target string, body string) string {
+		return requestHash(httptest.NewRequest(method, target, nil), []byte(body))
+	}
+	base := hash(http.MethodPost, "/appointments", `{"a":1}`)
+
+	tests := []struct {
+		name     string
+		hash     string
+		wantSame bool
+	}{
+		{
+			name:     "same request",
+			hash:     hash(http.MethodPost, "/appointments", `{"a":1}`),
+			wantSame: true,
+		},
+		{name: "another body", hash: hash(http.MethodPost, "/appointments", `{"a":2}`)},
+		{name: "another path", hash: hash(http.MethodPost, "/waitlist", `{"a":1}`)},
+		{name: "another query", hash: hash(http.MethodPost, "/appointments?x=1", `{"a":1}`)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if same := tt.hash == base; same != tt.wantSame {
+				t.Errorf("same hash = %v, want %v", same, tt.wantSame)
+			}
+		})
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/go-chi/cors"
 	"github.com/go-chi/httplog/v2"
 	validation_middleware "github.com/oapi-codegen/nethttp-middleware"
+
+	"github.com/Nesquiko/aass/common/audit"
 )
 
 type OapiValidationOptions struct {
@@ -17,27 +19,50 @@ type OapiValidationOptions struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
-func Middleware(logger *httplog.Logger, opts OapiValidationOptions) []MiddlewareFunc {
+// Middleware returns the api middlewares in the order they run, the api is
+// generated with apply-chi-middleware-first-to-last.
+func Middleware(
+	logger *httplog.Logger,
+	opts OapiValidationOptions,
+	auditAdminToken string,
+	limiter *RateLimiter,
+	idempotency *Idempotency,
+) []MiddlewareFunc {
 	return []MiddlewareFunc{
+		traceRoute,
 		chi_middleware.Recoverer,
 		cors.Handler(cors.Options{
 			AllowedOrigins: []string{"*"},
 			AllowedMethods: []string{"GET", "POST", "PATCH", "PUT", "DELETE", "OPTIONS"},
-			AllowedHeaders: []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
+			AllowedHeaders: []string{
+				"Accept",
+				"Authorization",
+				"Content-Type",
+				"X-CSRF-Token",
+				"X-Request-ID",
+				audit.ActorHeader,
+				IdempotencyKeyHeader,
+				IfMatchHeader,
+			},
+			ExposedHeaders: []string{ETagHeader},
 			MaxAge:         300,
 		}),
-		chi_middleware.RealIP,
+		limiter.Middleware,
+		idempotency.Middleware,
 		validation_middleware.OapiRequestValidatorWithOptions(
 			opts.Spec,
 			&validation_middleware.Options{ErrorHandler: opts.ErrorHandler},
 		),
 		httplog.RequestLogger(logger),
 		chi_middleware.AllowContentType(ApplicationJSON),
+		audit.Middleware(auditAdminToken),
 	}
 }
 
+const heartbeatPath = "/monitoring/heartbeat"
+
 func Heartbeat() func(http.Handler) http.Handler {
-	return chi_middleware.Heartbeat("/monitoring/heartbeat")
+	return chi_middleware.Heartbeat(heartbeatPath)
 }
 
 func OptionsMiddleware(next http.Handler) http.Handler {
@@ -46,7 +71,10 @@ func OptionsMiddleware(next http.Handler) http.Handler {
 			w.Header().Set("Access-Control-Allow-Origin", "*")
 			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, PATCH, OPTIONS")
 			w.Header().
-				Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Request-ID")
+				Set(
+					"Access-Control-Allow-Headers",
+					"Content-Type, Authorization, X-Request-ID, X-User-Id, Idempotency-Key, If-Match",
+				)
 			w.Header().
 				Set("Access-Control-Max-Age", "86400")
 			w.WriteHeader(http.StatusNoContent)
@@ -0,0 +1,154 @@
+package server
+
+import (
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func TestRateLimitTake(t *testing.T) {
+	limit := RateLimit{Burst: 2, Period: time.Second}
+	updatedAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		tokens         float64
+		elapsed        time.Duration
+		wantTokens     float64
+		wantRetryAfter time.Duration
+	}{
+		{
+			name:       "full bucket",
+			tokens:     2,
+			wantTokens: 1,
+		},
+		{
+			name:           "empty bucket",
+			tokens:         0,
+			wantTokens:     0,
+			wantRetryAfter: 500 * time.Millisecond,
+		},
+		{
+			name:       "refilled a whole token",
+			tokens:     0,
+			elapsed:    500 * time.Millisecond,
+			wantTokens: 0,
+		},
+		{
+			name:           "refilled half a token",
+			tokens:         0,
+			elapsed:        250 * time.Millisecond,
+			wantTokens:     0.5,
+			wantRetryAfter: 250 * time.Millisecond,
+		},
+		{
+			name:       "refill stops at burst",
+			tokens:     1,
+			elapsed:    time.Minute,
+			wantTokens: 1,
+		},
+		{
+			name:           "clock went back",
+			tokens:         0,
+			elapsed:        -time.Second,
+			wantTokens:     0,
+			wantRetryAfter: 500 * time.Millisecond,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens, retryAfter := limit.take(tt.tokens, updatedAt, updatedAt.Add(tt.elapsed))
+			if math.Abs(tokens-tt.wantTokens) > 1e-9 {
+				t.Errorf("tokens = %v, want %v", tokens, tt.wantTokens)
+			}
+			if retryAfter != tt.wantRetryAfter {
+				t.Errorf("retryAfter = %v, want %v", retryAfter, tt.wantRetryAfter)
+			}
+		})
+	}
+}
+
+func TestRateLimiterMiddleware(t *testing.T) {
+	type request struct {
+		path           string
+		remoteAddr     string
+		wantStatus     int
+		wantRetryAfter string
+	}
+
+	tests := []struct {
+		name     string
+		requests []request
+	}{
+		{
+			name: "client runs out of requests",
+			requests: []request{
+				{path: "/items", remoteAddr: "10.0.0.1:1234", wantStatus: http.StatusOK},
+				{path: "/items", remoteAddr: "10.0.0.1:1234", wantStatus: http.StatusOK},
+				{
+					path:           "/items",
+					remoteAddr:     "10.0.0.1:1234",
+					wantStatus:     http.StatusTooManyRequests,
+					wantRetryAfter: "30",
+				},
+			},
+		},
+		{
+			name: "clients have their own buckets",
+			requests: []request{
+				{path: "/items", remoteAddr: "10.0.0.1:1234", wantStatus: http.StatusOK},
+				{path: "/items", remoteAddr: "10.0.0.1:1234", wantStatus: http.StatusOK},
+				{path: "/items", remoteAddr: "10.0.0.2:1234", wantStatus: http.StatusOK},
+			},
+		},
+		{
+			name: "route has its own limit",
+			requests: []request{
+				{path: "/login", remoteAddr: "10.0.0.1:1234", wantStatus: http.StatusOK},
+				{
+					path:           "/login",
+					remoteAddr:     "10.0.0.1:1234",
+					wantStatus:     http.StatusTooManyRequests,
+					wantRetryAfter: "60",
+				},
+				{path: "/items", remoteAddr: "10.0.0.1:1234", wantStatus: http.StatusOK},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter, err := NewRateLimiter(
+				RateLimitConfig{Default: "2/1m", Routes: []string{"GET /login=1/1m"}},
+				NewMemoryRateLimitStore(),
+				nil,
+			)
+			if err != nil {
+				t.Fatalf("NewRateLimiter: %v", err)
+			}
+			router := chi.NewRouter()
+			ok := func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) }
+			router.With(limiter.Middleware).Get("/items", ok)
+			router.With(limiter.Middleware).Get("/login", ok)
+
+			for i, req := range tt.requests {
+				r := httptest.NewRequest(http.MethodGet, req.path, nil)
+				r.RemoteAddr = req.remoteAddr
+				w := httptest.NewRecorder()
+				router.ServeHTTP(w, r)
+
+				if w.Code != req.wantStatus {
+					t.Errorf("request %d: status = %d, want %d", i, w.Code, req.wantStatus)
+				}
+				if got := w.Header().Get("Retry-After"); got != req.wantRetryAfter {
+					t.Errorf("request %d: Retry-After = %q, want %q", i, got, req.wantRetryAfter)
+				}
+			}
+		})
+	}
+}
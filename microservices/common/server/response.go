@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"strings"
 
+	chi_middleware "github.com/go-chi/chi/v5/middleware"
 	"github.com/google/uuid"
 
 	"github.com/Nesquiko/aass/common/server/api"
@@ -28,8 +29,14 @@ func Encode[T any](w http.ResponseWriter, status int, response T) {
 	EncodeWithContentType(w, status, response, ApplicationJSON)
 }
 
+// EncodeError encodes the problem with the request id sent back in the
+// response headers, so that the problem can be found in the logs.
 func EncodeError(w http.ResponseWriter, err *ApiError) {
-	EncodeWithContentType(w, err.Status, err.ErrorDetail, ApplicationProblemJSON)
+	detail := err.ErrorDetail
+	if id := w.Header().Get(chi_middleware.RequestIDHeader); id != "" {
+		detail.RequestId = &id
+	}
+	EncodeWithContentType(w, err.Status, detail, ApplicationProblemJSON)
 }
 
 func EncodeWithContentType[T any](
@@ -51,6 +58,21 @@ func EncodeWithContentType[T any](
 	}
 }
 
+// ExternalUrl returns the absolute URL of path on this service, as seen by
+// the client. Behind the gateway the host and the stripped path prefix are
+// taken from the forwarded headers.
+func ExternalUrl(r *http.Request, path string) string {
+	scheme := "http"
+	if r.TLS != nil || strings.EqualFold(r.Header.Get("X-Forwarded-Proto"), "https") {
+		scheme = "https"
+	}
+	host := r.Host
+	if forwarded := r.Header.Get("X-Forwarded-Host"); forwarded != "" {
+		host = forwarded
+	}
+	return scheme + "://" + host + r.Header.Get("X-Forwarded-Prefix") + path
+}
+
 func Decode[T any](w http.ResponseWriter, r *http.Request) (T, *ApiError) {
 	dst, err := decode[T](w, r)
 	var decErr *DecodeErr
@@ -203,6 +225,17 @@ func InternalServerError() *ApiError {
 	}
 }
 
+func Forbidden(detail string) *ApiError {
+	return &ApiError{
+		ErrorDetail: api.ErrorDetail{
+			Code:   "forbidden",
+			Title:  "Forbidden",
+			Detail: detail,
+			Status: http.StatusForbidden,
+		},
+	}
+}
+
 const (
 	NotFoundCode         = "%s.not.found"
 	NotFoundTitleFormat  = "%s was not found"
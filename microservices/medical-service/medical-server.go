@@ -2,18 +2,29 @@ package main
 
 import (
 	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"time"
 
 	"github.com/go-chi/httplog/v2"
 
+	"github.com/Nesquiko/aass/common/audit"
 	"github.com/Nesquiko/aass/common/server"
 	commonapi "github.com/Nesquiko/aass/common/server/api"
 	"github.com/Nesquiko/aass/medical-service/api"
 	appointmentapi "github.com/Nesquiko/aass/medical-service/appointment-api"
 )
 
+const (
+	auditActionConditionRead      = "condition.read"
+	auditActionConditionUpdate    = "condition.update"
+	auditActionPrescriptionRead   = "prescription.read"
+	auditActionPrescriptionUpdate = "prescription.update"
+	auditActionPrescriptionDelete = "prescription.delete"
+	auditActionRecordsExport      = "medical-records.export"
+)
+
 type medicalServer struct {
 	db      mongoMedicalDb
 	apptApi *appointmentapi.ClientWithResponses
@@ -21,10 +32,18 @@ type medicalServer struct {
 
 func newMedicalServer(
 	db mongoMedicalDb,
+	clients *server.Clients,
 	logger *httplog.Logger,
 	opts commonapi.ChiServerOptions,
-) http.Handler {
-	apptClient, _ := appointmentapi.NewClientWithResponses("http://appointment-service:8080/")
+) (http.Handler, error) {
+	apptUrl, apptHttpClient := clients.Client(server.AppointmentService)
+	apptClient, err := appointmentapi.NewClientWithResponses(
+		apptUrl,
+		appointmentapi.WithHTTPClient(apptHttpClient),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("newMedicalServer appointment client: %w", err)
+	}
 	srv := medicalServer{db: db, apptApi: apptClient}
 
 	middlewares := make([]api.MiddlewareFunc, len(opts.Middlewares))
@@ -39,7 +58,7 @@ func newMedicalServer(
 		ErrorHandlerFunc: opts.ErrorHandlerFunc,
 	}
 
-	return api.HandlerWithOptions(srv, mappedOpts)
+	return api.HandlerWithOptions(srv, mappedOpts), nil
 }
 
 // ConditionDetail implements api.ServerInterface.
@@ -53,14 +72,22 @@ func (m medicalServer) ConditionDetail(
 		server.EncodeError(w, server.NotFoundId("Condition", conditionId))
 		return
 	} else if err != nil {
-		slog.Error(server.UnexpectedError, "error", err.Error(), "where", "ConditionDetail")
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"ConditionDetail",
+		)
 		server.EncodeError(w, server.InternalServerError())
 		return
 	}
 
 	res, err := m.apptApi.AppointmentsByConditionIdWithResponse(r.Context(), cond.Id)
 	if err != nil {
-		slog.Error(
+		slog.ErrorContext(
+			r.Context(),
 			server.UnexpectedError,
 			"error",
 			err.Error(),
@@ -70,7 +97,7 @@ func (m medicalServer) ConditionDetail(
 		server.EncodeError(w, server.InternalServerError())
 		return
 	} else if res.JSON200 == nil {
-		slog.Error("no appointments response")
+		slog.ErrorContext(r.Context(), "no appointments response")
 		server.EncodeError(w, server.InternalServerError())
 		return
 	}
@@ -80,9 +107,67 @@ func (m medicalServer) ConditionDetail(
 		appts = *res.JSON200.Appointments
 	}
 
+	err = m.db.audit.Record(r.Context(), auditActionConditionRead, cond.Id, nil, nil)
+	if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"ConditionDetail audit",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+	server.SetETag(w, cond.Version)
 	server.Encode(w, http.StatusOK, dataCondToCond(cond, appts))
 }
 
+// GetConditionsBatch implements api.ServerInterface.
+func (m medicalServer) GetConditionsBatch(w http.ResponseWriter, r *http.Request) {
+	req, decodeErr := server.Decode[api.GetConditionsBatchJSONRequestBody](w, r)
+	if decodeErr != nil {
+		server.EncodeError(w, decodeErr)
+		return
+	}
+
+	conditions, err := m.db.ConditionsByIds(r.Context(), req.Ids)
+	if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"GetConditionsBatch",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+
+	for _, cond := range conditions {
+		err = m.db.audit.Record(r.Context(), auditActionConditionRead, cond.Id, nil, nil)
+		if err != nil {
+			slog.ErrorContext(
+				r.Context(),
+				server.UnexpectedError,
+				"error",
+				err.Error(),
+				"where",
+				"GetConditionsBatch audit",
+			)
+			server.EncodeError(w, server.InternalServerError())
+			return
+		}
+	}
+	server.Encode(
+		w,
+		http.StatusOK,
+		api.Conditions{Conditions: server.Map(conditions, dataCondToCondDisplay)},
+	)
+}
+
 // ConditionsInDateRange implements api.ServerInterface.
 func (m medicalServer) ConditionsInDateRange(
 	w http.ResponseWriter,
@@ -102,7 +187,8 @@ func (m medicalServer) ConditionsInDateRange(
 		to,
 	)
 	if err != nil {
-		slog.Error(
+		slog.ErrorContext(
+			r.Context(),
 			server.UnexpectedError,
 			"error",
 			err.Error(),
@@ -126,6 +212,59 @@ func (m medicalServer) ConditionsInDateRange(
 	)
 }
 
+// ExportPatientMedicalRecords implements api.ServerInterface.
+func (m medicalServer) ExportPatientMedicalRecords(
+	w http.ResponseWriter,
+	r *http.Request,
+	patientId api.PatientId,
+) {
+	conditions, err := m.db.AllConditionsByPatientId(r.Context(), patientId)
+	if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"ExportPatientMedicalRecords",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+
+	prescriptions, err := m.db.AllPrescriptionsByPatientId(r.Context(), patientId)
+	if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"ExportPatientMedicalRecords",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+
+	err = m.db.audit.Record(r.Context(), auditActionRecordsExport, patientId, nil, nil)
+	if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"ExportPatientMedicalRecords audit",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+	server.Encode(w, http.StatusOK, api.MedicalRecordsExport{
+		Conditions:    server.Map(conditions, dataCondToCondRecord),
+		Prescriptions: server.Map(prescriptions, dataPrescToPrescRecord),
+	})
+}
+
 // CreatePatientCondition implements api.ServerInterface.
 func (m medicalServer) CreatePatientCondition(w http.ResponseWriter, r *http.Request) {
 	req, decodeErr := server.Decode[api.NewCondition](w, r)
@@ -136,7 +275,14 @@ func (m medicalServer) CreatePatientCondition(w http.ResponseWriter, r *http.Req
 
 	cond, err := m.db.CreateCondition(r.Context(), newCondToDataCond(req))
 	if err != nil {
-		slog.Error(server.UnexpectedError, "error", err.Error(), "where", "CreatePatientCondition")
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"CreatePatientCondition",
+		)
 		server.EncodeError(w, server.InternalServerError())
 		return
 	}
@@ -153,7 +299,14 @@ func (m medicalServer) CreatePrescription(w http.ResponseWriter, r *http.Request
 
 	presc, err := m.db.CreatePrescription(r.Context(), newPrescToDataPresc(req))
 	if err != nil {
-		slog.Error(server.UnexpectedError, "error", err.Error(), "where", "CreatePrescription")
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"CreatePrescription",
+		)
 		server.EncodeError(w, server.InternalServerError())
 		return
 	}
@@ -162,7 +315,14 @@ func (m medicalServer) CreatePrescription(w http.ResponseWriter, r *http.Request
 	if presc.AppointmentId != nil {
 		appt, err := m.apptApi.AppointmentByIdWithResponse(r.Context(), *presc.AppointmentId)
 		if err != nil {
-			slog.Error(server.UnexpectedError, "error", err.Error(), "where", "CreatePrescription")
+			slog.ErrorContext(
+				r.Context(),
+				server.UnexpectedError,
+				"error",
+				err.Error(),
+				"where",
+				"CreatePrescription",
+			)
 			server.EncodeError(w, server.InternalServerError())
 			return
 		}
@@ -179,14 +339,31 @@ func (m medicalServer) DeletePrescription(
 	r *http.Request,
 	prescriptionId api.PrescriptionId,
 ) {
-	err := m.db.DeletePrescription(r.Context(), prescriptionId)
+	before, err := m.db.PrescriptionById(r.Context(), prescriptionId)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"DeletePrescription fetch",
+			"prescriptionId",
+			prescriptionId.String(),
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+
+	err = m.db.DeletePrescription(r.Context(), prescriptionId)
 	if err != nil {
 		if errors.Is(err, ErrNotFound) {
 			server.EncodeError(w, server.NotFoundId("Prescription", prescriptionId))
 			return
 		}
 
-		slog.Error(
+		slog.ErrorContext(
+			r.Context(),
 			server.UnexpectedError,
 			"error",
 			err.Error(),
@@ -198,6 +375,19 @@ func (m medicalServer) DeletePrescription(
 		server.EncodeError(w, server.InternalServerError())
 		return
 	}
+	err = m.db.audit.Record(r.Context(), auditActionPrescriptionDelete, prescriptionId, before, nil)
+	if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"DeletePrescription audit",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
@@ -214,7 +404,8 @@ func (m medicalServer) PrescriptionDetail(
 			server.EncodeError(w, server.NotFoundId("Prescription", prescriptionId))
 			return
 		}
-		slog.Error(
+		slog.ErrorContext(
+			r.Context(),
 			server.UnexpectedError,
 			"error",
 			err.Error(),
@@ -231,7 +422,14 @@ func (m medicalServer) PrescriptionDetail(
 	if prescription.AppointmentId != nil {
 		appt, err := m.apptApi.AppointmentByIdWithResponse(r.Context(), *prescription.AppointmentId)
 		if err != nil {
-			slog.Error(server.UnexpectedError, "error", err.Error(), "where", "PrescriptionDetail")
+			slog.ErrorContext(
+				r.Context(),
+				server.UnexpectedError,
+				"error",
+				err.Error(),
+				"where",
+				"PrescriptionDetail",
+			)
 			server.EncodeError(w, server.InternalServerError())
 			return
 		}
@@ -239,6 +437,20 @@ func (m medicalServer) PrescriptionDetail(
 		prescAppt = appt.JSON200
 	}
 
+	err = m.db.audit.Record(r.Context(), auditActionPrescriptionRead, prescriptionId, nil, nil)
+	if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"PrescriptionDetail audit",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+	server.SetETag(w, prescription.Version)
 	server.Encode(w, http.StatusOK, dataPrescToPresc(prescription, prescAppt))
 }
 
@@ -261,7 +473,8 @@ func (m medicalServer) PrescriptionsInDateRange(
 		to,
 	)
 	if err != nil {
-		slog.Error(
+		slog.ErrorContext(
+			r.Context(),
 			server.UnexpectedError,
 			"error",
 			err.Error(),
@@ -290,7 +503,14 @@ func (m medicalServer) UpdateCondition(
 	w http.ResponseWriter,
 	r *http.Request,
 	conditionId api.ConditionId,
+	params api.UpdateConditionParams,
 ) {
+	versions, preconditionErr := server.IfMatchVersions(params.IfMatch)
+	if preconditionErr != nil {
+		server.EncodeError(w, preconditionErr)
+		return
+	}
+
 	req, decodeErr := server.Decode[api.UpdateCondition](w, r)
 	if decodeErr != nil {
 		server.EncodeError(w, decodeErr)
@@ -303,7 +523,8 @@ func (m medicalServer) UpdateCondition(
 			server.EncodeError(w, server.NotFoundId("Condition", conditionId))
 			return
 		}
-		slog.Error(
+		slog.ErrorContext(
+			r.Context(),
 			server.UnexpectedError,
 			"error",
 			err.Error(),
@@ -315,6 +536,12 @@ func (m medicalServer) UpdateCondition(
 		server.EncodeError(w, server.InternalServerError())
 		return
 	}
+	_, preconditionErr = server.MatchVersion(existingCondition.Version, versions)
+	if preconditionErr != nil {
+		server.EncodeError(w, preconditionErr)
+		return
+	}
+	before := existingCondition
 
 	updated := false
 	if req.End != nil {
@@ -352,8 +579,14 @@ func (m medicalServer) UpdateCondition(
 			conditionId,
 			existingCondition,
 		)
-		if err != nil {
-			slog.Error(server.UnexpectedError, "error", err.Error(),
+		if errors.Is(err, ErrNotFound) {
+			server.EncodeError(w, server.NotFoundId("Condition", conditionId))
+			return
+		} else if errors.Is(err, ErrVersionMismatch) {
+			server.EncodeError(w, server.PreconditionFailed())
+			return
+		} else if err != nil {
+			slog.ErrorContext(r.Context(), server.UnexpectedError, "error", err.Error(),
 				"where",
 				"UpdateCondition update",
 				"conditionId",
@@ -363,13 +596,33 @@ func (m medicalServer) UpdateCondition(
 			return
 		}
 		finalConditionData = updatedDbResult
+		err = m.db.audit.Record(
+			r.Context(),
+			auditActionConditionUpdate,
+			conditionId,
+			before,
+			finalConditionData,
+		)
+		if err != nil {
+			slog.ErrorContext(
+				r.Context(),
+				server.UnexpectedError,
+				"error",
+				err.Error(),
+				"where",
+				"UpdateCondition audit",
+			)
+			server.EncodeError(w, server.InternalServerError())
+			return
+		}
 	} else {
 		finalConditionData = existingCondition
 	}
 
 	res, err := m.apptApi.AppointmentsByConditionIdWithResponse(r.Context(), finalConditionData.Id)
 	if err != nil {
-		slog.Error(
+		slog.ErrorContext(
+			r.Context(),
 			server.UnexpectedError,
 			"error",
 			err.Error(),
@@ -379,7 +632,7 @@ func (m medicalServer) UpdateCondition(
 		server.EncodeError(w, server.InternalServerError())
 		return
 	} else if res.JSON200 == nil {
-		slog.Error("no appointments response")
+		slog.ErrorContext(r.Context(), "no appointments response")
 		server.EncodeError(w, server.InternalServerError())
 		return
 	}
@@ -389,6 +642,7 @@ func (m medicalServer) UpdateCondition(
 		appts = *res.JSON200.Appointments
 	}
 
+	server.SetETag(w, finalConditionData.Version)
 	server.Encode(w, http.StatusOK, dataCondToCond(finalConditionData, appts))
 }
 
@@ -397,7 +651,14 @@ func (m medicalServer) UpdatePrescription(
 	w http.ResponseWriter,
 	r *http.Request,
 	prescriptionId api.PrescriptionId,
+	params api.UpdatePrescriptionParams,
 ) {
+	versions, preconditionErr := server.IfMatchVersions(params.IfMatch)
+	if preconditionErr != nil {
+		server.EncodeError(w, preconditionErr)
+		return
+	}
+
 	req, decodeErr := server.Decode[api.UpdatePrescription](w, r)
 	if decodeErr != nil {
 		server.EncodeError(w, decodeErr)
@@ -410,7 +671,8 @@ func (m medicalServer) UpdatePrescription(
 			server.EncodeError(w, server.NotFoundId("Prescription", prescriptionId))
 			return
 		}
-		slog.Error(
+		slog.ErrorContext(
+			r.Context(),
 			server.UnexpectedError,
 			"error",
 			err.Error(),
@@ -422,6 +684,12 @@ func (m medicalServer) UpdatePrescription(
 		server.EncodeError(w, server.InternalServerError())
 		return
 	}
+	_, preconditionErr = server.MatchVersion(existingPrescription.Version, versions)
+	if preconditionErr != nil {
+		server.EncodeError(w, preconditionErr)
+		return
+	}
+	before := existingPrescription
 
 	updated := false
 	if req.AppointmentId != nil {
@@ -474,8 +742,12 @@ func (m medicalServer) UpdatePrescription(
 			if errors.Is(err, ErrNotFound) {
 				server.EncodeError(w, server.NotFoundId("Prescription", prescriptionId))
 				return
+			} else if errors.Is(err, ErrVersionMismatch) {
+				server.EncodeError(w, server.PreconditionFailed())
+				return
 			}
-			slog.Error(
+			slog.ErrorContext(
+				r.Context(),
 				server.UnexpectedError,
 				"error",
 				err.Error(),
@@ -487,6 +759,25 @@ func (m medicalServer) UpdatePrescription(
 			server.EncodeError(w, server.InternalServerError())
 			return
 		}
+		err = m.db.audit.Record(
+			r.Context(),
+			auditActionPrescriptionUpdate,
+			prescriptionId,
+			before,
+			updatedDbPrescription,
+		)
+		if err != nil {
+			slog.ErrorContext(
+				r.Context(),
+				server.UnexpectedError,
+				"error",
+				err.Error(),
+				"where",
+				"UpdatePrescription audit",
+			)
+			server.EncodeError(w, server.InternalServerError())
+			return
+		}
 	} else {
 		updatedDbPrescription = existingPrescription
 	}
@@ -498,7 +789,14 @@ func (m medicalServer) UpdatePrescription(
 			*updatedDbPrescription.AppointmentId,
 		)
 		if err != nil {
-			slog.Error(server.UnexpectedError, "error", err.Error(), "where", "CreatePrescription")
+			slog.ErrorContext(
+				r.Context(),
+				server.UnexpectedError,
+				"error",
+				err.Error(),
+				"where",
+				"CreatePrescription",
+			)
 			server.EncodeError(w, server.InternalServerError())
 			return
 		}
@@ -506,6 +804,7 @@ func (m medicalServer) UpdatePrescription(
 		prescAppt = appt.JSON200
 	}
 
+	server.SetETag(w, updatedDbPrescription.Version)
 	server.Encode(w, http.StatusOK, dataPrescToPresc(updatedDbPrescription, prescAppt))
 }
 
@@ -520,7 +819,8 @@ func (m medicalServer) GetPrescriptionsByAppointmentId(
 		server.EncodeError(w, server.NotFoundId("Prescriptions", appointmentId))
 		return
 	} else if err != nil {
-		slog.Error(
+		slog.ErrorContext(
+			r.Context(),
 			server.UnexpectedError,
 			"error",
 			err.Error(),
@@ -537,3 +837,51 @@ func (m medicalServer) GetPrescriptionsByAppointmentId(
 		Prescriptions: server.Map(prescs, dataPrescToPrescDisplay),
 	})
 }
+
+// GetPrescriptionsByAppointmentIds implements api.ServerInterface.
+func (m medicalServer) GetPrescriptionsByAppointmentIds(w http.ResponseWriter, r *http.Request) {
+	req, decodeErr := server.Decode[api.GetPrescriptionsByAppointmentIdsJSONRequestBody](w, r)
+	if decodeErr != nil {
+		server.EncodeError(w, decodeErr)
+		return
+	}
+
+	prescs, err := m.db.PrescriptionsByAppointmentIds(r.Context(), req.Ids)
+	if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			server.UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"GetPrescriptionsByAppointmentIds",
+		)
+		server.EncodeError(w, server.InternalServerError())
+		return
+	}
+
+	server.Encode(w, http.StatusOK, api.Prescriptions{
+		Prescriptions: server.Map(prescs, dataPrescToPrescDisplay),
+	})
+}
+
+// AuditEvents implements api.ServerInterface.
+func (m medicalServer) AuditEvents(
+	w http.ResponseWriter,
+	r *http.Request,
+	params api.AuditEventsParams,
+) {
+	filter := audit.Filter{
+		TargetId: params.TargetId,
+		Actor:    params.Actor,
+		From:     params.From,
+		To:       params.To,
+	}
+	if params.AfterSeq != nil {
+		filter.AfterSeq = *params.AfterSeq
+	}
+	if params.Limit != nil {
+		filter.Limit = *params.Limit
+	}
+	server.AuditEvents(w, r, m.db.audit, filter)
+}
@@ -116,6 +116,10 @@ func (db mongoResourcesDb) Disconnect(ctx context.Context) error {
 	return db.resources.Database().Client().Disconnect(ctx)
 }
 
+func (db mongoResourcesDb) Ping(ctx context.Context) error {
+	return db.resources.Database().Client().Ping(ctx, nil)
+}
+
 func (m *mongoResourcesDb) CreateResource(
 	ctx context.Context,
 	name string,
@@ -154,6 +158,32 @@ func (m *mongoResourcesDb) ResourceById(ctx context.Context, id uuid.UUID) (Reso
 	return resource, nil
 }
 
+// ResourcesByIds returns resources with the ids, unknown ids are skipped.
+func (m *mongoResourcesDb) ResourcesByIds(
+	ctx context.Context,
+	ids []uuid.UUID,
+) ([]Resource, error) {
+	resources := make([]Resource, 0, len(ids))
+	filter := bson.M{"_id": bson.M{"$in": ids}}
+
+	cursor, err := m.resources.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("ResourcesByIds find failed: %w", err)
+	}
+
+	defer func() {
+		if cerr := cursor.Close(ctx); cerr != nil {
+			slog.WarnContext(ctx, "Failed to close resources by ids cursor", "error", cerr.Error())
+		}
+	}()
+
+	if err = cursor.All(ctx, &resources); err != nil {
+		return nil, fmt.Errorf("ResourcesByIds decode failed: %w", err)
+	}
+
+	return resources, nil
+}
+
 func (m *mongoResourcesDb) CreateReservation(
 	ctx context.Context,
 	appointmentId uuid.UUID,
@@ -187,6 +217,7 @@ func (m *mongoResourcesDb) CreateReservation(
 
 	if count > 0 {
 		// Conflict found with a *different* appointment
+		reservationConflicts.Inc()
 		return Reservation{}, fmt.Errorf(
 			"%w: resourceId %s from %s to %s (conflict with another appointment)",
 			ErrResourceUnavailable,
@@ -316,7 +347,12 @@ func (m *mongoResourcesDb) FindAvailableResourcesAtTime(
 	}
 	defer func() {
 		if cerr := cursor.Close(ctx); cerr != nil {
-			slog.Warn("Failed to close available resources cursor", "error", cerr.Error())
+			slog.WarnContext(
+				ctx,
+				"Failed to close available resources cursor",
+				"error",
+				cerr.Error(),
+			)
 		}
 	}()
 
@@ -334,7 +370,8 @@ func (m *mongoResourcesDb) FindAvailableResourcesAtTime(
 		case ResourceTypeEquipment:
 			result.Equipment = append(result.Equipment, resource)
 		default:
-			slog.Warn(
+			slog.WarnContext(
+				ctx,
 				"Found resource with unknown type",
 				"resourceId",
 				resource.Id,
@@ -365,6 +402,55 @@ func (m *mongoResourcesDb) DeleteReservationsByAppointmentId(
 	return nil
 }
 
+// MoveReservations moves all reservations of the appointment to the new
+// interval if every reserved resource is free in it. Otherwise all
+// reservations of the appointment are released and the conflicting ones are
+// returned.
+func (m *mongoResourcesDb) MoveReservations(
+	ctx context.Context,
+	appointmentId uuid.UUID,
+	startTime time.Time,
+	endTime time.Time,
+) ([]Reservation, error) {
+	reservations, err := m.ReservationsByAppointmentId(ctx, appointmentId)
+	if err != nil {
+		return nil, fmt.Errorf("MoveReservations: %w", err)
+	}
+
+	conflicts := make([]Reservation, 0)
+	for _, reservation := range reservations {
+		conflictFilter := bson.M{
+			"resourceId":    reservation.ResourceId,
+			"appointmentId": bson.M{"$ne": appointmentId},
+			"startTime":     bson.M{"$lt": endTime},
+			"endTime":       bson.M{"$gt": startTime},
+		}
+		count, err := m.reservations.CountDocuments(ctx, conflictFilter)
+		if err != nil {
+			return nil, fmt.Errorf("MoveReservations conflict check failed: %w", err)
+		}
+		if count > 0 {
+			conflicts = append(conflicts, reservation)
+		}
+	}
+
+	if len(conflicts) != 0 {
+		reservationConflicts.Add(float64(len(conflicts)))
+		if err := m.DeleteReservationsByAppointmentId(ctx, appointmentId); err != nil {
+			return nil, fmt.Errorf("MoveReservations: %w", err)
+		}
+		return conflicts, nil
+	}
+
+	filter := bson.M{"appointmentId": appointmentId}
+	update := bson.M{"$set": bson.M{"startTime": startTime, "endTime": endTime}}
+	if _, err := m.reservations.UpdateMany(ctx, filter, update); err != nil {
+		return nil, fmt.Errorf("MoveReservations update failed: %w", err)
+	}
+
+	return conflicts, nil
+}
+
 func (m *mongoResourcesDb) ResourcesByAppointmentId(
 	ctx context.Context,
 	appointmentId uuid.UUID,
@@ -405,7 +491,7 @@ func (m *mongoResourcesDb) ReservationsByAppointmentId(
 	}
 	defer func() {
 		if cerr := cursor.Close(ctx); cerr != nil {
-			slog.Warn("Failed to close reservations cursor", "error", cerr.Error())
+			slog.WarnContext(ctx, "Failed to close reservations cursor", "error", cerr.Error())
 		}
 	}()
 
@@ -420,6 +506,32 @@ func (m *mongoResourcesDb) ReservationsByAppointmentId(
 	return reservations, nil
 }
 
+// ReservationsByAppointmentIds returns the reservations of all of the
+// appointments.
+func (m *mongoResourcesDb) ReservationsByAppointmentIds(
+	ctx context.Context,
+	appointmentIds []uuid.UUID,
+) ([]Reservation, error) {
+	filter := bson.M{"appointmentId": bson.M{"$in": appointmentIds}}
+
+	reservations := make([]Reservation, 0)
+	cursor, err := m.reservations.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("ReservationsByAppointmentIds: %w", err)
+	}
+	defer func() {
+		if cerr := cursor.Close(ctx); cerr != nil {
+			slog.WarnContext(ctx, "Failed to close reservations cursor", "error", cerr.Error())
+		}
+	}()
+
+	if err = cursor.All(ctx, &reservations); err != nil {
+		return nil, fmt.Errorf("ReservationsByAppointmentIds decode failed: %w", err)
+	}
+
+	return reservations, nil
+}
+
 func (m *mongoResourcesDb) resourceExists(ctx context.Context, id uuid.UUID) error {
 	filter := bson.M{"_id": id}
 
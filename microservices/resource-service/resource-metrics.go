@@ -0,0 +1,11 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var reservationConflicts = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "reservation_conflicts_total",
+	Help: "Resources which couldn't be reserved for an appointment, because they were taken.",
+})
@@ -0,0 +1,3 @@
+package medicalapi
+
+//go:generate go tool oapi-codegen --config=./cfg.yaml ./medicalservice-openapi.yaml
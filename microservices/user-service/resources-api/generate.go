@@ -0,0 +1,3 @@
+package resourceapi
+
+//go:generate go tool oapi-codegen --config=./cfg.yaml ./resourceservice-openapi.yaml
@@ -0,0 +1,202 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Nesquiko/wac/pkg/api"
+	"github.com/Nesquiko/wac/pkg/data"
+	"github.com/Nesquiko/wac/pkg/notify"
+)
+
+// CreateAbsence blocks the period for new appointments with the doctor.
+// Appointments already booked in it are flagged for rescheduling, and their
+// patients are notified.
+func (a MonolithApp) CreateAbsence(
+	ctx context.Context,
+	doctorId uuid.UUID,
+	req api.NewAbsence,
+) (api.Absence, error) {
+	if err := validateAbsence(req); err != nil {
+		return api.Absence{}, err
+	}
+
+	absence, err := a.db.CreateAbsence(ctx, data.Absence{
+		DoctorId: doctorId,
+		Type:     data.AbsenceType(req.Type),
+		Start:    req.Start,
+		End:      req.End,
+		Note:     req.Note,
+	})
+	if err != nil {
+		if errors.Is(err, data.ErrNotFound) {
+			return api.Absence{}, fmt.Errorf("CreateAbsence: %w", ErrNotFound)
+		}
+		return api.Absence{}, fmt.Errorf("CreateAbsence: %w", err)
+	}
+
+	flagged, err := a.flagAppointmentsForReschedule(ctx, absence)
+	if err != nil {
+		return api.Absence{}, fmt.Errorf("CreateAbsence: %w", err)
+	}
+
+	apiAbsence := dataAbsenceToApiAbsence(absence)
+	apiAbsence.FlaggedAppointments = &flagged
+	return apiAbsence, nil
+}
+
+func (a MonolithApp) AbsenceById(ctx context.Context, id uuid.UUID) (api.Absence, error) {
+	absence, err := a.db.AbsenceById(ctx, id)
+	if err != nil {
+		if errors.Is(err, data.ErrNotFound) {
+			return api.Absence{}, fmt.Errorf("AbsenceById: %w", ErrNotFound)
+		}
+		return api.Absence{}, fmt.Errorf("AbsenceById: %w", err)
+	}
+
+	return dataAbsenceToApiAbsence(absence), nil
+}
+
+// DoctorAbsences returns the doctor's absences overlapping the window, which
+// is resolved the same way as the window of the doctor search.
+func (a MonolithApp) DoctorAbsences(
+	ctx context.Context,
+	doctorId uuid.UUID,
+	params api.DoctorsAbsencesParams,
+) ([]api.Absence, error) {
+	from, to, err := searchWindow(time.Now(), params.From, params.To, absenceValidationError)
+	if err != nil {
+		return nil, err
+	}
+
+	absences, err := a.db.AbsencesByDoctorId(ctx, doctorId, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("DoctorAbsences: %w", err)
+	}
+
+	return Map(absences, dataAbsenceToApiAbsence), nil
+}
+
+// UpdateAbsence replaces the absence. Appointments in the new period, which
+// weren't flagged yet, are flagged for rescheduling and their patients are
+// notified. Appointments flagged before stay flagged.
+func (a MonolithApp) UpdateAbsence(
+	ctx context.Context,
+	id uuid.UUID,
+	req api.NewAbsence,
+) (api.Absence, error) {
+	if err := validateAbsence(req); err != nil {
+		return api.Absence{}, err
+	}
+
+	absence, err := a.db.UpdateAbsence(ctx, data.Absence{
+		Id:    id,
+		Type:  data.AbsenceType(req.Type),
+		Start: req.Start,
+		End:   req.End,
+		Note:  req.Note,
+	})
+	if err != nil {
+		if errors.Is(err, data.ErrNotFound) {
+			return api.Absence{}, fmt.Errorf("UpdateAbsence: %w", ErrNotFound)
+		}
+		return api.Absence{}, fmt.Errorf("UpdateAbsence: %w", err)
+	}
+
+	flagged, err := a.flagAppointmentsForReschedule(ctx, absence)
+	if err != nil {
+		return api.Absence{}, fmt.Errorf("UpdateAbsence: %w", err)
+	}
+
+	apiAbsence := dataAbsenceToApiAbsence(absence)
+	apiAbsence.FlaggedAppointments = &flagged
+	return apiAbsence, nil
+}
+
+// DeleteAbsence removes the absence, appointments flagged because of it stay
+// flagged until they are rescheduled.
+func (a MonolithApp) DeleteAbsence(ctx context.Context, id uuid.UUID) error {
+	err := a.db.DeleteAbsence(ctx, id)
+	if err != nil {
+		if errors.Is(err, data.ErrNotFound) {
+			return fmt.Errorf("DeleteAbsence: %w", ErrNotFound)
+		}
+		return fmt.Errorf("DeleteAbsence: %w", err)
+	}
+
+	return nil
+}
+
+// flagAppointmentsForReschedule flags the doctor's appointments overlapping
+// the absence and notifies their patients. Ids of the newly flagged
+// appointments are returned.
+func (a MonolithApp) flagAppointmentsForReschedule(
+	ctx context.Context,
+	absence data.Absence,
+) ([]uuid.UUID, error) {
+	flagged, err := a.db.FlagAppointmentsForReschedule(
+		ctx,
+		absence.DoctorId,
+		absence.Start,
+		absence.End,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("flagAppointmentsForReschedule: %w", err)
+	}
+
+	ids := make([]uuid.UUID, len(flagged))
+	for i, appt := range flagged {
+		ids[i] = appt.Id
+		a.notify(ctx, notify.KindAppointmentRescheduleRequired, appt, nil, api.UserRolePatient)
+	}
+	return ids, nil
+}
+
+// doctorAbsent reports whether the doctor is absent at any time in
+// [start, end).
+func (a MonolithApp) doctorAbsent(
+	ctx context.Context,
+	doctorId uuid.UUID,
+	start time.Time,
+	end time.Time,
+) (bool, error) {
+	absences, err := a.db.AbsencesByDoctorId(ctx, doctorId, start, end)
+	if err != nil {
+		return false, fmt.Errorf("doctorAbsent: %w", err)
+	}
+	return len(absences) > 0, nil
+}
+
+func validateAbsence(req api.NewAbsence) error {
+	switch req.Type {
+	case api.Vacation, api.SickLeave, api.Conference:
+	default:
+		return absenceValidationError(
+			"Unknown absence type",
+			fmt.Sprintf("%q is not an absence type", req.Type),
+		)
+	}
+	if !req.End.After(req.Start) {
+		return absenceValidationError(
+			"Empty absence",
+			"The end of the absence must be after its start",
+		)
+	}
+	return nil
+}
+
+func absenceValidationError(title, detail string) *ValidationError {
+	return &ValidationError{
+		ErrorDetail: api.ErrorDetail{
+			Code:   "invalid.absence",
+			Title:  title,
+			Detail: detail,
+			Status: http.StatusBadRequest,
+		},
+	}
+}
@@ -2,6 +2,8 @@ package app
 
 import (
 	"errors"
+	"slices"
+	"time"
 
 	"github.com/Nesquiko/wac/pkg/data"
 )
@@ -9,12 +11,49 @@ import (
 var (
 	ErrDuplicateEmail      = errors.New("email address already exists")
 	ErrNotFound            = errors.New("resource not found")
+	ErrDeleted             = errors.New("resource was deleted")
 	ErrDoctorUnavailable   = errors.New("doctor unavailable at the specified time")
 	ErrResourceUnavailable = errors.New("resource is unavailable during the requested time slot")
+	ErrNoWaitlistOffer     = errors.New("waitlist entry has no offer")
+	ErrVersionMismatch     = errors.New("resource was changed since the expected version")
 )
 
-func New(db *data.MongoDb) MonolithApp { return MonolithApp{db} }
+// AnyVersion is expected of a resource, which may be updated at whatever
+// version it is, e.g. with If-Match: *.
+const AnyVersion int64 = -1
+
+// matchVersion resolves the expected versions against the current one of the
+// resource, which must be among them, AnyVersion matches current.
+// ErrVersionMismatch is returned otherwise.
+func matchVersion(current int64, versions []int64) (int64, error) {
+	if !slices.Contains(versions, AnyVersion) && !slices.Contains(versions, current) {
+		return 0, ErrVersionMismatch
+	}
+	return current, nil
+}
+
+// Options tune the app's scheduling behaviour.
+type Options struct {
+	// ReminderOffsets are how long before appointments patients are
+	// reminded of them.
+	ReminderOffsets []time.Duration
+	// WaitlistOfferHold is how long a freed slot is held for a waitlisted
+	// patient before it is offered to the next one.
+	WaitlistOfferHold time.Duration
+	// AppointmentDurations are the default appointment lengths keyed by
+	// appointment type, doctors may override them.
+	AppointmentDurations map[string]time.Duration
+	// Policy limits how late and how often appointments can be cancelled
+	// or rescheduled.
+	Policy Policy
+}
+
+// New returns the app storing its data in db.
+func New(db data.Storage, opts Options) MonolithApp {
+	return MonolithApp{db: db, opts: opts}
+}
 
 type MonolithApp struct {
-	db *data.MongoDb
+	db   data.Storage
+	opts Options
 }
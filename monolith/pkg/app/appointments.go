@@ -10,6 +10,7 @@ import (
 
 	"github.com/Nesquiko/wac/pkg/api"
 	"github.com/Nesquiko/wac/pkg/data"
+	"github.com/Nesquiko/wac/pkg/notify"
 )
 
 // CreateAppointment implements App.
@@ -17,16 +18,48 @@ func (a MonolithApp) CreateAppointment(
 	ctx context.Context,
 	appt api.NewAppointmentRequest,
 ) (api.Appointment, error) {
-	appointment, err := a.db.CreateAppointment(ctx, newApptToDataAppt(appt))
+	doc, err := a.db.DoctorById(ctx, appt.DoctorId)
 	if err != nil {
-		return api.Appointment{}, fmt.Errorf("CreateAppointment create appointment: %w", err)
+		return api.Appointment{}, fmt.Errorf("CreateAppointment find doctor: %w", err)
+	}
+	if doc.DeletedAt != nil {
+		return api.Appointment{}, fmt.Errorf(
+			"CreateAppointment doctor erased: %w",
+			ErrDoctorUnavailable,
+		)
 	}
 
-	doc, err := a.db.DoctorById(ctx, appointment.DoctorId)
+	duration := a.appointmentDuration(doc, appt.Type, appt.DurationMinutes)
+	end := appt.AppointmentDateTime.Add(duration)
+	absent, err := a.doctorAbsent(ctx, appt.DoctorId, appt.AppointmentDateTime, end)
 	if err != nil {
-		return api.Appointment{}, fmt.Errorf("CreateAppointment find doctor: %w", err)
+		return api.Appointment{}, fmt.Errorf("CreateAppointment: %w", err)
+	}
+	if absent {
+		return api.Appointment{}, fmt.Errorf("CreateAppointment doctor absent: %w", ErrDoctorUnavailable)
+	}
+	// a slot held for a waitlisted patient can only be booked by them
+	offered, err := a.slotOffered(ctx, doc, appt.PatientId, appt.AppointmentDateTime, end)
+	if err != nil {
+		return api.Appointment{}, fmt.Errorf("CreateAppointment: %w", err)
+	}
+	if offered {
+		return api.Appointment{}, fmt.Errorf(
+			"CreateAppointment slot offered: %w",
+			ErrDoctorUnavailable,
+		)
 	}
 
+	appointment, err := a.db.CreateAppointment(ctx, newApptToDataAppt(appt, duration))
+	if err != nil {
+		if errors.Is(err, data.ErrDoctorUnavailable) {
+			return api.Appointment{}, fmt.Errorf("CreateAppointment: %w", ErrDoctorUnavailable)
+		}
+		return api.Appointment{}, fmt.Errorf("CreateAppointment create appointment: %w", err)
+	}
+	appointmentsRequested.Inc()
+	a.scheduleReminders(ctx, appointment)
+
 	var cond *data.Condition = nil
 	if appointment.ConditionId != nil {
 		c, err := a.db.ConditionById(ctx, *appointment.ConditionId)
@@ -57,7 +90,31 @@ func (a MonolithApp) CancelAppointment(
 	appointmentId uuid.UUID,
 	req api.AppointmentCancellation,
 ) error {
-	err := a.db.CancelAppointment(ctx, appointmentId, string(req.By), req.Reason)
+	before, err := a.db.AppointmentById(ctx, appointmentId)
+	if err != nil {
+		return fmt.Errorf("CancelAppointment: %w", err)
+	}
+	override := req.OverridePolicy != nil && *req.OverridePolicy
+	late, err := a.opts.Policy.checkCancellation(before, req.By, override, time.Now())
+	if err != nil {
+		return err
+	}
+
+	err = a.db.CancelAppointment(ctx, appointmentId, string(req.By), req.Reason, late)
+	if err != nil {
+		return fmt.Errorf("CancelAppointment: %w", err)
+	}
+	appointmentsCancelled.Inc()
+
+	after, err := a.db.AppointmentById(ctx, appointmentId)
+	if err != nil {
+		return fmt.Errorf("CancelAppointment fetch cancelled: %w", err)
+	}
+	a.notify(ctx, notify.KindAppointmentCancelled, after, req.Reason, otherParticipant(req.By))
+	a.cancelReminders(ctx, appointmentId)
+	a.offerFreedSlot(ctx, after)
+
+	err = a.audit(ctx, AuditActionAppointmentCancel, appointmentId, before, after)
 	if err != nil {
 		return fmt.Errorf("CancelAppointment: %w", err)
 	}
@@ -135,9 +192,12 @@ func (a MonolithApp) AppointmentById(
 	), nil
 }
 
+// DecideAppointment accepts or rejects the requested appointment if it is
+// still at one of versions, otherwise it fails with ErrVersionMismatch.
 func (a MonolithApp) DecideAppointment(
 	ctx context.Context,
 	appointmentId uuid.UUID,
+	versions []int64,
 	decision api.AppointmentDecision,
 ) (api.Appointment, error) {
 	var resources []data.Resource
@@ -160,20 +220,47 @@ func (a MonolithApp) DecideAppointment(
 		})
 	}
 
+	before, err := a.db.AppointmentById(ctx, appointmentId)
+	if err != nil {
+		return api.Appointment{}, fmt.Errorf("DecideAppointment: %w", err)
+	}
+	version, err := matchVersion(before.Version, versions)
+	if err != nil {
+		return api.Appointment{}, fmt.Errorf("DecideAppointment: %w", err)
+	}
+
 	appointment, err := a.db.DecideAppointment(
 		ctx,
 		appointmentId,
+		version,
 		string(decision.Action),
 		decision.Reason,
 		resources,
 	)
 	if err != nil {
 		if errors.Is(err, data.ErrResourceUnavailable) {
+			reservationConflicts.Inc()
 			return api.Appointment{}, fmt.Errorf(
 				"DecideAppointment: %w",
 				ErrResourceUnavailable,
 			)
 		}
+		if errors.Is(err, data.ErrVersionMismatch) {
+			return api.Appointment{}, fmt.Errorf("DecideAppointment: %w", ErrVersionMismatch)
+		}
+		return api.Appointment{}, fmt.Errorf("DecideAppointment: %w", err)
+	}
+	observeDecision(before, decision.Action == api.Accept, time.Now())
+	if decision.Action == api.Accept {
+		a.notify(ctx, notify.KindAppointmentAccepted, appointment, nil, api.UserRolePatient)
+		a.scheduleReminders(ctx, appointment)
+	} else {
+		a.notify(ctx, notify.KindAppointmentDenied, appointment, decision.Reason, api.UserRolePatient)
+		a.cancelReminders(ctx, appointmentId)
+		a.offerFreedSlot(ctx, appointment)
+	}
+	err = a.audit(ctx, AuditActionAppointmentDecide, appointmentId, before, appointment)
+	if err != nil {
 		return api.Appointment{}, fmt.Errorf("DecideAppointment: %w", err)
 	}
 
@@ -239,37 +326,107 @@ func (a MonolithApp) DoctorTimeSlots(
 	if err != nil {
 		return api.DoctorTimeslots{}, fmt.Errorf("DoctorTimeSlots: %w", err)
 	}
-
-	bookedHours := make(map[int]bool)
-	for _, appt := range appointments {
-		bookedHours[appt.AppointmentDateTime.Hour()] = true
+	dayStart := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	absences, err := a.db.AbsencesByDoctorId(ctx, doctorId, dayStart, dayStart.AddDate(0, 0, 1))
+	if err != nil {
+		return api.DoctorTimeslots{}, fmt.Errorf("DoctorTimeSlots: %w", err)
 	}
 
 	var slots []api.TimeSlot
-	for hour := 8; hour <= 14; hour++ {
+	for hour := firstSlotHour; hour <= lastSlotHour; hour++ {
+		slotStart := time.Date(date.Year(), date.Month(), date.Day(), hour, 0, 0, 0, date.Location())
+		slotEnd := slotStart.Add(time.Hour)
+
 		status := api.Available
-		if bookedHours[hour] {
-			status = api.Unavailable
+		for _, appt := range appointments {
+			if appointmentActive(appt) && overlaps(appt, slotStart, slotEnd) {
+				status = api.Unavailable
+				break
+			}
+		}
+		for _, absence := range absences {
+			if absence.Start.Before(slotEnd) && absence.End.After(slotStart) {
+				status = api.Unavailable
+				break
+			}
 		}
-
-		slotTime := time.Date(date.Year(), date.Month(), date.Day(), hour, 0, 0, 0, date.Location()).
-			Format("15:04")
 
 		slots = append(slots, api.TimeSlot{
 			Status: status,
-			Time:   slotTime,
+			Time:   slotStart.Format("15:04"),
 		})
 	}
 
 	return api.DoctorTimeslots{Slots: slots}, nil
 }
 
+// RescheduleAppointment moves the appointment to the new time and puts it
+// back to the requested state, releasing its reservations. With
+// keepReservations the reservations are moved along instead, and the
+// appointment keeps its status, unless some reserved resource is taken at the
+// new time. Then it is rescheduled as without keepReservations and the taken
+// resources are reported. The reschedule must be allowed by the policy,
+// unless an administrator overrides it. An appointment which isn't at one of versions
+// anymore fails with ErrVersionMismatch.
 func (a MonolithApp) RescheduleAppointment(
 	ctx context.Context,
 	appointmentId api.AppointmentId,
-	newDateTime time.Time,
+	versions []int64,
+	req api.AppointmentReschedule,
 ) (api.Appointment, error) {
-	appt, err := a.db.RescheduleAppointment(ctx, appointmentId, newDateTime)
+	newDateTime := req.NewAppointmentDateTime
+	keepReservations := req.KeepReservations != nil && *req.KeepReservations
+	before, err := a.db.AppointmentById(ctx, appointmentId)
+	if err != nil {
+		return api.Appointment{}, fmt.Errorf("RescheduleAppointment: %w", notFoundErr(err))
+	}
+	version, err := matchVersion(before.Version, versions)
+	if err != nil {
+		return api.Appointment{}, fmt.Errorf("RescheduleAppointment: %w", err)
+	}
+	override := req.OverridePolicy != nil && *req.OverridePolicy
+	err = a.opts.Policy.checkReschedule(before, newDateTime, override, time.Now())
+	if err != nil {
+		return api.Appointment{}, err
+	}
+	doc, err := a.db.DoctorById(ctx, before.DoctorId)
+	if err != nil {
+		return api.Appointment{}, fmt.Errorf("RescheduleAppointment find doctor: %w", err)
+	}
+	newEnd := newDateTime.Add(before.EndTime.Sub(before.AppointmentDateTime))
+	absent, err := a.doctorAbsent(ctx, before.DoctorId, newDateTime, newEnd)
+	if err != nil {
+		return api.Appointment{}, fmt.Errorf("RescheduleAppointment: %w", err)
+	}
+	if absent {
+		return api.Appointment{}, fmt.Errorf(
+			"RescheduleAppointment doctor absent: %w",
+			ErrDoctorUnavailable,
+		)
+	}
+	offered, err := a.slotOffered(ctx, doc, before.PatientId, newDateTime, newEnd)
+	if err != nil {
+		return api.Appointment{}, fmt.Errorf("RescheduleAppointment: %w", err)
+	}
+	if offered {
+		return api.Appointment{}, fmt.Errorf(
+			"RescheduleAppointment slot offered: %w",
+			ErrDoctorUnavailable,
+		)
+	}
+
+	var appt data.Appointment
+	var conflicts []data.Reservation
+	if keepReservations {
+		appt, conflicts, err = a.db.RescheduleAppointmentKeepingReservations(
+			ctx,
+			appointmentId,
+			version,
+			newDateTime,
+		)
+	} else {
+		appt, err = a.db.RescheduleAppointment(ctx, appointmentId, version, newDateTime)
+	}
 	if err != nil {
 		if errors.Is(err, data.ErrDoctorUnavailable) {
 			return api.Appointment{}, fmt.Errorf(
@@ -277,12 +434,34 @@ func (a MonolithApp) RescheduleAppointment(
 				ErrDoctorUnavailable,
 			)
 		}
+		if errors.Is(err, data.ErrVersionMismatch) {
+			return api.Appointment{}, fmt.Errorf(
+				"RescheduleAppointment: %w",
+				ErrVersionMismatch,
+			)
+		}
 		return api.Appointment{}, fmt.Errorf("RescheduleAppointment: %w", err)
 	}
+	reservationConflicts.Add(float64(len(conflicts)))
 
-	doc, err := a.db.DoctorById(ctx, appt.DoctorId)
+	// whoever rescheduled the appointment doesn't need to be told about it
+	recipients := make([]api.UserRole, 0, 2)
+	actor := auditMetaFrom(ctx).Actor
+	if actor != appt.DoctorId.String() {
+		recipients = append(recipients, api.UserRoleDoctor)
+	}
+	if actor != appt.PatientId.String() {
+		recipients = append(recipients, api.UserRolePatient)
+	}
+	kind := notify.KindAppointmentRescheduled
+	if appt.Status == before.Status && before.Status == string(api.Scheduled) {
+		kind = notify.KindAppointmentMoved
+	}
+	a.notify(ctx, kind, appt, nil, recipients...)
+	a.scheduleReminders(ctx, appt)
+	err = a.audit(ctx, AuditActionAppointmentReschedule, appointmentId, before, appt)
 	if err != nil {
-		return api.Appointment{}, fmt.Errorf("RescheduleAppointment find doctor: %w", err)
+		return api.Appointment{}, fmt.Errorf("RescheduleAppointment: %w", err)
 	}
 
 	var cond *data.Condition
@@ -310,5 +489,9 @@ func (a MonolithApp) RescheduleAppointment(
 		return api.Appointment{}, fmt.Errorf("RescheduleAppointment find patient: %w", err)
 	}
 
-	return dataApptToPatientAppt(appt, doc, patient, cond, prescriptions), nil
+	apiAppt := dataApptToPatientAppt(appt, doc, patient, cond, prescriptions)
+	if keepReservations {
+		apiAppt.ReservationConflicts = asPtr(Map(conflicts, reservationToApiResource))
+	}
+	return apiAppt, nil
 }
@@ -0,0 +1,170 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/Nesquiko/wac/pkg/api"
+	"github.com/Nesquiko/wac/pkg/data"
+)
+
+const (
+	AuditActionConditionRead         = "condition.read"
+	AuditActionConditionUpdate       = "condition.update"
+	AuditActionPrescriptionRead      = "prescription.read"
+	AuditActionPrescriptionUpdate    = "prescription.update"
+	AuditActionPrescriptionDelete    = "prescription.delete"
+	AuditActionAppointmentDecide     = "appointment.decide"
+	AuditActionAppointmentCancel     = "appointment.cancel"
+	AuditActionAppointmentReschedule = "appointment.reschedule"
+	AuditActionAppointmentReassign   = "appointment.reassign"
+	AuditActionPatientErase          = "patient.erase"
+	AuditActionPatientExport         = "patient.export"
+	AuditActionDoctorErase           = "doctor.erase"
+
+	// AnonymousActor is recorded when a request doesn't identify its caller.
+	AnonymousActor = "anonymous"
+
+	// AuditEventsPageLimit is the size of a page of the audit log, if the
+	// request doesn't limit it.
+	AuditEventsPageLimit = 100
+)
+
+// AuditMeta identifies who caused an audited action and by which request.
+type AuditMeta struct {
+	Actor     string
+	RequestId string
+}
+
+type auditMetaKey struct{}
+
+func WithAuditMeta(ctx context.Context, meta AuditMeta) context.Context {
+	return context.WithValue(ctx, auditMetaKey{}, meta)
+}
+
+func auditMetaFrom(ctx context.Context) AuditMeta {
+	meta, _ := ctx.Value(auditMetaKey{}).(AuditMeta)
+	if meta.Actor == "" {
+		meta.Actor = AnonymousActor
+	}
+	return meta
+}
+
+// AuditEvents returns a page of the audit log, NextAfterSeq is set if more
+// events follow.
+func (a MonolithApp) AuditEvents(
+	ctx context.Context,
+	params api.AuditEventsParams,
+) (api.AuditEvents, error) {
+	limit := AuditEventsPageLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	filter := data.AuditFilter{
+		TargetId: params.TargetId,
+		Actor:    params.Actor,
+		From:     params.From,
+		To:       params.To,
+		// one more, to tell whether there is a next page
+		Limit: limit + 1,
+	}
+	if params.AfterSeq != nil {
+		filter.AfterSeq = *params.AfterSeq
+	}
+
+	events, err := a.db.AuditEvents(ctx, filter)
+	if err != nil {
+		return api.AuditEvents{}, fmt.Errorf("AuditEvents: %w", err)
+	}
+
+	page := api.AuditEvents{}
+	if len(events) > limit {
+		events = events[:limit]
+		page.NextAfterSeq = &events[limit-1].Seq
+	}
+	page.Events = make([]api.AuditEvent, len(events))
+	for i, event := range events {
+		page.Events[i], err = dataAuditEventToAuditEvent(event)
+		if err != nil {
+			return api.AuditEvents{}, fmt.Errorf("AuditEvents map event %d: %w", event.Seq, err)
+		}
+	}
+	return page, nil
+}
+
+// audit appends an event of an already performed action to the audit log.
+// before and after are states of the target, either can be nil, for reads
+// both are nil. An action which can't be audited must fail, so callers audit
+// after the action's side effects and return the error.
+func (a MonolithApp) audit(
+	ctx context.Context,
+	action string,
+	targetId uuid.UUID,
+	before, after any,
+) error {
+	meta := auditMetaFrom(ctx)
+	diff, err := auditDiff(before, after)
+	if err != nil {
+		return fmt.Errorf("audit %s: %w", action, err)
+	}
+
+	_, err = a.db.AppendAuditEvent(ctx, data.AuditEvent{
+		Actor:     meta.Actor,
+		Action:    action,
+		TargetId:  targetId,
+		Diff:      diff,
+		RequestId: meta.RequestId,
+	})
+	if err != nil {
+		return fmt.Errorf("audit %s: %w", action, err)
+	}
+	return nil
+}
+
+// auditDiff compares JSON representations of before and after field by
+// field and returns the fields which differ.
+func auditDiff(before, after any) (map[string]data.AuditChange, error) {
+	beforeFields, err := auditFields(before)
+	if err != nil {
+		return nil, fmt.Errorf("auditDiff before: %w", err)
+	}
+	afterFields, err := auditFields(after)
+	if err != nil {
+		return nil, fmt.Errorf("auditDiff after: %w", err)
+	}
+
+	diff := make(map[string]data.AuditChange)
+	for field, value := range beforeFields {
+		if !bytes.Equal(value, afterFields[field]) {
+			diff[field] = data.AuditChange{Before: value, After: afterFields[field]}
+		}
+	}
+	for field, value := range afterFields {
+		if _, ok := beforeFields[field]; !ok {
+			diff[field] = data.AuditChange{After: value}
+		}
+	}
+
+	if len(diff) == 0 {
+		return nil, nil
+	}
+	return diff, nil
+}
+
+func auditFields(v any) (map[string]json.RawMessage, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	err = json.Unmarshal(raw, &fields)
+	return fields, err
+}
@@ -0,0 +1,261 @@
+package app
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Nesquiko/wac/pkg/api"
+	"github.com/Nesquiko/wac/pkg/data"
+	"github.com/Nesquiko/wac/pkg/ics"
+)
+
+const (
+	// CalendarFeedHistoryMonths is how many months of past appointments a
+	// calendar feed contains, all future ones are always included.
+	CalendarFeedHistoryMonths = 3
+
+	calendarFeedTokenBytes = 32
+	calendarUidDomain      = "wac"
+)
+
+// IssueCalendarFeed creates a new secret feed of the user's appointments and
+// returns its token. The user's previous feed stops working.
+func (a MonolithApp) IssueCalendarFeed(
+	ctx context.Context,
+	userId uuid.UUID,
+	role api.UserRole,
+) (string, error) {
+	if err := a.calendarOwnerExists(ctx, userId, role); err != nil {
+		return "", fmt.Errorf("IssueCalendarFeed: %w", err)
+	}
+
+	raw := make([]byte, calendarFeedTokenBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("IssueCalendarFeed generate token: %w", err)
+	}
+	token := hex.EncodeToString(raw)
+
+	err := a.db.SaveCalendarFeed(ctx, data.CalendarFeed{
+		UserId:    userId,
+		Role:      string(role),
+		TokenHash: hashCalendarFeedToken(token),
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("IssueCalendarFeed: %w", err)
+	}
+
+	return token, nil
+}
+
+func (a MonolithApp) RevokeCalendarFeed(ctx context.Context, userId uuid.UUID) error {
+	err := a.db.DeleteCalendarFeed(ctx, userId)
+	if err != nil {
+		return fmt.Errorf("RevokeCalendarFeed: %w", notFoundErr(err))
+	}
+	return nil
+}
+
+// CalendarFeed returns appointments of the feed's owner. Events are written
+// in the local time zone, which is the one configured for the app.
+func (a MonolithApp) CalendarFeed(ctx context.Context, token string) (ics.Calendar, error) {
+	feed, err := a.db.CalendarFeedByTokenHash(ctx, hashCalendarFeedToken(token))
+	if err != nil {
+		return ics.Calendar{}, fmt.Errorf("CalendarFeed: %w", notFoundErr(err))
+	}
+
+	from := time.Now().AddDate(0, -CalendarFeedHistoryMonths, 0)
+	switch api.UserRole(feed.Role) {
+	case api.UserRoleDoctor:
+		return a.doctorCalendarFeed(ctx, feed.UserId, from)
+	case api.UserRolePatient:
+		return a.patientCalendarFeed(ctx, feed.UserId, from)
+	default:
+		return ics.Calendar{}, fmt.Errorf("CalendarFeed unknown role %q", feed.Role)
+	}
+}
+
+func (a MonolithApp) doctorCalendarFeed(
+	ctx context.Context,
+	doctorId uuid.UUID,
+	from time.Time,
+) (ics.Calendar, error) {
+	doctor, err := a.activeDoctor(ctx, doctorId)
+	if err != nil {
+		return ics.Calendar{}, fmt.Errorf("doctorCalendarFeed doc find: %w", err)
+	}
+
+	appts, err := a.db.AppointmentsByDoctorId(ctx, doctorId, from, nil)
+	if err != nil {
+		return ics.Calendar{}, fmt.Errorf("doctorCalendarFeed appointments: %w", err)
+	}
+
+	patients := make(map[uuid.UUID]data.Patient)
+	events := make([]ics.Event, len(appts))
+	for i, appt := range appts {
+		patient, ok := patients[appt.PatientId]
+		if !ok {
+			patient, err = a.db.PatientById(ctx, appt.PatientId)
+			if err != nil {
+				return ics.Calendar{}, fmt.Errorf("doctorCalendarFeed patient find: %w", err)
+			}
+			patients[appt.PatientId] = patient
+		}
+		events[i] = apptToIcsEvent(appt, patient.FirstName+" "+patient.LastName)
+	}
+
+	return ics.Calendar{
+		Name:     fmt.Sprintf("Dr. %s %s", doctor.FirstName, doctor.LastName),
+		Location: time.Local,
+		Events:   events,
+	}, nil
+}
+
+func (a MonolithApp) patientCalendarFeed(
+	ctx context.Context,
+	patientId uuid.UUID,
+	from time.Time,
+) (ics.Calendar, error) {
+	patient, err := a.db.PatientById(ctx, patientId)
+	if err != nil {
+		return ics.Calendar{}, fmt.Errorf("patientCalendarFeed patient find: %w", notFoundErr(err))
+	}
+	if patient.DeletedAt != nil {
+		return ics.Calendar{}, fmt.Errorf("patientCalendarFeed patient was erased: %w", ErrNotFound)
+	}
+
+	appts, err := a.db.AppointmentsByPatientId(ctx, patientId, from, nil)
+	if err != nil {
+		return ics.Calendar{}, fmt.Errorf("patientCalendarFeed appointments: %w", err)
+	}
+
+	doctors := make(map[uuid.UUID]data.Doctor)
+	events := make([]ics.Event, len(appts))
+	for i, appt := range appts {
+		doctor, ok := doctors[appt.DoctorId]
+		if !ok {
+			doctor, err = a.db.DoctorById(ctx, appt.DoctorId)
+			if err != nil {
+				return ics.Calendar{}, fmt.Errorf("patientCalendarFeed doc find: %w", err)
+			}
+			doctors[appt.DoctorId] = doctor
+		}
+		events[i] = apptToIcsEvent(appt, "Dr. "+doctor.FirstName+" "+doctor.LastName)
+	}
+
+	return ics.Calendar{
+		Name:     fmt.Sprintf("%s %s", patient.FirstName, patient.LastName),
+		Location: time.Local,
+		Events:   events,
+	}, nil
+}
+
+// AppointmentIcs returns a calendar with the single appointment, which can be
+// imported by both of its participants.
+func (a MonolithApp) AppointmentIcs(ctx context.Context, id uuid.UUID) (ics.Calendar, error) {
+	appt, err := a.db.AppointmentById(ctx, id)
+	if err != nil {
+		return ics.Calendar{}, fmt.Errorf("AppointmentIcs: %w", notFoundErr(err))
+	}
+
+	doctor, err := a.db.DoctorById(ctx, appt.DoctorId)
+	if err != nil {
+		return ics.Calendar{}, fmt.Errorf("AppointmentIcs doc find: %w", err)
+	}
+	patient, err := a.db.PatientById(ctx, appt.PatientId)
+	if err != nil {
+		return ics.Calendar{}, fmt.Errorf("AppointmentIcs patient find: %w", err)
+	}
+
+	with := fmt.Sprintf(
+		"Dr. %s %s and %s %s",
+		doctor.FirstName,
+		doctor.LastName,
+		patient.FirstName,
+		patient.LastName,
+	)
+	return ics.Calendar{
+		Location: time.Local,
+		Events:   []ics.Event{apptToIcsEvent(appt, with)},
+	}, nil
+}
+
+func (a MonolithApp) calendarOwnerExists(
+	ctx context.Context,
+	userId uuid.UUID,
+	role api.UserRole,
+) error {
+	switch role {
+	case api.UserRoleDoctor:
+		_, err := a.activeDoctor(ctx, userId)
+		return err
+	case api.UserRolePatient:
+		patient, err := a.db.PatientById(ctx, userId)
+		if err != nil {
+			return notFoundErr(err)
+		}
+		if patient.DeletedAt != nil {
+			return ErrNotFound
+		}
+		return nil
+	default:
+		return errors.New("unknown user role")
+	}
+}
+
+// apptToIcsEvent maps the appointment to an event named after its type and
+// the other participant.
+func apptToIcsEvent(appt data.Appointment, with string) ics.Event {
+	event := ics.Event{
+		Uid:     appt.Id.String() + "@" + calendarUidDomain,
+		Start:   appt.AppointmentDateTime,
+		End:     appt.EndTime,
+		Summary: appointmentTypeLabel(appt.Type) + " - " + with,
+	}
+
+	description := make([]string, 0, 2)
+	if appt.Reason != nil {
+		description = append(description, "Reason: "+*appt.Reason)
+	}
+
+	switch api.AppointmentStatus(appt.Status) {
+	case api.Requested:
+		event.Status = ics.StatusTentative
+	case api.Cancelled:
+		event.Status = ics.StatusCancelled
+		if appt.CancellationReason != nil {
+			description = append(description, "Cancelled: "+*appt.CancellationReason)
+		}
+	case api.Denied:
+		event.Status = ics.StatusCancelled
+		if appt.DenialReason != nil {
+			description = append(description, "Denied: "+*appt.DenialReason)
+		}
+	default:
+		event.Status = ics.StatusConfirmed
+	}
+	event.Description = strings.Join(description, "\n")
+
+	return event
+}
+
+func appointmentTypeLabel(typ string) string {
+	if typ == "" {
+		return "Appointment"
+	}
+	label := strings.ReplaceAll(typ, "_", " ")
+	return strings.ToUpper(label[:1]) + label[1:]
+}
+
+func hashCalendarFeedToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
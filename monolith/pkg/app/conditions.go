@@ -38,6 +38,9 @@ func (a MonolithApp) ConditionById(ctx context.Context, id uuid.UUID) (api.Condi
 	if err != nil {
 		return api.Condition{}, fmt.Errorf("ConditionById find patient: %w", err)
 	}
+	if err := a.audit(ctx, AuditActionConditionRead, cond.Id, nil, nil); err != nil {
+		return api.Condition{}, fmt.Errorf("ConditionById: %w", err)
+	}
 
 	appointments := make([]api.AppointmentDisplay, len(appts))
 	for i, appt := range appts {
@@ -51,9 +54,12 @@ func (a MonolithApp) ConditionById(ctx context.Context, id uuid.UUID) (api.Condi
 	return dataCondToCond(cond, appointments), nil
 }
 
+// UpdatePatientCondition updates the condition if it is still at one of
+// versions, otherwise it fails with ErrVersionMismatch.
 func (a MonolithApp) UpdatePatientCondition(
 	ctx context.Context,
 	conditionId uuid.UUID,
+	versions []int64,
 	updateData api.UpdateCondition,
 ) (api.Condition, error) {
 	existingCondition, err := a.db.ConditionById(ctx, conditionId)
@@ -63,6 +69,11 @@ func (a MonolithApp) UpdatePatientCondition(
 		}
 		return api.Condition{}, fmt.Errorf("UpdatePatientCondition fetch failed: %w", err)
 	}
+	_, err = matchVersion(existingCondition.Version, versions)
+	if err != nil {
+		return api.Condition{}, fmt.Errorf("UpdatePatientCondition: %w", err)
+	}
+	before := existingCondition
 
 	updated := false
 	if updateData.End != nil {
@@ -107,12 +118,22 @@ func (a MonolithApp) UpdatePatientCondition(
 					ErrNotFound,
 				)
 			}
+			if errors.Is(err, data.ErrVersionMismatch) {
+				return api.Condition{}, fmt.Errorf(
+					"UpdatePatientCondition: %w",
+					ErrVersionMismatch,
+				)
+			}
 			return api.Condition{}, fmt.Errorf(
 				"UpdatePatientCondition update failed: %w",
 				err,
 			)
 		}
 		finalConditionData = updatedDbResult
+		err = a.audit(ctx, AuditActionConditionUpdate, conditionId, before, finalConditionData)
+		if err != nil {
+			return api.Condition{}, fmt.Errorf("UpdatePatientCondition: %w", err)
+		}
 	} else {
 		finalConditionData = existingCondition
 	}
@@ -35,10 +35,68 @@ func (a MonolithApp) DoctorById(ctx context.Context, id uuid.UUID) (api.Doctor,
 		}
 		return api.Doctor{}, fmt.Errorf("DoctorById: %w", err)
 	}
+	if doctor.DeletedAt != nil {
+		return api.Doctor{}, fmt.Errorf("DoctorById doctor was erased: %w", ErrNotFound)
+	}
 
 	return dataDoctorToApiDoctor(doctor), nil
 }
 
+// activeDoctor returns the doctor, ErrNotFound is returned also if the
+// doctor's personal data were erased.
+func (a MonolithApp) activeDoctor(ctx context.Context, id uuid.UUID) (data.Doctor, error) {
+	doctor, err := a.db.DoctorById(ctx, id)
+	if err != nil {
+		return data.Doctor{}, notFoundErr(err)
+	}
+	if doctor.DeletedAt != nil {
+		return data.Doctor{}, ErrNotFound
+	}
+
+	return doctor, nil
+}
+
+// EraseDoctor anonymizes personal data of the doctor. Their future
+// appointments are cancelled and their waitlist is dropped, past appointments
+// are retained for RecordsRetentionYears.
+func (a MonolithApp) EraseDoctor(ctx context.Context, id uuid.UUID) error {
+	if _, err := a.activeDoctor(ctx, id); err != nil {
+		return fmt.Errorf("EraseDoctor: %w", err)
+	}
+
+	// dropped first, so that the cancelled slots aren't offered to anyone
+	if err := a.db.DeleteWaitlistEntriesByDoctorId(ctx, id); err != nil {
+		return fmt.Errorf("EraseDoctor waitlist: %w", err)
+	}
+
+	appts, err := a.db.AppointmentsByDoctorId(ctx, id, time.Now(), nil)
+	if err != nil {
+		return fmt.Errorf("EraseDoctor appointments: %w", err)
+	}
+	if err := a.cancelErasedAppointments(ctx, appts, api.UserRoleDoctor); err != nil {
+		return fmt.Errorf("EraseDoctor: %w", err)
+	}
+
+	err = a.db.DeleteCalendarFeed(ctx, id)
+	if err != nil && !errors.Is(err, data.ErrNotFound) {
+		return fmt.Errorf("EraseDoctor calendar feed: %w", err)
+	}
+
+	retainUntil := time.Now().AddDate(RecordsRetentionYears, 0, 0)
+	_, err = a.db.AnonymizeDoctor(ctx, id, retainUntil)
+	if err != nil {
+		if errors.Is(err, data.ErrNotFound) {
+			return fmt.Errorf("EraseDoctor: %w", ErrNotFound)
+		}
+		return fmt.Errorf("EraseDoctor: %w", err)
+	}
+	// no diff, the erased personal data must not survive in the audit log
+	if err := a.audit(ctx, AuditActionDoctorErase, id, nil, nil); err != nil {
+		return fmt.Errorf("EraseDoctor: %w", err)
+	}
+	return nil
+}
+
 func (a MonolithApp) DoctorByEmail(ctx context.Context, email string) (api.Doctor, error) {
 	doctor, err := a.db.DoctorByEmail(ctx, email)
 	if err != nil {
@@ -88,11 +146,14 @@ func (a MonolithApp) DoctorsCalendar(
 	return calendar, nil
 }
 
+// AvailableDoctors returns doctors, who are free for the whole duration long
+// interval starting at dateTime.
 func (a MonolithApp) AvailableDoctors(
 	ctx context.Context,
 	dateTime time.Time,
+	duration time.Duration,
 ) ([]api.Doctor, error) {
-	availableDataDoctors, err := a.db.AvailableDoctors(ctx, dateTime)
+	availableDataDoctors, err := a.db.AvailableDoctors(ctx, dateTime, duration)
 	if err != nil {
 		return nil, fmt.Errorf("AvailableDoctors failed: %w", err)
 	}
@@ -0,0 +1,157 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Nesquiko/wac/pkg/api"
+	"github.com/Nesquiko/wac/pkg/data"
+)
+
+const (
+	// defaultAppointmentDuration is used for appointments without a type, or
+	// with a type that has no configured duration.
+	defaultAppointmentDuration = time.Hour
+
+	minAppointmentDurationMinutes = 5
+	maxAppointmentDurationMinutes = 480
+)
+
+var appointmentTypes = []api.AppointmentType{
+	api.RegularCheck,
+	api.NewPatient,
+	api.FollowUp,
+	api.AnnualPhysical,
+	api.Consultation,
+	api.Vaccination,
+	api.UrgentCare,
+	api.Procedure,
+	api.SpecialistVisit,
+}
+
+func (a MonolithApp) DoctorAppointmentDurations(
+	ctx context.Context,
+	doctorId uuid.UUID,
+) (api.AppointmentDurations, error) {
+	doctor, err := a.db.DoctorById(ctx, doctorId)
+	if err != nil {
+		if errors.Is(err, data.ErrNotFound) {
+			return nil, fmt.Errorf("DoctorAppointmentDurations: %w", ErrNotFound)
+		}
+		return nil, fmt.Errorf("DoctorAppointmentDurations: %w", err)
+	}
+
+	return a.appointmentDurations(doctor), nil
+}
+
+// UpdateDoctorAppointmentDurations replaces the doctor's duration overrides.
+// Appointments which are already booked keep their length.
+func (a MonolithApp) UpdateDoctorAppointmentDurations(
+	ctx context.Context,
+	doctorId uuid.UUID,
+	durations api.AppointmentDurations,
+) (api.AppointmentDurations, error) {
+	for typ, minutes := range durations {
+		if !isAppointmentType(typ) {
+			return nil, durationsValidationError(
+				"Unknown appointment type",
+				fmt.Sprintf("%q is not an appointment type", typ),
+			)
+		}
+		if minutes < minAppointmentDurationMinutes || minutes > maxAppointmentDurationMinutes {
+			return nil, durationsValidationError(
+				"Duration out of range",
+				fmt.Sprintf(
+					"Duration of %q must be between %d and %d minutes",
+					typ,
+					minAppointmentDurationMinutes,
+					maxAppointmentDurationMinutes,
+				),
+			)
+		}
+	}
+
+	doctor, err := a.db.UpdateDoctorAppointmentDurations(ctx, doctorId, durations)
+	if err != nil {
+		if errors.Is(err, data.ErrNotFound) {
+			return nil, fmt.Errorf("UpdateDoctorAppointmentDurations: %w", ErrNotFound)
+		}
+		return nil, fmt.Errorf("UpdateDoctorAppointmentDurations: %w", err)
+	}
+
+	return a.appointmentDurations(doctor), nil
+}
+
+// appointmentDuration resolves how long an appointment of typ with the doctor
+// lasts. Explicitly requested minutes win over the doctor's override, which
+// wins over the configured default of the type.
+func (a MonolithApp) appointmentDuration(
+	doctor data.Doctor,
+	typ *api.AppointmentType,
+	minutes *int,
+) time.Duration {
+	if minutes != nil {
+		return time.Duration(*minutes) * time.Minute
+	}
+	if typ == nil {
+		return defaultAppointmentDuration
+	}
+	if override, ok := doctor.AppointmentDurations[string(*typ)]; ok {
+		return time.Duration(override) * time.Minute
+	}
+	if duration, ok := a.opts.AppointmentDurations[string(*typ)]; ok {
+		return duration
+	}
+	return defaultAppointmentDuration
+}
+
+// appointmentDurations returns the effective duration of every appointment
+// type with the doctor.
+func (a MonolithApp) appointmentDurations(doctor data.Doctor) api.AppointmentDurations {
+	durations := make(api.AppointmentDurations, len(appointmentTypes))
+	for _, typ := range appointmentTypes {
+		duration := a.appointmentDuration(doctor, &typ, nil)
+		durations[string(typ)] = int(duration / time.Minute)
+	}
+	return durations
+}
+
+// appointmentActive reports whether the appointment still occupies its slot.
+func appointmentActive(appt data.Appointment) bool {
+	switch api.AppointmentStatus(appt.Status) {
+	case api.Cancelled, api.Denied:
+		return false
+	}
+	return true
+}
+
+// overlaps reports whether the appointment intersects the [start, end)
+// interval.
+func overlaps(appt data.Appointment, start, end time.Time) bool {
+	return appt.AppointmentDateTime.Before(end) && appt.EndTime.After(start)
+}
+
+func isAppointmentType(typ string) bool {
+	for _, t := range appointmentTypes {
+		if string(t) == typ {
+			return true
+		}
+	}
+	return false
+}
+
+func durationsValidationError(title, detail string) *ValidationError {
+	return &ValidationError{
+		ErrorDetail: api.ErrorDetail{
+			Code:   "invalid.durations",
+			Title:  title,
+			Detail: detail,
+			Status: http.StatusBadRequest,
+		},
+	}
+}
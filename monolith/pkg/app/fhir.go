@@ -0,0 +1,306 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Nesquiko/wac/pkg/data"
+	"github.com/Nesquiko/wac/pkg/fhir"
+)
+
+func (a MonolithApp) FhirPatientById(ctx context.Context, id uuid.UUID) (fhir.Patient, error) {
+	patient, err := a.db.PatientById(ctx, id)
+	if err != nil {
+		return fhir.Patient{}, fmt.Errorf("FhirPatientById: %w", notFoundErr(err))
+	}
+	if patient.DeletedAt != nil {
+		return fhir.Patient{}, fmt.Errorf("FhirPatientById patient was erased: %w", ErrDeleted)
+	}
+
+	return fhir.PatientFromData(patient), nil
+}
+
+func (a MonolithApp) FhirCreatePatient(ctx context.Context, p fhir.Patient) (fhir.Patient, error) {
+	reg, err := fhir.PatientToRegistration(p)
+	if err != nil {
+		return fhir.Patient{}, fmt.Errorf("FhirCreatePatient: %w", err)
+	}
+
+	patient, err := a.CreatePatient(ctx, reg)
+	if err != nil {
+		return fhir.Patient{}, fmt.Errorf("FhirCreatePatient: %w", err)
+	}
+
+	return a.FhirPatientById(ctx, patient.Id)
+}
+
+func (a MonolithApp) FhirPractitionerById(
+	ctx context.Context,
+	id uuid.UUID,
+) (fhir.Practitioner, error) {
+	doctor, err := a.activeDoctor(ctx, id)
+	if err != nil {
+		return fhir.Practitioner{}, fmt.Errorf("FhirPractitionerById: %w", err)
+	}
+
+	return fhir.PractitionerFromData(doctor), nil
+}
+
+func (a MonolithApp) FhirPractitioners(ctx context.Context) ([]fhir.Practitioner, error) {
+	doctors, err := a.db.GetAllDoctors(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("FhirPractitioners: %w", err)
+	}
+
+	return Map(doctors, fhir.PractitionerFromData), nil
+}
+
+func (a MonolithApp) FhirCreatePractitioner(
+	ctx context.Context,
+	p fhir.Practitioner,
+) (fhir.Practitioner, error) {
+	reg, err := fhir.PractitionerToRegistration(p)
+	if err != nil {
+		return fhir.Practitioner{}, fmt.Errorf("FhirCreatePractitioner: %w", err)
+	}
+
+	doctor, err := a.CreateDoctor(ctx, reg)
+	if err != nil {
+		return fhir.Practitioner{}, fmt.Errorf("FhirCreatePractitioner: %w", err)
+	}
+
+	return a.FhirPractitionerById(ctx, doctor.Id)
+}
+
+func (a MonolithApp) FhirConditionById(ctx context.Context, id uuid.UUID) (fhir.Condition, error) {
+	cond, err := a.db.ConditionById(ctx, id)
+	if err != nil {
+		return fhir.Condition{}, fmt.Errorf("FhirConditionById: %w", notFoundErr(err))
+	}
+	if err := a.audit(ctx, AuditActionConditionRead, cond.Id, nil, nil); err != nil {
+		return fhir.Condition{}, fmt.Errorf("FhirConditionById: %w", err)
+	}
+
+	return fhir.ConditionFromData(cond), nil
+}
+
+func (a MonolithApp) FhirConditions(
+	ctx context.Context,
+	patientId uuid.UUID,
+	from time.Time,
+	to *time.Time,
+) ([]fhir.Condition, error) {
+	conds, err := a.db.FindConditionsByPatientId(ctx, patientId, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("FhirConditions: %w", err)
+	}
+
+	return Map(conds, fhir.ConditionFromData), nil
+}
+
+func (a MonolithApp) FhirCreateCondition(
+	ctx context.Context,
+	c fhir.Condition,
+) (fhir.Condition, error) {
+	newCond, err := fhir.ConditionToNewCondition(c)
+	if err != nil {
+		return fhir.Condition{}, fmt.Errorf("FhirCreateCondition: %w", err)
+	}
+
+	cond, err := a.db.CreateCondition(ctx, newCondToDataCond(newCond))
+	if err != nil {
+		return fhir.Condition{}, fmt.Errorf("FhirCreateCondition: %w", notFoundErr(err))
+	}
+
+	return fhir.ConditionFromData(cond), nil
+}
+
+func (a MonolithApp) FhirMedicationRequestById(
+	ctx context.Context,
+	id uuid.UUID,
+) (fhir.MedicationRequest, error) {
+	prescription, err := a.db.PrescriptionById(ctx, id)
+	if err != nil {
+		return fhir.MedicationRequest{}, fmt.Errorf(
+			"FhirMedicationRequestById: %w",
+			notFoundErr(err),
+		)
+	}
+	if err := a.audit(ctx, AuditActionPrescriptionRead, prescription.Id, nil, nil); err != nil {
+		return fhir.MedicationRequest{}, fmt.Errorf("FhirMedicationRequestById: %w", err)
+	}
+
+	return fhir.MedicationRequestFromData(prescription), nil
+}
+
+func (a MonolithApp) FhirMedicationRequests(
+	ctx context.Context,
+	patientId uuid.UUID,
+	from time.Time,
+	to *time.Time,
+) ([]fhir.MedicationRequest, error) {
+	prescriptions, err := a.db.FindPrescriptionsByPatientId(ctx, patientId, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("FhirMedicationRequests: %w", err)
+	}
+
+	return Map(prescriptions, fhir.MedicationRequestFromData), nil
+}
+
+func (a MonolithApp) FhirCreateMedicationRequest(
+	ctx context.Context,
+	m fhir.MedicationRequest,
+) (fhir.MedicationRequest, error) {
+	newPresc, err := fhir.MedicationRequestToNewPrescription(m)
+	if err != nil {
+		return fhir.MedicationRequest{}, fmt.Errorf("FhirCreateMedicationRequest: %w", err)
+	}
+
+	if newPresc.AppointmentId != nil {
+		_, err := a.db.AppointmentById(ctx, *newPresc.AppointmentId)
+		if err != nil {
+			return fhir.MedicationRequest{}, fmt.Errorf(
+				"FhirCreateMedicationRequest find appointment: %w",
+				notFoundErr(err),
+			)
+		}
+	}
+
+	prescription, err := a.db.CreatePrescription(ctx, newPrescToDataPresc(newPresc))
+	if err != nil {
+		return fhir.MedicationRequest{}, fmt.Errorf(
+			"FhirCreateMedicationRequest: %w",
+			notFoundErr(err),
+		)
+	}
+
+	return fhir.MedicationRequestFromData(prescription), nil
+}
+
+func (a MonolithApp) FhirAppointmentById(
+	ctx context.Context,
+	id uuid.UUID,
+) (fhir.Appointment, error) {
+	appt, err := a.db.AppointmentById(ctx, id)
+	if err != nil {
+		return fhir.Appointment{}, fmt.Errorf("FhirAppointmentById: %w", notFoundErr(err))
+	}
+
+	reservations, err := a.db.ReservationsByAppointmentId(ctx, appt.Id)
+	if err != nil {
+		return fhir.Appointment{}, fmt.Errorf("FhirAppointmentById find reservations: %w", err)
+	}
+
+	return fhir.AppointmentFromData(appt, reservations), nil
+}
+
+// FhirAppointments returns appointments of the patient, or of the doctor if
+// patientId is nil.
+func (a MonolithApp) FhirAppointments(
+	ctx context.Context,
+	patientId *uuid.UUID,
+	doctorId *uuid.UUID,
+	from time.Time,
+	to *time.Time,
+) ([]fhir.Appointment, error) {
+	var appts []data.Appointment
+	var err error
+	switch {
+	case patientId != nil:
+		appts, err = a.db.AppointmentsByPatientId(ctx, *patientId, from, to)
+	case doctorId != nil:
+		appts, err = a.db.AppointmentsByDoctorId(ctx, *doctorId, from, to)
+	default:
+		return nil, errors.New("FhirAppointments: either patient or doctor id is required")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("FhirAppointments: %w", err)
+	}
+
+	appointments := make([]fhir.Appointment, len(appts))
+	for i, appt := range appts {
+		reservations, err := a.db.ReservationsByAppointmentId(ctx, appt.Id)
+		if err != nil {
+			return nil, fmt.Errorf("FhirAppointments find reservations: %w", err)
+		}
+		appointments[i] = fhir.AppointmentFromData(appt, reservations)
+	}
+
+	return appointments, nil
+}
+
+func (a MonolithApp) FhirCreateAppointment(
+	ctx context.Context,
+	appt fhir.Appointment,
+) (fhir.Appointment, error) {
+	req, err := fhir.AppointmentToRequest(appt)
+	if err != nil {
+		return fhir.Appointment{}, fmt.Errorf("FhirCreateAppointment: %w", err)
+	}
+
+	doctor, err := a.activeDoctor(ctx, req.DoctorId)
+	if err != nil {
+		return fhir.Appointment{}, fmt.Errorf("FhirCreateAppointment: %w", err)
+	}
+
+	duration := a.appointmentDuration(doctor, req.Type, req.DurationMinutes)
+	absent, err := a.doctorAbsent(
+		ctx,
+		req.DoctorId,
+		req.AppointmentDateTime,
+		req.AppointmentDateTime.Add(duration),
+	)
+	if err != nil {
+		return fhir.Appointment{}, fmt.Errorf("FhirCreateAppointment: %w", err)
+	}
+	if absent {
+		return fhir.Appointment{}, fmt.Errorf("FhirCreateAppointment: %w", ErrDoctorUnavailable)
+	}
+
+	created, err := a.db.CreateAppointment(ctx, newApptToDataAppt(req, duration))
+	if errors.Is(err, data.ErrDoctorUnavailable) {
+		return fhir.Appointment{}, fmt.Errorf("FhirCreateAppointment: %w", ErrDoctorUnavailable)
+	} else if err != nil {
+		return fhir.Appointment{}, fmt.Errorf("FhirCreateAppointment: %w", notFoundErr(err))
+	}
+	appointmentsRequested.Inc()
+
+	return fhir.AppointmentFromData(created, nil), nil
+}
+
+func (a MonolithApp) FhirScheduleById(ctx context.Context, id uuid.UUID) (fhir.Schedule, error) {
+	resource, err := a.db.ResourceById(ctx, id)
+	if err != nil {
+		return fhir.Schedule{}, fmt.Errorf("FhirScheduleById: %w", notFoundErr(err))
+	}
+
+	return fhir.ScheduleFromData(resource), nil
+}
+
+// FhirSlots returns reservations of the resource, whose schedule is
+// identified by scheduleId, as busy slots.
+func (a MonolithApp) FhirSlots(
+	ctx context.Context,
+	scheduleId uuid.UUID,
+	from time.Time,
+	to *time.Time,
+) ([]fhir.Slot, error) {
+	reservations, err := a.db.ReservationsByResourceId(ctx, scheduleId, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("FhirSlots: %w", err)
+	}
+
+	return Map(reservations, fhir.SlotFromData), nil
+}
+
+// notFoundErr translates the storage's not found error to the app's one.
+func notFoundErr(err error) error {
+	if errors.Is(err, data.ErrNotFound) {
+		return ErrNotFound
+	}
+	return err
+}
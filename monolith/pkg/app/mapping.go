@@ -1,6 +1,7 @@
 package app
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -21,11 +22,12 @@ func patientRegToDataPatient(p api.PatientRegistration) data.Patient {
 
 func dataPatientToApiPatient(p data.Patient) api.Patient {
 	return api.Patient{
-		Id:        p.Id,
-		Email:     types.Email(p.Email),
-		FirstName: p.FirstName,
-		LastName:  p.LastName,
-		Role:      api.UserRolePatient,
+		Id:                p.Id,
+		Email:             types.Email(p.Email),
+		FirstName:         p.FirstName,
+		LastName:          p.LastName,
+		Role:              api.UserRolePatient,
+		LateCancellations: &p.LateCancellations,
 	}
 }
 
@@ -79,6 +81,7 @@ func dataCondToCond(c data.Condition, appts []api.AppointmentDisplay) api.Condit
 		AppointmentsIds: asPtr(
 			Map(appts, func(appt api.AppointmentDisplay) uuid.UUID { return appt.Id }),
 		),
+		Version: c.Version,
 	}
 }
 
@@ -106,6 +109,7 @@ func dataPrescToPresc(
 		End:           p.End,
 		DoctorsNote:   p.DoctorsNote,
 		AppointmentId: p.AppointmentId,
+		Version:       p.Version,
 	}
 	if appt != nil {
 		presc.AppointmentId = &appt.Id
@@ -136,12 +140,20 @@ func resourceToMedicine(r data.Resource) api.Medicine {
 	return api.Medicine{Id: r.Id, Name: r.Name}
 }
 
-func newApptToDataAppt(a api.NewAppointmentRequest) data.Appointment {
+func reservationToApiResource(r data.Reservation) api.NewResource {
+	return api.NewResource{
+		Id:   &r.ResourceId,
+		Name: r.ResourceName,
+		Type: api.ResourceType(r.ResourceType),
+	}
+}
+
+func newApptToDataAppt(a api.NewAppointmentRequest, duration time.Duration) data.Appointment {
 	appt := data.Appointment{
 		PatientId:           a.PatientId,
 		DoctorId:            a.DoctorId,
 		AppointmentDateTime: a.AppointmentDateTime,
-		EndTime:             a.AppointmentDateTime.Add(time.Hour),
+		EndTime:             a.AppointmentDateTime.Add(duration),
 		Status:              string(api.Requested),
 		Reason:              a.Reason,
 		ConditionId:         a.ConditionId,
@@ -178,6 +190,7 @@ func dataApptToPatientAppt(
 	appt := api.Appointment{
 		Id:                  a.Id,
 		AppointmentDateTime: a.AppointmentDateTime,
+		EndTime:             &a.EndTime,
 		Doctor:              dataDoctorToApiDoctor(d),
 		Reason:              a.Reason,
 		Status:              api.AppointmentStatus(a.Status),
@@ -186,6 +199,11 @@ func dataApptToPatientAppt(
 		CanceledBy:          (*api.UserRole)(a.CancelledBy),
 		DenialReason:        a.DenialReason,
 		Patient:             dataPatientToApiPatient(p),
+		SeriesId:            a.SeriesId,
+		RescheduleRequired:  &a.RescheduleRequired,
+		RescheduleCount:     &a.RescheduleCount,
+		LateCancellation:    &a.LateCancellation,
+		Version:             a.Version,
 	}
 
 	if c != nil {
@@ -211,6 +229,7 @@ func dataApptToDoctorAppt(
 	doctorAppt := api.Appointment{
 		Id:                  appt.Id,
 		AppointmentDateTime: appt.AppointmentDateTime,
+		EndTime:             &appt.EndTime,
 		CancellationReason:  appt.CancellationReason,
 		CanceledBy:          (*api.UserRole)(appt.CancelledBy),
 		Patient:             dataPatientToApiPatient(patient),
@@ -220,6 +239,11 @@ func dataApptToDoctorAppt(
 		DenialReason:        appt.DenialReason,
 		Condition:           &api.ConditionDisplay{},
 		Doctor:              dataDoctorToApiDoctor(doctor),
+		SeriesId:            appt.SeriesId,
+		RescheduleRequired:  &appt.RescheduleRequired,
+		RescheduleCount:     &appt.RescheduleCount,
+		LateCancellation:    &appt.LateCancellation,
+		Version:             appt.Version,
 	}
 
 	if cond != nil {
@@ -241,6 +265,113 @@ func dataApptToDoctorAppt(
 	return doctorAppt
 }
 
+func dataPatientToPatientRecord(p data.Patient) api.PatientRecord {
+	return api.PatientRecord{
+		Id:          p.Id,
+		Email:       types.Email(p.Email),
+		FirstName:   p.FirstName,
+		LastName:    p.LastName,
+		DeletedAt:   p.DeletedAt,
+		RetainUntil: p.RetainUntil,
+	}
+}
+
+func dataCondToCondRecord(c data.Condition) api.ConditionRecord {
+	return api.ConditionRecord{
+		Id:        c.Id,
+		PatientId: c.PatientId,
+		Name:      c.Name,
+		Start:     c.Start,
+		End:       c.End,
+	}
+}
+
+func dataPrescToPrescRecord(p data.Prescription) api.PrescriptionRecord {
+	return api.PrescriptionRecord{
+		Id:            p.Id,
+		PatientId:     p.PatientId,
+		AppointmentId: p.AppointmentId,
+		Name:          p.Name,
+		Start:         p.Start,
+		End:           p.End,
+		DoctorsNote:   p.DoctorsNote,
+		DeletedAt:     p.DeletedAt,
+	}
+}
+
+func dataApptToApptRecord(a data.Appointment) api.AppointmentRecord {
+	return api.AppointmentRecord{
+		Id:                  a.Id,
+		PatientId:           a.PatientId,
+		DoctorId:            a.DoctorId,
+		AppointmentDateTime: a.AppointmentDateTime,
+		EndTime:             a.EndTime,
+		Type:                api.AppointmentType(a.Type),
+		Status:              api.AppointmentStatus(a.Status),
+		Reason:              a.Reason,
+		ConditionId:         a.ConditionId,
+		CancellationReason:  a.CancellationReason,
+		CancelledBy:         (*api.UserRole)(a.CancelledBy),
+		DenialReason:        a.DenialReason,
+	}
+}
+
+func dataReservationToReservationRecord(r data.Reservation) api.ReservationRecord {
+	return api.ReservationRecord{
+		Id:            r.Id,
+		AppointmentId: r.AppointmentId,
+		ResourceId:    r.ResourceId,
+		ResourceName:  r.ResourceName,
+		ResourceType:  api.ResourceType(r.ResourceType),
+		Start:         r.StartTime,
+		End:           r.EndTime,
+	}
+}
+
+func dataAuditEventToAuditEvent(e data.AuditEvent) (api.AuditEvent, error) {
+	hash, err := e.ComputeHash()
+	if err != nil {
+		return api.AuditEvent{}, err
+	}
+
+	event := api.AuditEvent{
+		Id:        e.Id,
+		Seq:       e.Seq,
+		Actor:     e.Actor,
+		Action:    e.Action,
+		TargetId:  e.TargetId,
+		CreatedAt: e.CreatedAt,
+		PrevHash:  e.PrevHash,
+		Hash:      e.Hash,
+		HashValid: hash == e.Hash,
+	}
+	if e.RequestId != "" {
+		event.RequestId = &e.RequestId
+	}
+	if len(e.Diff) != 0 {
+		diff := make(map[string]api.AuditChange, len(e.Diff))
+		for field, change := range e.Diff {
+			var apiChange api.AuditChange
+			if change.Before != nil {
+				apiChange.Before = new(any)
+				if err := json.Unmarshal(change.Before, apiChange.Before); err != nil {
+					return api.AuditEvent{}, err
+				}
+			}
+			if change.After != nil {
+				apiChange.After = new(any)
+				if err := json.Unmarshal(change.After, apiChange.After); err != nil {
+					return api.AuditEvent{}, err
+				}
+			}
+			diff[field] = apiChange
+		}
+		event.Diff = &diff
+	}
+
+	return event, nil
+}
+
 func asPtr[T any](v T) *T {
 	return &v
 }
@@ -252,3 +383,14 @@ func Map[T, V any](ts []T, fn func(T) V) []V {
 	}
 	return result
 }
+
+func dataAbsenceToApiAbsence(a data.Absence) api.Absence {
+	return api.Absence{
+		Id:       a.Id,
+		DoctorId: a.DoctorId,
+		Type:     api.AbsenceType(a.Type),
+		Start:    a.Start,
+		End:      a.End,
+		Note:     a.Note,
+	}
+}
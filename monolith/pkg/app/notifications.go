@@ -0,0 +1,145 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/Nesquiko/wac/pkg/api"
+	"github.com/Nesquiko/wac/pkg/data"
+	"github.com/Nesquiko/wac/pkg/notify"
+)
+
+// notify queues notifications of the appointment's transition for the given
+// participants, they are delivered by a notify.Dispatcher. Like auditing,
+// failing to notify doesn't fail the already performed transition, it is
+// only logged.
+func (a MonolithApp) notify(
+	ctx context.Context,
+	kind notify.Kind,
+	appt data.Appointment,
+	reason *string,
+	recipients ...api.UserRole,
+) {
+	for _, recipient := range recipients {
+		err := a.queueNotification(ctx, kind, appt, reason, recipient)
+		if err != nil {
+			slog.ErrorContext(
+				ctx,
+				"failed to queue notification",
+				"error", err.Error(),
+				"kind", kind,
+				"appointmentId", appt.Id.String(),
+				"recipient", recipient,
+			)
+		}
+	}
+}
+
+func (a MonolithApp) queueNotification(
+	ctx context.Context,
+	kind notify.Kind,
+	appt data.Appointment,
+	reason *string,
+	recipient api.UserRole,
+) error {
+	doctor, err := a.db.DoctorById(ctx, appt.DoctorId)
+	if err != nil {
+		return fmt.Errorf("queueNotification doc find: %w", err)
+	}
+	patient, err := a.db.PatientById(ctx, appt.PatientId)
+	if err != nil {
+		return fmt.Errorf("queueNotification patient find: %w", err)
+	}
+	doctorName := fmt.Sprintf("Dr. %s %s", doctor.FirstName, doctor.LastName)
+	patientName := fmt.Sprintf("%s %s", patient.FirstName, patient.LastName)
+
+	event := notify.Event{Kind: kind, Start: appt.AppointmentDateTime, Reason: reason}
+	switch recipient {
+	case api.UserRoleDoctor:
+		// erased doctors can't be contacted anymore
+		if doctor.DeletedAt != nil {
+			return nil
+		}
+		event.RecipientName, event.RecipientAddress, event.With = doctorName, doctor.Email, patientName
+	case api.UserRolePatient:
+		// erased patients can't be contacted anymore
+		if patient.DeletedAt != nil {
+			return nil
+		}
+		event.RecipientName, event.RecipientAddress, event.With = patientName, patient.Email, doctorName
+	default:
+		return fmt.Errorf("queueNotification unknown recipient %q", recipient)
+	}
+
+	if err := a.queueEvent(ctx, appt.Id, event); err != nil {
+		return fmt.Errorf("queueNotification: %w", err)
+	}
+	return nil
+}
+
+// queueWaitlistOffer lets the entry's patient know about the slot held for
+// them. There is no appointment yet, so the notification refers to the
+// offer.
+func (a MonolithApp) queueWaitlistOffer(
+	ctx context.Context,
+	entry data.WaitlistEntry,
+	offer data.WaitlistOffer,
+) error {
+	doctor, err := a.db.DoctorById(ctx, entry.DoctorId)
+	if err != nil {
+		return fmt.Errorf("queueWaitlistOffer doc find: %w", err)
+	}
+	patient, err := a.db.PatientById(ctx, entry.PatientId)
+	if err != nil {
+		return fmt.Errorf("queueWaitlistOffer patient find: %w", err)
+	}
+	// erased patients can't be contacted anymore
+	if patient.DeletedAt != nil {
+		return nil
+	}
+
+	event := notify.Event{
+		Kind:             notify.KindWaitlistOffer,
+		RecipientName:    fmt.Sprintf("%s %s", patient.FirstName, patient.LastName),
+		RecipientAddress: patient.Email,
+		With:             fmt.Sprintf("Dr. %s %s", doctor.FirstName, doctor.LastName),
+		Start:            offer.AppointmentDateTime,
+		ExpiresAt:        offer.ExpiresAt,
+	}
+	if err := a.queueEvent(ctx, offer.Id, event); err != nil {
+		return fmt.Errorf("queueWaitlistOffer: %w", err)
+	}
+	return nil
+}
+
+func (a MonolithApp) queueEvent(
+	ctx context.Context,
+	appointmentId uuid.UUID,
+	event notify.Event,
+) error {
+	msg, err := notify.Render(event)
+	if err != nil {
+		return err
+	}
+
+	_, err = a.db.CreateNotification(ctx, data.Notification{
+		AppointmentId: appointmentId,
+		Kind:          string(event.Kind),
+		Recipient:     msg.To,
+		Subject:       msg.Subject,
+		Body:          msg.Body,
+	})
+	return err
+}
+
+// otherParticipant returns the participant of an appointment which isn't
+// role.
+func otherParticipant(role api.UserRole) api.UserRole {
+	if role == api.UserRoleDoctor {
+		return api.UserRolePatient
+	}
+	return api.UserRoleDoctor
+}
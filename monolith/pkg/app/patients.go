@@ -36,6 +36,9 @@ func (a MonolithApp) PatientById(ctx context.Context, id uuid.UUID) (api.Patient
 		}
 		return api.Patient{}, fmt.Errorf("PatientById: %w", err)
 	}
+	if patient.DeletedAt != nil {
+		return api.Patient{}, fmt.Errorf("PatientById patient was erased: %w", ErrNotFound)
+	}
 
 	return dataPatientToApiPatient(patient), nil
 }
@@ -52,6 +55,142 @@ func (a MonolithApp) PatientByEmail(ctx context.Context, email string) (api.Pati
 	return dataPatientToApiPatient(patient), nil
 }
 
+// RecordsRetentionYears is for how long are clinical records of an erased
+// patient retained.
+const RecordsRetentionYears = 10
+
+// ErasePatient anonymizes personal data of the patient. Their future
+// appointments are cancelled and they leave all waitlists. Conditions,
+// prescriptions and past appointments of the patient are retained for
+// RecordsRetentionYears.
+func (a MonolithApp) ErasePatient(ctx context.Context, id uuid.UUID) error {
+	if _, err := a.PatientById(ctx, id); err != nil {
+		return fmt.Errorf("ErasePatient: %w", err)
+	}
+
+	// left first, so that the cancelled slots aren't offered back to them
+	entries, err := a.db.WaitlistEntriesByPatientId(ctx, id)
+	if err != nil {
+		return fmt.Errorf("ErasePatient waitlist: %w", err)
+	}
+	for _, entry := range entries {
+		err := a.LeaveWaitlist(ctx, entry.Id)
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			return fmt.Errorf("ErasePatient: %w", err)
+		}
+	}
+
+	appts, err := a.db.AppointmentsByPatientId(ctx, id, time.Now(), nil)
+	if err != nil {
+		return fmt.Errorf("ErasePatient appointments: %w", err)
+	}
+	if err := a.cancelErasedAppointments(ctx, appts, api.UserRolePatient); err != nil {
+		return fmt.Errorf("ErasePatient: %w", err)
+	}
+
+	err = a.db.DeleteCalendarFeed(ctx, id)
+	if err != nil && !errors.Is(err, data.ErrNotFound) {
+		return fmt.Errorf("ErasePatient calendar feed: %w", err)
+	}
+
+	retainUntil := time.Now().AddDate(RecordsRetentionYears, 0, 0)
+	_, err = a.db.AnonymizePatient(ctx, id, retainUntil)
+	if err != nil {
+		if errors.Is(err, data.ErrNotFound) {
+			return fmt.Errorf("ErasePatient: %w", ErrNotFound)
+		}
+		return fmt.Errorf("ErasePatient: %w", err)
+	}
+	// no diff, the erased personal data must not survive in the audit log
+	if err := a.audit(ctx, AuditActionPatientErase, id, nil, nil); err != nil {
+		return fmt.Errorf("ErasePatient: %w", err)
+	}
+	return nil
+}
+
+// erasureCancellationReason is given to the other participant of an
+// appointment cancelled by an erasure.
+const erasureCancellationReason = "Personal data of the participant were erased"
+
+// cancelErasedAppointments cancels the requested and scheduled appointments of
+// a participant being erased through the regular cancel path, so the other
+// participant is notified, reminders are cancelled and the freed slots are
+// offered to the waitlist.
+func (a MonolithApp) cancelErasedAppointments(
+	ctx context.Context,
+	appts []data.Appointment,
+	by api.UserRole,
+) error {
+	for _, appt := range appts {
+		status := api.AppointmentStatus(appt.Status)
+		if status != api.Requested && status != api.Scheduled {
+			continue
+		}
+
+		err := a.CancelAppointment(ctx, appt.Id, api.AppointmentCancellation{
+			By:             by,
+			Reason:         asPtr(erasureCancellationReason),
+			OverridePolicy: asPtr(true),
+		})
+		if err != nil {
+			return fmt.Errorf("cancelErasedAppointments %s: %w", appt.Id, err)
+		}
+	}
+
+	return nil
+}
+
+// ExportPatientData collects everything stored about the patient, including
+// soft deleted records.
+func (a MonolithApp) ExportPatientData(
+	ctx context.Context,
+	id uuid.UUID,
+) (api.PatientDataExport, error) {
+	patient, err := a.db.PatientById(ctx, id)
+	if err != nil {
+		if errors.Is(err, data.ErrNotFound) {
+			return api.PatientDataExport{}, fmt.Errorf("ExportPatientData: %w", ErrNotFound)
+		}
+		return api.PatientDataExport{}, fmt.Errorf("ExportPatientData patient: %w", err)
+	}
+
+	conditions, err := a.db.AllConditionsByPatientId(ctx, id)
+	if err != nil {
+		return api.PatientDataExport{}, fmt.Errorf("ExportPatientData conditions: %w", err)
+	}
+
+	prescriptions, err := a.db.AllPrescriptionsByPatientId(ctx, id)
+	if err != nil {
+		return api.PatientDataExport{}, fmt.Errorf("ExportPatientData prescriptions: %w", err)
+	}
+
+	appts, err := a.db.AllAppointmentsByPatientId(ctx, id)
+	if err != nil {
+		return api.PatientDataExport{}, fmt.Errorf("ExportPatientData appointments: %w", err)
+	}
+
+	apptIds := make([]uuid.UUID, len(appts))
+	for i, appt := range appts {
+		apptIds[i] = appt.Id
+	}
+	reservations, err := a.db.ReservationsByAppointmentIds(ctx, apptIds)
+	if err != nil {
+		return api.PatientDataExport{}, fmt.Errorf("ExportPatientData reservations: %w", err)
+	}
+
+	if err := a.audit(ctx, AuditActionPatientExport, id, nil, nil); err != nil {
+		return api.PatientDataExport{}, fmt.Errorf("ExportPatientData: %w", err)
+	}
+	return api.PatientDataExport{
+		ExportedAt:    time.Now(),
+		Patient:       dataPatientToPatientRecord(patient),
+		Conditions:    Map(conditions, dataCondToCondRecord),
+		Prescriptions: Map(prescriptions, dataPrescToPrescRecord),
+		Appointments:  Map(appts, dataApptToApptRecord),
+		Reservations:  Map(reservations, dataReservationToReservationRecord),
+	}, nil
+}
+
 func (a MonolithApp) PatientsCalendar(
 	ctx context.Context,
 	patientId uuid.UUID,
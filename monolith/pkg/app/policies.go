@@ -0,0 +1,129 @@
+package app
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Nesquiko/wac/pkg/api"
+	"github.com/Nesquiko/wac/pkg/data"
+)
+
+// Policy limits how late and how often appointments can be cancelled or
+// rescheduled. Administrators may override it, the caller must be authorized
+// to override before the app is asked to.
+type Policy struct {
+	// CancelLeadTime is how long before the appointment it can be cancelled
+	// at the latest.
+	CancelLeadTime time.Duration
+	// LateCancellationWindow is how long before the appointment a patient's
+	// cancellation is flagged as late.
+	LateCancellationWindow time.Duration
+	// RescheduleLeadTime is how long before both the current and the new
+	// time an appointment can be rescheduled at the latest.
+	RescheduleLeadTime time.Duration
+	// MaxReschedules is how many times an appointment can be rescheduled,
+	// zero means unlimited.
+	MaxReschedules int
+}
+
+const (
+	PolicyCodeCancelLeadTime     = "policy.cancel.lead_time"
+	PolicyCodeRescheduleLeadTime = "policy.reschedule.lead_time"
+	PolicyCodeRescheduleLimit    = "policy.reschedule.limit"
+	PolicyCodeOverrideForbidden  = "policy.override.forbidden"
+)
+
+// PolicyError is returned when a change of an appointment violates the
+// policy, its code names the violated rule.
+type PolicyError struct {
+	api.ErrorDetail
+}
+
+func (e *PolicyError) Error() string {
+	return fmt.Sprintf("policy %q violated, detail %q", e.Code, e.Detail)
+}
+
+// checkCancellation returns whether the cancellation of the appointment by
+// the user is late, or a PolicyError if the policy doesn't allow it.
+func (p Policy) checkCancellation(
+	appt data.Appointment,
+	by api.UserRole,
+	override bool,
+	now time.Time,
+) (bool, error) {
+	if override {
+		return false, nil
+	}
+
+	lead := appt.AppointmentDateTime.Sub(now)
+	if lead < p.CancelLeadTime {
+		return false, policyError(
+			PolicyCodeCancelLeadTime,
+			"Too late to cancel",
+			fmt.Sprintf(
+				"Appointments can be cancelled at the latest %s before they start",
+				p.CancelLeadTime,
+			),
+		)
+	}
+
+	return by == api.UserRolePatient && lead < p.LateCancellationWindow, nil
+}
+
+// checkReschedule returns a PolicyError if the policy doesn't allow the
+// appointment to be rescheduled to newDateTime.
+func (p Policy) checkReschedule(
+	appt data.Appointment,
+	newDateTime time.Time,
+	override bool,
+	now time.Time,
+) error {
+	if override {
+		return nil
+	}
+
+	if appt.AppointmentDateTime.Sub(now) < p.RescheduleLeadTime {
+		return policyError(
+			PolicyCodeRescheduleLeadTime,
+			"Too late to reschedule",
+			fmt.Sprintf(
+				"Appointments can be rescheduled at the latest %s before they start",
+				p.RescheduleLeadTime,
+			),
+		)
+	}
+	if newDateTime.Sub(now) < p.RescheduleLeadTime {
+		return policyError(
+			PolicyCodeRescheduleLeadTime,
+			"New time too soon",
+			fmt.Sprintf(
+				"Appointments can be rescheduled only to at least %s from now",
+				p.RescheduleLeadTime,
+			),
+		)
+	}
+	if p.MaxReschedules > 0 && appt.RescheduleCount >= p.MaxReschedules {
+		return policyError(
+			PolicyCodeRescheduleLimit,
+			"Reschedule limit reached",
+			fmt.Sprintf(
+				"Appointments can be rescheduled at most %d times",
+				p.MaxReschedules,
+			),
+		)
+	}
+
+	return nil
+}
+
+func policyError(code, title, detail string) *PolicyError {
+	return &PolicyError{
+		ErrorDetail: api.ErrorDetail{
+			Code:   code,
+			Title:  title,
+			Detail: detail,
+			Status: http.StatusConflict,
+		},
+	}
+}
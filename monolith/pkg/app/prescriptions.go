@@ -46,9 +46,12 @@ func (a MonolithApp) CreatePatientPrescription(
 	return dataPrescToPresc(prescription, appt, patient, doctor), nil
 }
 
+// UpdatePatientPrescription updates the prescription if it is still at one of
+// versions, otherwise it fails with ErrVersionMismatch.
 func (a MonolithApp) UpdatePatientPrescription(
 	ctx context.Context,
 	prescriptionId uuid.UUID,
+	versions []int64,
 	updateData api.UpdatePrescription,
 ) (api.Prescription, error) {
 	existingPrescription, err := a.db.PrescriptionById(ctx, prescriptionId)
@@ -58,6 +61,11 @@ func (a MonolithApp) UpdatePatientPrescription(
 		}
 		return api.Prescription{}, fmt.Errorf("UpdatePatientPrescription fetch failed: %w", err)
 	}
+	_, err = matchVersion(existingPrescription.Version, versions)
+	if err != nil {
+		return api.Prescription{}, fmt.Errorf("UpdatePatientPrescription: %w", err)
+	}
+	before := existingPrescription
 
 	updated := false
 	if updateData.AppointmentId != nil {
@@ -113,11 +121,27 @@ func (a MonolithApp) UpdatePatientPrescription(
 					ErrNotFound,
 				)
 			}
+			if errors.Is(err, data.ErrVersionMismatch) {
+				return api.Prescription{}, fmt.Errorf(
+					"UpdatePatientPrescription: %w",
+					ErrVersionMismatch,
+				)
+			}
 			return api.Prescription{}, fmt.Errorf(
 				"UpdatePatientPrescription update failed: %w",
 				err,
 			)
 		}
+		err = a.audit(
+			ctx,
+			AuditActionPrescriptionUpdate,
+			prescriptionId,
+			before,
+			updatedDbPrescription,
+		)
+		if err != nil {
+			return api.Prescription{}, fmt.Errorf("UpdatePatientPrescription: %w", err)
+		}
 	} else {
 		updatedDbPrescription = existingPrescription
 	}
@@ -223,11 +247,19 @@ func (a MonolithApp) PrescriptionById(
 		doctorData = &doctor
 	}
 
+	if err := a.audit(ctx, AuditActionPrescriptionRead, prescriptionId, nil, nil); err != nil {
+		return api.Prescription{}, fmt.Errorf("PrescriptionById: %w", err)
+	}
 	return dataPrescToPresc(prescription, apptData, &patient, doctorData), nil
 }
 
 func (a MonolithApp) DeletePrescription(ctx context.Context, id uuid.UUID) error {
-	err := a.db.DeletePrescription(ctx, id)
+	before, err := a.db.PrescriptionById(ctx, id)
+	if err != nil && !errors.Is(err, data.ErrNotFound) {
+		return fmt.Errorf("DeletePrescription fetch failed: %w", err)
+	}
+
+	err = a.db.DeletePrescription(ctx, id)
 	if err != nil {
 		if errors.Is(err, data.ErrNotFound) {
 			return fmt.Errorf(
@@ -239,6 +271,9 @@ func (a MonolithApp) DeletePrescription(ctx context.Context, id uuid.UUID) error
 		return fmt.Errorf("DeletePrescription failed: %w", err)
 	}
 
+	if err := a.audit(ctx, AuditActionPrescriptionDelete, id, before, nil); err != nil {
+		return fmt.Errorf("DeletePrescription: %w", err)
+	}
 	return nil
 }
 
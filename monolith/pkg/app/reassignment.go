@@ -0,0 +1,247 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Nesquiko/wac/pkg/api"
+	"github.com/Nesquiko/wac/pkg/data"
+	"github.com/Nesquiko/wac/pkg/notify"
+)
+
+const (
+	conflictNoSubstitute    = "No substitute doctor is available at the appointment's time"
+	conflictReassignChanged = "The appointment was changed during the reassignment"
+)
+
+// ReassignAppointment moves the appointment to a substitute doctor with the
+// same specialization. Without a new time the appointment keeps its status
+// and reservations, otherwise it awaits the substitute's decision again.
+func (a MonolithApp) ReassignAppointment(
+	ctx context.Context,
+	appointmentId uuid.UUID,
+	req api.AppointmentReassignment,
+) (api.Appointment, error) {
+	appt, err := a.db.AppointmentById(ctx, appointmentId)
+	if err != nil {
+		return api.Appointment{}, fmt.Errorf("ReassignAppointment: %w", notFoundErr(err))
+	}
+	doctor, err := a.db.DoctorById(ctx, appt.DoctorId)
+	if err != nil {
+		return api.Appointment{}, fmt.Errorf("ReassignAppointment: %w", err)
+	}
+	substitute, err := a.activeDoctor(ctx, req.DoctorId)
+	if err != nil {
+		return api.Appointment{}, fmt.Errorf("ReassignAppointment: %w", err)
+	}
+	if err := validateSubstitute(doctor, substitute); err != nil {
+		return api.Appointment{}, err
+	}
+
+	newDateTime := appt.AppointmentDateTime
+	if req.NewAppointmentDateTime != nil {
+		newDateTime = *req.NewAppointmentDateTime
+	}
+	if err := a.reassignAppointment(ctx, appt, substitute.Id, newDateTime, req.Reason); err != nil {
+		return api.Appointment{}, fmt.Errorf("ReassignAppointment: %w", err)
+	}
+
+	return a.AppointmentById(ctx, appointmentId)
+}
+
+// ReassignDoctorAppointments reassigns the doctor's requested and scheduled
+// appointments starting in the range, keeping their time. Appointments for
+// which no substitute is available stay with the doctor and are reported
+// with their conflict.
+func (a MonolithApp) ReassignDoctorAppointments(
+	ctx context.Context,
+	doctorId uuid.UUID,
+	req api.DoctorReassignment,
+) (api.ReassignmentReport, error) {
+	from, to, err := searchWindow(time.Now(), &req.From, &req.To, reassignmentValidationError)
+	if err != nil {
+		return api.ReassignmentReport{}, err
+	}
+
+	doctor, err := a.db.DoctorById(ctx, doctorId)
+	if err != nil {
+		return api.ReassignmentReport{}, fmt.Errorf(
+			"ReassignDoctorAppointments: %w",
+			notFoundErr(err),
+		)
+	}
+	substitutes, err := a.substitutes(ctx, doctor, req.SubstituteDoctorId)
+	if err != nil {
+		return api.ReassignmentReport{}, fmt.Errorf("ReassignDoctorAppointments: %w", err)
+	}
+
+	appts, err := a.db.AppointmentsByDoctorId(ctx, doctorId, from, &to)
+	if err != nil {
+		return api.ReassignmentReport{}, fmt.Errorf("ReassignDoctorAppointments: %w", err)
+	}
+
+	report := api.ReassignmentReport{
+		Reassigned: []api.ReassignmentOutcome{},
+		Conflicts:  []api.ReassignmentOutcome{},
+	}
+	for _, appt := range appts {
+		if !appt.AppointmentDateTime.Before(to) || !appointmentReassignable(appt) {
+			continue
+		}
+
+		outcome := api.ReassignmentOutcome{
+			AppointmentId:       appt.Id,
+			AppointmentDateTime: appt.AppointmentDateTime,
+		}
+		conflict := conflictNoSubstitute
+		for _, substitute := range substitutes {
+			err := a.reassignAppointment(ctx, appt, substitute.Id, appt.AppointmentDateTime, req.Reason)
+			if errors.Is(err, ErrDoctorUnavailable) {
+				continue
+			} else if errors.Is(err, ErrVersionMismatch) {
+				conflict = conflictReassignChanged
+				break
+			} else if err != nil {
+				return api.ReassignmentReport{}, fmt.Errorf("ReassignDoctorAppointments: %w", err)
+			}
+			outcome.DoctorId = &substitute.Id
+			break
+		}
+
+		if outcome.DoctorId != nil {
+			report.Reassigned = append(report.Reassigned, outcome)
+		} else {
+			outcome.Conflict = asPtr(conflict)
+			report.Conflicts = append(report.Conflicts, outcome)
+		}
+	}
+
+	return report, nil
+}
+
+// reassignAppointment moves the appointment to the substitute at newDateTime,
+// records the change and notifies the patient and the substitute.
+// ErrDoctorUnavailable is returned if the substitute is booked or absent, and
+// ErrVersionMismatch if the appointment was changed since before was read.
+func (a MonolithApp) reassignAppointment(
+	ctx context.Context,
+	before data.Appointment,
+	substituteId uuid.UUID,
+	newDateTime time.Time,
+	reason *string,
+) error {
+	newEndTime := newDateTime.Add(before.EndTime.Sub(before.AppointmentDateTime))
+	absent, err := a.doctorAbsent(ctx, substituteId, newDateTime, newEndTime)
+	if err != nil {
+		return fmt.Errorf("reassignAppointment: %w", err)
+	}
+	if absent {
+		return fmt.Errorf("reassignAppointment substitute absent: %w", ErrDoctorUnavailable)
+	}
+
+	after, err := a.db.ReassignAppointment(ctx, before.Id, substituteId, newDateTime)
+	if err != nil {
+		if errors.Is(err, data.ErrDoctorUnavailable) {
+			return fmt.Errorf("reassignAppointment: %w", ErrDoctorUnavailable)
+		}
+		if errors.Is(err, data.ErrVersionMismatch) {
+			return fmt.Errorf("reassignAppointment: %w", ErrVersionMismatch)
+		}
+		return fmt.Errorf("reassignAppointment: %w", notFoundErr(err))
+	}
+
+	a.notify(
+		ctx,
+		notify.KindAppointmentReassigned,
+		after,
+		reason,
+		api.UserRolePatient,
+		api.UserRoleDoctor,
+	)
+	if !after.AppointmentDateTime.Equal(before.AppointmentDateTime) {
+		a.scheduleReminders(ctx, after)
+	}
+	a.offerFreedSlot(ctx, before)
+
+	if err := a.audit(ctx, AuditActionAppointmentReassign, after.Id, before, after); err != nil {
+		return fmt.Errorf("reassignAppointment: %w", err)
+	}
+	return nil
+}
+
+// substitutes returns the doctors to which appointments of the doctor can be
+// reassigned, either only the requested substitute, or every other doctor
+// with the same specialization ordered by name.
+func (a MonolithApp) substitutes(
+	ctx context.Context,
+	doctor data.Doctor,
+	substituteId *uuid.UUID,
+) ([]data.Doctor, error) {
+	if substituteId != nil {
+		substitute, err := a.activeDoctor(ctx, *substituteId)
+		if err != nil {
+			return nil, fmt.Errorf("substitutes: %w", err)
+		}
+		if err := validateSubstitute(doctor, substitute); err != nil {
+			return nil, err
+		}
+		return []data.Doctor{substitute}, nil
+	}
+
+	doctors, err := a.db.GetAllDoctors(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("substitutes: %w", err)
+	}
+
+	substitutes := make([]data.Doctor, 0, len(doctors))
+	for _, d := range doctors {
+		if d.Id != doctor.Id && d.Specialization == doctor.Specialization {
+			substitutes = append(substitutes, d)
+		}
+	}
+	return substitutes, nil
+}
+
+func appointmentReassignable(appt data.Appointment) bool {
+	switch api.AppointmentStatus(appt.Status) {
+	case api.Requested, api.Scheduled:
+		return true
+	}
+	return false
+}
+
+func validateSubstitute(doctor, substitute data.Doctor) error {
+	if substitute.Id == doctor.Id {
+		return reassignmentValidationError(
+			"Same doctor",
+			"The substitute must be a different doctor",
+		)
+	}
+	if substitute.Specialization != doctor.Specialization {
+		return reassignmentValidationError(
+			"Different specialization",
+			fmt.Sprintf(
+				"The substitute's specialization %q doesn't match %q",
+				substitute.Specialization,
+				doctor.Specialization,
+			),
+		)
+	}
+	return nil
+}
+
+func reassignmentValidationError(title, detail string) *ValidationError {
+	return &ValidationError{
+		ErrorDetail: api.ErrorDetail{
+			Code:   "invalid.reassignment",
+			Title:  title,
+			Detail: detail,
+			Status: http.StatusBadRequest,
+		},
+	}
+}
@@ -0,0 +1,141 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Nesquiko/wac/pkg/api"
+	"github.com/Nesquiko/wac/pkg/data"
+	"github.com/Nesquiko/wac/pkg/notify"
+)
+
+// how many due reminders are queued in one round
+const reminderBatchSize = 50
+
+// scheduleReminders replaces reminders of the appointment with ones at the
+// configured offsets before its new time. Reminders which would already be
+// due are skipped. Like notifications, failures are only logged.
+func (a MonolithApp) scheduleReminders(ctx context.Context, appt data.Appointment) {
+	now := time.Now()
+	jobs := make([]data.ReminderJob, 0, len(a.opts.ReminderOffsets))
+	for _, offset := range a.opts.ReminderOffsets {
+		dueAt := appt.AppointmentDateTime.Add(-offset)
+		if dueAt.After(now) {
+			jobs = append(jobs, data.ReminderJob{Offset: offset, DueAt: dueAt})
+		}
+	}
+
+	if err := a.db.ScheduleReminders(ctx, appt.Id, jobs); err != nil {
+		slog.ErrorContext(
+			ctx,
+			"failed to schedule reminders",
+			"error", err.Error(),
+			"appointmentId", appt.Id.String(),
+		)
+	}
+}
+
+func (a MonolithApp) cancelReminders(ctx context.Context, appointmentId uuid.UUID) {
+	if err := a.db.CancelReminders(ctx, appointmentId); err != nil {
+		slog.ErrorContext(
+			ctx,
+			"failed to cancel reminders",
+			"error", err.Error(),
+			"appointmentId", appointmentId.String(),
+		)
+	}
+}
+
+// ReminderScheduler periodically queues notifications of due reminders.
+// Each reminder is leased to one scheduler while it is being queued, so
+// several replicas can run at once. If a scheduler dies while holding a
+// lease, the reminder is queued by another one after the lease expires.
+type ReminderScheduler struct {
+	app      MonolithApp
+	owner    string
+	interval time.Duration
+	lease    time.Duration
+}
+
+func NewReminderScheduler(app MonolithApp, interval, lease time.Duration) ReminderScheduler {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+	return ReminderScheduler{
+		app:      app,
+		owner:    fmt.Sprintf("%s-%s", host, uuid.NewString()),
+		interval: interval,
+		lease:    lease,
+	}
+}
+
+// Run queues reminders until ctx is done.
+func (s ReminderScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.remindDue(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s ReminderScheduler) remindDue(ctx context.Context) {
+	for range reminderBatchSize {
+		job, err := s.app.db.ClaimDueReminder(ctx, time.Now(), s.owner, s.lease)
+		if errors.Is(err, data.ErrNotFound) {
+			return
+		} else if err != nil {
+			slog.ErrorContext(ctx, "failed to claim due reminder", "error", err.Error())
+			return
+		}
+
+		if err := s.remind(ctx, job); err != nil {
+			// the reminder is retried once its lease expires
+			slog.ErrorContext(
+				ctx,
+				"failed to queue reminder",
+				"error", err.Error(),
+				"appointmentId", job.AppointmentId.String(),
+			)
+			continue
+		}
+
+		if err := s.app.db.CompleteReminder(ctx, job.Id, s.owner); err != nil {
+			slog.ErrorContext(ctx, "failed to complete reminder", "error", err.Error())
+		}
+	}
+}
+
+func (s ReminderScheduler) remind(ctx context.Context, job data.ReminderJob) error {
+	appt, err := s.app.db.AppointmentById(ctx, job.AppointmentId)
+	if errors.Is(err, data.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("remind: %w", err)
+	}
+
+	switch api.AppointmentStatus(appt.Status) {
+	case api.Cancelled, api.Denied:
+		return nil
+	}
+
+	return s.app.queueNotification(
+		ctx,
+		notify.KindAppointmentReminder,
+		appt,
+		nil,
+		api.UserRolePatient,
+	)
+}
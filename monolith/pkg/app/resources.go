@@ -28,11 +28,19 @@ func (a MonolithApp) CreateResource(
 	}, nil
 }
 
+// AvailableResources returns resources free for the whole slot starting at
+// dateTime, which lasts durationMinutes, or an hour if not given.
 func (a MonolithApp) AvailableResources(
 	ctx context.Context,
 	dateTime time.Time,
+	durationMinutes *int,
 ) (api.AvailableResources, error) {
-	resources, err := a.db.FindAvailableResourcesAtTime(ctx, dateTime)
+	duration := defaultAppointmentDuration
+	if durationMinutes != nil {
+		duration = time.Duration(*durationMinutes) * time.Minute
+	}
+
+	resources, err := a.db.FindAvailableResourcesAtTime(ctx, dateTime, dateTime.Add(duration))
 	if err != nil {
 		return api.AvailableResources{}, fmt.Errorf("AvailableResources: %w", err)
 	}
@@ -114,6 +122,7 @@ func (a MonolithApp) ReserveAppointmentResources(
 		)
 		if err != nil {
 			if errors.Is(err, data.ErrResourceUnavailable) {
+				reservationConflicts.Inc()
 				return fmt.Errorf(
 					"ReserveAppointmentResources: equipment %s %w",
 					resourceId,
@@ -162,6 +171,7 @@ func (a MonolithApp) ReserveAppointmentResources(
 		)
 		if err != nil {
 			if errors.Is(err, data.ErrResourceUnavailable) {
+				reservationConflicts.Inc()
 				return fmt.Errorf(
 					"ReserveAppointmentResources: facility %s %w",
 					resourceId,
@@ -210,6 +220,7 @@ func (a MonolithApp) ReserveAppointmentResources(
 		)
 		if err != nil {
 			if errors.Is(err, data.ErrResourceUnavailable) {
+				reservationConflicts.Inc()
 				return fmt.Errorf(
 					"ReserveAppointmentResources: medicine %s %w",
 					resourceId,
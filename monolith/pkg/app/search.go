@@ -0,0 +1,152 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/Nesquiko/wac/pkg/api"
+	"github.com/Nesquiko/wac/pkg/data"
+)
+
+const (
+	// firstSlotHour and lastSlotHour bound the hourly slots in which doctors
+	// take appointments.
+	firstSlotHour = 8
+	lastSlotHour  = 14
+
+	searchWindowDefault = 14 * 24 * time.Hour
+	searchWindowMax     = 31 * 24 * time.Hour
+)
+
+// SearchDoctors returns doctors with the specialization ranked by their
+// earliest free slot in the window, the slot is as long as an appointment of
+// the type with the doctor. Fully booked doctors are ranked last.
+func (a MonolithApp) SearchDoctors(
+	ctx context.Context,
+	params api.SearchDoctorsParams,
+) (api.DoctorSearchResults, error) {
+	now := time.Now()
+	from, to, err := searchWindow(now, params.From, params.To, searchValidationError)
+	if err != nil {
+		return api.DoctorSearchResults{}, err
+	}
+
+	var specialization *string
+	if params.Specialization != nil {
+		specialization = asPtr(string(*params.Specialization))
+	}
+	schedules, err := a.db.SearchDoctors(ctx, specialization, from, to)
+	if err != nil {
+		return api.DoctorSearchResults{}, fmt.Errorf("SearchDoctors: %w", err)
+	}
+
+	if from.Before(now) {
+		from = now
+	}
+	results := make([]api.DoctorSearchResult, len(schedules))
+	for i, schedule := range schedules {
+		duration := a.appointmentDuration(schedule.Doctor, params.Type, nil)
+		results[i] = api.DoctorSearchResult{
+			Doctor:       dataDoctorToApiDoctor(schedule.Doctor),
+			NextFreeSlot: nextFreeSlot(schedule.Busy, from, to, duration),
+		}
+	}
+
+	slices.SortStableFunc(results, func(x, y api.DoctorSearchResult) int {
+		switch {
+		case x.NextFreeSlot == nil && y.NextFreeSlot == nil:
+			return 0
+		case x.NextFreeSlot == nil:
+			return 1
+		case y.NextFreeSlot == nil:
+			return -1
+		}
+		return x.NextFreeSlot.Compare(*y.NextFreeSlot)
+	})
+
+	return api.DoctorSearchResults{Doctors: results}, nil
+}
+
+// searchWindow resolves the optional bounds of a window, which starts now and
+// spans searchWindowDefault unless set. Empty windows and windows longer than
+// searchWindowMax are reported with the invalid error.
+func searchWindow(
+	now time.Time,
+	from *time.Time,
+	to *time.Time,
+	invalid func(title, detail string) *ValidationError,
+) (time.Time, time.Time, error) {
+	start := now
+	if from != nil {
+		start = *from
+	}
+	end := start.Add(searchWindowDefault)
+	if to != nil {
+		end = *to
+	}
+
+	if !end.After(start) {
+		return time.Time{}, time.Time{}, invalid(
+			"Empty search window",
+			"The end of the search window must be after its start",
+		)
+	}
+	if end.Sub(start) > searchWindowMax {
+		return time.Time{}, time.Time{}, invalid(
+			"Search window too long",
+			fmt.Sprintf("The search window can span at most %d days", searchWindowMax/(24*time.Hour)),
+		)
+	}
+
+	return start, end, nil
+}
+
+// nextFreeSlot returns the start of the first hourly slot, which starts at or
+// after from, ends by to and doesn't overlap any busy interval.
+func nextFreeSlot(
+	busy []data.Interval,
+	from time.Time,
+	to time.Time,
+	duration time.Duration,
+) *time.Time {
+	from = from.In(time.Local)
+	day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.Local)
+	for ; day.Before(to); day = day.AddDate(0, 0, 1) {
+		for hour := firstSlotHour; hour <= lastSlotHour; hour++ {
+			start := time.Date(day.Year(), day.Month(), day.Day(), hour, 0, 0, 0, time.Local)
+			end := start.Add(duration)
+			if start.Before(from) {
+				continue
+			}
+			if end.After(to) {
+				return nil
+			}
+
+			free := true
+			for _, interval := range busy {
+				if interval.Start.Before(end) && interval.End.After(start) {
+					free = false
+					break
+				}
+			}
+			if free {
+				return &start
+			}
+		}
+	}
+	return nil
+}
+
+func searchValidationError(title, detail string) *ValidationError {
+	return &ValidationError{
+		ErrorDetail: api.ErrorDetail{
+			Code:   "invalid.search",
+			Title:  title,
+			Detail: detail,
+			Status: http.StatusBadRequest,
+		},
+	}
+}
@@ -0,0 +1,420 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Nesquiko/wac/pkg/api"
+	"github.com/Nesquiko/wac/pkg/data"
+)
+
+// a series can't book more appointments than this
+const maxSeriesOccurrences = 52
+
+const (
+	conflictDoctorUnavailable    = "Doctor is unavailable at the occurrence's time"
+	conflictDoctorUnavailableNew = "Doctor is unavailable at the occurrence's new time"
+	conflictChanged              = "Occurrence was changed while the series was being rescheduled"
+)
+
+// CreateAppointmentSeries requests an appointment for every occurrence of
+// the recurrence. Occurrences at which the doctor is unavailable aren't
+// booked, they are reported with their conflict.
+func (a MonolithApp) CreateAppointmentSeries(
+	ctx context.Context,
+	req api.NewAppointmentSeries,
+) (api.AppointmentSeries, error) {
+	starts, err := seriesOccurrences(req.FirstAppointmentDateTime, req.Recurrence)
+	if err != nil {
+		return api.AppointmentSeries{}, fmt.Errorf("CreateAppointmentSeries: %w", err)
+	}
+
+	if req.ConditionId != nil {
+		if _, err := a.db.ConditionById(ctx, *req.ConditionId); err != nil {
+			if errors.Is(err, data.ErrNotFound) {
+				return api.AppointmentSeries{}, fmt.Errorf(
+					"CreateAppointmentSeries condition check: %w",
+					ErrNotFound,
+				)
+			}
+			return api.AppointmentSeries{}, fmt.Errorf("CreateAppointmentSeries: %w", err)
+		}
+	}
+
+	doctor, err := a.activeDoctor(ctx, req.DoctorId)
+	if err != nil {
+		return api.AppointmentSeries{}, fmt.Errorf("CreateAppointmentSeries doctor check: %w", err)
+	}
+	duration := a.appointmentDuration(doctor, req.Type, req.DurationMinutes)
+
+	series := data.AppointmentSeries{
+		PatientId: req.PatientId,
+		DoctorId:  req.DoctorId,
+		Frequency: string(req.Recurrence.Frequency),
+		Interval:  recurrenceInterval(req.Recurrence),
+		Count:     req.Recurrence.Count,
+		Until:     req.Recurrence.Until,
+	}
+	if req.Type != nil {
+		series.Type = string(*req.Type)
+	}
+	series, err = a.db.CreateAppointmentSeries(ctx, series)
+	if err != nil {
+		if errors.Is(err, data.ErrNotFound) {
+			return api.AppointmentSeries{}, fmt.Errorf("CreateAppointmentSeries: %w", ErrNotFound)
+		}
+		return api.AppointmentSeries{}, fmt.Errorf("CreateAppointmentSeries: %w", err)
+	}
+
+	var unbooked []api.SeriesOccurrence
+	for _, start := range starts {
+		appt := newApptToDataAppt(api.NewAppointmentRequest{
+			PatientId:           req.PatientId,
+			DoctorId:            req.DoctorId,
+			AppointmentDateTime: start,
+			Type:                req.Type,
+			ConditionId:         req.ConditionId,
+			Reason:              req.Reason,
+		}, duration)
+		appt.SeriesId = &series.Id
+
+		absent, err := a.doctorAbsent(ctx, appt.DoctorId, appt.AppointmentDateTime, appt.EndTime)
+		if err != nil {
+			return api.AppointmentSeries{}, fmt.Errorf("CreateAppointmentSeries occurrence: %w", err)
+		}
+		if absent {
+			unbooked = append(unbooked, api.SeriesOccurrence{
+				AppointmentDateTime: start,
+				Conflict:            asPtr(conflictDoctorUnavailable),
+			})
+			continue
+		}
+
+		created, err := a.db.CreateAppointment(ctx, appt)
+		if errors.Is(err, data.ErrDoctorUnavailable) {
+			unbooked = append(unbooked, api.SeriesOccurrence{
+				AppointmentDateTime: start,
+				Conflict:            asPtr(conflictDoctorUnavailable),
+			})
+			continue
+		} else if err != nil {
+			return api.AppointmentSeries{}, fmt.Errorf("CreateAppointmentSeries occurrence: %w", err)
+		}
+		appointmentsRequested.Inc()
+		a.scheduleReminders(ctx, created)
+	}
+
+	return a.apiAppointmentSeries(ctx, series, unbooked, nil)
+}
+
+func (a MonolithApp) AppointmentSeries(
+	ctx context.Context,
+	seriesId uuid.UUID,
+) (api.AppointmentSeries, error) {
+	series, err := a.db.AppointmentSeriesById(ctx, seriesId)
+	if err != nil {
+		if errors.Is(err, data.ErrNotFound) {
+			return api.AppointmentSeries{}, fmt.Errorf("AppointmentSeries: %w", ErrNotFound)
+		}
+		return api.AppointmentSeries{}, fmt.Errorf("AppointmentSeries: %w", err)
+	}
+
+	return a.apiAppointmentSeries(ctx, series, nil, nil)
+}
+
+// RescheduleAppointmentSeries moves the occurrence to the new time, and the
+// other occurrences in scope by the same amount of time. Occurrences at
+// whose new time the doctor is unavailable, or which the policy doesn't allow
+// to be rescheduled, stay, they are reported with their conflict.
+func (a MonolithApp) RescheduleAppointmentSeries(
+	ctx context.Context,
+	seriesId uuid.UUID,
+	req api.SeriesReschedule,
+) (api.AppointmentSeries, error) {
+	series, anchor, appts, err := a.seriesOccurrencesInScope(
+		ctx,
+		seriesId,
+		&req.AppointmentId,
+		req.Scope,
+	)
+	if err != nil {
+		return api.AppointmentSeries{}, fmt.Errorf("RescheduleAppointmentSeries: %w", err)
+	}
+
+	shift := req.NewAppointmentDateTime.Sub(anchor.AppointmentDateTime)
+	// occurrences are moved away from the ones following them in the
+	// direction of the shift first, so they don't collide with each other
+	if shift > 0 {
+		slices.Reverse(appts)
+	}
+
+	conflicts := make(map[uuid.UUID]string)
+	for _, appt := range appts {
+		_, err := a.RescheduleAppointment(
+			ctx,
+			appt.Id,
+			[]int64{appt.Version},
+			api.AppointmentReschedule{
+				NewAppointmentDateTime: appt.AppointmentDateTime.Add(shift),
+				By:                     req.By,
+			},
+		)
+		var policyErr *PolicyError
+		if errors.Is(err, ErrDoctorUnavailable) {
+			conflicts[appt.Id] = conflictDoctorUnavailableNew
+			continue
+		} else if errors.Is(err, ErrVersionMismatch) {
+			conflicts[appt.Id] = conflictChanged
+			continue
+		} else if errors.As(err, &policyErr) {
+			conflicts[appt.Id] = policyErr.Detail
+			continue
+		} else if err != nil {
+			return api.AppointmentSeries{}, fmt.Errorf("RescheduleAppointmentSeries: %w", err)
+		}
+	}
+
+	return a.apiAppointmentSeries(ctx, series, nil, conflicts)
+}
+
+// CancelAppointmentSeries cancels the occurrences in scope. Occurrences which
+// the policy doesn't allow to be cancelled stay, they are reported with their
+// conflict.
+func (a MonolithApp) CancelAppointmentSeries(
+	ctx context.Context,
+	seriesId uuid.UUID,
+	req api.SeriesCancellation,
+) (api.AppointmentSeries, error) {
+	series, _, appts, err := a.seriesOccurrencesInScope(ctx, seriesId, req.AppointmentId, req.Scope)
+	if err != nil {
+		return api.AppointmentSeries{}, fmt.Errorf("CancelAppointmentSeries: %w", err)
+	}
+
+	cancellation := api.AppointmentCancellation{By: req.By, Reason: req.Reason}
+	conflicts := make(map[uuid.UUID]string)
+	for _, appt := range appts {
+		err := a.CancelAppointment(ctx, appt.Id, cancellation)
+		var policyErr *PolicyError
+		if errors.As(err, &policyErr) {
+			conflicts[appt.Id] = policyErr.Detail
+			continue
+		} else if err != nil {
+			return api.AppointmentSeries{}, fmt.Errorf("CancelAppointmentSeries: %w", err)
+		}
+	}
+
+	return a.apiAppointmentSeries(ctx, series, nil, conflicts)
+}
+
+// seriesOccurrencesInScope returns the series, the anchor occurrence and the
+// occurrences in scope relative to it, which can still be changed, i.e. are
+// requested or scheduled and didn't start yet. The anchor is required
+// unless the scope is all occurrences.
+func (a MonolithApp) seriesOccurrencesInScope(
+	ctx context.Context,
+	seriesId uuid.UUID,
+	anchorId *uuid.UUID,
+	scope api.SeriesScope,
+) (data.AppointmentSeries, data.Appointment, []data.Appointment, error) {
+	series, err := a.db.AppointmentSeriesById(ctx, seriesId)
+	if err != nil {
+		if errors.Is(err, data.ErrNotFound) {
+			return data.AppointmentSeries{}, data.Appointment{}, nil, ErrNotFound
+		}
+		return data.AppointmentSeries{}, data.Appointment{}, nil, err
+	}
+
+	appts, err := a.db.AppointmentsBySeriesId(ctx, seriesId)
+	if err != nil {
+		return data.AppointmentSeries{}, data.Appointment{}, nil, err
+	}
+
+	var anchor data.Appointment
+	if anchorId != nil {
+		i := slices.IndexFunc(appts, func(appt data.Appointment) bool { return appt.Id == *anchorId })
+		if i == -1 {
+			return data.AppointmentSeries{}, data.Appointment{}, nil, fmt.Errorf(
+				"occurrence %s of series %s: %w",
+				anchorId,
+				seriesId,
+				ErrNotFound,
+			)
+		}
+		anchor = appts[i]
+	} else if scope != api.All {
+		return data.AppointmentSeries{}, data.Appointment{}, nil, seriesValidationError(
+			"Invalid scope",
+			"An occurrence must be given unless all occurrences are changed",
+		)
+	}
+
+	now := time.Now()
+	inScope := make([]data.Appointment, 0, len(appts))
+	for _, appt := range appts {
+		switch api.AppointmentStatus(appt.Status) {
+		case api.Requested, api.Scheduled:
+		default:
+			continue
+		}
+		if !appt.AppointmentDateTime.After(now) {
+			continue
+		}
+
+		switch scope {
+		case api.This:
+			if appt.Id != anchor.Id {
+				continue
+			}
+		case api.ThisAndFollowing:
+			if appt.AppointmentDateTime.Before(anchor.AppointmentDateTime) {
+				continue
+			}
+		case api.All:
+		default:
+			return data.AppointmentSeries{}, data.Appointment{}, nil, seriesValidationError(
+				"Invalid scope",
+				fmt.Sprintf("Unknown scope %q", scope),
+			)
+		}
+		inScope = append(inScope, appt)
+	}
+
+	return series, anchor, inScope, nil
+}
+
+// apiAppointmentSeries returns the series with its occurrences. The unbooked
+// occurrences are added to them, and occurrences with conflicts carry them.
+func (a MonolithApp) apiAppointmentSeries(
+	ctx context.Context,
+	series data.AppointmentSeries,
+	unbooked []api.SeriesOccurrence,
+	conflicts map[uuid.UUID]string,
+) (api.AppointmentSeries, error) {
+	appts, err := a.db.AppointmentsBySeriesId(ctx, series.Id)
+	if err != nil {
+		return api.AppointmentSeries{}, fmt.Errorf("apiAppointmentSeries: %w", err)
+	}
+
+	occurrences := make([]api.SeriesOccurrence, 0, len(appts)+len(unbooked))
+	for _, appt := range appts {
+		occurrence := api.SeriesOccurrence{
+			AppointmentId:       &appt.Id,
+			AppointmentDateTime: appt.AppointmentDateTime,
+			Status:              asPtr(api.AppointmentStatus(appt.Status)),
+		}
+		if conflict, ok := conflicts[appt.Id]; ok {
+			occurrence.Conflict = &conflict
+		}
+		occurrences = append(occurrences, occurrence)
+	}
+	occurrences = append(occurrences, unbooked...)
+	slices.SortFunc(occurrences, func(x, y api.SeriesOccurrence) int {
+		return x.AppointmentDateTime.Compare(y.AppointmentDateTime)
+	})
+
+	result := api.AppointmentSeries{
+		Id:        series.Id,
+		PatientId: series.PatientId,
+		DoctorId:  series.DoctorId,
+		Recurrence: api.Recurrence{
+			Frequency: api.RecurrenceFrequency(series.Frequency),
+			Interval:  &series.Interval,
+			Count:     series.Count,
+			Until:     series.Until,
+		},
+		Occurrences: occurrences,
+	}
+	if series.Type != "" {
+		result.Type = asPtr(api.AppointmentType(series.Type))
+	}
+	return result, nil
+}
+
+// seriesOccurrences returns start times of the recurrence's occurrences.
+// Occurrences keep the wall clock time of the first one in the app's
+// timezone, also across daylight saving time changes.
+func seriesOccurrences(first time.Time, r api.Recurrence) ([]time.Time, error) {
+	if (r.Count == nil) == (r.Until == nil) {
+		return nil, seriesValidationError(
+			"Invalid recurrence",
+			"Exactly one of count and until must be set",
+		)
+	}
+	if r.Count != nil && (*r.Count < 1 || *r.Count > maxSeriesOccurrences) {
+		return nil, seriesValidationError(
+			"Invalid recurrence",
+			fmt.Sprintf("Count must be between 1 and %d", maxSeriesOccurrences),
+		)
+	}
+	if r.Until != nil && r.Until.Before(first) {
+		return nil, seriesValidationError(
+			"Invalid recurrence",
+			"Until must not be before the first appointment",
+		)
+	}
+	interval := recurrenceInterval(r)
+	if interval < 1 {
+		return nil, seriesValidationError("Invalid recurrence", "Interval must be at least 1")
+	}
+
+	first = first.In(time.Local)
+	var starts []time.Time
+	for i := 0; ; i++ {
+		var start time.Time
+		switch r.Frequency {
+		case api.Daily:
+			start = first.AddDate(0, 0, i*interval)
+		case api.Weekly:
+			start = first.AddDate(0, 0, 7*i*interval)
+		case api.Monthly:
+			start = first.AddDate(0, i*interval, 0)
+			// e.g. the 31st overflows into the next month
+			if start.Day() != first.Day() {
+				continue
+			}
+		default:
+			return nil, seriesValidationError(
+				"Invalid recurrence",
+				fmt.Sprintf("Unknown frequency %q", r.Frequency),
+			)
+		}
+
+		if r.Until != nil && start.After(*r.Until) {
+			return starts, nil
+		}
+		if len(starts) == maxSeriesOccurrences {
+			return nil, seriesValidationError(
+				"Invalid recurrence",
+				fmt.Sprintf("A series can't have more than %d occurrences", maxSeriesOccurrences),
+			)
+		}
+		starts = append(starts, start)
+		if r.Count != nil && len(starts) == *r.Count {
+			return starts, nil
+		}
+	}
+}
+
+func recurrenceInterval(r api.Recurrence) int {
+	if r.Interval == nil {
+		return 1
+	}
+	return *r.Interval
+}
+
+func seriesValidationError(title, detail string) *ValidationError {
+	return &ValidationError{
+		ErrorDetail: api.ErrorDetail{
+			Code:   "invalid.series",
+			Title:  title,
+			Detail: detail,
+			Status: http.StatusBadRequest,
+		},
+	}
+}
@@ -0,0 +1,472 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/Nesquiko/wac/pkg/api"
+	"github.com/Nesquiko/wac/pkg/data"
+)
+
+const (
+	// how many expired offers are passed on in one round
+	waitlistSweepBatchSize = 50
+	// how many candidates a slot is offered to, if they are concurrently
+	// offered another slot
+	waitlistOfferAttempts = 5
+)
+
+func (a MonolithApp) JoinWaitlist(
+	ctx context.Context,
+	doctorId uuid.UUID,
+	req api.NewWaitlistEntry,
+) (api.WaitlistEntry, error) {
+	entry := data.WaitlistEntry{DoctorId: doctorId, PatientId: req.PatientId}
+	if req.From != nil {
+		entry.From = &req.From.Time
+	}
+	if req.To != nil {
+		entry.To = &req.To.Time
+	}
+	if req.Type != nil {
+		entry.Type = asPtr(string(*req.Type))
+	}
+
+	entry, err := a.db.CreateWaitlistEntry(ctx, entry)
+	if err != nil {
+		if errors.Is(err, data.ErrNotFound) {
+			return api.WaitlistEntry{}, fmt.Errorf("JoinWaitlist: %w", ErrNotFound)
+		}
+		return api.WaitlistEntry{}, fmt.Errorf("JoinWaitlist: %w", err)
+	}
+
+	return dataWaitlistEntryToWaitlistEntry(entry, nil), nil
+}
+
+func (a MonolithApp) PatientsWaitlist(
+	ctx context.Context,
+	patientId uuid.UUID,
+) (api.WaitlistEntries, error) {
+	entries, err := a.db.WaitlistEntriesByPatientId(ctx, patientId)
+	if err != nil {
+		return api.WaitlistEntries{}, fmt.Errorf("PatientsWaitlist: %w", err)
+	}
+
+	result := make([]api.WaitlistEntry, len(entries))
+	for i, entry := range entries {
+		var offer *data.WaitlistOffer
+		if entry.Status == data.WaitlistEntryStatusOffered {
+			o, err := a.db.PendingWaitlistOffer(ctx, entry.Id)
+			if err != nil && !errors.Is(err, data.ErrNotFound) {
+				return api.WaitlistEntries{}, fmt.Errorf("PatientsWaitlist find offer: %w", err)
+			} else if err == nil {
+				offer = &o
+			}
+		}
+		result[i] = dataWaitlistEntryToWaitlistEntry(entry, offer)
+	}
+
+	return api.WaitlistEntries{Entries: &result}, nil
+}
+
+// LeaveWaitlist deletes the entry, the slot held for it is offered to the
+// next patient.
+func (a MonolithApp) LeaveWaitlist(ctx context.Context, entryId uuid.UUID) error {
+	offer, err := a.db.PendingWaitlistOffer(ctx, entryId)
+	hasOffer := err == nil
+	if err != nil && !errors.Is(err, data.ErrNotFound) {
+		return fmt.Errorf("LeaveWaitlist find offer: %w", err)
+	}
+
+	if err := a.db.DeleteWaitlistEntry(ctx, entryId); err != nil {
+		if errors.Is(err, data.ErrNotFound) {
+			return fmt.Errorf("LeaveWaitlist: %w", ErrNotFound)
+		}
+		return fmt.Errorf("LeaveWaitlist: %w", err)
+	}
+
+	if hasOffer {
+		a.offerSlot(ctx, offer.DoctorId, offer.AppointmentDateTime, offer.EndTime, offer.Type)
+	}
+	return nil
+}
+
+// AcceptWaitlistOffer requests an appointment in the slot held for the
+// entry. ErrNoWaitlistOffer is returned if no slot is held for it, and
+// ErrDoctorUnavailable if the slot was booked in the meantime.
+func (a MonolithApp) AcceptWaitlistOffer(
+	ctx context.Context,
+	entryId uuid.UUID,
+) (api.Appointment, error) {
+	entry, offer, err := a.pendingWaitlistOffer(ctx, entryId)
+	if err != nil {
+		return api.Appointment{}, fmt.Errorf("AcceptWaitlistOffer: %w", err)
+	}
+
+	appointmentType := api.AppointmentType(offer.Type)
+	req := api.NewAppointmentRequest{
+		PatientId:           entry.PatientId,
+		DoctorId:            entry.DoctorId,
+		AppointmentDateTime: offer.AppointmentDateTime,
+		Type:                &appointmentType,
+	}
+	// the freed slot is booked whole, offers without an end get the doctor's
+	// duration for the type
+	if !offer.EndTime.IsZero() {
+		req.DurationMinutes = asPtr(int(offer.EndTime.Sub(offer.AppointmentDateTime) / time.Minute))
+	}
+	appt, err := a.CreateAppointment(ctx, req)
+	if errors.Is(err, ErrDoctorUnavailable) {
+		// the slot was booked directly, it can't be passed on either
+		a.resolveWaitlistOffer(ctx, offer, data.WaitlistOfferStatusExpired)
+		return api.Appointment{}, fmt.Errorf("AcceptWaitlistOffer: %w", ErrDoctorUnavailable)
+	} else if err != nil {
+		return api.Appointment{}, fmt.Errorf("AcceptWaitlistOffer: %w", err)
+	}
+
+	err = a.db.ResolveWaitlistOffer(
+		ctx,
+		offer,
+		data.WaitlistOfferStatusAccepted,
+		data.WaitlistEntryStatusBooked,
+	)
+	if err != nil {
+		// the offer expired while booking, the next candidate will find the
+		// slot booked
+		slog.WarnContext(
+			ctx,
+			"failed to resolve accepted waitlist offer",
+			"error", err.Error(),
+			"offerId", offer.Id.String(),
+		)
+	}
+
+	return appt, nil
+}
+
+// DeclineWaitlistOffer passes the slot held for the entry to the next
+// patient, the entry waits for other slots.
+func (a MonolithApp) DeclineWaitlistOffer(ctx context.Context, entryId uuid.UUID) error {
+	_, offer, err := a.pendingWaitlistOffer(ctx, entryId)
+	if err != nil {
+		return fmt.Errorf("DeclineWaitlistOffer: %w", err)
+	}
+
+	err = a.db.ResolveWaitlistOffer(
+		ctx,
+		offer,
+		data.WaitlistOfferStatusDeclined,
+		data.WaitlistEntryStatusWaiting,
+	)
+	if err != nil {
+		if errors.Is(err, data.ErrNotFound) {
+			return fmt.Errorf("DeclineWaitlistOffer: %w", ErrNoWaitlistOffer)
+		}
+		return fmt.Errorf("DeclineWaitlistOffer: %w", err)
+	}
+
+	a.offerSlot(ctx, offer.DoctorId, offer.AppointmentDateTime, offer.EndTime, offer.Type)
+	return nil
+}
+
+// pendingWaitlistOffer returns the entry and its offer, which didn't expire
+// yet. An expired offer is passed on to the next patient.
+func (a MonolithApp) pendingWaitlistOffer(
+	ctx context.Context,
+	entryId uuid.UUID,
+) (data.WaitlistEntry, data.WaitlistOffer, error) {
+	entry, err := a.db.WaitlistEntryById(ctx, entryId)
+	if err != nil {
+		if errors.Is(err, data.ErrNotFound) {
+			return data.WaitlistEntry{}, data.WaitlistOffer{}, ErrNotFound
+		}
+		return data.WaitlistEntry{}, data.WaitlistOffer{}, err
+	}
+
+	offer, err := a.db.PendingWaitlistOffer(ctx, entryId)
+	if err != nil {
+		if errors.Is(err, data.ErrNotFound) {
+			return data.WaitlistEntry{}, data.WaitlistOffer{}, ErrNoWaitlistOffer
+		}
+		return data.WaitlistEntry{}, data.WaitlistOffer{}, err
+	}
+
+	// the sweeper may not have caught up with the offer yet
+	if !time.Now().Before(offer.ExpiresAt) {
+		a.expireWaitlistOffer(ctx, offer)
+		return data.WaitlistEntry{}, data.WaitlistOffer{}, ErrNoWaitlistOffer
+	}
+
+	return entry, offer, nil
+}
+
+// offerFreedSlot offers the slot of the cancelled or denied appointment to
+// the waitlist of its doctor.
+func (a MonolithApp) offerFreedSlot(ctx context.Context, appt data.Appointment) {
+	a.offerSlot(ctx, appt.DoctorId, appt.AppointmentDateTime, appt.EndTime, appt.Type)
+}
+
+// offerSlot holds the doctor's slot for the first matching waitlisted
+// patient and lets them know. The slot is held until the configured hold
+// passes, at most until it starts. Like notifications, failures are only
+// logged, the slot is then just not offered. A zero end, of offers made
+// before their end was stored, is derived from the doctor's duration for typ.
+func (a MonolithApp) offerSlot(
+	ctx context.Context,
+	doctorId uuid.UUID,
+	start time.Time,
+	end time.Time,
+	typ string,
+) {
+	logger := slog.With("doctorId", doctorId.String(), "start", start)
+	now := time.Now()
+	if !start.After(now) {
+		return
+	}
+
+	free, end, err := a.slotFree(ctx, doctorId, start, end, typ)
+	if err != nil {
+		logger.Error("failed to check freed slot", "error", err.Error())
+		return
+	} else if !free {
+		return
+	}
+
+	expiresAt := now.Add(a.opts.WaitlistOfferHold)
+	if start.Before(expiresAt) {
+		expiresAt = start
+	}
+
+	for range waitlistOfferAttempts {
+		entry, err := a.db.NextWaitlistCandidate(ctx, doctorId, start, typ)
+		if errors.Is(err, data.ErrNotFound) {
+			return
+		} else if err != nil {
+			logger.Error("failed to find waitlist candidate", "error", err.Error())
+			return
+		}
+
+		offer, err := a.db.CreateWaitlistOffer(ctx, data.WaitlistOffer{
+			EntryId:             entry.Id,
+			DoctorId:            doctorId,
+			AppointmentDateTime: start,
+			EndTime:             end,
+			Type:                typ,
+			ExpiresAt:           expiresAt,
+		})
+		if errors.Is(err, data.ErrNotFound) {
+			// the candidate was offered another slot in the meantime
+			continue
+		} else if err != nil {
+			logger.Error("failed to offer freed slot", "error", err.Error())
+			return
+		}
+
+		if err := a.queueWaitlistOffer(ctx, entry, offer); err != nil {
+			logger.Error(
+				"failed to queue waitlist offer notification",
+				"error", err.Error(),
+				"offerId", offer.Id.String(),
+			)
+		}
+		return
+	}
+}
+
+// slotFree reports whether the doctor isn't absent, no pending offer holds
+// the slot and no active appointment of the doctor overlaps the [start, end)
+// slot. A zero end is derived from the doctor's duration for typ, the end of
+// the slot is returned with the report.
+func (a MonolithApp) slotFree(
+	ctx context.Context,
+	doctorId uuid.UUID,
+	start time.Time,
+	end time.Time,
+	typ string,
+) (bool, time.Time, error) {
+	doctor, err := a.db.DoctorById(ctx, doctorId)
+	if err != nil {
+		return false, time.Time{}, fmt.Errorf("slotFree find doctor: %w", err)
+	}
+	if end.IsZero() {
+		appointmentType := api.AppointmentType(typ)
+		end = start.Add(a.appointmentDuration(doctor, &appointmentType, nil))
+	}
+
+	absent, err := a.doctorAbsent(ctx, doctorId, start, end)
+	if err != nil {
+		return false, time.Time{}, fmt.Errorf("slotFree: %w", err)
+	}
+	if absent {
+		return false, end, nil
+	}
+
+	offered, err := a.slotOffered(ctx, doctor, uuid.Nil, start, end)
+	if err != nil {
+		return false, time.Time{}, fmt.Errorf("slotFree: %w", err)
+	}
+	if offered {
+		return false, end, nil
+	}
+
+	appts, err := a.db.AppointmentsByDoctorIdAndDate(ctx, doctorId, start)
+	if err != nil {
+		return false, time.Time{}, fmt.Errorf("slotFree: %w", err)
+	}
+
+	for _, appt := range appts {
+		if appointmentActive(appt) && overlaps(appt, start, end) {
+			return false, end, nil
+		}
+	}
+	return true, end, nil
+}
+
+// slotOffered reports whether a pending offer overlaps the doctor's
+// [start, end) interval. Offers held for patientId don't count, the patient
+// books the slot by accepting them.
+func (a MonolithApp) slotOffered(
+	ctx context.Context,
+	doctor data.Doctor,
+	patientId uuid.UUID,
+	start time.Time,
+	end time.Time,
+) (bool, error) {
+	offers, err := a.db.PendingWaitlistOffersByDoctorIdAndDate(ctx, doctor.Id, start)
+	if err != nil {
+		return false, fmt.Errorf("slotOffered: %w", err)
+	}
+
+	now := time.Now()
+	for _, offer := range offers {
+		// the sweeper may not have caught up with the offer yet
+		if !now.Before(offer.ExpiresAt) {
+			continue
+		}
+		offerEnd := offer.EndTime
+		if offerEnd.IsZero() {
+			offerType := api.AppointmentType(offer.Type)
+			offerEnd = offer.AppointmentDateTime.Add(a.appointmentDuration(doctor, &offerType, nil))
+		}
+		if !offer.AppointmentDateTime.Before(end) || !offerEnd.After(start) {
+			continue
+		}
+
+		entry, err := a.db.WaitlistEntryById(ctx, offer.EntryId)
+		if errors.Is(err, data.ErrNotFound) {
+			// the entry left the waitlist together with its offer
+			continue
+		} else if err != nil {
+			return false, fmt.Errorf("slotOffered find entry: %w", err)
+		}
+		if entry.PatientId != patientId {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// expireWaitlistOffer puts the offer's entry back to the waitlist and passes
+// the slot on to the next patient.
+func (a MonolithApp) expireWaitlistOffer(ctx context.Context, offer data.WaitlistOffer) {
+	if a.resolveWaitlistOffer(ctx, offer, data.WaitlistOfferStatusExpired) {
+		a.offerSlot(ctx, offer.DoctorId, offer.AppointmentDateTime, offer.EndTime, offer.Type)
+	}
+}
+
+// resolveWaitlistOffer moves the offer to status, and its entry back to the
+// waitlist. It reports whether the offer was still pending.
+func (a MonolithApp) resolveWaitlistOffer(
+	ctx context.Context,
+	offer data.WaitlistOffer,
+	status data.WaitlistOfferStatus,
+) bool {
+	err := a.db.ResolveWaitlistOffer(ctx, offer, status, data.WaitlistEntryStatusWaiting)
+	if errors.Is(err, data.ErrNotFound) {
+		return false
+	} else if err != nil {
+		slog.ErrorContext(
+			ctx,
+			"failed to resolve waitlist offer",
+			"error", err.Error(),
+			"offerId", offer.Id.String(),
+			"status", status,
+		)
+		return false
+	}
+	return true
+}
+
+// WaitlistSweeper periodically passes slots, whose offers expired, on to the
+// next waitlisted patients.
+type WaitlistSweeper struct {
+	app      MonolithApp
+	interval time.Duration
+}
+
+func NewWaitlistSweeper(app MonolithApp, interval time.Duration) WaitlistSweeper {
+	return WaitlistSweeper{app: app, interval: interval}
+}
+
+// Run sweeps expired offers until ctx is done.
+func (s WaitlistSweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.sweep(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s WaitlistSweeper) sweep(ctx context.Context) {
+	offers, err := s.app.db.ExpiredWaitlistOffers(ctx, time.Now(), waitlistSweepBatchSize)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to find expired waitlist offers", "error", err.Error())
+		return
+	}
+
+	for _, offer := range offers {
+		s.app.expireWaitlistOffer(ctx, offer)
+	}
+}
+
+func dataWaitlistEntryToWaitlistEntry(
+	entry data.WaitlistEntry,
+	offer *data.WaitlistOffer,
+) api.WaitlistEntry {
+	result := api.WaitlistEntry{
+		Id:        entry.Id,
+		DoctorId:  entry.DoctorId,
+		PatientId: entry.PatientId,
+		Status:    api.WaitlistEntryStatus(entry.Status),
+		CreatedAt: entry.CreatedAt,
+	}
+	if entry.From != nil {
+		result.From = &types.Date{Time: *entry.From}
+	}
+	if entry.To != nil {
+		result.To = &types.Date{Time: *entry.To}
+	}
+	if entry.Type != nil {
+		result.Type = asPtr(api.AppointmentType(*entry.Type))
+	}
+	if offer != nil {
+		result.Offer = &api.WaitlistOffer{
+			AppointmentDateTime: offer.AppointmentDateTime,
+			Type:                api.AppointmentType(offer.Type),
+			ExpiresAt:           offer.ExpiresAt,
+		}
+	}
+	return result
+}
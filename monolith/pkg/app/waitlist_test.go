@@ -0,0 +1,173 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/test-go/testify/require"
+
+	"github.com/Nesquiko/wac/pkg/api"
+	"github.com/Nesquiko/wac/pkg/data"
+)
+
+// slotBookedStorage is storage in which the slot of the waitlist offer was
+// booked directly. Storage methods not used when booking the slot are left
+// to the embedded nil interface.
+type slotBookedStorage struct {
+	data.Storage
+	entry    data.WaitlistEntry
+	offer    data.WaitlistOffer
+	resolved []data.WaitlistOfferStatus
+	created  []data.Appointment
+}
+
+func (s *slotBookedStorage) WaitlistEntryById(
+	ctx context.Context,
+	id uuid.UUID,
+) (data.WaitlistEntry, error) {
+	return s.entry, nil
+}
+
+func (s *slotBookedStorage) PendingWaitlistOffer(
+	ctx context.Context,
+	entryId uuid.UUID,
+) (data.WaitlistOffer, error) {
+	return s.offer, nil
+}
+
+func (s *slotBookedStorage) PendingWaitlistOffersByDoctorIdAndDate(
+	ctx context.Context,
+	doctorId uuid.UUID,
+	date time.Time,
+) ([]data.WaitlistOffer, error) {
+	return []data.WaitlistOffer{s.offer}, nil
+}
+
+func (s *slotBookedStorage) DoctorById(ctx context.Context, id uuid.UUID) (data.Doctor, error) {
+	return data.Doctor{Id: id}, nil
+}
+
+func (s *slotBookedStorage) AbsencesByDoctorId(
+	ctx context.Context,
+	doctorId uuid.UUID,
+	from time.Time,
+	to time.Time,
+) ([]data.Absence, error) {
+	return nil, nil
+}
+
+func (s *slotBookedStorage) CreateAppointment(
+	ctx context.Context,
+	appointment data.Appointment,
+) (data.Appointment, error) {
+	s.created = append(s.created, appointment)
+	return data.Appointment{}, fmt.Errorf("CreateAppointment: %w", data.ErrDoctorUnavailable)
+}
+
+func (s *slotBookedStorage) ResolveWaitlistOffer(
+	ctx context.Context,
+	offer data.WaitlistOffer,
+	status data.WaitlistOfferStatus,
+	entryStatus data.WaitlistEntryStatus,
+) error {
+	s.resolved = append(s.resolved, status)
+	return nil
+}
+
+func newSlotBookedStorage() *slotBookedStorage {
+	entry := data.WaitlistEntry{
+		Id:        uuid.New(),
+		DoctorId:  uuid.New(),
+		PatientId: uuid.New(),
+		Status:    data.WaitlistEntryStatusOffered,
+	}
+	return &slotBookedStorage{
+		entry: entry,
+		offer: data.WaitlistOffer{
+			Id:                  uuid.New(),
+			EntryId:             entry.Id,
+			DoctorId:            entry.DoctorId,
+			AppointmentDateTime: time.Now().Add(48 * time.Hour),
+			Type:                "consultation",
+			Status:              data.WaitlistOfferStatusPending,
+			ExpiresAt:           time.Now().Add(time.Hour),
+		},
+	}
+}
+
+func TestAcceptWaitlistOfferSlotBooked(t *testing.T) {
+	db := newSlotBookedStorage()
+	entry := db.entry
+	app := New(db, Options{})
+
+	_, err := app.AcceptWaitlistOffer(context.Background(), entry.Id)
+
+	require.True(t, errors.Is(err, ErrDoctorUnavailable), "unexpected error: %v", err)
+	require.Equal(
+		t,
+		[]data.WaitlistOfferStatus{data.WaitlistOfferStatusExpired},
+		db.resolved,
+		"the offer of the booked slot should expire without passing it on",
+	)
+}
+
+func TestCreateAppointmentSlotOffered(t *testing.T) {
+	db := newSlotBookedStorage()
+	app := New(db, Options{})
+
+	appointmentType := api.Consultation
+	_, err := app.CreateAppointment(context.Background(), api.NewAppointmentRequest{
+		PatientId:           uuid.New(),
+		DoctorId:            db.offer.DoctorId,
+		AppointmentDateTime: db.offer.AppointmentDateTime.Add(30 * time.Minute),
+		Type:                &appointmentType,
+	})
+
+	require.True(t, errors.Is(err, ErrDoctorUnavailable), "unexpected error: %v", err)
+	require.Empty(t, db.created, "the slot held for another patient shouldn't be booked")
+}
+
+func TestAcceptWaitlistOfferEndTime(t *testing.T) {
+	tests := []struct {
+		name    string
+		endTime func(start time.Time) time.Time
+		wantEnd func(start time.Time) time.Time
+	}{
+		{
+			name:    "freed slot is booked whole",
+			endTime: func(start time.Time) time.Time { return start.Add(50 * time.Minute) },
+			wantEnd: func(start time.Time) time.Time { return start.Add(50 * time.Minute) },
+		},
+		{
+			name:    "offer without end gets the default duration",
+			endTime: func(start time.Time) time.Time { return time.Time{} },
+			wantEnd: func(start time.Time) time.Time {
+				return start.Add(defaultAppointmentDuration)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newSlotBookedStorage()
+			start := db.offer.AppointmentDateTime
+			db.offer.EndTime = tt.endTime(start)
+			app := New(db, Options{})
+
+			_, _ = app.AcceptWaitlistOffer(context.Background(), db.entry.Id)
+
+			require.Len(t, db.created, 1)
+			require.True(
+				t,
+				db.created[0].EndTime.Equal(tt.wantEnd(start)),
+				"booked until %s, want %s",
+				db.created[0].EndTime,
+				tt.wantEnd(start),
+			)
+		})
+	}
+}
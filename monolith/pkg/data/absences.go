@@ -0,0 +1,213 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+type AbsenceType string
+
+const (
+	AbsenceTypeVacation   AbsenceType = "vacation"
+	AbsenceTypeSickLeave  AbsenceType = "sick_leave"
+	AbsenceTypeConference AbsenceType = "conference"
+)
+
+// Absence is the half-open interval [Start, End) in which the doctor doesn't
+// take appointments.
+type Absence struct {
+	Id        uuid.UUID   `bson:"_id"            json:"id"`
+	DoctorId  uuid.UUID   `bson:"doctorId"       json:"doctorId"` // Reference to Doctor._id
+	Type      AbsenceType `bson:"type"           json:"type"`
+	Start     time.Time   `bson:"start"          json:"start"`
+	End       time.Time   `bson:"end"            json:"end"`
+	Note      *string     `bson:"note,omitempty" json:"note,omitempty"`
+	CreatedAt time.Time   `bson:"createdAt"      json:"createdAt"`
+}
+
+func (m *MongoDb) CreateAbsence(ctx context.Context, absence Absence) (Absence, error) {
+	if err := m.doctorExists(ctx, absence.DoctorId); err != nil {
+		return Absence{}, fmt.Errorf("CreateAbsence doctor check: %w", err)
+	}
+
+	collection := m.Database.Collection(doctorAbsencesCollection)
+	absence.Id = uuid.New()
+	absence.CreatedAt = time.Now()
+
+	_, err := collection.InsertOne(ctx, absence)
+	if err != nil {
+		return Absence{}, fmt.Errorf("CreateAbsence: failed to insert document: %w", err)
+	}
+
+	return absence, nil
+}
+
+func (m *MongoDb) AbsenceById(ctx context.Context, id uuid.UUID) (Absence, error) {
+	collection := m.Database.Collection(doctorAbsencesCollection)
+
+	var absence Absence
+	err := collection.FindOne(ctx, bson.M{"_id": id}).Decode(&absence)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return Absence{}, ErrNotFound
+		}
+		return Absence{}, fmt.Errorf("AbsenceById: failed to find document: %w", err)
+	}
+
+	return absence, nil
+}
+
+// AbsencesByDoctorId returns the doctor's absences overlapping [from, to),
+// sorted by their start.
+func (m *MongoDb) AbsencesByDoctorId(
+	ctx context.Context,
+	doctorId uuid.UUID,
+	from time.Time,
+	to time.Time,
+) ([]Absence, error) {
+	collection := m.Database.Collection(doctorAbsencesCollection)
+	filter := bson.M{
+		"doctorId": doctorId,
+		"start":    bson.M{"$lt": to},
+		"end":      bson.M{"$gt": from},
+	}
+	opts := options.Find().SetSort(bson.M{"start": 1})
+
+	cursor, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("AbsencesByDoctorId: failed to find documents: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	absences := make([]Absence, 0)
+	if err = cursor.All(ctx, &absences); err != nil {
+		return nil, fmt.Errorf("AbsencesByDoctorId: failed to decode documents: %w", err)
+	}
+
+	return absences, nil
+}
+
+// UpdateAbsence replaces the type, interval and note of the absence.
+func (m *MongoDb) UpdateAbsence(ctx context.Context, absence Absence) (Absence, error) {
+	collection := m.Database.Collection(doctorAbsencesCollection)
+	update := bson.M{
+		"$set": bson.M{
+			"type":  absence.Type,
+			"start": absence.Start,
+			"end":   absence.End,
+			"note":  absence.Note,
+		},
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var updated Absence
+	err := collection.FindOneAndUpdate(ctx, bson.M{"_id": absence.Id}, update, opts).
+		Decode(&updated)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return Absence{}, ErrNotFound
+		}
+		return Absence{}, fmt.Errorf("UpdateAbsence: failed to update document: %w", err)
+	}
+
+	return updated, nil
+}
+
+func (m *MongoDb) DeleteAbsence(ctx context.Context, id uuid.UUID) error {
+	collection := m.Database.Collection(doctorAbsencesCollection)
+	res, err := collection.DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		return fmt.Errorf("DeleteAbsence: failed to delete document: %w", err)
+	}
+	if res.DeletedCount == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// FlagAppointmentsForReschedule marks the doctor's active appointments
+// overlapping [start, end) as requiring a reschedule. Only the appointments,
+// which weren't flagged before, are returned.
+func (m *MongoDb) FlagAppointmentsForReschedule(
+	ctx context.Context,
+	doctorId uuid.UUID,
+	start time.Time,
+	end time.Time,
+) ([]Appointment, error) {
+	collection := m.Database.Collection(appointmentsCollection)
+	filter := overlappingAppointmentsFilter(doctorId, start, end)
+	filter["rescheduleRequired"] = bson.M{"$ne": true}
+
+	cursor, err := collection.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("FlagAppointmentsForReschedule: failed to find documents: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var appts []Appointment
+	if err = cursor.All(ctx, &appts); err != nil {
+		return nil, fmt.Errorf("FlagAppointmentsForReschedule: failed to decode documents: %w", err)
+	}
+	if len(appts) == 0 {
+		return appts, nil
+	}
+
+	ids := make([]uuid.UUID, len(appts))
+	for i := range appts {
+		ids[i] = appts[i].Id
+		appts[i].RescheduleRequired = true
+		appts[i].Version++
+	}
+	_, err = collection.UpdateMany(
+		ctx,
+		bson.M{"_id": bson.M{"$in": ids}},
+		bson.M{"$set": bson.M{"rescheduleRequired": true}, "$inc": bson.M{"version": 1}},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("FlagAppointmentsForReschedule: failed to update documents: %w", err)
+	}
+
+	return appts, nil
+}
+
+// absentDoctorIds returns ids of doctors, who are absent at some point of the
+// [start, end) interval.
+func (m *MongoDb) absentDoctorIds(
+	ctx context.Context,
+	start time.Time,
+	end time.Time,
+) ([]uuid.UUID, error) {
+	collection := m.Database.Collection(doctorAbsencesCollection)
+	filter := bson.M{
+		"start": bson.M{"$lt": end},
+		"end":   bson.M{"$gt": start},
+	}
+	opts := options.Find().SetProjection(bson.M{"doctorId": 1, "_id": 0})
+
+	cursor, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("absentDoctorIds: failed to find documents: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var results []struct {
+		DoctorId uuid.UUID `bson:"doctorId"`
+	}
+	if err = cursor.All(ctx, &results); err != nil {
+		return nil, fmt.Errorf("absentDoctorIds: failed to decode documents: %w", err)
+	}
+
+	ids := make([]uuid.UUID, len(results))
+	for i, result := range results {
+		ids[i] = result.DoctorId
+	}
+	return ids, nil
+}
@@ -32,6 +32,24 @@ type Appointment struct {
 	Medicines  []Resource `bson:"medicines,omitempty"  json:"medicines,omitempty"`
 	Facilities []Resource `bson:"facilities,omitempty" json:"facilities,omitempty"`
 	Equipment  []Resource `bson:"equipment,omitempty"  json:"equipment,omitempty"`
+
+	SeriesId *uuid.UUID `bson:"seriesId,omitempty" json:"seriesId,omitempty"` // Reference to AppointmentSeries._id
+
+	// RescheduleRequired is set when the doctor became absent at the time of
+	// the appointment, it is cleared once the appointment is rescheduled.
+	RescheduleRequired bool `bson:"rescheduleRequired,omitempty" json:"rescheduleRequired,omitempty"`
+
+	// RescheduleCount is how many times the appointment was rescheduled.
+	RescheduleCount int `bson:"rescheduleCount,omitempty" json:"rescheduleCount,omitempty"`
+	// LateCancellation is set when the patient cancelled the appointment
+	// shortly before it.
+	LateCancellation bool `bson:"lateCancellation,omitempty" json:"lateCancellation,omitempty"`
+	// RequestedAt is when the appointment was requested, it is unset for
+	// appointments requested before it was recorded.
+	RequestedAt *time.Time `bson:"requestedAt,omitempty" json:"requestedAt,omitempty"`
+	// Version is bumped by every change of the appointment, a decision or a
+	// reschedule expecting another version fails with ErrVersionMismatch.
+	Version int64 `bson:"version" json:"version"`
 }
 
 func (m *MongoDb) CreateAppointment(
@@ -53,15 +71,16 @@ func (m *MongoDb) CreateAppointment(
 	}
 
 	appointmentsColl := m.Database.Collection(appointmentsCollection)
-	availabilityFilter := bson.M{
-		"doctorId":            appointment.DoctorId,
-		"appointmentDateTime": appointment.AppointmentDateTime,
-		"status":              bson.M{"$nin": []string{"cancelled", "denied"}},
-	}
+	availabilityFilter := overlappingAppointmentsFilter(
+		appointment.DoctorId,
+		appointment.AppointmentDateTime,
+		appointment.EndTime,
+	)
 
 	count, err := appointmentsColl.CountDocuments(ctx, availabilityFilter)
 	if err != nil {
-		slog.Error(
+		slog.ErrorContext(
+			ctx,
 			"Failed to count doctor appointments for availability check",
 			"doctorId", appointment.DoctorId,
 			"dateTime", appointment.AppointmentDateTime,
@@ -74,7 +93,8 @@ func (m *MongoDb) CreateAppointment(
 	}
 
 	if count > 0 {
-		slog.Warn(
+		slog.WarnContext(
+			ctx,
 			"Attempted to schedule appointment when doctor is unavailable",
 			"doctorId", appointment.DoctorId,
 			"dateTime", appointment.AppointmentDateTime,
@@ -87,6 +107,9 @@ func (m *MongoDb) CreateAppointment(
 	}
 
 	appointment.Id = uuid.New()
+	requestedAt := time.Now()
+	appointment.RequestedAt = &requestedAt
+	appointment.Version = 1
 	_, err = appointmentsColl.InsertOne(ctx, appointment)
 	if err != nil {
 		return Appointment{}, fmt.Errorf("CreateAppointment: failed to insert document: %w", err)
@@ -112,13 +135,18 @@ func (m *MongoDb) AppointmentById(ctx context.Context, id uuid.UUID) (Appointmen
 	return appt, nil
 }
 
+// CancelAppointment cancels the appointment and releases its reservations. A
+// late cancellation is flagged on the appointment and counted on its
+// patient.
 func (m *MongoDb) CancelAppointment(
 	ctx context.Context,
 	appointmentId uuid.UUID,
 	by string,
 	cancellationReason *string,
+	late bool,
 ) error {
-	if err := m.appointmentExists(ctx, appointmentId); err != nil {
+	appointment, err := m.AppointmentById(ctx, appointmentId)
+	if err != nil {
 		return fmt.Errorf("CancelAppointment appointment check failed: %w", err)
 	}
 
@@ -128,15 +156,29 @@ func (m *MongoDb) CancelAppointment(
 			"status":             "cancelled",
 			"cancellationReason": cancellationReason,
 			"cancelledBy":        by,
+			"lateCancellation":   late,
 		},
+		"$inc": bson.M{"version": 1},
 	}
 	filter := bson.M{"_id": appointmentId}
 
-	_, err := appointmentsColl.UpdateOne(ctx, filter, update)
+	_, err = appointmentsColl.UpdateOne(ctx, filter, update)
 	if err != nil {
 		return fmt.Errorf("CancelAppointment failed to update appointment status: %w", err)
 	}
 
+	if late {
+		patientsColl := m.Database.Collection(patientsCollection)
+		_, err = patientsColl.UpdateOne(
+			ctx,
+			bson.M{"_id": appointment.PatientId},
+			bson.M{"$inc": bson.M{"lateCancellations": 1}},
+		)
+		if err != nil {
+			return fmt.Errorf("CancelAppointment failed to count late cancellation: %w", err)
+		}
+	}
+
 	if err := m.DeleteReservationsByAppointmentId(ctx, appointmentId); err != nil {
 		return fmt.Errorf("CancelAppointment failed to delete reservations: %w", err)
 	}
@@ -144,9 +186,13 @@ func (m *MongoDb) CancelAppointment(
 	return nil
 }
 
+// DecideAppointment accepts or rejects the requested appointment if it is
+// still at version, otherwise it fails with ErrVersionMismatch. Reservations
+// made for an accepted appointment which moved in the meantime are released.
 func (m *MongoDb) DecideAppointment(
 	ctx context.Context,
 	appointmentId uuid.UUID,
+	version int64,
 	decision string,
 	denyReason *string,
 	resources []Resource,
@@ -162,8 +208,12 @@ func (m *MongoDb) DecideAppointment(
 			appointmentId,
 		)
 	}
+	if appointment.Version != version {
+		return Appointment{}, fmt.Errorf("DecideAppointment: %w", ErrVersionMismatch)
+	}
 
 	if decision == "accept" {
+		var reservationIds []uuid.UUID
 		for _, res := range resources {
 			resource, err := m.ResourceById(ctx, res.Id)
 			if err != nil {
@@ -174,7 +224,7 @@ func (m *MongoDb) DecideAppointment(
 				)
 			}
 
-			_, err = m.CreateReservation(
+			reservation, err := m.CreateReservation(
 				ctx,
 				appointmentId,
 				resource.Id,
@@ -190,19 +240,28 @@ func (m *MongoDb) DecideAppointment(
 					err,
 				)
 			}
+			reservationIds = append(reservationIds, reservation.Id)
 		}
 
-		_, err = m.scheduleAppointment(ctx, appointmentId)
-		if err != nil {
-			return Appointment{}, fmt.Errorf("DecideAppointment: %w", err)
+		appointment, err = m.scheduleAppointment(ctx, appointmentId, version, resources)
+		if errors.Is(err, ErrVersionMismatch) && len(reservationIds) > 0 {
+			reservationsColl := m.Database.Collection(reservationsCollection)
+			_, delErr := reservationsColl.DeleteMany(
+				ctx,
+				bson.M{"_id": bson.M{"$in": reservationIds}},
+			)
+			if delErr != nil {
+				return Appointment{}, fmt.Errorf(
+					"DecideAppointment failed to release reservations: %w",
+					delErr,
+				)
+			}
 		}
-
-		appointment, err = m.updateAppointmentResources(ctx, appointmentId, resources)
 		if err != nil {
 			return Appointment{}, fmt.Errorf("DecideAppointment: %w", err)
 		}
 	} else if decision == "reject" {
-		appointment, err = m.denyAppointment(ctx, appointmentId, denyReason)
+		appointment, err = m.denyAppointment(ctx, appointmentId, version, denyReason)
 		if err != nil {
 			return Appointment{}, fmt.Errorf("DecideAppointment: %w", err)
 		}
@@ -241,6 +300,36 @@ func (m *MongoDb) AppointmentsByPatientId(
 	return appts, nil
 }
 
+// AllAppointmentsByPatientId returns every appointment of the patient,
+// including cancelled and denied ones.
+func (m *MongoDb) AllAppointmentsByPatientId(
+	ctx context.Context,
+	patientId uuid.UUID,
+) ([]Appointment, error) {
+	appointmentsColl := m.Database.Collection(appointmentsCollection)
+	appointments := make([]Appointment, 0)
+
+	filter := bson.M{"patientId": patientId}
+	opts := options.Find().SetSort(bson.D{{Key: "appointmentDateTime", Value: 1}})
+
+	cursor, err := appointmentsColl.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("AllAppointmentsByPatientId find failed: %w", err)
+	}
+
+	defer func() {
+		if cerr := cursor.Close(ctx); cerr != nil {
+			slog.WarnContext(ctx, "Failed to close cursor", "error", cerr.Error())
+		}
+	}()
+
+	if err = cursor.All(ctx, &appointments); err != nil {
+		return nil, fmt.Errorf("AllAppointmentsByPatientId decode failed: %w", err)
+	}
+
+	return appointments, nil
+}
+
 func (m *MongoDb) AppointmentsByDoctorIdAndDate(
 	ctx context.Context,
 	doctorId uuid.UUID,
@@ -263,9 +352,14 @@ func (m *MongoDb) AppointmentsByDoctorIdAndDate(
 	return appts, nil
 }
 
+// RescheduleAppointment moves the appointment to newDateTime, keeping its
+// duration, if it is still at version, otherwise it fails with
+// ErrVersionMismatch. The appointment is put back to the requested state and
+// its reservations are released.
 func (m *MongoDb) RescheduleAppointment(
 	ctx context.Context,
 	appointmentId uuid.UUID,
+	version int64,
 	newDateTime time.Time,
 ) (Appointment, error) {
 	appointment, err := m.AppointmentById(ctx, appointmentId)
@@ -279,13 +373,18 @@ func (m *MongoDb) RescheduleAppointment(
 			appointmentId,
 		)
 	}
-
-	availabilityFilter := bson.M{
-		"doctorId":            appointment.DoctorId,
-		"appointmentDateTime": newDateTime,
-		"status":              bson.M{"$nin": []string{"cancelled", "denied"}},
+	if appointment.Version != version {
+		return Appointment{}, fmt.Errorf("RescheduleAppointment: %w", ErrVersionMismatch)
 	}
 
+	newEndTime := newDateTime.Add(appointment.EndTime.Sub(appointment.AppointmentDateTime))
+	availabilityFilter := overlappingAppointmentsFilter(
+		appointment.DoctorId,
+		newDateTime,
+		newEndTime,
+	)
+	availabilityFilter["_id"] = bson.M{"$ne": appointmentId}
+
 	appointmentsColl := m.Database.Collection(appointmentsCollection)
 	count, err := appointmentsColl.CountDocuments(ctx, availabilityFilter)
 	if err != nil {
@@ -306,18 +405,28 @@ func (m *MongoDb) RescheduleAppointment(
 	update := bson.M{
 		"$set": bson.M{
 			"appointmentDateTime": newDateTime,
+			"endTime":             newEndTime,
 			"status":              "requested",
+			"rescheduleRequired":  false,
 		},
+		"$unset": reservedResourcesUnset,
+		"$inc":   bson.M{"rescheduleCount": 1, "version": 1},
 	}
-	filter := bson.M{"_id": appointmentId}
+	filter := bson.M{"_id": appointmentId, "version": versionFilter(version)}
 
-	_, err = appointmentsColl.UpdateOne(ctx, filter, update)
+	res, err := appointmentsColl.UpdateOne(ctx, filter, update)
 	if err != nil {
 		return Appointment{}, fmt.Errorf(
 			"RescheduleAppointment failed to update appointment: %w",
 			err,
 		)
 	}
+	if res.MatchedCount == 0 {
+		return Appointment{}, fmt.Errorf(
+			"RescheduleAppointment: %w",
+			m.missingOrMoved(ctx, appointmentsCollection, bson.M{"_id": appointmentId}),
+		)
+	}
 
 	if err := m.DeleteReservationsByAppointmentId(ctx, appointmentId); err != nil {
 		return Appointment{}, fmt.Errorf(
@@ -329,6 +438,296 @@ func (m *MongoDb) RescheduleAppointment(
 	return m.AppointmentById(ctx, appointmentId)
 }
 
+// RescheduleAppointmentKeepingReservations moves the appointment and its
+// reservations to newDateTime, keeping their durations and the appointment's
+// status. If some reserved resource is taken at the new time, the appointment
+// is rescheduled like by RescheduleAppointment instead, and the conflicting
+// reservations, as they were before the move, are returned.
+//
+// Mongo runs without transactions here, so the move is guarded by conditional
+// updates instead. The appointment is moved only if it is still at version,
+// its reservations are shifted by a single update and the resources are
+// checked again after the move. A resource taken by another appointment in
+// the meantime is reported as a conflict, and the reservations are released,
+// the same as if it was taken before.
+func (m *MongoDb) RescheduleAppointmentKeepingReservations(
+	ctx context.Context,
+	appointmentId uuid.UUID,
+	version int64,
+	newDateTime time.Time,
+) (Appointment, []Reservation, error) {
+	appointment, err := m.AppointmentById(ctx, appointmentId)
+	if err != nil {
+		return Appointment{}, nil, fmt.Errorf("RescheduleAppointmentKeepingReservations: %w", err)
+	}
+
+	if appointment.Status != "scheduled" && appointment.Status != "requested" {
+		return Appointment{}, nil, fmt.Errorf(
+			"RescheduleAppointmentKeepingReservations appointment %s is not in a reschedulable state",
+			appointmentId,
+		)
+	}
+	if appointment.Version != version {
+		return Appointment{}, nil, fmt.Errorf(
+			"RescheduleAppointmentKeepingReservations: %w",
+			ErrVersionMismatch,
+		)
+	}
+
+	reservations, err := m.ReservationsByAppointmentId(ctx, appointmentId)
+	if err != nil {
+		return Appointment{}, nil, fmt.Errorf("RescheduleAppointmentKeepingReservations: %w", err)
+	}
+
+	shift := newDateTime.Sub(appointment.AppointmentDateTime)
+	conflicts, err := m.reservationConflicts(ctx, appointmentId, reservations, shift)
+	if err != nil {
+		return Appointment{}, nil, fmt.Errorf("RescheduleAppointmentKeepingReservations: %w", err)
+	}
+	if len(conflicts) > 0 {
+		appointment, err = m.RescheduleAppointment(ctx, appointmentId, version, newDateTime)
+		if err != nil {
+			return Appointment{}, nil, fmt.Errorf("RescheduleAppointmentKeepingReservations: %w", err)
+		}
+		return appointment, conflicts, nil
+	}
+
+	newEndTime := appointment.EndTime.Add(shift)
+	availabilityFilter := overlappingAppointmentsFilter(
+		appointment.DoctorId,
+		newDateTime,
+		newEndTime,
+	)
+	availabilityFilter["_id"] = bson.M{"$ne": appointmentId}
+
+	appointmentsColl := m.Database.Collection(appointmentsCollection)
+	count, err := appointmentsColl.CountDocuments(ctx, availabilityFilter)
+	if err != nil {
+		return Appointment{}, nil, fmt.Errorf(
+			"RescheduleAppointmentKeepingReservations doctor availability check failed: %w",
+			err,
+		)
+	}
+
+	if count > 0 {
+		return Appointment{}, nil, fmt.Errorf(
+			"%w at %s",
+			ErrDoctorUnavailable,
+			newDateTime.Format(time.RFC3339),
+		)
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"appointmentDateTime": newDateTime,
+			"endTime":             newEndTime,
+			"rescheduleRequired":  false,
+		},
+		"$inc": bson.M{"rescheduleCount": 1, "version": 1},
+	}
+	filter := bson.M{"_id": appointmentId, "version": versionFilter(version)}
+	res, err := appointmentsColl.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return Appointment{}, nil, fmt.Errorf(
+			"RescheduleAppointmentKeepingReservations failed to update appointment: %w",
+			err,
+		)
+	}
+	if res.MatchedCount == 0 {
+		return Appointment{}, nil, fmt.Errorf(
+			"RescheduleAppointmentKeepingReservations: %w",
+			m.missingOrMoved(ctx, appointmentsCollection, bson.M{"_id": appointmentId}),
+		)
+	}
+	movedVersion := version + 1
+
+	reservationsColl := m.Database.Collection(reservationsCollection)
+	_, err = reservationsColl.UpdateMany(
+		ctx,
+		bson.M{"appointmentId": appointmentId},
+		shiftReservationsUpdate(shift),
+	)
+	if err != nil {
+		return Appointment{}, nil, fmt.Errorf(
+			"RescheduleAppointmentKeepingReservations failed to move reservations: %w",
+			err,
+		)
+	}
+
+	conflicts, err = m.reservationConflicts(ctx, appointmentId, reservations, shift)
+	if err != nil {
+		return Appointment{}, nil, fmt.Errorf("RescheduleAppointmentKeepingReservations: %w", err)
+	}
+	if len(conflicts) > 0 {
+		res, err := appointmentsColl.UpdateOne(
+			ctx,
+			bson.M{"_id": appointmentId, "version": movedVersion},
+			bson.M{
+				"$set":   bson.M{"status": "requested"},
+				"$unset": reservedResourcesUnset,
+				"$inc":   bson.M{"version": 1},
+			},
+		)
+		if err != nil {
+			return Appointment{}, nil, fmt.Errorf(
+				"RescheduleAppointmentKeepingReservations failed to update appointment: %w",
+				err,
+			)
+		}
+		if res.MatchedCount == 0 {
+			return Appointment{}, nil, fmt.Errorf(
+				"RescheduleAppointmentKeepingReservations: %w",
+				m.missingOrMoved(ctx, appointmentsCollection, bson.M{"_id": appointmentId}),
+			)
+		}
+		if err := m.DeleteReservationsByAppointmentId(ctx, appointmentId); err != nil {
+			return Appointment{}, nil, fmt.Errorf(
+				"RescheduleAppointmentKeepingReservations failed to delete reservations: %w",
+				err,
+			)
+		}
+	}
+
+	appointment, err = m.AppointmentById(ctx, appointmentId)
+	if err != nil {
+		return Appointment{}, nil, fmt.Errorf("RescheduleAppointmentKeepingReservations: %w", err)
+	}
+	return appointment, conflicts, nil
+}
+
+// reservationConflicts returns those of the appointment's reservations whose
+// resource is reserved by another appointment once shifted by shift.
+func (m *MongoDb) reservationConflicts(
+	ctx context.Context,
+	appointmentId uuid.UUID,
+	reservations []Reservation,
+	shift time.Duration,
+) ([]Reservation, error) {
+	reservationsColl := m.Database.Collection(reservationsCollection)
+	var conflicts []Reservation
+	for _, reservation := range reservations {
+		count, err := reservationsColl.CountDocuments(ctx, bson.M{
+			"resourceId":    reservation.ResourceId,
+			"appointmentId": bson.M{"$ne": appointmentId},
+			"startTime":     bson.M{"$lt": reservation.EndTime.Add(shift)},
+			"endTime":       bson.M{"$gt": reservation.StartTime.Add(shift)},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("resource availability check failed: %w", err)
+		}
+		if count > 0 {
+			conflicts = append(conflicts, reservation)
+		}
+	}
+	return conflicts, nil
+}
+
+// reservedResourcesUnset clears the resources embedded in an appointment, so
+// they match its reservations once those are released.
+var reservedResourcesUnset = bson.M{"facilities": "", "equipment": "", "medicines": ""}
+
+// shiftReservationsUpdate moves reservations by shift, each from its own
+// current interval, so all of them are moved by one update.
+func shiftReservationsUpdate(shift time.Duration) bson.A {
+	return bson.A{
+		bson.M{"$set": bson.M{
+			"startTime": bson.M{"$add": bson.A{"$startTime", shift.Milliseconds()}},
+			"endTime":   bson.M{"$add": bson.A{"$endTime", shift.Milliseconds()}},
+		}},
+	}
+}
+
+// ReassignAppointment moves the appointment to the doctor at newDateTime,
+// keeping its duration. If the time stays the same, the appointment keeps its
+// status and reservations, otherwise it is put back to the requested state
+// and its reservations are released. An appointment changed since it was read
+// fails with ErrVersionMismatch.
+func (m *MongoDb) ReassignAppointment(
+	ctx context.Context,
+	appointmentId uuid.UUID,
+	doctorId uuid.UUID,
+	newDateTime time.Time,
+) (Appointment, error) {
+	appointment, err := m.AppointmentById(ctx, appointmentId)
+	if err != nil {
+		return Appointment{}, fmt.Errorf("ReassignAppointment: %w", err)
+	}
+
+	if appointment.Status != "scheduled" && appointment.Status != "requested" {
+		return Appointment{}, fmt.Errorf(
+			"ReassignAppointment appointment %s is not in a reassignable state",
+			appointmentId,
+		)
+	}
+
+	if err := m.doctorExists(ctx, doctorId); err != nil {
+		return Appointment{}, fmt.Errorf("ReassignAppointment doctor check: %w", err)
+	}
+
+	newEndTime := newDateTime.Add(appointment.EndTime.Sub(appointment.AppointmentDateTime))
+	availabilityFilter := overlappingAppointmentsFilter(doctorId, newDateTime, newEndTime)
+	availabilityFilter["_id"] = bson.M{"$ne": appointmentId}
+
+	appointmentsColl := m.Database.Collection(appointmentsCollection)
+	count, err := appointmentsColl.CountDocuments(ctx, availabilityFilter)
+	if err != nil {
+		return Appointment{}, fmt.Errorf(
+			"ReassignAppointment doctor availability check failed: %w",
+			err,
+		)
+	}
+
+	if count > 0 {
+		return Appointment{}, fmt.Errorf(
+			"%w at %s",
+			ErrDoctorUnavailable,
+			newDateTime.Format(time.RFC3339),
+		)
+	}
+
+	moved := !newDateTime.Equal(appointment.AppointmentDateTime)
+	set := bson.M{
+		"doctorId":            doctorId,
+		"appointmentDateTime": newDateTime,
+		"endTime":             newEndTime,
+		"rescheduleRequired":  false,
+	}
+	update := bson.M{"$set": set, "$inc": bson.M{"version": 1}}
+	if moved {
+		set["status"] = "requested"
+		update["$unset"] = reservedResourcesUnset
+	}
+
+	res, err := appointmentsColl.UpdateOne(
+		ctx,
+		bson.M{"_id": appointmentId, "version": versionFilter(appointment.Version)},
+		update,
+	)
+	if err != nil {
+		return Appointment{}, fmt.Errorf(
+			"ReassignAppointment failed to update appointment: %w",
+			err,
+		)
+	}
+	if res.MatchedCount == 0 {
+		return Appointment{}, fmt.Errorf(
+			"ReassignAppointment: %w",
+			m.missingOrMoved(ctx, appointmentsCollection, bson.M{"_id": appointmentId}),
+		)
+	}
+
+	if moved {
+		if err := m.DeleteReservationsByAppointmentId(ctx, appointmentId); err != nil {
+			return Appointment{}, fmt.Errorf(
+				"ReassignAppointment failed to delete reservations: %w",
+				err,
+			)
+		}
+	}
+
+	return m.AppointmentById(ctx, appointmentId)
+}
+
 func (m *MongoDb) AppointmentsByConditionId(
 	ctx context.Context,
 	conditionId uuid.UUID,
@@ -346,7 +745,12 @@ func (m *MongoDb) AppointmentsByConditionId(
 
 	defer func() {
 		if cerr := cursor.Close(ctx); cerr != nil {
-			slog.Warn("Failed to close cursor in AppointmentsByConditionId", "error", cerr.Error())
+			slog.WarnContext(
+				ctx,
+				"Failed to close cursor in AppointmentsByConditionId",
+				"error",
+				cerr.Error(),
+			)
 		}
 	}()
 
@@ -390,7 +794,7 @@ func (m *MongoDb) appointmentsByIdFieldAndDateRange(
 
 	defer func() {
 		if cerr := cursor.Close(ctx); cerr != nil {
-			slog.Warn("Failed to close cursor", "error", cerr.Error())
+			slog.WarnContext(ctx, "Failed to close cursor", "error", cerr.Error())
 		}
 	}()
 
@@ -437,7 +841,7 @@ func (m *MongoDb) appointmentsByIdField(
 
 	defer func() {
 		if cerr := cursor.Close(ctx); cerr != nil {
-			slog.Warn("Failed to close cursor", "error", cerr.Error())
+			slog.WarnContext(ctx, "Failed to close cursor", "error", cerr.Error())
 		}
 	}()
 
@@ -452,73 +856,93 @@ func (m *MongoDb) appointmentsByIdField(
 	return appointments, nil
 }
 
+// scheduleAppointment schedules the appointment with the reserved resources
+// if it is still requested at version, otherwise it fails with
+// ErrVersionMismatch.
 func (m *MongoDb) scheduleAppointment(
 	ctx context.Context,
 	appointmentId uuid.UUID,
+	version int64,
+	resources []Resource,
 ) (Appointment, error) {
-	appointmentsColl := m.Database.Collection(appointmentsCollection)
-	update := bson.M{"$set": bson.M{"status": "scheduled"}}
-	filter := bson.M{"_id": appointmentId}
+	var facilities, equipment, medicine []Resource
+	for _, resource := range resources {
+		switch resource.Type {
+		case ResourceTypeFacility:
+			facilities = append(facilities, resource)
+		case ResourceTypeEquipment:
+			equipment = append(equipment, resource)
+		case ResourceTypeMedicine:
+			medicine = append(medicine, resource)
+		}
+	}
 
-	_, err := appointmentsColl.UpdateOne(ctx, filter, update)
+	update := bson.M{
+		"$set": bson.M{
+			"status":     "scheduled",
+			"facilities": facilities,
+			"equipment":  equipment,
+			"medicines":  medicine,
+		},
+		"$inc": bson.M{"version": 1},
+	}
+	appointment, err := m.decideRequested(ctx, appointmentId, version, update)
 	if err != nil {
 		return Appointment{}, fmt.Errorf("scheduleAppointment: %w", err)
 	}
 
-	return m.AppointmentById(ctx, appointmentId)
+	return appointment, nil
 }
 
 func (m *MongoDb) denyAppointment(
 	ctx context.Context,
 	appointmentId uuid.UUID,
+	version int64,
 	reason *string,
 ) (Appointment, error) {
-	appointmentsColl := m.Database.Collection(appointmentsCollection)
-	update := bson.M{"$set": bson.M{"status": "denied", "denialReason": reason}}
-	filter := bson.M{"_id": appointmentId}
-
-	_, err := appointmentsColl.UpdateOne(ctx, filter, update)
+	update := bson.M{
+		"$set": bson.M{"status": "denied", "denialReason": reason},
+		"$inc": bson.M{"version": 1},
+	}
+	appointment, err := m.decideRequested(ctx, appointmentId, version, update)
 	if err != nil {
 		return Appointment{}, fmt.Errorf("denyAppointment: %w", err)
 	}
 
-	return m.AppointmentById(ctx, appointmentId)
+	return appointment, nil
 }
 
-func (m *MongoDb) updateAppointmentResources(
+// decideRequested applies the decision update to the appointment if it is
+// still requested at version, a concurrent decision or change of the
+// appointment fails it with ErrVersionMismatch.
+func (m *MongoDb) decideRequested(
 	ctx context.Context,
 	appointmentId uuid.UUID,
-	resources []Resource,
+	version int64,
+	update bson.M,
 ) (Appointment, error) {
 	appointmentsColl := m.Database.Collection(appointmentsCollection)
-
-	var facilities, equipment, medicine []Resource
-	for _, resource := range resources {
-		switch resource.Type {
-		case ResourceTypeFacility:
-			facilities = append(facilities, resource)
-		case ResourceTypeEquipment:
-			equipment = append(equipment, resource)
-		case ResourceTypeMedicine:
-			medicine = append(medicine, resource)
-		}
-	}
-
-	update := bson.M{
-		"$set": bson.M{
-			"facilities": facilities,
-			"equipment":  equipment,
-			"medicines":  medicine,
-		},
+	filter := bson.M{
+		"_id":     appointmentId,
+		"status":  "requested",
+		"version": versionFilter(version),
 	}
-	filter := bson.M{"_id": appointmentId}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
 
-	_, err := appointmentsColl.UpdateOne(ctx, filter, update)
+	var appointment Appointment
+	err := appointmentsColl.FindOneAndUpdate(ctx, filter, update, opts).Decode(&appointment)
 	if err != nil {
-		return Appointment{}, fmt.Errorf("updateAppointmentResources: %w", err)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return Appointment{}, m.missingOrMoved(
+				ctx,
+				appointmentsCollection,
+				bson.M{"_id": appointmentId},
+			)
+		}
+		return Appointment{}, err
 	}
 
-	return m.AppointmentById(ctx, appointmentId)
+	return appointment, nil
 }
 
 func (m *MongoDb) appointmentExists(ctx context.Context, id uuid.UUID) error {
@@ -535,3 +959,14 @@ func (m *MongoDb) appointmentExists(ctx context.Context, id uuid.UUID) error {
 
 	return nil
 }
+
+// overlappingAppointmentsFilter matches active appointments of the doctor whose
+// [appointmentDateTime, endTime) interval intersects [start, end).
+func overlappingAppointmentsFilter(doctorId uuid.UUID, start, end time.Time) bson.M {
+	return bson.M{
+		"doctorId":            doctorId,
+		"appointmentDateTime": bson.M{"$lt": end},
+		"endTime":             bson.M{"$gt": start},
+		"status":              bson.M{"$nin": []string{"cancelled", "denied"}},
+	}
+}
@@ -0,0 +1,222 @@
+package data
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+var ErrAuditChainContention = errors.New("audit chain is being appended by too many writers")
+
+const (
+	// how many times an append is retried when another writer takes the
+	// next sequence number first
+	auditAppendAttempts = 10
+	// bounds of the random wait before a retry, the upper one doubles with
+	// each attempt up to auditAppendBackoffMax
+	auditAppendBackoffBase = 5 * time.Millisecond
+	auditAppendBackoffMax  = 500 * time.Millisecond
+)
+
+// AuditEvent is an entry of the append-only audit log. Events are chained
+// by their sequence number, each one stores the hash of its predecessor and
+// its own hash covers it, so changing or removing any past event breaks the
+// chain from that point on.
+type AuditEvent struct {
+	Id        uuid.UUID              `bson:"_id"                 json:"id"`
+	Seq       int64                  `bson:"seq"                 json:"seq"`
+	Actor     string                 `bson:"actor"               json:"actor"`
+	Action    string                 `bson:"action"              json:"action"`
+	TargetId  uuid.UUID              `bson:"targetId"            json:"targetId"`
+	Diff      map[string]AuditChange `bson:"diff,omitempty"      json:"diff,omitempty"`
+	RequestId string                 `bson:"requestId,omitempty" json:"requestId,omitempty"`
+	CreatedAt time.Time              `bson:"createdAt"           json:"createdAt"`
+	PrevHash  string                 `bson:"prevHash"            json:"prevHash"`
+	Hash      string                 `bson:"hash"                json:"hash"`
+}
+
+// AuditChange holds JSON encoded values of a field before and after the
+// audited action. A nil value means the field wasn't present.
+type AuditChange struct {
+	Before json.RawMessage `bson:"before,omitempty" json:"before,omitempty"`
+	After  json.RawMessage `bson:"after,omitempty"  json:"after,omitempty"`
+}
+
+// AuditFilter selects events of the audit log. Events are returned in pages
+// of at most Limit events, starting after the event with sequence number
+// AfterSeq.
+type AuditFilter struct {
+	TargetId *uuid.UUID
+	Actor    *string
+	From     *time.Time
+	To       *time.Time
+	AfterSeq int64
+	Limit    int
+}
+
+// ComputeHash returns the hex encoded SHA-256 hash of the event's content
+// and the hash of its predecessor.
+func (e AuditEvent) ComputeHash() (string, error) {
+	payload, err := json.Marshal(struct {
+		Id        uuid.UUID              `json:"id"`
+		Seq       int64                  `json:"seq"`
+		Actor     string                 `json:"actor"`
+		Action    string                 `json:"action"`
+		TargetId  uuid.UUID              `json:"targetId"`
+		Diff      map[string]AuditChange `json:"diff,omitempty"`
+		RequestId string                 `json:"requestId,omitempty"`
+		CreatedAt string                 `json:"createdAt"`
+		PrevHash  string                 `json:"prevHash"`
+	}{
+		Id:        e.Id,
+		Seq:       e.Seq,
+		Actor:     e.Actor,
+		Action:    e.Action,
+		TargetId:  e.TargetId,
+		Diff:      e.Diff,
+		RequestId: e.RequestId,
+		CreatedAt: e.CreatedAt.UTC().Format(time.RFC3339Nano),
+		PrevHash:  e.PrevHash,
+	})
+	if err != nil {
+		return "", fmt.Errorf("ComputeHash: %w", err)
+	}
+
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// chain links the event after the last event of the chain, prev is nil if
+// the chain is empty.
+func (e *AuditEvent) chain(prev *AuditEvent) error {
+	e.Seq = 1
+	e.PrevHash = ""
+	if prev != nil {
+		e.Seq = prev.Seq + 1
+		e.PrevHash = prev.Hash
+	}
+
+	hash, err := e.ComputeHash()
+	if err != nil {
+		return err
+	}
+	e.Hash = hash
+	return nil
+}
+
+// newAuditEvent prepares the event for appending. Creation time is truncated
+// to milliseconds, which is the precision all storages can round trip, so the
+// hash stays verifiable after reading the event back.
+func newAuditEvent(event AuditEvent) AuditEvent {
+	event.Id = uuid.New()
+	event.CreatedAt = time.Now().UTC().Truncate(time.Millisecond)
+	return event
+}
+
+// auditAppendBackoff waits a random time before the attempt-th retry of an
+// append, so that contending writers don't keep colliding. It returns early
+// with the context's error if it's cancelled.
+func auditAppendBackoff(ctx context.Context, attempt int) error {
+	ceiling := min(auditAppendBackoffBase<<attempt, auditAppendBackoffMax)
+	timer := time.NewTimer(rand.N(ceiling))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (m *MongoDb) AppendAuditEvent(ctx context.Context, event AuditEvent) (AuditEvent, error) {
+	collection := m.Database.Collection(auditEventsCollection)
+	event = newAuditEvent(event)
+
+	for attempt := range auditAppendAttempts {
+		if attempt > 0 {
+			if err := auditAppendBackoff(ctx, attempt); err != nil {
+				return AuditEvent{}, fmt.Errorf("AppendAuditEvent: %w", err)
+			}
+		}
+
+		var last *AuditEvent
+		var prev AuditEvent
+		opts := options.FindOne().SetSort(bson.D{{Key: "seq", Value: -1}})
+		err := collection.FindOne(ctx, bson.M{}, opts).Decode(&prev)
+		if err == nil {
+			last = &prev
+		} else if !errors.Is(err, mongo.ErrNoDocuments) {
+			return AuditEvent{}, fmt.Errorf("AppendAuditEvent: failed to find last event: %w", err)
+		}
+
+		if err := event.chain(last); err != nil {
+			return AuditEvent{}, fmt.Errorf("AppendAuditEvent: %w", err)
+		}
+
+		_, err = collection.InsertOne(ctx, event)
+		if mongo.IsDuplicateKeyError(err) {
+			// someone else appended an event with the same seq, retry
+			// on top of theirs
+			continue
+		} else if err != nil {
+			return AuditEvent{}, fmt.Errorf("AppendAuditEvent: failed to insert document: %w", err)
+		}
+
+		return event, nil
+	}
+
+	return AuditEvent{}, fmt.Errorf("AppendAuditEvent: %w", ErrAuditChainContention)
+}
+
+func (m *MongoDb) AuditEvents(ctx context.Context, filter AuditFilter) ([]AuditEvent, error) {
+	collection := m.Database.Collection(auditEventsCollection)
+	events := make([]AuditEvent, 0)
+
+	query := bson.M{"seq": bson.M{"$gt": filter.AfterSeq}}
+	if filter.TargetId != nil {
+		query["targetId"] = *filter.TargetId
+	}
+	if filter.Actor != nil {
+		query["actor"] = *filter.Actor
+	}
+	createdAt := bson.M{}
+	if filter.From != nil {
+		createdAt["$gte"] = *filter.From
+	}
+	if filter.To != nil {
+		createdAt["$lte"] = *filter.To
+	}
+	if len(createdAt) != 0 {
+		query["createdAt"] = createdAt
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "seq", Value: 1}}).SetLimit(int64(filter.Limit))
+	cursor, err := collection.Find(ctx, query, opts)
+	if err != nil {
+		return nil, fmt.Errorf("AuditEvents: find failed: %w", err)
+	}
+
+	defer func() {
+		if cerr := cursor.Close(ctx); cerr != nil {
+			slog.WarnContext(ctx, "Failed to close cursor", "error", cerr.Error())
+		}
+	}()
+
+	if err = cursor.All(ctx, &events); err != nil {
+		return nil, fmt.Errorf("AuditEvents: decode failed: %w", err)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,69 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// CalendarFeed is the secret iCalendar feed of a user's appointments. Only a
+// hash of its token is stored, a user has at most one feed.
+type CalendarFeed struct {
+	UserId    uuid.UUID `bson:"_id"       json:"userId"`
+	Role      string    `bson:"role"      json:"role"`
+	TokenHash string    `bson:"tokenHash" json:"tokenHash"`
+	CreatedAt time.Time `bson:"createdAt" json:"createdAt"`
+}
+
+// SaveCalendarFeed stores the feed, replacing the user's previous one.
+func (m *MongoDb) SaveCalendarFeed(ctx context.Context, feed CalendarFeed) error {
+	collection := m.Database.Collection(calendarFeedsCollection)
+	filter := bson.M{"_id": feed.UserId}
+
+	_, err := collection.ReplaceOne(ctx, filter, feed, options.Replace().SetUpsert(true))
+	if err != nil {
+		return fmt.Errorf("SaveCalendarFeed: failed to upsert document: %w", err)
+	}
+
+	return nil
+}
+
+func (m *MongoDb) CalendarFeedByTokenHash(
+	ctx context.Context,
+	tokenHash string,
+) (CalendarFeed, error) {
+	collection := m.Database.Collection(calendarFeedsCollection)
+	filter := bson.M{"tokenHash": tokenHash}
+
+	var feed CalendarFeed
+	err := collection.FindOne(ctx, filter).Decode(&feed)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return CalendarFeed{}, ErrNotFound
+		}
+		return CalendarFeed{}, fmt.Errorf("CalendarFeedByTokenHash: failed to find document: %w", err)
+	}
+
+	return feed, nil
+}
+
+func (m *MongoDb) DeleteCalendarFeed(ctx context.Context, userId uuid.UUID) error {
+	collection := m.Database.Collection(calendarFeedsCollection)
+	filter := bson.M{"_id": userId}
+
+	res, err := collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("DeleteCalendarFeed: failed to delete document: %w", err)
+	}
+	if res.DeletedCount == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
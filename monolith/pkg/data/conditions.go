@@ -19,6 +19,9 @@ type Condition struct {
 	Name      string     `bson:"name"          json:"name"`
 	Start     time.Time  `bson:"start"         json:"start"`
 	End       *time.Time `bson:"end,omitempty" json:"end,omitempty"`
+	// Version is bumped by every update, an update expecting another version
+	// fails with ErrVersionMismatch.
+	Version int64 `bson:"version" json:"version"`
 }
 
 func (m *MongoDb) CreateCondition(ctx context.Context, condition Condition) (Condition, error) {
@@ -28,6 +31,7 @@ func (m *MongoDb) CreateCondition(ctx context.Context, condition Condition) (Con
 
 	collection := m.Database.Collection(conditionsCollection)
 	condition.Id = uuid.New()
+	condition.Version = 1
 
 	_, err := collection.InsertOne(ctx, condition)
 	if err != nil {
@@ -79,7 +83,7 @@ func (m *MongoDb) FindConditionsByPatientId(
 
 	defer func() {
 		if cerr := cursor.Close(ctx); cerr != nil {
-			slog.Warn("Failed to close cursor", "error", cerr.Error())
+			slog.WarnContext(ctx, "Failed to close cursor", "error", cerr.Error())
 		}
 	}()
 
@@ -94,6 +98,8 @@ func (m *MongoDb) FindConditionsByPatientId(
 	return conditions, nil
 }
 
+// UpdateCondition replaces the condition if it is still at condition.Version,
+// otherwise it fails with ErrVersionMismatch.
 func (m *MongoDb) UpdateCondition(
 	ctx context.Context,
 	id uuid.UUID,
@@ -107,7 +113,8 @@ func (m *MongoDb) UpdateCondition(
 	}
 
 	collection := m.Database.Collection(conditionsCollection)
-	filter := bson.M{"_id": id}
+	filter := bson.M{"_id": id, "version": versionFilter(condition.Version)}
+	condition.Version++
 
 	opts := options.FindOneAndReplace().SetReturnDocument(options.After)
 
@@ -116,7 +123,7 @@ func (m *MongoDb) UpdateCondition(
 		Decode(&updatedCondition)
 	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
-			return Condition{}, ErrNotFound
+			return Condition{}, m.missingOrMoved(ctx, conditionsCollection, bson.M{"_id": id})
 		}
 		return Condition{}, fmt.Errorf("UpdateCondition failed: %w", err)
 	}
@@ -156,23 +163,53 @@ func (m *MongoDb) FindConditionsByPatientIdAndDate(
 
 	defer func() {
 		if cerr := cursor.Close(ctx); cerr != nil {
-			slog.Warn("Failed to close conditions cursor", "error", cerr.Error())
+			slog.WarnContext(ctx, "Failed to close conditions cursor", "error", cerr.Error())
 		}
 	}()
 
 	if err = cursor.All(ctx, &conditions); err != nil {
-		slog.Error("Failed to decode condition documents from cursor", "error", err)
+		slog.ErrorContext(ctx, "Failed to decode condition documents from cursor", "error", err)
 		return nil, fmt.Errorf("FindConditionsByPatientIdAndDate decode failed: %w", err)
 	}
 
 	if err = cursor.Err(); err != nil {
-		slog.Error("Conditions cursor iteration error", "error", err)
+		slog.ErrorContext(ctx, "Conditions cursor iteration error", "error", err)
 		return nil, fmt.Errorf("FindConditionsByPatientIdAndDate cursor error: %w", err)
 	}
 
 	return conditions, nil
 }
 
+// AllConditionsByPatientId returns every condition of the patient, regardless
+// of when it started or ended.
+func (m *MongoDb) AllConditionsByPatientId(
+	ctx context.Context,
+	patientId uuid.UUID,
+) ([]Condition, error) {
+	collection := m.Database.Collection(conditionsCollection)
+	conditions := make([]Condition, 0)
+
+	filter := bson.M{"patientId": patientId}
+	opts := options.Find().SetSort(bson.D{{Key: "start", Value: 1}})
+
+	cursor, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("AllConditionsByPatientId: find failed: %w", err)
+	}
+
+	defer func() {
+		if cerr := cursor.Close(ctx); cerr != nil {
+			slog.WarnContext(ctx, "Failed to close cursor", "error", cerr.Error())
+		}
+	}()
+
+	if err = cursor.All(ctx, &conditions); err != nil {
+		return nil, fmt.Errorf("AllConditionsByPatientId: decode failed: %w", err)
+	}
+
+	return conditions, nil
+}
+
 func (m *MongoDb) conditionExists(ctx context.Context, id uuid.UUID) error {
 	conditionsColl := m.Database.Collection(conditionsCollection)
 	filter := bson.M{"_id": id}
@@ -1,7 +1,11 @@
 package data
 
 import (
+	"context"
 	"errors"
+	"time"
+
+	"github.com/google/uuid"
 )
 
 var (
@@ -9,6 +13,7 @@ var (
 	ErrNotFound            = errors.New("resource not found")
 	ErrDoctorUnavailable   = errors.New("doctor unavailable at the specified time")
 	ErrResourceUnavailable = errors.New("resource is unavailable during the requested time slot")
+	ErrVersionMismatch     = errors.New("resource was changed since the expected version")
 )
 
 type PaginationResult struct {
@@ -16,3 +21,256 @@ type PaginationResult struct {
 	Page     int
 	PageSize int
 }
+
+type AvailableResources struct {
+	Medicines  []Resource
+	Facilities []Resource
+	Equipment  []Resource
+}
+
+// Storage is the persistence layer used by the app. It is implemented by
+// MongoDb and PostgresDb, the active one is selected by the storage driver
+// in the server config.
+type Storage interface {
+	CreatePatient(ctx context.Context, patient Patient) (Patient, error)
+	PatientById(ctx context.Context, id uuid.UUID) (Patient, error)
+	PatientByEmail(ctx context.Context, email string) (Patient, error)
+	AnonymizePatient(ctx context.Context, id uuid.UUID, retainUntil time.Time) (Patient, error)
+
+	CreateDoctor(ctx context.Context, doctor Doctor) (Doctor, error)
+	DoctorById(ctx context.Context, id uuid.UUID) (Doctor, error)
+	DoctorByEmail(ctx context.Context, email string) (Doctor, error)
+	AvailableDoctors(
+		ctx context.Context,
+		dateTime time.Time,
+		duration time.Duration,
+	) ([]Doctor, error)
+	AnonymizeDoctor(ctx context.Context, id uuid.UUID, retainUntil time.Time) (Doctor, error)
+	GetAllDoctors(ctx context.Context) ([]Doctor, error)
+	SearchDoctors(
+		ctx context.Context,
+		specialization *string,
+		from time.Time,
+		to time.Time,
+	) ([]DoctorSchedule, error)
+	UpdateDoctorAppointmentDurations(
+		ctx context.Context,
+		id uuid.UUID,
+		durations map[string]int,
+	) (Doctor, error)
+
+	CreateAppointment(ctx context.Context, appointment Appointment) (Appointment, error)
+	AppointmentById(ctx context.Context, id uuid.UUID) (Appointment, error)
+	CancelAppointment(
+		ctx context.Context,
+		appointmentId uuid.UUID,
+		by string,
+		cancellationReason *string,
+		late bool,
+	) error
+	DecideAppointment(
+		ctx context.Context,
+		appointmentId uuid.UUID,
+		version int64,
+		decision string,
+		denyReason *string,
+		resources []Resource,
+	) (Appointment, error)
+	AppointmentsByDoctorId(
+		ctx context.Context,
+		doctorId uuid.UUID,
+		from time.Time,
+		to *time.Time,
+	) ([]Appointment, error)
+	AppointmentsByPatientId(
+		ctx context.Context,
+		patientId uuid.UUID,
+		from time.Time,
+		to *time.Time,
+	) ([]Appointment, error)
+	AppointmentsByDoctorIdAndDate(
+		ctx context.Context,
+		doctorId uuid.UUID,
+		date time.Time,
+	) ([]Appointment, error)
+	RescheduleAppointment(
+		ctx context.Context,
+		appointmentId uuid.UUID,
+		version int64,
+		newDateTime time.Time,
+	) (Appointment, error)
+	RescheduleAppointmentKeepingReservations(
+		ctx context.Context,
+		appointmentId uuid.UUID,
+		version int64,
+		newDateTime time.Time,
+	) (Appointment, []Reservation, error)
+	ReassignAppointment(
+		ctx context.Context,
+		appointmentId uuid.UUID,
+		doctorId uuid.UUID,
+		newDateTime time.Time,
+	) (Appointment, error)
+	AppointmentsByConditionId(ctx context.Context, conditionId uuid.UUID) ([]Appointment, error)
+	AllAppointmentsByPatientId(ctx context.Context, patientId uuid.UUID) ([]Appointment, error)
+
+	CreateAppointmentSeries(ctx context.Context, series AppointmentSeries) (AppointmentSeries, error)
+	AppointmentSeriesById(ctx context.Context, id uuid.UUID) (AppointmentSeries, error)
+	AppointmentsBySeriesId(ctx context.Context, seriesId uuid.UUID) ([]Appointment, error)
+
+	CreateCondition(ctx context.Context, condition Condition) (Condition, error)
+	ConditionById(ctx context.Context, id uuid.UUID) (Condition, error)
+	FindConditionsByPatientId(
+		ctx context.Context,
+		patientId uuid.UUID,
+		from time.Time,
+		to *time.Time,
+	) ([]Condition, error)
+	UpdateCondition(ctx context.Context, id uuid.UUID, condition Condition) (Condition, error)
+	FindConditionsByPatientIdAndDate(
+		ctx context.Context,
+		patientId uuid.UUID,
+		date time.Time,
+	) ([]Condition, error)
+	AllConditionsByPatientId(ctx context.Context, patientId uuid.UUID) ([]Condition, error)
+
+	CreatePrescription(ctx context.Context, prescription Prescription) (Prescription, error)
+	PrescriptionById(ctx context.Context, id uuid.UUID) (Prescription, error)
+	FindPrescriptionsByPatientId(
+		ctx context.Context,
+		patientId uuid.UUID,
+		from time.Time,
+		to *time.Time,
+	) ([]Prescription, error)
+	UpdatePrescription(
+		ctx context.Context,
+		id uuid.UUID,
+		prescription Prescription,
+	) (Prescription, error)
+	PrescriptionByAppointmentId(
+		ctx context.Context,
+		appointmentId uuid.UUID,
+	) ([]Prescription, error)
+	DeletePrescription(ctx context.Context, id uuid.UUID) error
+	AllPrescriptionsByPatientId(ctx context.Context, patientId uuid.UUID) ([]Prescription, error)
+
+	CreateResource(ctx context.Context, name string, typ ResourceType) (Resource, error)
+	ResourceById(ctx context.Context, id uuid.UUID) (Resource, error)
+	CreateReservation(
+		ctx context.Context,
+		appointmentId uuid.UUID,
+		resourceId uuid.UUID,
+		resourceName string,
+		resourceType ResourceType,
+		startTime time.Time,
+		endTime time.Time,
+	) (Reservation, error)
+	FindAvailableResourcesAtTime(
+		ctx context.Context,
+		start time.Time,
+		end time.Time,
+	) (AvailableResources, error)
+	DeleteReservationsByAppointmentId(ctx context.Context, appointmentId uuid.UUID) error
+	ResourcesByAppointmentId(ctx context.Context, appointmentId uuid.UUID) ([]Resource, error)
+	ReservationsByAppointmentId(
+		ctx context.Context,
+		appointmentId uuid.UUID,
+	) ([]Reservation, error)
+	ReservationsByAppointmentIds(
+		ctx context.Context,
+		appointmentIds []uuid.UUID,
+	) ([]Reservation, error)
+	ReservationsByResourceId(
+		ctx context.Context,
+		resourceId uuid.UUID,
+		from time.Time,
+		to *time.Time,
+	) ([]Reservation, error)
+
+	SaveCalendarFeed(ctx context.Context, feed CalendarFeed) error
+	CalendarFeedByTokenHash(ctx context.Context, tokenHash string) (CalendarFeed, error)
+	DeleteCalendarFeed(ctx context.Context, userId uuid.UUID) error
+
+	CreateNotification(ctx context.Context, notification Notification) (Notification, error)
+	DueNotifications(ctx context.Context, now time.Time, limit int) ([]Notification, error)
+	UpdateNotification(ctx context.Context, notification Notification) error
+
+	ScheduleReminders(ctx context.Context, appointmentId uuid.UUID, jobs []ReminderJob) error
+	CancelReminders(ctx context.Context, appointmentId uuid.UUID) error
+	ClaimDueReminder(
+		ctx context.Context,
+		now time.Time,
+		owner string,
+		lease time.Duration,
+	) (ReminderJob, error)
+	CompleteReminder(ctx context.Context, id uuid.UUID, owner string) error
+
+	CreateWaitlistEntry(ctx context.Context, entry WaitlistEntry) (WaitlistEntry, error)
+	WaitlistEntryById(ctx context.Context, id uuid.UUID) (WaitlistEntry, error)
+	WaitlistEntriesByPatientId(ctx context.Context, patientId uuid.UUID) ([]WaitlistEntry, error)
+	DeleteWaitlistEntry(ctx context.Context, id uuid.UUID) error
+	DeleteWaitlistEntriesByDoctorId(ctx context.Context, doctorId uuid.UUID) error
+	NextWaitlistCandidate(
+		ctx context.Context,
+		doctorId uuid.UUID,
+		start time.Time,
+		typ string,
+	) (WaitlistEntry, error)
+	CreateWaitlistOffer(ctx context.Context, offer WaitlistOffer) (WaitlistOffer, error)
+	PendingWaitlistOffer(ctx context.Context, entryId uuid.UUID) (WaitlistOffer, error)
+	PendingWaitlistOffersByDoctorIdAndDate(
+		ctx context.Context,
+		doctorId uuid.UUID,
+		date time.Time,
+	) ([]WaitlistOffer, error)
+	ExpiredWaitlistOffers(ctx context.Context, now time.Time, limit int) ([]WaitlistOffer, error)
+	ResolveWaitlistOffer(
+		ctx context.Context,
+		offer WaitlistOffer,
+		status WaitlistOfferStatus,
+		entryStatus WaitlistEntryStatus,
+	) error
+
+	CreateAbsence(ctx context.Context, absence Absence) (Absence, error)
+	AbsenceById(ctx context.Context, id uuid.UUID) (Absence, error)
+	AbsencesByDoctorId(
+		ctx context.Context,
+		doctorId uuid.UUID,
+		from time.Time,
+		to time.Time,
+	) ([]Absence, error)
+	UpdateAbsence(ctx context.Context, absence Absence) (Absence, error)
+	DeleteAbsence(ctx context.Context, id uuid.UUID) error
+	FlagAppointmentsForReschedule(
+		ctx context.Context,
+		doctorId uuid.UUID,
+		start time.Time,
+		end time.Time,
+	) ([]Appointment, error)
+
+	AppendAuditEvent(ctx context.Context, event AuditEvent) (AuditEvent, error)
+	AuditEvents(ctx context.Context, filter AuditFilter) ([]AuditEvent, error)
+
+	TakeRateLimitToken(
+		ctx context.Context,
+		key string,
+		limit RateLimit,
+		now time.Time,
+	) (time.Duration, error)
+
+	ReserveIdempotencyKey(
+		ctx context.Context,
+		record IdempotencyRecord,
+		now time.Time,
+	) (IdempotencyRecord, error)
+	CompleteIdempotencyKey(ctx context.Context, record IdempotencyRecord) error
+	ReleaseIdempotencyKey(ctx context.Context, principal string, key string) error
+
+	Ping(ctx context.Context) error
+	Disconnect(ctx context.Context) error
+}
+
+var (
+	_ Storage = (*MongoDb)(nil)
+	_ Storage = (*PostgresDb)(nil)
+)
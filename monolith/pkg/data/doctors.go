@@ -19,8 +19,20 @@ type Doctor struct {
 	FirstName      string    `bson:"firstName"      json:"firstName"`
 	LastName       string    `bson:"lastName"       json:"lastName"`
 	Specialization string    `bson:"specialization" json:"specialization"`
+
+	// AppointmentDurations overrides the default appointment length, in
+	// minutes, keyed by appointment type.
+	AppointmentDurations map[string]int `bson:"appointmentDurations,omitempty" json:"appointmentDurations,omitempty"`
+
+	// DeletedAt is set when the doctor's personal data were erased, the
+	// record itself is kept, so that appointments still reference it.
+	DeletedAt   *time.Time `bson:"deletedAt,omitempty"   json:"deletedAt,omitempty"`
+	RetainUntil *time.Time `bson:"retainUntil,omitempty" json:"retainUntil,omitempty"`
 }
 
+// AnonymizedDoctorName replaces first and last name of an erased doctor.
+const AnonymizedDoctorName = "Anonymized"
+
 func (m *MongoDb) CreateDoctor(ctx context.Context, doctor Doctor) (Doctor, error) {
 	collection := m.Database.Collection(doctorsCollection)
 	doctor.Id = uuid.New()
@@ -73,14 +85,76 @@ func (m *MongoDb) DoctorByEmail(ctx context.Context, email string) (Doctor, erro
 	return doctor, nil
 }
 
-func (m *MongoDb) AvailableDoctors(ctx context.Context, dateTime time.Time) ([]Doctor, error) {
+func (m *MongoDb) UpdateDoctorAppointmentDurations(
+	ctx context.Context,
+	id uuid.UUID,
+	durations map[string]int,
+) (Doctor, error) {
+	collection := m.Database.Collection(doctorsCollection)
+	filter := bson.M{"_id": id}
+	update := bson.M{"$set": bson.M{"appointmentDurations": durations}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var doctor Doctor
+	err := collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&doctor)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return Doctor{}, ErrNotFound
+		}
+		return Doctor{}, fmt.Errorf("UpdateDoctorAppointmentDurations failed: %w", err)
+	}
+
+	return doctor, nil
+}
+
+// AnonymizeDoctor replaces personal data of the doctor and marks them as
+// erased. ErrNotFound is returned if the doctor doesn't exist or was already
+// erased.
+func (m *MongoDb) AnonymizeDoctor(
+	ctx context.Context,
+	id uuid.UUID,
+	retainUntil time.Time,
+) (Doctor, error) {
+	collection := m.Database.Collection(doctorsCollection)
+	filter := bson.M{"_id": id, "deletedAt": nil}
+	update := bson.M{
+		"$set": bson.M{
+			"email":       anonymizedEmail(id),
+			"firstName":   AnonymizedDoctorName,
+			"lastName":    AnonymizedDoctorName,
+			"deletedAt":   time.Now(),
+			"retainUntil": retainUntil,
+		},
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var doctor Doctor
+	err := collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&doctor)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return Doctor{}, ErrNotFound
+		}
+		return Doctor{}, fmt.Errorf("AnonymizeDoctor failed: %w", err)
+	}
+
+	return doctor, nil
+}
+
+// AvailableDoctors returns doctors, who are neither absent nor have an active
+// appointment overlapping the duration long interval starting at dateTime.
+func (m *MongoDb) AvailableDoctors(
+	ctx context.Context,
+	dateTime time.Time,
+	duration time.Duration,
+) ([]Doctor, error) {
 	apptCollection := m.Database.Collection(appointmentsCollection)
 	doctorCollection := m.Database.Collection(doctorsCollection)
 
-	busyStatuses := []string{"requested", "Scheduled"}
+	busyStatuses := []string{"requested", "scheduled"}
+	end := dateTime.Add(duration)
 
 	appointmentFilter := bson.M{
-		"appointmentDateTime": bson.M{"$lte": dateTime},
+		"appointmentDateTime": bson.M{"$lt": end},
 		"endTime":             bson.M{"$gt": dateTime},
 		"status":              bson.M{"$in": busyStatuses},
 	}
@@ -99,7 +173,12 @@ func (m *MongoDb) AvailableDoctors(ctx context.Context, dateTime time.Time) ([]D
 	if cursor != nil {
 		defer func() {
 			if cerr := cursor.Close(ctx); cerr != nil {
-				slog.Warn("Failed to close conflicting appointments cursor", "error", cerr.Error())
+				slog.WarnContext(
+					ctx,
+					"Failed to close conflicting appointments cursor",
+					"error",
+					cerr.Error(),
+				)
 			}
 		}()
 
@@ -107,7 +186,12 @@ func (m *MongoDb) AvailableDoctors(ctx context.Context, dateTime time.Time) ([]D
 			DoctorId uuid.UUID `bson:"doctorId"`
 		}
 		if err = cursor.All(ctx, &results); err != nil {
-			slog.Error("Failed to decode conflicting appointment documents", "error", err)
+			slog.ErrorContext(
+				ctx,
+				"Failed to decode conflicting appointment documents",
+				"error",
+				err,
+			)
 			return nil, fmt.Errorf(
 				"AvailableDoctors decode conflicting appointments failed: %w",
 				err,
@@ -119,7 +203,7 @@ func (m *MongoDb) AvailableDoctors(ctx context.Context, dateTime time.Time) ([]D
 		}
 
 		if err = cursor.Err(); err != nil {
-			slog.Error("Conflicting appointments cursor iteration error", "error", err)
+			slog.ErrorContext(ctx, "Conflicting appointments cursor iteration error", "error", err)
 			return nil, fmt.Errorf(
 				"AvailableDoctors conflicting appointments cursor error: %w",
 				err,
@@ -127,13 +211,22 @@ func (m *MongoDb) AvailableDoctors(ctx context.Context, dateTime time.Time) ([]D
 		}
 	}
 
+	absentDoctorIds, err := m.absentDoctorIds(ctx, dateTime, end)
+	if err != nil {
+		return nil, fmt.Errorf("AvailableDoctors: %w", err)
+	}
+	for _, id := range absentDoctorIds {
+		busyDoctorIdsMap[id] = struct{}{}
+	}
+
 	busyDoctorIds := make([]uuid.UUID, 0, len(busyDoctorIdsMap))
 	for id := range busyDoctorIdsMap {
 		busyDoctorIds = append(busyDoctorIds, id)
 	}
 
 	doctorFilter := bson.M{
-		"_id": bson.M{"$nin": busyDoctorIds},
+		"_id":       bson.M{"$nin": busyDoctorIds},
+		"deletedAt": nil,
 	}
 
 	doctorCursor, err := doctorCollection.Find(
@@ -150,7 +243,8 @@ func (m *MongoDb) AvailableDoctors(ctx context.Context, dateTime time.Time) ([]D
 
 	defer func() {
 		if cerr := doctorCursor.Close(ctx); cerr != nil {
-			slog.Warn(
+			slog.WarnContext(
+				ctx,
 				"Failed to close available doctors cursor",
 				"error",
 				cerr.Error(),
@@ -160,12 +254,12 @@ func (m *MongoDb) AvailableDoctors(ctx context.Context, dateTime time.Time) ([]D
 
 	var availableDoctors []Doctor
 	if err = doctorCursor.All(ctx, &availableDoctors); err != nil {
-		slog.Error("Failed to decode available doctor documents", "error", err)
+		slog.ErrorContext(ctx, "Failed to decode available doctor documents", "error", err)
 		return nil, fmt.Errorf("AvailableDoctors decode available doctors failed: %w", err)
 	}
 
 	if err = doctorCursor.Err(); err != nil {
-		slog.Error("Available doctors cursor iteration error", "error", err)
+		slog.ErrorContext(ctx, "Available doctors cursor iteration error", "error", err)
 		return nil, fmt.Errorf("AvailableDoctors available doctors cursor error: %w", err)
 	}
 
@@ -191,7 +285,7 @@ func (m *MongoDb) GetAllDoctors(ctx context.Context) ([]Doctor, error) {
 	collection := m.Database.Collection(doctorsCollection)
 	doctors := make([]Doctor, 0)
 
-	filter := bson.M{}
+	filter := bson.M{"deletedAt": nil}
 
 	findOptions := options.Find().SetSort(
 		bson.D{{Key: "lastName", Value: 1}, {Key: "firstName", Value: 1}},
@@ -207,17 +301,17 @@ func (m *MongoDb) GetAllDoctors(ctx context.Context) ([]Doctor, error) {
 
 	defer func() {
 		if cerr := cursor.Close(ctx); cerr != nil {
-			slog.Warn("Failed to close doctors cursor", "error", cerr.Error())
+			slog.WarnContext(ctx, "Failed to close doctors cursor", "error", cerr.Error())
 		}
 	}()
 
 	if err = cursor.All(ctx, &doctors); err != nil {
-		slog.Error("Failed to decode doctor documents from cursor", "error", err)
+		slog.ErrorContext(ctx, "Failed to decode doctor documents from cursor", "error", err)
 		return nil, fmt.Errorf("GetAllDoctors decode failed: %w", err)
 	}
 
 	if err = cursor.Err(); err != nil {
-		slog.Error("Doctors cursor iteration error", "error", err)
+		slog.ErrorContext(ctx, "Doctors cursor iteration error", "error", err)
 		return nil, fmt.Errorf("GetAllDoctors cursor error: %w", err)
 	}
 
@@ -0,0 +1,102 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+var ErrIdempotencyKeyReserved = errors.New("idempotency key is already reserved")
+
+// IdempotencyRecord is the first response to a request with an idempotency
+// key, replayed to the retries of the request. Keys are scoped by the
+// principal making the request. RequestHash identifies the request, so that a
+// key reused for another request is rejected. Status is zero while the first
+// request is in flight.
+type IdempotencyRecord struct {
+	Principal   string    `bson:"principal"`
+	Key         string    `bson:"key"`
+	RequestHash string    `bson:"requestHash"`
+	Status      int       `bson:"status"`
+	ContentType string    `bson:"contentType,omitempty"`
+	Location    string    `bson:"location,omitempty"`
+	Body        []byte    `bson:"body,omitempty"`
+	ExpiresAt   time.Time `bson:"expiresAt"`
+}
+
+func idempotencyFilter(principal string, key string) bson.M {
+	return bson.M{"principal": principal, "key": key}
+}
+
+// ReserveIdempotencyKey stores the record of the first request with the key.
+// If the key is reserved and not expired, the existing record is returned
+// with ErrIdempotencyKeyReserved.
+func (m *MongoDb) ReserveIdempotencyKey(
+	ctx context.Context,
+	record IdempotencyRecord,
+	now time.Time,
+) (IdempotencyRecord, error) {
+	collection := m.Database.Collection(idempotencyKeysCollection)
+	filter := idempotencyFilter(record.Principal, record.Key)
+
+	// the TTL monitor drops expired records only once a minute
+	expired := idempotencyFilter(record.Principal, record.Key)
+	expired["expiresAt"] = bson.M{"$lte": now}
+	_, err := collection.DeleteOne(ctx, expired)
+	if err != nil {
+		return IdempotencyRecord{}, fmt.Errorf(
+			"ReserveIdempotencyKey: failed to delete expired document: %w",
+			err,
+		)
+	}
+
+	_, err = collection.InsertOne(ctx, record)
+	if mongo.IsDuplicateKeyError(err) {
+		var existing IdempotencyRecord
+		err = collection.FindOne(ctx, filter).Decode(&existing)
+		if err != nil {
+			return IdempotencyRecord{}, fmt.Errorf(
+				"ReserveIdempotencyKey: failed to find document: %w",
+				err,
+			)
+		}
+		return existing, ErrIdempotencyKeyReserved
+	} else if err != nil {
+		return IdempotencyRecord{}, fmt.Errorf(
+			"ReserveIdempotencyKey: failed to insert document: %w",
+			err,
+		)
+	}
+
+	return record, nil
+}
+
+// CompleteIdempotencyKey stores the response of the record's request.
+func (m *MongoDb) CompleteIdempotencyKey(ctx context.Context, record IdempotencyRecord) error {
+	collection := m.Database.Collection(idempotencyKeysCollection)
+	update := bson.M{"$set": bson.M{
+		"status":      record.Status,
+		"contentType": record.ContentType,
+		"location":    record.Location,
+		"body":        record.Body,
+	}}
+	_, err := collection.UpdateOne(ctx, idempotencyFilter(record.Principal, record.Key), update)
+	if err != nil {
+		return fmt.Errorf("CompleteIdempotencyKey: failed to update document: %w", err)
+	}
+	return nil
+}
+
+// ReleaseIdempotencyKey drops the key, so that the request can be retried.
+func (m *MongoDb) ReleaseIdempotencyKey(ctx context.Context, principal string, key string) error {
+	collection := m.Database.Collection(idempotencyKeysCollection)
+	_, err := collection.DeleteOne(ctx, idempotencyFilter(principal, key))
+	if err != nil {
+		return fmt.Errorf("ReleaseIdempotencyKey: failed to delete document: %w", err)
+	}
+	return nil
+}
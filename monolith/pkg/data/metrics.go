@@ -0,0 +1,37 @@
+package data
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.mongodb.org/mongo-driver/v2/event"
+	"go.opentelemetry.io/otel"
+)
+
+var mongoCommandDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "mongo_command_duration_seconds",
+	Help:    "Duration of commands sent to mongo by command and outcome.",
+	Buckets: prometheus.DefBuckets,
+}, []string{"command", "status"})
+
+// newCommandMonitor traces the commands sent to mongo and records how long
+// they took.
+func newCommandMonitor() *event.CommandMonitor {
+	tracing := &tracingMonitor{tracer: otel.Tracer(tracerName)}
+	return &event.CommandMonitor{
+		Started: tracing.started,
+		Succeeded: func(_ context.Context, evt *event.CommandSucceededEvent) {
+			observeCommand(evt.CommandFinishedEvent, "ok")
+			tracing.finished(evt.CommandFinishedEvent, nil)
+		},
+		Failed: func(_ context.Context, evt *event.CommandFailedEvent) {
+			observeCommand(evt.CommandFinishedEvent, "error")
+			tracing.finished(evt.CommandFinishedEvent, evt.Failure)
+		},
+	}
+}
+
+func observeCommand(evt event.CommandFinishedEvent, status string) {
+	mongoCommandDuration.WithLabelValues(evt.CommandName, status).Observe(evt.Duration.Seconds())
+}
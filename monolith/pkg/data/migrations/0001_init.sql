@@ -0,0 +1,96 @@
+-- btree_gist is required for the `=` operator on uuid columns in exclusion
+-- constraints
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+CREATE TABLE patients (
+    id         UUID PRIMARY KEY,
+    email      TEXT NOT NULL,
+    first_name TEXT NOT NULL,
+    last_name  TEXT NOT NULL,
+    CONSTRAINT idx_patient_email_unique UNIQUE (email)
+);
+
+CREATE TABLE doctors (
+    id             UUID PRIMARY KEY,
+    email          TEXT NOT NULL,
+    first_name     TEXT NOT NULL,
+    last_name      TEXT NOT NULL,
+    specialization TEXT NOT NULL,
+    CONSTRAINT idx_doctor_email_unique UNIQUE (email)
+);
+
+CREATE TABLE conditions (
+    id         UUID PRIMARY KEY,
+    patient_id UUID NOT NULL REFERENCES patients (id),
+    name       TEXT NOT NULL,
+    start_time TIMESTAMPTZ NOT NULL,
+    end_time   TIMESTAMPTZ
+);
+
+CREATE INDEX idx_condition_patient_id_start ON conditions (patient_id, start_time);
+
+CREATE TABLE appointments (
+    id                    UUID PRIMARY KEY,
+    patient_id            UUID NOT NULL REFERENCES patients (id),
+    doctor_id             UUID NOT NULL REFERENCES doctors (id),
+    appointment_date_time TIMESTAMPTZ NOT NULL,
+    end_time              TIMESTAMPTZ NOT NULL,
+    type                  TEXT NOT NULL DEFAULT '',
+    status                TEXT NOT NULL,
+    reason                TEXT,
+    condition_id          UUID REFERENCES conditions (id),
+    cancellation_reason   TEXT,
+    cancelled_by          TEXT,
+    denial_reason         TEXT,
+    CONSTRAINT appointment_end_after_start CHECK (end_time > appointment_date_time),
+    -- a doctor can't have two active appointments which overlap
+    CONSTRAINT appointment_doctor_no_overlap EXCLUDE USING gist (
+        doctor_id WITH =,
+        tstzrange(appointment_date_time, end_time) WITH &&
+    ) WHERE (status NOT IN ('cancelled', 'denied'))
+);
+
+CREATE INDEX idx_appointment_patient_id_datetime ON appointments (patient_id, appointment_date_time);
+CREATE INDEX idx_appointment_doctor_id_datetime ON appointments (doctor_id, appointment_date_time);
+CREATE INDEX idx_appointment_condition_id ON appointments (condition_id);
+CREATE INDEX idx_appointment_status ON appointments (status);
+
+CREATE TABLE prescriptions (
+    id             UUID PRIMARY KEY,
+    patient_id     UUID NOT NULL REFERENCES patients (id),
+    appointment_id UUID REFERENCES appointments (id),
+    name           TEXT NOT NULL,
+    start_time     TIMESTAMPTZ NOT NULL,
+    end_time       TIMESTAMPTZ NOT NULL,
+    doctors_note   TEXT
+);
+
+CREATE INDEX idx_prescription_patient_id_start ON prescriptions (patient_id, start_time);
+CREATE INDEX idx_prescription_appointment_id ON prescriptions (appointment_id);
+
+CREATE TABLE resources (
+    id   UUID PRIMARY KEY,
+    name TEXT NOT NULL,
+    type TEXT NOT NULL
+);
+
+CREATE INDEX idx_resource_type ON resources (type);
+
+CREATE TABLE reservations (
+    id             UUID PRIMARY KEY,
+    appointment_id UUID NOT NULL REFERENCES appointments (id) ON DELETE CASCADE,
+    resource_id    UUID NOT NULL REFERENCES resources (id),
+    resource_name  TEXT NOT NULL,
+    resource_type  TEXT NOT NULL,
+    start_time     TIMESTAMPTZ NOT NULL,
+    end_time       TIMESTAMPTZ NOT NULL,
+    CONSTRAINT reservation_end_after_start CHECK (end_time > start_time),
+    CONSTRAINT reservation_appointment_resource_unique UNIQUE (appointment_id, resource_id),
+    -- a resource can't be reserved twice for overlapping time slots
+    CONSTRAINT reservation_resource_no_overlap EXCLUDE USING gist (
+        resource_id WITH =,
+        tstzrange(start_time, end_time) WITH &&
+    )
+);
+
+CREATE INDEX idx_reservation_appointment_id ON reservations (appointment_id);
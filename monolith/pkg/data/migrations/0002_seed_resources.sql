@@ -0,0 +1,16 @@
+INSERT INTO resources (id, name, type) VALUES
+    (gen_random_uuid(), 'Operating Room 1', 'facility'),
+    (gen_random_uuid(), 'Consultation Room A', 'facility'),
+    (gen_random_uuid(), 'Radiology Suite', 'facility'),
+    (gen_random_uuid(), 'Physical Therapy Gym', 'facility'),
+    (gen_random_uuid(), 'Emergency Bay 3', 'facility'),
+    (gen_random_uuid(), 'Aspirin 100mg', 'medicine'),
+    (gen_random_uuid(), 'Amoxicillin 500mg', 'medicine'),
+    (gen_random_uuid(), 'Metformin 1000mg', 'medicine'),
+    (gen_random_uuid(), 'Salbutamol Inhaler', 'medicine'),
+    (gen_random_uuid(), 'Atorvastatin 20mg', 'medicine'),
+    (gen_random_uuid(), 'MRI Scanner', 'equipment'),
+    (gen_random_uuid(), 'X-Ray Machine', 'equipment'),
+    (gen_random_uuid(), 'Ultrasound Device', 'equipment'),
+    (gen_random_uuid(), 'Ventilator', 'equipment'),
+    (gen_random_uuid(), 'ECG Monitor', 'equipment');
@@ -0,0 +1,6 @@
+-- erased patients are kept anonymized, so that their clinical records can be
+-- retained for the legal retention period
+ALTER TABLE patients ADD COLUMN deleted_at TIMESTAMPTZ;
+ALTER TABLE patients ADD COLUMN retain_until TIMESTAMPTZ;
+
+ALTER TABLE prescriptions ADD COLUMN deleted_at TIMESTAMPTZ;
@@ -0,0 +1,29 @@
+-- diff is stored as JSON and not JSONB, because the event hash is computed
+-- over its exact text
+CREATE TABLE audit_events (
+    id         UUID NOT NULL UNIQUE,
+    seq        BIGINT PRIMARY KEY,
+    actor      TEXT NOT NULL,
+    action     TEXT NOT NULL,
+    target_id  UUID NOT NULL,
+    diff       JSON,
+    request_id TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL,
+    prev_hash  TEXT NOT NULL,
+    hash       TEXT NOT NULL
+);
+
+CREATE INDEX idx_audit_event_target_id ON audit_events (target_id);
+CREATE INDEX idx_audit_event_actor ON audit_events (actor);
+CREATE INDEX idx_audit_event_created_at ON audit_events (created_at);
+
+-- the audit log is append-only
+CREATE FUNCTION audit_events_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_events_append_only
+    BEFORE UPDATE OR DELETE ON audit_events
+    FOR EACH ROW EXECUTE FUNCTION audit_events_append_only();
@@ -0,0 +1,6 @@
+CREATE TABLE calendar_feeds (
+    user_id    UUID PRIMARY KEY,
+    role       TEXT NOT NULL,
+    token_hash TEXT NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL
+);
@@ -0,0 +1,12 @@
+CREATE TABLE reminder_jobs (
+    id             UUID PRIMARY KEY,
+    appointment_id UUID NOT NULL,
+    offset_seconds BIGINT NOT NULL,
+    due_at         TIMESTAMPTZ NOT NULL,
+    locked_by      TEXT,
+    locked_until   TIMESTAMPTZ,
+    created_at     TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_reminder_job_due_at ON reminder_jobs (due_at);
+CREATE INDEX idx_reminder_job_appointment_id ON reminder_jobs (appointment_id);
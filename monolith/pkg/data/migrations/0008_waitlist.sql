@@ -0,0 +1,28 @@
+CREATE TABLE waitlist_entries (
+    id         UUID PRIMARY KEY,
+    doctor_id  UUID NOT NULL REFERENCES doctors (id),
+    patient_id UUID NOT NULL REFERENCES patients (id),
+    from_day   TIMESTAMPTZ,
+    to_day     TIMESTAMPTZ,
+    type       TEXT,
+    status     TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_waitlist_entry_doctor_id_status_created_at ON waitlist_entries (doctor_id, status, created_at);
+CREATE INDEX idx_waitlist_entry_patient_id ON waitlist_entries (patient_id);
+
+CREATE TABLE waitlist_offers (
+    id                    UUID PRIMARY KEY,
+    entry_id              UUID NOT NULL REFERENCES waitlist_entries (id) ON DELETE CASCADE,
+    doctor_id             UUID NOT NULL,
+    appointment_date_time TIMESTAMPTZ NOT NULL,
+    type                  TEXT NOT NULL,
+    status                TEXT NOT NULL,
+    expires_at            TIMESTAMPTZ NOT NULL,
+    created_at            TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_waitlist_offer_doctor_id_datetime ON waitlist_offers (doctor_id, appointment_date_time);
+CREATE INDEX idx_waitlist_offer_entry_id ON waitlist_offers (entry_id);
+CREATE INDEX idx_waitlist_offer_status_expires_at ON waitlist_offers (status, expires_at);
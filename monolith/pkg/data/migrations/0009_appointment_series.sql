@@ -0,0 +1,15 @@
+CREATE TABLE appointment_series (
+    id         UUID PRIMARY KEY,
+    patient_id UUID NOT NULL REFERENCES patients (id),
+    doctor_id  UUID NOT NULL REFERENCES doctors (id),
+    type       TEXT NOT NULL,
+    frequency  TEXT NOT NULL,
+    interval   INTEGER NOT NULL,
+    count      INTEGER,
+    until      TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+ALTER TABLE appointments ADD COLUMN series_id UUID REFERENCES appointment_series (id);
+
+CREATE INDEX idx_appointment_series_id ON appointments (series_id);
@@ -0,0 +1 @@
+ALTER TABLE doctors ADD COLUMN appointment_durations JSONB;
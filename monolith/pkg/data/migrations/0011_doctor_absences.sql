@@ -0,0 +1,14 @@
+CREATE TABLE doctor_absences (
+    id         UUID PRIMARY KEY,
+    doctor_id  UUID NOT NULL REFERENCES doctors (id),
+    type       TEXT NOT NULL,
+    start_time TIMESTAMPTZ NOT NULL,
+    end_time   TIMESTAMPTZ NOT NULL,
+    note       TEXT,
+    created_at TIMESTAMPTZ NOT NULL,
+    CHECK (end_time > start_time)
+);
+
+CREATE INDEX idx_doctor_absence_doctor_id_start_time ON doctor_absences (doctor_id, start_time);
+
+ALTER TABLE appointments ADD COLUMN reschedule_required BOOLEAN NOT NULL DEFAULT false;
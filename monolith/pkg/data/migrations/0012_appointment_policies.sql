@@ -0,0 +1,5 @@
+ALTER TABLE appointments
+    ADD COLUMN reschedule_count  INT NOT NULL DEFAULT 0,
+    ADD COLUMN late_cancellation BOOLEAN NOT NULL DEFAULT false;
+
+ALTER TABLE patients ADD COLUMN late_cancellations INT NOT NULL DEFAULT 0;
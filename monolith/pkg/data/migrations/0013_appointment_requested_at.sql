@@ -0,0 +1 @@
+ALTER TABLE appointments ADD COLUMN requested_at TIMESTAMPTZ;
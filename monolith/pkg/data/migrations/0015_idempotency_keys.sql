@@ -0,0 +1,13 @@
+CREATE TABLE idempotency_keys (
+    principal    TEXT NOT NULL,
+    key          TEXT NOT NULL,
+    request_hash TEXT NOT NULL,
+    status       INTEGER NOT NULL,
+    content_type TEXT NOT NULL,
+    location     TEXT NOT NULL,
+    body         BYTEA,
+    expires_at   TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (principal, key)
+);
+
+CREATE INDEX idx_idempotency_key_expires_at ON idempotency_keys (expires_at);
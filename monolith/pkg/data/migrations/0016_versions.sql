@@ -0,0 +1,3 @@
+ALTER TABLE conditions ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE prescriptions ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE appointments ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
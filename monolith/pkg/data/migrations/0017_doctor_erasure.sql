@@ -0,0 +1,4 @@
+-- erased doctors are kept anonymized, so that appointments they attended can
+-- be retained for the legal retention period
+ALTER TABLE doctors ADD COLUMN deleted_at TIMESTAMPTZ;
+ALTER TABLE doctors ADD COLUMN retain_until TIMESTAMPTZ;
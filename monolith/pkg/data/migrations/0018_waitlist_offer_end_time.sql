@@ -0,0 +1,3 @@
+-- offers keep the end of the freed slot, offers made before have none and
+-- are booked with the doctor's duration for their type
+ALTER TABLE waitlist_offers ADD COLUMN end_time TIMESTAMPTZ;
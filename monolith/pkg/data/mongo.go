@@ -18,13 +18,23 @@ type MongoDb struct {
 }
 
 const (
-	patientsCollection      = "patients"
-	doctorsCollection       = "doctors"
-	conditionsCollection    = "conditions"
-	prescriptionsCollection = "prescriptions"
-	appointmentsCollection  = "appointments"
-	resourcesCollection     = "resources"
-	reservationsCollection  = "reservations"
+	patientsCollection          = "patients"
+	doctorsCollection           = "doctors"
+	conditionsCollection        = "conditions"
+	prescriptionsCollection     = "prescriptions"
+	appointmentsCollection      = "appointments"
+	resourcesCollection         = "resources"
+	reservationsCollection      = "reservations"
+	auditEventsCollection       = "audit_events"
+	calendarFeedsCollection     = "calendar_feeds"
+	notificationsCollection     = "notifications"
+	reminderJobsCollection      = "reminder_jobs"
+	waitlistEntriesCollection   = "waitlist_entries"
+	waitlistOffersCollection    = "waitlist_offers"
+	appointmentSeriesCollection = "appointment_series"
+	doctorAbsencesCollection    = "doctor_absences"
+	rateLimitBucketsCollection  = "rate_limit_buckets"
+	idempotencyKeysCollection   = "idempotency_keys"
 )
 
 var Collections = []string{
@@ -35,6 +45,16 @@ var Collections = []string{
 	appointmentsCollection,
 	resourcesCollection,
 	reservationsCollection,
+	auditEventsCollection,
+	calendarFeedsCollection,
+	notificationsCollection,
+	reminderJobsCollection,
+	waitlistEntriesCollection,
+	waitlistOffersCollection,
+	appointmentSeriesCollection,
+	doctorAbsencesCollection,
+	rateLimitBucketsCollection,
+	idempotencyKeysCollection,
 }
 
 var (
@@ -47,7 +67,10 @@ func ConnectMongo(ctx context.Context, uri string, db string) (*MongoDb, error)
 	mongoRegistry.RegisterTypeEncoder(tUUID, bson.ValueEncoderFunc(uuidEncodeValue))
 	mongoRegistry.RegisterTypeDecoder(tUUID, bson.ValueDecoderFunc(uuidDecodeValue))
 
-	opts := options.Client().ApplyURI(uri).SetRegistry(mongoRegistry)
+	opts := options.Client().
+		ApplyURI(uri).
+		SetRegistry(mongoRegistry).
+		SetMonitor(newCommandMonitor())
 	client, err := mongo.Connect(opts)
 	if err != nil {
 		return nil, fmt.Errorf("ConnectMongo: %w", err)
@@ -111,6 +134,10 @@ func initIndexes(ctx context.Context, mongoDb *mongo.Database) error {
 				Keys:    bson.D{{Key: "email", Value: 1}},
 				Options: options.Index().SetUnique(true).SetName("idx_doctor_email_unique"),
 			},
+			{
+				Keys:    bson.D{{Key: "specialization", Value: 1}},
+				Options: options.Index().SetName("idx_doctor_specialization"),
+			},
 		},
 		conditionsCollection: {
 			{
@@ -141,6 +168,17 @@ func initIndexes(ctx context.Context, mongoDb *mongo.Database) error {
 				Keys:    bson.D{{Key: "appointmentDateTime", Value: 1}},
 				Options: options.Index().SetName("idx_appointment_datetime"),
 			},
+			{
+				Keys:    bson.D{{Key: "seriesId", Value: 1}},
+				Options: options.Index().SetName("idx_appointment_seriesId"),
+			},
+			{
+				Keys: bson.D{
+					{Key: "doctorId", Value: 1},
+					{Key: "appointmentDateTime", Value: 1},
+				},
+				Options: options.Index().SetName("idx_appointment_doctorId_datetime"),
+			},
 		},
 		resourcesCollection: {
 			{
@@ -161,6 +199,102 @@ func initIndexes(ctx context.Context, mongoDb *mongo.Database) error {
 				Options: options.Index().SetName("idx_reservation_appointmentId"),
 			},
 		},
+		auditEventsCollection: {
+			{
+				Keys:    bson.D{{Key: "seq", Value: 1}},
+				Options: options.Index().SetUnique(true).SetName("idx_audit_event_seq_unique"),
+			},
+			{
+				Keys:    bson.D{{Key: "targetId", Value: 1}},
+				Options: options.Index().SetName("idx_audit_event_targetId"),
+			},
+			{
+				Keys:    bson.D{{Key: "actor", Value: 1}},
+				Options: options.Index().SetName("idx_audit_event_actor"),
+			},
+			{
+				Keys:    bson.D{{Key: "createdAt", Value: 1}},
+				Options: options.Index().SetName("idx_audit_event_createdAt"),
+			},
+		},
+		calendarFeedsCollection: {
+			{
+				Keys:    bson.D{{Key: "tokenHash", Value: 1}},
+				Options: options.Index().SetUnique(true).SetName("idx_calendar_feed_tokenHash_unique"),
+			},
+		},
+		notificationsCollection: {
+			{
+				Keys:    bson.D{{Key: "status", Value: 1}, {Key: "nextAttemptAt", Value: 1}},
+				Options: options.Index().SetName("idx_notification_status_nextAttemptAt"),
+			},
+		},
+		reminderJobsCollection: {
+			{
+				Keys:    bson.D{{Key: "dueAt", Value: 1}},
+				Options: options.Index().SetName("idx_reminder_job_dueAt"),
+			},
+			{
+				Keys:    bson.D{{Key: "appointmentId", Value: 1}},
+				Options: options.Index().SetName("idx_reminder_job_appointmentId"),
+			},
+		},
+		waitlistEntriesCollection: {
+			{
+				Keys: bson.D{
+					{Key: "doctorId", Value: 1},
+					{Key: "status", Value: 1},
+					{Key: "createdAt", Value: 1},
+				},
+				Options: options.Index().SetName("idx_waitlist_entry_doctorId_status_createdAt"),
+			},
+			{
+				Keys:    bson.D{{Key: "patientId", Value: 1}},
+				Options: options.Index().SetName("idx_waitlist_entry_patientId"),
+			},
+		},
+		waitlistOffersCollection: {
+			{
+				Keys:    bson.D{{Key: "doctorId", Value: 1}, {Key: "appointmentDateTime", Value: 1}},
+				Options: options.Index().SetName("idx_waitlist_offer_doctorId_appointmentDateTime"),
+			},
+			{
+				Keys:    bson.D{{Key: "entryId", Value: 1}},
+				Options: options.Index().SetName("idx_waitlist_offer_entryId"),
+			},
+			{
+				Keys:    bson.D{{Key: "status", Value: 1}, {Key: "expiresAt", Value: 1}},
+				Options: options.Index().SetName("idx_waitlist_offer_status_expiresAt"),
+			},
+		},
+		doctorAbsencesCollection: {
+			{
+				Keys:    bson.D{{Key: "doctorId", Value: 1}, {Key: "start", Value: 1}},
+				Options: options.Index().SetName("idx_doctor_absence_doctorId_start"),
+			},
+		},
+		rateLimitBucketsCollection: {
+			{
+				Keys: bson.D{{Key: "expiresAt", Value: 1}},
+				Options: options.Index().
+					SetExpireAfterSeconds(0).
+					SetName("idx_rate_limit_bucket_expiresAt_ttl"),
+			},
+		},
+		idempotencyKeysCollection: {
+			{
+				Keys: bson.D{{Key: "principal", Value: 1}, {Key: "key", Value: 1}},
+				Options: options.Index().
+					SetUnique(true).
+					SetName("idx_idempotency_key_principal_key_unique"),
+			},
+			{
+				Keys: bson.D{{Key: "expiresAt", Value: 1}},
+				Options: options.Index().
+					SetExpireAfterSeconds(0).
+					SetName("idx_idempotency_key_expiresAt_ttl"),
+			},
+		},
 	}
 
 	for collName, indexModels := range indexes {
@@ -183,10 +317,37 @@ func initIndexes(ctx context.Context, mongoDb *mongo.Database) error {
 	return nil
 }
 
+func (m *MongoDb) Ping(ctx context.Context) error {
+	return m.Database.Client().Ping(ctx, nil)
+}
+
 func (m *MongoDb) Disconnect(ctx context.Context) error {
 	return m.Database.Client().Disconnect(ctx)
 }
 
+// versionFilter matches documents at version. Documents written before they
+// were versioned have no version and are at version 0.
+func versionFilter(version int64) any {
+	if version == 0 {
+		return bson.M{"$in": bson.A{0, nil}}
+	}
+	return version
+}
+
+// missingOrMoved tells why a versioned update of the document matching filter
+// matched none, ErrNotFound if there is no such document, ErrVersionMismatch
+// if it is at another version.
+func (m *MongoDb) missingOrMoved(ctx context.Context, collection string, filter bson.M) error {
+	count, err := m.Database.Collection(collection).CountDocuments(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("missingOrMoved: failed to count documents: %w", err)
+	}
+	if count == 0 {
+		return ErrNotFound
+	}
+	return ErrVersionMismatch
+}
+
 func uuidEncodeValue(ec bson.EncodeContext, vw bson.ValueWriter, val reflect.Value) error {
 	if !val.IsValid() || val.Type() != tUUID {
 		return bsoncodec.ValueEncoderError{
@@ -264,7 +425,7 @@ func seedResources(ctx context.Context, db *MongoDb) error {
 	for _, name := range facilities {
 		_, err := db.CreateResource(ctx, name, ResourceTypeFacility)
 		if err != nil {
-			slog.Error("Failed to create facility", "name", name, "error", err)
+			slog.ErrorContext(ctx, "Failed to create facility", "name", name, "error", err)
 			return fmt.Errorf("failed to seed facility %q: %w", name, err)
 		}
 	}
@@ -272,7 +433,7 @@ func seedResources(ctx context.Context, db *MongoDb) error {
 	for _, name := range medicines {
 		_, err := db.CreateResource(ctx, name, ResourceTypeMedicine)
 		if err != nil {
-			slog.Error("Failed to create medicine", "name", name, "error", err)
+			slog.ErrorContext(ctx, "Failed to create medicine", "name", name, "error", err)
 			return fmt.Errorf("failed to seed medicine %q: %w", name, err)
 		}
 	}
@@ -280,7 +441,7 @@ func seedResources(ctx context.Context, db *MongoDb) error {
 	for _, name := range equipment {
 		_, err := db.CreateResource(ctx, name, ResourceTypeEquipment)
 		if err != nil {
-			slog.Error("Failed to create equipment", "name", name, "error", err)
+			slog.ErrorContext(ctx, "Failed to create equipment", "name", name, "error", err)
 			return fmt.Errorf("failed to seed equipment %q: %w", name, err)
 		}
 	}
@@ -0,0 +1,111 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+type NotificationStatus string
+
+const (
+	NotificationStatusPending NotificationStatus = "pending"
+	NotificationStatusSent    NotificationStatus = "sent"
+	// NotificationStatusFailed is final, the notification won't be retried.
+	NotificationStatusFailed NotificationStatus = "failed"
+)
+
+// Notification is a rendered message about an appointment waiting for, or
+// already after, its delivery. Pending notifications are delivered once
+// their NextAttemptAt passes.
+type Notification struct {
+	Id            uuid.UUID          `bson:"_id"                 json:"id"`
+	AppointmentId uuid.UUID          `bson:"appointmentId"       json:"appointmentId"`
+	Kind          string             `bson:"kind"                json:"kind"`
+	Recipient     string             `bson:"recipient"           json:"recipient"`
+	Subject       string             `bson:"subject"             json:"subject"`
+	Body          string             `bson:"body"                json:"body"`
+	Status        NotificationStatus `bson:"status"              json:"status"`
+	Attempts      int                `bson:"attempts"            json:"attempts"`
+	LastError     string             `bson:"lastError,omitempty" json:"lastError,omitempty"`
+	CreatedAt     time.Time          `bson:"createdAt"           json:"createdAt"`
+	NextAttemptAt time.Time          `bson:"nextAttemptAt"       json:"nextAttemptAt"`
+	SentAt        *time.Time         `bson:"sentAt,omitempty"    json:"sentAt,omitempty"`
+}
+
+// newNotification prepares a notification for its first delivery attempt.
+func newNotification(n Notification) Notification {
+	n.Id = uuid.New()
+	n.Status = NotificationStatusPending
+	n.Attempts = 0
+	n.CreatedAt = time.Now()
+	n.NextAttemptAt = n.CreatedAt
+	return n
+}
+
+func (m *MongoDb) CreateNotification(ctx context.Context, n Notification) (Notification, error) {
+	collection := m.Database.Collection(notificationsCollection)
+	n = newNotification(n)
+
+	_, err := collection.InsertOne(ctx, n)
+	if err != nil {
+		return Notification{}, fmt.Errorf("CreateNotification: failed to insert document: %w", err)
+	}
+
+	return n, nil
+}
+
+// DueNotifications returns at most limit pending notifications whose next
+// attempt is due at now, the longest waiting first.
+func (m *MongoDb) DueNotifications(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+) ([]Notification, error) {
+	collection := m.Database.Collection(notificationsCollection)
+	filter := bson.M{
+		"status":        NotificationStatusPending,
+		"nextAttemptAt": bson.M{"$lte": now},
+	}
+	opts := options.Find().SetSort(bson.M{"nextAttemptAt": 1}).SetLimit(int64(limit))
+
+	cursor, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("DueNotifications: failed to find documents: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var notifications []Notification
+	if err = cursor.All(ctx, &notifications); err != nil {
+		return nil, fmt.Errorf("DueNotifications: failed to decode documents: %w", err)
+	}
+
+	return notifications, nil
+}
+
+// UpdateNotification stores the delivery state of the notification.
+func (m *MongoDb) UpdateNotification(ctx context.Context, n Notification) error {
+	collection := m.Database.Collection(notificationsCollection)
+	filter := bson.M{"_id": n.Id}
+	update := bson.M{"$set": bson.M{
+		"status":        n.Status,
+		"attempts":      n.Attempts,
+		"lastError":     n.LastError,
+		"nextAttemptAt": n.NextAttemptAt,
+		"sentAt":        n.SentAt,
+	}}
+
+	res, err := collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("UpdateNotification: failed to update document: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
 )
 
 type Patient struct {
@@ -15,6 +17,24 @@ type Patient struct {
 	Email     string    `bson:"email"     json:"email"`
 	FirstName string    `bson:"firstName" json:"firstName"`
 	LastName  string    `bson:"lastName"  json:"lastName"`
+
+	// LateCancellations is how many appointments the patient cancelled
+	// shortly before them.
+	LateCancellations int `bson:"lateCancellations,omitempty" json:"lateCancellations,omitempty"`
+
+	// DeletedAt is set when the patient's personal data were erased, the
+	// record itself is kept, so that clinical records still reference it.
+	DeletedAt   *time.Time `bson:"deletedAt,omitempty"   json:"deletedAt,omitempty"`
+	RetainUntil *time.Time `bson:"retainUntil,omitempty" json:"retainUntil,omitempty"`
+}
+
+// AnonymizedPatientName replaces first and last name of an erased patient.
+const AnonymizedPatientName = "Anonymized"
+
+// anonymizedEmail is unique per patient, so that the unique email index holds
+// and the original address can be used for a new registration.
+func anonymizedEmail(id uuid.UUID) string {
+	return fmt.Sprintf("anonymized-%s@invalid", id)
 }
 
 func (m *MongoDb) CreatePatient(ctx context.Context, patient Patient) (Patient, error) {
@@ -69,6 +89,39 @@ func (m *MongoDb) PatientByEmail(ctx context.Context, email string) (Patient, er
 	return patient, nil
 }
 
+// AnonymizePatient erases personal fields of the patient and marks it as
+// deleted. Clinical records referencing the patient are left untouched and
+// are retained until retainUntil.
+func (m *MongoDb) AnonymizePatient(
+	ctx context.Context,
+	id uuid.UUID,
+	retainUntil time.Time,
+) (Patient, error) {
+	collection := m.Database.Collection(patientsCollection)
+	filter := bson.M{"_id": id, "deletedAt": nil}
+	update := bson.M{
+		"$set": bson.M{
+			"email":       anonymizedEmail(id),
+			"firstName":   AnonymizedPatientName,
+			"lastName":    AnonymizedPatientName,
+			"deletedAt":   time.Now(),
+			"retainUntil": retainUntil,
+		},
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var patient Patient
+	err := collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&patient)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return Patient{}, ErrNotFound
+		}
+		return Patient{}, fmt.Errorf("AnonymizePatient failed: %w", err)
+	}
+
+	return patient, nil
+}
+
 func (m *MongoDb) patientExists(ctx context.Context, patientId uuid.UUID) error {
 	patientsColl := m.Database.Collection(patientsCollection)
 	filter := bson.M{"_id": patientId}
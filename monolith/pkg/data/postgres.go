@@ -0,0 +1,168 @@
+package data
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"path"
+	"sort"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PostgresDb struct {
+	pool *pgxpool.Pool
+}
+
+//go:embed migrations/*.sql
+var migrationsFS embed.FS
+
+const (
+	pgUniqueViolation     = "23505"
+	pgForeignKeyViolation = "23503"
+	pgExclusionViolation  = "23P01"
+
+	// arbitrary key, so that only one instance runs the migrations at a time
+	migrationsAdvisoryLock = 4206942069
+)
+
+func ConnectPostgres(ctx context.Context, uri string) (*PostgresDb, error) {
+	pool, err := pgxpool.New(ctx, uri)
+	if err != nil {
+		return nil, fmt.Errorf("ConnectPostgres: %w", err)
+	}
+
+	err = pool.Ping(ctx)
+	if err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("ConnectPostgres: failed to ping postgres server: %w", err)
+	}
+
+	err = migrate(ctx, pool)
+	if err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("ConnectPostgres: failed to run migrations: %w", err)
+	}
+
+	return &PostgresDb{pool: pool}, nil
+}
+
+func (p *PostgresDb) Ping(ctx context.Context) error {
+	return p.pool.Ping(ctx)
+}
+
+func (p *PostgresDb) Disconnect(ctx context.Context) error {
+	p.pool.Close()
+	return nil
+}
+
+// migrate applies every migration from the migrations directory, which wasn't
+// applied yet, in lexical order of their file names. Each migration runs in
+// its own transaction.
+func migrate(ctx context.Context, pool *pgxpool.Pool) error {
+	conn, err := pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("migrate acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	_, err = conn.Exec(ctx, "SELECT pg_advisory_lock($1)", migrationsAdvisoryLock)
+	if err != nil {
+		return fmt.Errorf("migrate acquire lock: %w", err)
+	}
+	defer func() {
+		_, err := conn.Exec(ctx, "SELECT pg_advisory_unlock($1)", migrationsAdvisoryLock)
+		if err != nil {
+			slog.Warn("failed to release migrations lock", "error", err.Error())
+		}
+	}()
+
+	_, err = conn.Exec(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    TEXT PRIMARY KEY,
+		applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+	)`)
+	if err != nil {
+		return fmt.Errorf("migrate create schema_migrations: %w", err)
+	}
+
+	files, err := fs.Glob(migrationsFS, "migrations/*.sql")
+	if err != nil {
+		return fmt.Errorf("migrate list migrations: %w", err)
+	}
+	sort.Strings(files)
+
+	for _, file := range files {
+		version := path.Base(file)
+		var applied bool
+		err = conn.QueryRow(
+			ctx,
+			"SELECT EXISTS (SELECT 1 FROM schema_migrations WHERE version = $1)",
+			version,
+		).Scan(&applied)
+		if err != nil {
+			return fmt.Errorf("migrate check %q: %w", version, err)
+		}
+		if applied {
+			continue
+		}
+
+		sql, err := migrationsFS.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("migrate read %q: %w", version, err)
+		}
+
+		err = pgx.BeginFunc(ctx, conn, func(tx pgx.Tx) error {
+			if _, err := tx.Exec(ctx, string(sql)); err != nil {
+				return err
+			}
+			_, err := tx.Exec(ctx, "INSERT INTO schema_migrations (version) VALUES ($1)", version)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("migrate apply %q: %w", version, err)
+		}
+		slog.Info("applied migration", "migration", version)
+	}
+
+	return nil
+}
+
+func pgErrCode(err error) string {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code
+	}
+	return ""
+}
+
+func isPgErr(err error, code string) bool {
+	return pgErrCode(err) == code
+}
+
+// pgQuerier is satisfied by both *pgxpool.Pool and pgx.Tx, so that helpers
+// can be used inside and outside of a transaction.
+type pgQuerier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// missingOrMoved tells why a versioned update matched no row, ErrNotFound if
+// the exists query finds no row, ErrVersionMismatch if the row is at another
+// version.
+func (p *PostgresDb) missingOrMoved(ctx context.Context, exists string, args ...any) error {
+	var found bool
+	err := p.pool.QueryRow(ctx, "SELECT EXISTS ("+exists+")", args...).Scan(&found)
+	if err != nil {
+		return fmt.Errorf("missingOrMoved: failed to query row: %w", err)
+	}
+	if !found {
+		return ErrNotFound
+	}
+	return ErrVersionMismatch
+}
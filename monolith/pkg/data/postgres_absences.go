@@ -0,0 +1,162 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const absenceColumns = "id, doctor_id, type, start_time, end_time, note, created_at"
+
+func scanAbsence(row pgx.Row) (Absence, error) {
+	var absence Absence
+	err := row.Scan(
+		&absence.Id,
+		&absence.DoctorId,
+		&absence.Type,
+		&absence.Start,
+		&absence.End,
+		&absence.Note,
+		&absence.CreatedAt,
+	)
+	return absence, err
+}
+
+func (p *PostgresDb) CreateAbsence(ctx context.Context, absence Absence) (Absence, error) {
+	absence.Id = uuid.New()
+	absence.CreatedAt = time.Now()
+
+	_, err := p.pool.Exec(
+		ctx,
+		"INSERT INTO doctor_absences ("+absenceColumns+") VALUES ($1, $2, $3, $4, $5, $6, $7)",
+		absence.Id,
+		absence.DoctorId,
+		absence.Type,
+		absence.Start,
+		absence.End,
+		absence.Note,
+		absence.CreatedAt,
+	)
+	if err != nil {
+		if isPgErr(err, pgForeignKeyViolation) {
+			return Absence{}, fmt.Errorf("CreateAbsence doctor check: %w", ErrNotFound)
+		}
+		return Absence{}, fmt.Errorf("CreateAbsence: failed to insert row: %w", err)
+	}
+
+	return absence, nil
+}
+
+func (p *PostgresDb) AbsenceById(ctx context.Context, id uuid.UUID) (Absence, error) {
+	row := p.pool.QueryRow(
+		ctx,
+		"SELECT "+absenceColumns+" FROM doctor_absences WHERE id = $1",
+		id,
+	)
+	absence, err := scanAbsence(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Absence{}, ErrNotFound
+		}
+		return Absence{}, fmt.Errorf("AbsenceById: %w", err)
+	}
+
+	return absence, nil
+}
+
+func (p *PostgresDb) AbsencesByDoctorId(
+	ctx context.Context,
+	doctorId uuid.UUID,
+	from time.Time,
+	to time.Time,
+) ([]Absence, error) {
+	rows, err := p.pool.Query(ctx, `
+		SELECT `+absenceColumns+` FROM doctor_absences
+		WHERE doctor_id = $1 AND start_time < $3 AND end_time > $2
+		ORDER BY start_time`,
+		doctorId,
+		from,
+		to,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("AbsencesByDoctorId: failed to query rows: %w", err)
+	}
+
+	absences, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (Absence, error) {
+		return scanAbsence(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("AbsencesByDoctorId: failed to scan rows: %w", err)
+	}
+
+	return absences, nil
+}
+
+func (p *PostgresDb) UpdateAbsence(ctx context.Context, absence Absence) (Absence, error) {
+	row := p.pool.QueryRow(ctx, `
+		UPDATE doctor_absences
+		SET type = $2, start_time = $3, end_time = $4, note = $5
+		WHERE id = $1
+		RETURNING `+absenceColumns,
+		absence.Id,
+		absence.Type,
+		absence.Start,
+		absence.End,
+		absence.Note,
+	)
+	updated, err := scanAbsence(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Absence{}, ErrNotFound
+		}
+		return Absence{}, fmt.Errorf("UpdateAbsence: %w", err)
+	}
+
+	return updated, nil
+}
+
+func (p *PostgresDb) DeleteAbsence(ctx context.Context, id uuid.UUID) error {
+	tag, err := p.pool.Exec(ctx, "DELETE FROM doctor_absences WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("DeleteAbsence: failed to delete row: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (p *PostgresDb) FlagAppointmentsForReschedule(
+	ctx context.Context,
+	doctorId uuid.UUID,
+	start time.Time,
+	end time.Time,
+) ([]Appointment, error) {
+	rows, err := p.pool.Query(ctx, `
+		UPDATE appointments SET reschedule_required = true, version = version + 1
+		WHERE doctor_id = $1
+			AND appointment_date_time < $3
+			AND end_time > $2
+			AND status NOT IN ('cancelled', 'denied')
+			AND NOT reschedule_required
+		RETURNING `+appointmentColumns,
+		doctorId,
+		start,
+		end,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("FlagAppointmentsForReschedule: failed to update rows: %w", err)
+	}
+
+	appts, err := collectAppointments(rows)
+	if err != nil {
+		return nil, fmt.Errorf("FlagAppointmentsForReschedule: failed to scan rows: %w", err)
+	}
+
+	return appts, nil
+}
@@ -0,0 +1,660 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const appointmentColumns = `id, patient_id, doctor_id, appointment_date_time, end_time, type, status,
+	reason, condition_id, cancellation_reason, cancelled_by, denial_reason, series_id,
+	reschedule_required, reschedule_count, late_cancellation, requested_at, version`
+
+func scanAppointment(row pgx.Row) (Appointment, error) {
+	var appt Appointment
+	err := row.Scan(
+		&appt.Id,
+		&appt.PatientId,
+		&appt.DoctorId,
+		&appt.AppointmentDateTime,
+		&appt.EndTime,
+		&appt.Type,
+		&appt.Status,
+		&appt.Reason,
+		&appt.ConditionId,
+		&appt.CancellationReason,
+		&appt.CancelledBy,
+		&appt.DenialReason,
+		&appt.SeriesId,
+		&appt.RescheduleRequired,
+		&appt.RescheduleCount,
+		&appt.LateCancellation,
+		&appt.RequestedAt,
+		&appt.Version,
+	)
+	return appt, err
+}
+
+func collectAppointments(rows pgx.Rows) ([]Appointment, error) {
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (Appointment, error) {
+		return scanAppointment(row)
+	})
+}
+
+func (p *PostgresDb) CreateAppointment(
+	ctx context.Context,
+	appointment Appointment,
+) (Appointment, error) {
+	appointment.Id = uuid.New()
+	requestedAt := time.Now()
+	appointment.RequestedAt = &requestedAt
+	appointment.Version = 1
+
+	_, err := p.pool.Exec(
+		ctx,
+		"INSERT INTO appointments ("+appointmentColumns+") VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)",
+		appointment.Id,
+		appointment.PatientId,
+		appointment.DoctorId,
+		appointment.AppointmentDateTime,
+		appointment.EndTime,
+		appointment.Type,
+		appointment.Status,
+		appointment.Reason,
+		appointment.ConditionId,
+		appointment.CancellationReason,
+		appointment.CancelledBy,
+		appointment.DenialReason,
+		appointment.SeriesId,
+		appointment.RescheduleRequired,
+		appointment.RescheduleCount,
+		appointment.LateCancellation,
+		appointment.RequestedAt,
+		appointment.Version,
+	)
+	if err != nil {
+		switch pgErrCode(err) {
+		case pgForeignKeyViolation:
+			return Appointment{}, fmt.Errorf(
+				"CreateAppointment patient, doctor or condition check: %w",
+				ErrNotFound,
+			)
+		case pgExclusionViolation:
+			return Appointment{}, fmt.Errorf(
+				"%w at %s",
+				ErrDoctorUnavailable,
+				appointment.AppointmentDateTime.Format(time.RFC3339),
+			)
+		}
+		return Appointment{}, fmt.Errorf("CreateAppointment: failed to insert row: %w", err)
+	}
+
+	return appointment, nil
+}
+
+func (p *PostgresDb) AppointmentById(ctx context.Context, id uuid.UUID) (Appointment, error) {
+	appt, err := appointmentById(ctx, p.pool, id, false)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return Appointment{}, ErrNotFound
+		}
+		return Appointment{}, fmt.Errorf("AppointmentById failed to find row: %w", err)
+	}
+
+	return appt, nil
+}
+
+// CancelAppointment cancels the appointment and releases its reservations. A
+// late cancellation is flagged on the appointment and counted on its
+// patient.
+func (p *PostgresDb) CancelAppointment(
+	ctx context.Context,
+	appointmentId uuid.UUID,
+	by string,
+	cancellationReason *string,
+	late bool,
+) error {
+	err := pgx.BeginFunc(ctx, p.pool, func(tx pgx.Tx) error {
+		var patientId uuid.UUID
+		err := tx.QueryRow(ctx, `
+			UPDATE appointments
+			SET status = 'cancelled', cancellation_reason = $2, cancelled_by = $3,
+				late_cancellation = $4, version = version + 1
+			WHERE id = $1
+			RETURNING patient_id`,
+			appointmentId,
+			cancellationReason,
+			by,
+			late,
+		).Scan(&patientId)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrNotFound
+			}
+			return fmt.Errorf("failed to update appointment status: %w", err)
+		}
+
+		if late {
+			_, err = tx.Exec(
+				ctx,
+				"UPDATE patients SET late_cancellations = late_cancellations + 1 WHERE id = $1",
+				patientId,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to count late cancellation: %w", err)
+			}
+		}
+
+		_, err = tx.Exec(ctx, "DELETE FROM reservations WHERE appointment_id = $1", appointmentId)
+		if err != nil {
+			return fmt.Errorf("failed to delete reservations: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("CancelAppointment: %w", err)
+	}
+
+	return nil
+}
+
+// DecideAppointment accepts or rejects the requested appointment if it is
+// still at version, otherwise it fails with ErrVersionMismatch.
+func (p *PostgresDb) DecideAppointment(
+	ctx context.Context,
+	appointmentId uuid.UUID,
+	version int64,
+	decision string,
+	denyReason *string,
+	resources []Resource,
+) (Appointment, error) {
+	var appointment Appointment
+	err := pgx.BeginFunc(ctx, p.pool, func(tx pgx.Tx) error {
+		var err error
+		appointment, err = appointmentById(ctx, tx, appointmentId, true)
+		if err != nil {
+			return err
+		}
+
+		if appointment.Status != "requested" {
+			return fmt.Errorf("appointment %s is not in scheduled state", appointmentId)
+		}
+		if appointment.Version != version {
+			return ErrVersionMismatch
+		}
+
+		switch decision {
+		case "accept":
+			for _, res := range resources {
+				resource, err := resourceById(ctx, tx, res.Id)
+				if err != nil {
+					return fmt.Errorf("failed to fetch resource %s: %w", res.Id, err)
+				}
+
+				_, err = createReservation(
+					ctx,
+					tx,
+					appointmentId,
+					resource.Id,
+					resource.Name,
+					resource.Type,
+					appointment.AppointmentDateTime,
+					appointment.EndTime,
+				)
+				if err != nil {
+					return fmt.Errorf("failed to reserve resource %s: %w", resource.Id, err)
+				}
+			}
+
+			appointment, err = scanAppointment(tx.QueryRow(
+				ctx,
+				"UPDATE appointments SET status = 'scheduled', version = version + 1 WHERE id = $1 RETURNING "+appointmentColumns,
+				appointmentId,
+			))
+			if err != nil {
+				return fmt.Errorf("failed to schedule appointment: %w", err)
+			}
+			appointment.Medicines, appointment.Facilities, appointment.Equipment = splitResources(resources)
+		case "reject":
+			appointment, err = scanAppointment(tx.QueryRow(
+				ctx,
+				"UPDATE appointments SET status = 'denied', denial_reason = $2, version = version + 1 WHERE id = $1 RETURNING "+appointmentColumns,
+				appointmentId,
+				denyReason,
+			))
+			if err != nil {
+				return fmt.Errorf("failed to deny appointment: %w", err)
+			}
+		default:
+			return fmt.Errorf("invalid decision %s for appointment %s", decision, appointmentId)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return Appointment{}, fmt.Errorf("DecideAppointment: %w", err)
+	}
+
+	return appointment, nil
+}
+
+func (p *PostgresDb) AppointmentsByDoctorId(
+	ctx context.Context,
+	doctorId uuid.UUID,
+	from time.Time,
+	to *time.Time,
+) ([]Appointment, error) {
+	appts, err := p.appointmentsByIdColumn(ctx, "doctor_id", doctorId, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("AppointmentsByDoctorId: %w", err)
+	}
+
+	return appts, nil
+}
+
+func (p *PostgresDb) AppointmentsByPatientId(
+	ctx context.Context,
+	patientId uuid.UUID,
+	from time.Time,
+	to *time.Time,
+) ([]Appointment, error) {
+	appts, err := p.appointmentsByIdColumn(ctx, "patient_id", patientId, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("AppointmentsByPatientId: %w", err)
+	}
+
+	return appts, nil
+}
+
+func (p *PostgresDb) AllAppointmentsByPatientId(
+	ctx context.Context,
+	patientId uuid.UUID,
+) ([]Appointment, error) {
+	rows, err := p.pool.Query(ctx, `
+		SELECT `+appointmentColumns+` FROM appointments
+		WHERE patient_id = $1
+		ORDER BY appointment_date_time`,
+		patientId,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("AllAppointmentsByPatientId query failed: %w", err)
+	}
+
+	appointments, err := collectAppointments(rows)
+	if err != nil {
+		return nil, fmt.Errorf("AllAppointmentsByPatientId scan failed: %w", err)
+	}
+
+	return appointments, nil
+}
+
+func (p *PostgresDb) AppointmentsByDoctorIdAndDate(
+	ctx context.Context,
+	doctorId uuid.UUID,
+	date time.Time,
+) ([]Appointment, error) {
+	startOfDay := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	endOfDay := startOfDay.AddDate(0, 0, 1).Add(-1 * time.Nanosecond)
+
+	appts, err := p.appointmentsByIdColumn(ctx, "doctor_id", doctorId, startOfDay, &endOfDay)
+	if err != nil {
+		return nil, fmt.Errorf("AppointmentsByDoctorIdAndDate: %w", err)
+	}
+
+	return appts, nil
+}
+
+// RescheduleAppointment moves the appointment to newDateTime, keeping its
+// duration, and puts it back to the requested state. Reservations of the
+// appointment are released, since they were made for the old time slot. An
+// appointment which isn't at version anymore fails with ErrVersionMismatch.
+func (p *PostgresDb) RescheduleAppointment(
+	ctx context.Context,
+	appointmentId uuid.UUID,
+	version int64,
+	newDateTime time.Time,
+) (Appointment, error) {
+	var appointment Appointment
+	err := pgx.BeginFunc(ctx, p.pool, func(tx pgx.Tx) error {
+		var err error
+		appointment, err = appointmentById(ctx, tx, appointmentId, true)
+		if err != nil {
+			return err
+		}
+
+		if appointment.Status != "scheduled" && appointment.Status != "requested" {
+			return fmt.Errorf("appointment %s is not in a reschedulable state", appointmentId)
+		}
+		if appointment.Version != version {
+			return ErrVersionMismatch
+		}
+
+		duration := appointment.EndTime.Sub(appointment.AppointmentDateTime)
+		appointment, err = scanAppointment(tx.QueryRow(ctx, `
+			UPDATE appointments
+			SET appointment_date_time = $2, end_time = $3, status = 'requested',
+				reschedule_required = false, reschedule_count = reschedule_count + 1,
+				version = version + 1
+			WHERE id = $1
+			RETURNING `+appointmentColumns,
+			appointmentId,
+			newDateTime,
+			newDateTime.Add(duration),
+		))
+		if err != nil {
+			if isPgErr(err, pgExclusionViolation) {
+				return fmt.Errorf(
+					"%w at %s",
+					ErrDoctorUnavailable,
+					newDateTime.Format(time.RFC3339),
+				)
+			}
+			return fmt.Errorf("failed to update appointment: %w", err)
+		}
+
+		_, err = tx.Exec(ctx, "DELETE FROM reservations WHERE appointment_id = $1", appointmentId)
+		if err != nil {
+			return fmt.Errorf("failed to delete reservations: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return Appointment{}, fmt.Errorf("RescheduleAppointment: %w", err)
+	}
+
+	return appointment, nil
+}
+
+// RescheduleAppointmentKeepingReservations moves the appointment and its
+// reservations to newDateTime, keeping their durations and the appointment's
+// status. If some reserved resource is taken at the new time, the appointment
+// is rescheduled like by RescheduleAppointment instead, and the conflicting
+// reservations, as they were before the move, are returned. Either happens
+// atomically, if the appointment is still at version.
+func (p *PostgresDb) RescheduleAppointmentKeepingReservations(
+	ctx context.Context,
+	appointmentId uuid.UUID,
+	version int64,
+	newDateTime time.Time,
+) (Appointment, []Reservation, error) {
+	var appointment Appointment
+	var conflicts []Reservation
+	err := pgx.BeginFunc(ctx, p.pool, func(tx pgx.Tx) error {
+		var err error
+		appointment, err = appointmentById(ctx, tx, appointmentId, true)
+		if err != nil {
+			return err
+		}
+
+		if appointment.Status != "scheduled" && appointment.Status != "requested" {
+			return fmt.Errorf("appointment %s is not in a reschedulable state", appointmentId)
+		}
+		if appointment.Version != version {
+			return ErrVersionMismatch
+		}
+
+		rows, err := tx.Query(
+			ctx,
+			"SELECT "+reservationColumns+" FROM reservations WHERE appointment_id = $1 FOR UPDATE",
+			appointmentId,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to query reservations: %w", err)
+		}
+		reservations, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (Reservation, error) {
+			return scanReservation(row)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to scan reservations: %w", err)
+		}
+
+		shift := newDateTime.Sub(appointment.AppointmentDateTime)
+		for _, reservation := range reservations {
+			var taken bool
+			err := tx.QueryRow(ctx, `
+				SELECT EXISTS (
+					SELECT 1 FROM reservations
+					WHERE resource_id = $1
+						AND appointment_id <> $2
+						AND tstzrange(start_time, end_time) && tstzrange($3, $4)
+				)`,
+				reservation.ResourceId,
+				appointmentId,
+				reservation.StartTime.Add(shift),
+				reservation.EndTime.Add(shift),
+			).Scan(&taken)
+			if err != nil {
+				return fmt.Errorf("resource availability check failed: %w", err)
+			}
+			if taken {
+				conflicts = append(conflicts, reservation)
+			}
+		}
+
+		status := appointment.Status
+		if len(conflicts) > 0 {
+			status = "requested"
+		}
+
+		appointment, err = scanAppointment(tx.QueryRow(ctx, `
+			UPDATE appointments
+			SET appointment_date_time = $2, end_time = $3, status = $4,
+				reschedule_required = false, reschedule_count = reschedule_count + 1,
+				version = version + 1
+			WHERE id = $1
+			RETURNING `+appointmentColumns,
+			appointmentId,
+			newDateTime,
+			appointment.EndTime.Add(shift),
+			status,
+		))
+		if err != nil {
+			if isPgErr(err, pgExclusionViolation) {
+				return fmt.Errorf(
+					"%w at %s",
+					ErrDoctorUnavailable,
+					newDateTime.Format(time.RFC3339),
+				)
+			}
+			return fmt.Errorf("failed to update appointment: %w", err)
+		}
+
+		if len(conflicts) > 0 {
+			_, err = tx.Exec(ctx, "DELETE FROM reservations WHERE appointment_id = $1", appointmentId)
+			if err != nil {
+				return fmt.Errorf("failed to delete reservations: %w", err)
+			}
+			return nil
+		}
+
+		for _, reservation := range reservations {
+			_, err = tx.Exec(
+				ctx,
+				"UPDATE reservations SET start_time = $2, end_time = $3 WHERE id = $1",
+				reservation.Id,
+				reservation.StartTime.Add(shift),
+				reservation.EndTime.Add(shift),
+			)
+			if err != nil {
+				if isPgErr(err, pgExclusionViolation) {
+					return fmt.Errorf("%w: resourceId %s", ErrResourceUnavailable, reservation.ResourceId)
+				}
+				return fmt.Errorf("failed to move reservation: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return Appointment{}, nil, fmt.Errorf("RescheduleAppointmentKeepingReservations: %w", err)
+	}
+
+	return appointment, conflicts, nil
+}
+
+// ReassignAppointment moves the appointment to the doctor at newDateTime,
+// keeping its duration. If the time stays the same, the appointment keeps its
+// status and reservations, otherwise it is put back to the requested state
+// and its reservations are released.
+func (p *PostgresDb) ReassignAppointment(
+	ctx context.Context,
+	appointmentId uuid.UUID,
+	doctorId uuid.UUID,
+	newDateTime time.Time,
+) (Appointment, error) {
+	var appointment Appointment
+	err := pgx.BeginFunc(ctx, p.pool, func(tx pgx.Tx) error {
+		var err error
+		appointment, err = appointmentById(ctx, tx, appointmentId, true)
+		if err != nil {
+			return err
+		}
+
+		if appointment.Status != "scheduled" && appointment.Status != "requested" {
+			return fmt.Errorf("appointment %s is not in a reassignable state", appointmentId)
+		}
+
+		moved := !newDateTime.Equal(appointment.AppointmentDateTime)
+		status := appointment.Status
+		if moved {
+			status = "requested"
+		}
+
+		duration := appointment.EndTime.Sub(appointment.AppointmentDateTime)
+		appointment, err = scanAppointment(tx.QueryRow(ctx, `
+			UPDATE appointments
+			SET doctor_id = $2, appointment_date_time = $3, end_time = $4, status = $5,
+				reschedule_required = false, version = version + 1
+			WHERE id = $1
+			RETURNING `+appointmentColumns,
+			appointmentId,
+			doctorId,
+			newDateTime,
+			newDateTime.Add(duration),
+			status,
+		))
+		if err != nil {
+			switch pgErrCode(err) {
+			case pgForeignKeyViolation:
+				return fmt.Errorf("doctor check: %w", ErrNotFound)
+			case pgExclusionViolation:
+				return fmt.Errorf(
+					"%w at %s",
+					ErrDoctorUnavailable,
+					newDateTime.Format(time.RFC3339),
+				)
+			}
+			return fmt.Errorf("failed to update appointment: %w", err)
+		}
+
+		if moved {
+			_, err = tx.Exec(ctx, "DELETE FROM reservations WHERE appointment_id = $1", appointmentId)
+			if err != nil {
+				return fmt.Errorf("failed to delete reservations: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return Appointment{}, fmt.Errorf("ReassignAppointment: %w", err)
+	}
+
+	return appointment, nil
+}
+
+func (p *PostgresDb) AppointmentsByConditionId(
+	ctx context.Context,
+	conditionId uuid.UUID,
+) ([]Appointment, error) {
+	rows, err := p.pool.Query(ctx, `
+		SELECT `+appointmentColumns+` FROM appointments
+		WHERE condition_id = $1
+		ORDER BY appointment_date_time DESC`,
+		conditionId,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("AppointmentsByConditionId query failed: %w", err)
+	}
+
+	appointments, err := collectAppointments(rows)
+	if err != nil {
+		return nil, fmt.Errorf("AppointmentsByConditionId scan failed: %w", err)
+	}
+
+	return appointments, nil
+}
+
+func (p *PostgresDb) appointmentsByIdColumn(
+	ctx context.Context,
+	idColumn string,
+	id uuid.UUID,
+	from time.Time,
+	to *time.Time,
+) ([]Appointment, error) {
+	// idColumn is never user input, it is one of the hard-coded columns above
+	rows, err := p.pool.Query(ctx, `
+		SELECT `+appointmentColumns+` FROM appointments
+		WHERE `+idColumn+` = $1
+			AND appointment_date_time >= $2
+			AND ($3::timestamptz IS NULL OR appointment_date_time <= $3)
+		ORDER BY appointment_date_time`,
+		id,
+		from,
+		to,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("appointmentsByIdColumn query failed: %w", err)
+	}
+
+	appointments, err := collectAppointments(rows)
+	if err != nil {
+		return nil, fmt.Errorf("appointmentsByIdColumn scan failed: %w", err)
+	}
+
+	return appointments, nil
+}
+
+// appointmentById loads a single appointment, if forUpdate is set the row is
+// locked until the end of the surrounding transaction.
+func appointmentById(
+	ctx context.Context,
+	q pgQuerier,
+	id uuid.UUID,
+	forUpdate bool,
+) (Appointment, error) {
+	query := "SELECT " + appointmentColumns + " FROM appointments WHERE id = $1"
+	if forUpdate {
+		query += " FOR UPDATE"
+	}
+
+	appt, err := scanAppointment(q.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Appointment{}, ErrNotFound
+		}
+		return Appointment{}, err
+	}
+
+	return appt, nil
+}
+
+func splitResources(resources []Resource) (medicines, facilities, equipment []Resource) {
+	for _, resource := range resources {
+		switch resource.Type {
+		case ResourceTypeMedicine:
+			medicines = append(medicines, resource)
+		case ResourceTypeFacility:
+			facilities = append(facilities, resource)
+		case ResourceTypeEquipment:
+			equipment = append(equipment, resource)
+		}
+	}
+	return medicines, facilities, equipment
+}
@@ -0,0 +1,124 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const auditEventColumns = "id, seq, actor, action, target_id, diff, request_id, created_at, prev_hash, hash"
+
+// arbitrary key, serializes appends to the audit chain
+const auditAdvisoryLock = 4206942070
+
+func scanAuditEvent(row pgx.Row) (AuditEvent, error) {
+	var event AuditEvent
+	err := row.Scan(
+		&event.Id,
+		&event.Seq,
+		&event.Actor,
+		&event.Action,
+		&event.TargetId,
+		&event.Diff,
+		&event.RequestId,
+		&event.CreatedAt,
+		&event.PrevHash,
+		&event.Hash,
+	)
+	return event, err
+}
+
+func (p *PostgresDb) AppendAuditEvent(ctx context.Context, event AuditEvent) (AuditEvent, error) {
+	event = newAuditEvent(event)
+
+	err := pgx.BeginFunc(ctx, p.pool, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock($1)", auditAdvisoryLock)
+		if err != nil {
+			return fmt.Errorf("failed to acquire lock: %w", err)
+		}
+
+		var last *AuditEvent
+		prev, err := scanAuditEvent(tx.QueryRow(
+			ctx,
+			"SELECT "+auditEventColumns+" FROM audit_events ORDER BY seq DESC LIMIT 1",
+		))
+		if err == nil {
+			last = &prev
+		} else if !errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("failed to find last event: %w", err)
+		}
+
+		if err := event.chain(last); err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(
+			ctx,
+			"INSERT INTO audit_events ("+auditEventColumns+") VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
+			event.Id,
+			event.Seq,
+			event.Actor,
+			event.Action,
+			event.TargetId,
+			event.Diff,
+			event.RequestId,
+			event.CreatedAt,
+			event.PrevHash,
+			event.Hash,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert row: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return AuditEvent{}, fmt.Errorf("AppendAuditEvent: %w", err)
+	}
+
+	return event, nil
+}
+
+func (p *PostgresDb) AuditEvents(ctx context.Context, filter AuditFilter) ([]AuditEvent, error) {
+	var conds []string
+	var args []any
+	where := func(cond string, arg any) {
+		args = append(args, arg)
+		conds = append(conds, cond+" $"+strconv.Itoa(len(args)))
+	}
+	where("seq >", filter.AfterSeq)
+	if filter.TargetId != nil {
+		where("target_id =", *filter.TargetId)
+	}
+	if filter.Actor != nil {
+		where("actor =", *filter.Actor)
+	}
+	if filter.From != nil {
+		where("created_at >=", *filter.From)
+	}
+	if filter.To != nil {
+		where("created_at <=", *filter.To)
+	}
+
+	args = append(args, filter.Limit)
+	query := "SELECT " + auditEventColumns + " FROM audit_events" +
+		" WHERE " + strings.Join(conds, " AND ") +
+		" ORDER BY seq LIMIT $" + strconv.Itoa(len(args))
+
+	rows, err := p.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("AuditEvents: query failed: %w", err)
+	}
+
+	events, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (AuditEvent, error) {
+		return scanAuditEvent(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("AuditEvents: decode failed: %w", err)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,60 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+func (p *PostgresDb) SaveCalendarFeed(ctx context.Context, feed CalendarFeed) error {
+	_, err := p.pool.Exec(ctx, `
+		INSERT INTO calendar_feeds (user_id, role, token_hash, created_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id) DO UPDATE
+		SET role = EXCLUDED.role, token_hash = EXCLUDED.token_hash, created_at = EXCLUDED.created_at`,
+		feed.UserId,
+		feed.Role,
+		feed.TokenHash,
+		feed.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("SaveCalendarFeed: failed to upsert row: %w", err)
+	}
+
+	return nil
+}
+
+func (p *PostgresDb) CalendarFeedByTokenHash(
+	ctx context.Context,
+	tokenHash string,
+) (CalendarFeed, error) {
+	var feed CalendarFeed
+	err := p.pool.QueryRow(
+		ctx,
+		"SELECT user_id, role, token_hash, created_at FROM calendar_feeds WHERE token_hash = $1",
+		tokenHash,
+	).Scan(&feed.UserId, &feed.Role, &feed.TokenHash, &feed.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return CalendarFeed{}, ErrNotFound
+		}
+		return CalendarFeed{}, fmt.Errorf("CalendarFeedByTokenHash: failed to query row: %w", err)
+	}
+
+	return feed, nil
+}
+
+func (p *PostgresDb) DeleteCalendarFeed(ctx context.Context, userId uuid.UUID) error {
+	tag, err := p.pool.Exec(ctx, "DELETE FROM calendar_feeds WHERE user_id = $1", userId)
+	if err != nil {
+		return fmt.Errorf("DeleteCalendarFeed: failed to delete row: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
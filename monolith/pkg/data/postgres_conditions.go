@@ -0,0 +1,185 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const conditionColumns = "id, patient_id, name, start_time, end_time, version"
+
+func scanCondition(row pgx.Row) (Condition, error) {
+	var condition Condition
+	err := row.Scan(
+		&condition.Id,
+		&condition.PatientId,
+		&condition.Name,
+		&condition.Start,
+		&condition.End,
+		&condition.Version,
+	)
+	return condition, err
+}
+
+func collectConditions(rows pgx.Rows) ([]Condition, error) {
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (Condition, error) {
+		return scanCondition(row)
+	})
+}
+
+func (p *PostgresDb) CreateCondition(ctx context.Context, condition Condition) (Condition, error) {
+	condition.Id = uuid.New()
+	condition.Version = 1
+
+	_, err := p.pool.Exec(
+		ctx,
+		"INSERT INTO conditions ("+conditionColumns+") VALUES ($1, $2, $3, $4, $5, $6)",
+		condition.Id,
+		condition.PatientId,
+		condition.Name,
+		condition.Start,
+		condition.End,
+		condition.Version,
+	)
+	if err != nil {
+		if isPgErr(err, pgForeignKeyViolation) {
+			return Condition{}, fmt.Errorf("CreateCondition patient check error: %w", ErrNotFound)
+		}
+		return Condition{}, fmt.Errorf("CreateCondition: failed to insert row: %w", err)
+	}
+
+	return condition, nil
+}
+
+func (p *PostgresDb) ConditionById(ctx context.Context, id uuid.UUID) (Condition, error) {
+	row := p.pool.QueryRow(ctx, "SELECT "+conditionColumns+" FROM conditions WHERE id = $1", id)
+	condition, err := scanCondition(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Condition{}, ErrNotFound
+		}
+		return Condition{}, fmt.Errorf("ConditionById: failed to find row: %w", err)
+	}
+
+	return condition, nil
+}
+
+func (p *PostgresDb) FindConditionsByPatientId(
+	ctx context.Context,
+	patientId uuid.UUID,
+	from time.Time,
+	to *time.Time,
+) ([]Condition, error) {
+	rows, err := p.pool.Query(ctx, `
+		SELECT `+conditionColumns+` FROM conditions
+		WHERE patient_id = $1
+			AND (end_time IS NULL OR end_time >= $2)
+			AND ($3::timestamptz IS NULL OR start_time <= $3)
+		ORDER BY start_time`,
+		patientId,
+		from,
+		to,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("FindConditionsByPatientId: query failed: %w", err)
+	}
+
+	conditions, err := collectConditions(rows)
+	if err != nil {
+		return nil, fmt.Errorf("FindConditionsByPatientId: scan failed: %w", err)
+	}
+
+	return conditions, nil
+}
+
+// UpdateCondition updates the condition if it is still at condition.Version,
+// otherwise it fails with ErrVersionMismatch.
+func (p *PostgresDb) UpdateCondition(
+	ctx context.Context,
+	id uuid.UUID,
+	condition Condition,
+) (Condition, error) {
+	row := p.pool.QueryRow(ctx, `
+		UPDATE conditions
+		SET patient_id = $2, name = $3, start_time = $4, end_time = $5,
+			version = version + 1
+		WHERE id = $1 AND version = $6
+		RETURNING `+conditionColumns,
+		id,
+		condition.PatientId,
+		condition.Name,
+		condition.Start,
+		condition.End,
+		condition.Version,
+	)
+	updatedCondition, err := scanCondition(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Condition{}, p.missingOrMoved(
+				ctx,
+				"SELECT 1 FROM conditions WHERE id = $1",
+				id,
+			)
+		}
+		if isPgErr(err, pgForeignKeyViolation) {
+			return Condition{}, fmt.Errorf("UpdateCondition patient check error: %w", ErrNotFound)
+		}
+		return Condition{}, fmt.Errorf("UpdateCondition failed: %w", err)
+	}
+
+	return updatedCondition, nil
+}
+
+func (p *PostgresDb) FindConditionsByPatientIdAndDate(
+	ctx context.Context,
+	patientId uuid.UUID,
+	date time.Time,
+) ([]Condition, error) {
+	year, month, day := date.Date()
+	startOfDay := time.Date(year, month, day, 0, 0, 0, 0, date.Location())
+
+	rows, err := p.pool.Query(ctx, `
+		SELECT `+conditionColumns+` FROM conditions
+		WHERE patient_id = $1
+			AND start_time <= $2
+			AND (end_time IS NULL OR end_time >= $2)
+		ORDER BY start_time`,
+		patientId,
+		startOfDay,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("FindConditionsByPatientIdAndDate query failed: %w", err)
+	}
+
+	conditions, err := collectConditions(rows)
+	if err != nil {
+		return nil, fmt.Errorf("FindConditionsByPatientIdAndDate scan failed: %w", err)
+	}
+
+	return conditions, nil
+}
+
+func (p *PostgresDb) AllConditionsByPatientId(
+	ctx context.Context,
+	patientId uuid.UUID,
+) ([]Condition, error) {
+	rows, err := p.pool.Query(
+		ctx,
+		"SELECT "+conditionColumns+" FROM conditions WHERE patient_id = $1 ORDER BY start_time",
+		patientId,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("AllConditionsByPatientId: query failed: %w", err)
+	}
+
+	conditions, err := collectConditions(rows)
+	if err != nil {
+		return nil, fmt.Errorf("AllConditionsByPatientId: scan failed: %w", err)
+	}
+
+	return conditions, nil
+}
@@ -0,0 +1,189 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const doctorColumns = "id, email, first_name, last_name, specialization, appointment_durations, " +
+	"deleted_at, retain_until"
+
+func scanDoctor(row pgx.Row) (Doctor, error) {
+	var doctor Doctor
+	err := row.Scan(
+		&doctor.Id,
+		&doctor.Email,
+		&doctor.FirstName,
+		&doctor.LastName,
+		&doctor.Specialization,
+		&doctor.AppointmentDurations,
+		&doctor.DeletedAt,
+		&doctor.RetainUntil,
+	)
+	return doctor, err
+}
+
+func (p *PostgresDb) CreateDoctor(ctx context.Context, doctor Doctor) (Doctor, error) {
+	doctor.Id = uuid.New()
+
+	_, err := p.pool.Exec(
+		ctx,
+		"INSERT INTO doctors ("+doctorColumns+") VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
+		doctor.Id,
+		doctor.Email,
+		doctor.FirstName,
+		doctor.LastName,
+		doctor.Specialization,
+		doctor.AppointmentDurations,
+		doctor.DeletedAt,
+		doctor.RetainUntil,
+	)
+	if err != nil {
+		if isPgErr(err, pgUniqueViolation) {
+			return Doctor{}, ErrDuplicateEmail
+		}
+		return Doctor{}, fmt.Errorf("CreateDoctor: failed to insert row: %w", err)
+	}
+
+	return doctor, nil
+}
+
+func (p *PostgresDb) DoctorById(ctx context.Context, id uuid.UUID) (Doctor, error) {
+	row := p.pool.QueryRow(ctx, "SELECT "+doctorColumns+" FROM doctors WHERE id = $1", id)
+	doctor, err := scanDoctor(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Doctor{}, ErrNotFound
+		}
+		return Doctor{}, fmt.Errorf("DoctorById: failed to find row: %w", err)
+	}
+
+	return doctor, nil
+}
+
+func (p *PostgresDb) DoctorByEmail(ctx context.Context, email string) (Doctor, error) {
+	row := p.pool.QueryRow(ctx, "SELECT "+doctorColumns+" FROM doctors WHERE email = $1", email)
+	doctor, err := scanDoctor(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Doctor{}, ErrNotFound
+		}
+		return Doctor{}, fmt.Errorf("DoctorByEmail: failed to find row: %w", err)
+	}
+
+	return doctor, nil
+}
+
+func (p *PostgresDb) UpdateDoctorAppointmentDurations(
+	ctx context.Context,
+	id uuid.UUID,
+	durations map[string]int,
+) (Doctor, error) {
+	row := p.pool.QueryRow(ctx, `
+		UPDATE doctors SET appointment_durations = $2
+		WHERE id = $1
+		RETURNING `+doctorColumns,
+		id,
+		durations,
+	)
+	doctor, err := scanDoctor(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Doctor{}, ErrNotFound
+		}
+		return Doctor{}, fmt.Errorf("UpdateDoctorAppointmentDurations failed: %w", err)
+	}
+
+	return doctor, nil
+}
+
+func (p *PostgresDb) AnonymizeDoctor(
+	ctx context.Context,
+	id uuid.UUID,
+	retainUntil time.Time,
+) (Doctor, error) {
+	row := p.pool.QueryRow(ctx, `
+		UPDATE doctors
+		SET email = $2, first_name = $3, last_name = $3, deleted_at = now(), retain_until = $4
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING `+doctorColumns,
+		id,
+		anonymizedEmail(id),
+		AnonymizedDoctorName,
+		retainUntil,
+	)
+	doctor, err := scanDoctor(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Doctor{}, ErrNotFound
+		}
+		return Doctor{}, fmt.Errorf("AnonymizeDoctor failed: %w", err)
+	}
+
+	return doctor, nil
+}
+
+// AvailableDoctors returns doctors, who are neither absent nor have an active
+// appointment overlapping the duration long interval starting at dateTime.
+func (p *PostgresDb) AvailableDoctors(
+	ctx context.Context,
+	dateTime time.Time,
+	duration time.Duration,
+) ([]Doctor, error) {
+	rows, err := p.pool.Query(ctx, `
+		SELECT `+doctorColumns+` FROM doctors d
+		WHERE d.deleted_at IS NULL AND NOT EXISTS (
+			SELECT 1 FROM appointments a
+			WHERE a.doctor_id = d.id
+				AND a.appointment_date_time < $2
+				AND a.end_time > $1
+				AND a.status IN ('requested', 'scheduled')
+		) AND NOT EXISTS (
+			SELECT 1 FROM doctor_absences da
+			WHERE da.doctor_id = d.id
+				AND da.start_time < $2
+				AND da.end_time > $1
+		)
+		ORDER BY last_name, first_name`,
+		dateTime,
+		dateTime.Add(duration),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("AvailableDoctors query failed: %w", err)
+	}
+
+	doctors, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (Doctor, error) {
+		return scanDoctor(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("AvailableDoctors scan failed: %w", err)
+	}
+
+	return doctors, nil
+}
+
+func (p *PostgresDb) GetAllDoctors(ctx context.Context) ([]Doctor, error) {
+	rows, err := p.pool.Query(
+		ctx,
+		"SELECT "+doctorColumns+` FROM doctors
+		WHERE deleted_at IS NULL
+		ORDER BY last_name, first_name`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("GetAllDoctors query failed: %w", err)
+	}
+
+	doctors, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (Doctor, error) {
+		return scanDoctor(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("GetAllDoctors scan failed: %w", err)
+	}
+
+	return doctors, nil
+}
@@ -0,0 +1,134 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const idempotencyKeyColumns = "principal, key, request_hash, status, content_type, location, body, expires_at"
+
+func (p *PostgresDb) ReserveIdempotencyKey(
+	ctx context.Context,
+	record IdempotencyRecord,
+	now time.Time,
+) (IdempotencyRecord, error) {
+	var existing *IdempotencyRecord
+	err := pgx.BeginFunc(ctx, p.pool, func(tx pgx.Tx) error {
+		_, err := tx.Exec(
+			ctx,
+			"DELETE FROM idempotency_keys WHERE principal = $1 AND key = $2 AND expires_at <= $3",
+			record.Principal,
+			record.Key,
+			now,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to delete expired row: %w", err)
+		}
+
+		tag, err := tx.Exec(
+			ctx,
+			"INSERT INTO idempotency_keys ("+idempotencyKeyColumns+`)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8) ON CONFLICT (principal, key) DO NOTHING`,
+			record.Principal,
+			record.Key,
+			record.RequestHash,
+			record.Status,
+			record.ContentType,
+			record.Location,
+			record.Body,
+			record.ExpiresAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert row: %w", err)
+		}
+		if tag.RowsAffected() == 1 {
+			return nil
+		}
+
+		var found IdempotencyRecord
+		err = tx.QueryRow(
+			ctx,
+			"SELECT "+idempotencyKeyColumns+`
+			FROM idempotency_keys WHERE principal = $1 AND key = $2`,
+			record.Principal,
+			record.Key,
+		).Scan(
+			&found.Principal,
+			&found.Key,
+			&found.RequestHash,
+			&found.Status,
+			&found.ContentType,
+			&found.Location,
+			&found.Body,
+			&found.ExpiresAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to select row: %w", err)
+		}
+		existing = &found
+		return nil
+	})
+	if err != nil {
+		return IdempotencyRecord{}, fmt.Errorf("ReserveIdempotencyKey: %w", err)
+	}
+	if existing != nil {
+		return *existing, ErrIdempotencyKeyReserved
+	}
+
+	// keys of other principals expire without being reused
+	_, err = p.pool.Exec(
+		ctx,
+		`DELETE FROM idempotency_keys WHERE (principal, key) IN (
+			SELECT principal, key FROM idempotency_keys WHERE expires_at <= $1
+			LIMIT $2 FOR UPDATE SKIP LOCKED
+		)`,
+		now,
+		expiredRowsBatch,
+	)
+	if err != nil {
+		return IdempotencyRecord{}, fmt.Errorf(
+			"ReserveIdempotencyKey: failed to delete expired rows: %w",
+			err,
+		)
+	}
+
+	return record, nil
+}
+
+func (p *PostgresDb) CompleteIdempotencyKey(ctx context.Context, record IdempotencyRecord) error {
+	_, err := p.pool.Exec(
+		ctx,
+		`UPDATE idempotency_keys SET status = $3, content_type = $4, location = $5, body = $6
+		WHERE principal = $1 AND key = $2`,
+		record.Principal,
+		record.Key,
+		record.Status,
+		record.ContentType,
+		record.Location,
+		record.Body,
+	)
+	if err != nil {
+		return fmt.Errorf("CompleteIdempotencyKey: failed to update row: %w", err)
+	}
+	return nil
+}
+
+func (p *PostgresDb) ReleaseIdempotencyKey(
+	ctx context.Context,
+	principal string,
+	key string,
+) error {
+	_, err := p.pool.Exec(
+		ctx,
+		"DELETE FROM idempotency_keys WHERE principal = $1 AND key = $2",
+		principal,
+		key,
+	)
+	if err != nil {
+		return fmt.Errorf("ReleaseIdempotencyKey: failed to delete row: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const notificationColumns = "id, appointment_id, kind, recipient, subject, body, status, attempts, last_error, created_at, next_attempt_at, sent_at"
+
+func scanNotification(row pgx.Row) (Notification, error) {
+	var n Notification
+	err := row.Scan(
+		&n.Id,
+		&n.AppointmentId,
+		&n.Kind,
+		&n.Recipient,
+		&n.Subject,
+		&n.Body,
+		&n.Status,
+		&n.Attempts,
+		&n.LastError,
+		&n.CreatedAt,
+		&n.NextAttemptAt,
+		&n.SentAt,
+	)
+	return n, err
+}
+
+func (p *PostgresDb) CreateNotification(ctx context.Context, n Notification) (Notification, error) {
+	n = newNotification(n)
+
+	_, err := p.pool.Exec(
+		ctx,
+		"INSERT INTO notifications ("+notificationColumns+") VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)",
+		n.Id,
+		n.AppointmentId,
+		n.Kind,
+		n.Recipient,
+		n.Subject,
+		n.Body,
+		n.Status,
+		n.Attempts,
+		n.LastError,
+		n.CreatedAt,
+		n.NextAttemptAt,
+		n.SentAt,
+	)
+	if err != nil {
+		return Notification{}, fmt.Errorf("CreateNotification: failed to insert row: %w", err)
+	}
+
+	return n, nil
+}
+
+func (p *PostgresDb) DueNotifications(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+) ([]Notification, error) {
+	rows, err := p.pool.Query(
+		ctx,
+		"SELECT "+notificationColumns+` FROM notifications
+		WHERE status = $1 AND next_attempt_at <= $2
+		ORDER BY next_attempt_at
+		LIMIT $3`,
+		NotificationStatusPending,
+		now,
+		limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("DueNotifications: query failed: %w", err)
+	}
+
+	notifications, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (Notification, error) {
+		return scanNotification(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("DueNotifications: decode failed: %w", err)
+	}
+
+	return notifications, nil
+}
+
+func (p *PostgresDb) UpdateNotification(ctx context.Context, n Notification) error {
+	tag, err := p.pool.Exec(
+		ctx,
+		`UPDATE notifications
+		SET status = $2, attempts = $3, last_error = $4, next_attempt_at = $5, sent_at = $6
+		WHERE id = $1`,
+		n.Id,
+		n.Status,
+		n.Attempts,
+		n.LastError,
+		n.NextAttemptAt,
+		n.SentAt,
+	)
+	if err != nil {
+		return fmt.Errorf("UpdateNotification: failed to update row: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
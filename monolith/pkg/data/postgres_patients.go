@@ -0,0 +1,100 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const patientColumns = "id, email, first_name, last_name, deleted_at, retain_until, late_cancellations"
+
+func scanPatient(row pgx.Row) (Patient, error) {
+	var patient Patient
+	err := row.Scan(
+		&patient.Id,
+		&patient.Email,
+		&patient.FirstName,
+		&patient.LastName,
+		&patient.DeletedAt,
+		&patient.RetainUntil,
+		&patient.LateCancellations,
+	)
+	return patient, err
+}
+
+func (p *PostgresDb) CreatePatient(ctx context.Context, patient Patient) (Patient, error) {
+	patient.Id = uuid.New()
+
+	_, err := p.pool.Exec(
+		ctx,
+		"INSERT INTO patients (id, email, first_name, last_name) VALUES ($1, $2, $3, $4)",
+		patient.Id,
+		patient.Email,
+		patient.FirstName,
+		patient.LastName,
+	)
+	if err != nil {
+		if isPgErr(err, pgUniqueViolation) {
+			return Patient{}, ErrDuplicateEmail
+		}
+		return Patient{}, fmt.Errorf("CreatePatient: failed to insert row: %w", err)
+	}
+
+	return patient, nil
+}
+
+func (p *PostgresDb) PatientById(ctx context.Context, id uuid.UUID) (Patient, error) {
+	row := p.pool.QueryRow(ctx, "SELECT "+patientColumns+" FROM patients WHERE id = $1", id)
+	patient, err := scanPatient(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Patient{}, ErrNotFound
+		}
+		return Patient{}, fmt.Errorf("PatientById: failed to find row: %w", err)
+	}
+
+	return patient, nil
+}
+
+func (p *PostgresDb) PatientByEmail(ctx context.Context, email string) (Patient, error) {
+	row := p.pool.QueryRow(ctx, "SELECT "+patientColumns+" FROM patients WHERE email = $1", email)
+	patient, err := scanPatient(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Patient{}, ErrNotFound
+		}
+		return Patient{}, fmt.Errorf("PatientByEmail: failed to find row: %w", err)
+	}
+
+	return patient, nil
+}
+
+func (p *PostgresDb) AnonymizePatient(
+	ctx context.Context,
+	id uuid.UUID,
+	retainUntil time.Time,
+) (Patient, error) {
+	row := p.pool.QueryRow(ctx, `
+		UPDATE patients
+		SET email = $2, first_name = $3, last_name = $3, deleted_at = now(), retain_until = $4
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING `+patientColumns,
+		id,
+		anonymizedEmail(id),
+		AnonymizedPatientName,
+		retainUntil,
+	)
+	patient, err := scanPatient(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Patient{}, ErrNotFound
+		}
+		return Patient{}, fmt.Errorf("AnonymizePatient failed: %w", err)
+	}
+
+	return patient, nil
+}
@@ -0,0 +1,219 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const prescriptionColumns = "id, patient_id, appointment_id, name, start_time, end_time, doctors_note, deleted_at, version"
+
+func scanPrescription(row pgx.Row) (Prescription, error) {
+	var prescription Prescription
+	err := row.Scan(
+		&prescription.Id,
+		&prescription.PatientId,
+		&prescription.AppointmentId,
+		&prescription.Name,
+		&prescription.Start,
+		&prescription.End,
+		&prescription.DoctorsNote,
+		&prescription.DeletedAt,
+		&prescription.Version,
+	)
+	return prescription, err
+}
+
+func collectPrescriptions(rows pgx.Rows) ([]Prescription, error) {
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (Prescription, error) {
+		return scanPrescription(row)
+	})
+}
+
+func (p *PostgresDb) CreatePrescription(
+	ctx context.Context,
+	prescription Prescription,
+) (Prescription, error) {
+	prescription.Id = uuid.New()
+	prescription.Version = 1
+
+	_, err := p.pool.Exec(
+		ctx,
+		`INSERT INTO prescriptions (id, patient_id, appointment_id, name, start_time, end_time, doctors_note)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		prescription.Id,
+		prescription.PatientId,
+		prescription.AppointmentId,
+		prescription.Name,
+		prescription.Start,
+		prescription.End,
+		prescription.DoctorsNote,
+	)
+	if err != nil {
+		if isPgErr(err, pgForeignKeyViolation) {
+			return Prescription{}, fmt.Errorf(
+				"CreatePrescription patient or appointment check error: %w",
+				ErrNotFound,
+			)
+		}
+		return Prescription{}, fmt.Errorf("CreatePrescription failed to insert row: %w", err)
+	}
+
+	return prescription, nil
+}
+
+func (p *PostgresDb) PrescriptionById(ctx context.Context, id uuid.UUID) (Prescription, error) {
+	row := p.pool.QueryRow(
+		ctx,
+		"SELECT "+prescriptionColumns+" FROM prescriptions WHERE id = $1 AND deleted_at IS NULL",
+		id,
+	)
+	prescription, err := scanPrescription(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Prescription{}, ErrNotFound
+		}
+		return Prescription{}, fmt.Errorf("PrescriptionById failed to find row: %w", err)
+	}
+
+	return prescription, nil
+}
+
+func (p *PostgresDb) FindPrescriptionsByPatientId(
+	ctx context.Context,
+	patientId uuid.UUID,
+	from time.Time,
+	to *time.Time,
+) ([]Prescription, error) {
+	rows, err := p.pool.Query(ctx, `
+		SELECT `+prescriptionColumns+` FROM prescriptions
+		WHERE patient_id = $1
+			AND deleted_at IS NULL
+			AND end_time >= $2
+			AND ($3::timestamptz IS NULL OR start_time <= $3)
+		ORDER BY start_time`,
+		patientId,
+		from,
+		to,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("FindPrescriptionsByPatientId query failed: %w", err)
+	}
+
+	prescriptions, err := collectPrescriptions(rows)
+	if err != nil {
+		return nil, fmt.Errorf("FindPrescriptionsByPatientId scan failed: %w", err)
+	}
+
+	return prescriptions, nil
+}
+
+// UpdatePrescription updates the prescription if it is still at
+// prescription.Version, otherwise it fails with ErrVersionMismatch.
+func (p *PostgresDb) UpdatePrescription(
+	ctx context.Context,
+	id uuid.UUID,
+	prescription Prescription,
+) (Prescription, error) {
+	row := p.pool.QueryRow(ctx, `
+		UPDATE prescriptions
+		SET patient_id = $2, appointment_id = $3, name = $4,
+			start_time = $5, end_time = $6, doctors_note = $7, version = version + 1
+		WHERE id = $1 AND deleted_at IS NULL AND version = $8
+		RETURNING `+prescriptionColumns,
+		id,
+		prescription.PatientId,
+		prescription.AppointmentId,
+		prescription.Name,
+		prescription.Start,
+		prescription.End,
+		prescription.DoctorsNote,
+		prescription.Version,
+	)
+	updatedPrescription, err := scanPrescription(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Prescription{}, p.missingOrMoved(
+				ctx,
+				"SELECT 1 FROM prescriptions WHERE id = $1 AND deleted_at IS NULL",
+				id,
+			)
+		}
+		if isPgErr(err, pgForeignKeyViolation) {
+			return Prescription{}, fmt.Errorf(
+				"UpdatePrescription patient or appointment check error: %w",
+				ErrNotFound,
+			)
+		}
+		return Prescription{}, fmt.Errorf("UpdatePrescription failed: %w", err)
+	}
+
+	return updatedPrescription, nil
+}
+
+func (p *PostgresDb) PrescriptionByAppointmentId(
+	ctx context.Context,
+	appointmentId uuid.UUID,
+) ([]Prescription, error) {
+	rows, err := p.pool.Query(
+		ctx,
+		`SELECT `+prescriptionColumns+` FROM prescriptions
+		WHERE appointment_id = $1 AND deleted_at IS NULL
+		ORDER BY start_time`,
+		appointmentId,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("PrescriptionByAppointmentId query failed: %w", err)
+	}
+
+	prescriptions, err := collectPrescriptions(rows)
+	if err != nil {
+		return nil, fmt.Errorf("PrescriptionByAppointmentId scan failed: %w", err)
+	}
+
+	return prescriptions, nil
+}
+
+// DeletePrescription soft deletes the prescription, it is no longer returned
+// by any query, except the patient data export.
+func (p *PostgresDb) DeletePrescription(ctx context.Context, id uuid.UUID) error {
+	tag, err := p.pool.Exec(
+		ctx,
+		"UPDATE prescriptions SET deleted_at = now() WHERE id = $1 AND deleted_at IS NULL",
+		id,
+	)
+	if err != nil {
+		return fmt.Errorf("DeletePrescription failed: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (p *PostgresDb) AllPrescriptionsByPatientId(
+	ctx context.Context,
+	patientId uuid.UUID,
+) ([]Prescription, error) {
+	rows, err := p.pool.Query(
+		ctx,
+		"SELECT "+prescriptionColumns+" FROM prescriptions WHERE patient_id = $1 ORDER BY start_time",
+		patientId,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("AllPrescriptionsByPatientId query failed: %w", err)
+	}
+
+	prescriptions, err := collectPrescriptions(rows)
+	if err != nil {
+		return nil, fmt.Errorf("AllPrescriptionsByPatientId scan failed: %w", err)
+	}
+
+	return prescriptions, nil
+}
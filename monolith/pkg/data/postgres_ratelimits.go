@@ -0,0 +1,80 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// expiredRowsBatch limits how many expired rows are dropped at once, so that
+// the cleanup stays cheap.
+const expiredRowsBatch = 100
+
+func (p *PostgresDb) TakeRateLimitToken(
+	ctx context.Context,
+	key string,
+	limit RateLimit,
+	now time.Time,
+) (time.Duration, error) {
+	var retryAfter time.Duration
+	err := pgx.BeginFunc(ctx, p.pool, func(tx pgx.Tx) error {
+		_, err := tx.Exec(
+			ctx,
+			`INSERT INTO rate_limit_buckets (key, tokens, updated_at, expires_at)
+			VALUES ($1, $2, $3, $3) ON CONFLICT (key) DO NOTHING`,
+			key,
+			float64(limit.Burst),
+			now,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert row: %w", err)
+		}
+
+		var tokens float64
+		var updatedAt time.Time
+		err = tx.QueryRow(
+			ctx,
+			"SELECT tokens, updated_at FROM rate_limit_buckets WHERE key = $1 FOR UPDATE",
+			key,
+		).Scan(&tokens, &updatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to select row: %w", err)
+		}
+
+		tokens, retryAfter = limit.Take(tokens, updatedAt, now)
+		_, err = tx.Exec(
+			ctx,
+			`UPDATE rate_limit_buckets SET tokens = $2, updated_at = $3, expires_at = $4
+			WHERE key = $1`,
+			key,
+			tokens,
+			now,
+			now.Add(limit.Period),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to update row: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("TakeRateLimitToken: %w", err)
+	}
+
+	// buckets of idle clients are full again, so they can be dropped
+	_, err = p.pool.Exec(
+		ctx,
+		`DELETE FROM rate_limit_buckets WHERE key IN (
+			SELECT key FROM rate_limit_buckets WHERE expires_at < $1
+			LIMIT $2 FOR UPDATE SKIP LOCKED
+		)`,
+		now,
+		expiredRowsBatch,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("TakeRateLimitToken: failed to delete expired rows: %w", err)
+	}
+
+	return retryAfter, nil
+}
@@ -0,0 +1,119 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const reminderJobColumns = "id, appointment_id, offset_seconds, due_at, locked_by, locked_until, created_at"
+
+func scanReminderJob(row pgx.Row) (ReminderJob, error) {
+	var job ReminderJob
+	var offsetSeconds int64
+	err := row.Scan(
+		&job.Id,
+		&job.AppointmentId,
+		&offsetSeconds,
+		&job.DueAt,
+		&job.LockedBy,
+		&job.LockedUntil,
+		&job.CreatedAt,
+	)
+	job.Offset = time.Duration(offsetSeconds) * time.Second
+	return job, err
+}
+
+func (p *PostgresDb) ScheduleReminders(
+	ctx context.Context,
+	appointmentId uuid.UUID,
+	jobs []ReminderJob,
+) error {
+	err := pgx.BeginFunc(ctx, p.pool, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, "DELETE FROM reminder_jobs WHERE appointment_id = $1", appointmentId)
+		if err != nil {
+			return fmt.Errorf("failed to delete rows: %w", err)
+		}
+
+		for _, job := range newReminderJobs(appointmentId, jobs) {
+			_, err = tx.Exec(
+				ctx,
+				"INSERT INTO reminder_jobs ("+reminderJobColumns+") VALUES ($1, $2, $3, $4, $5, $6, $7)",
+				job.Id,
+				job.AppointmentId,
+				int64(job.Offset/time.Second),
+				job.DueAt,
+				job.LockedBy,
+				job.LockedUntil,
+				job.CreatedAt,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to insert row: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("ScheduleReminders: %w", err)
+	}
+
+	return nil
+}
+
+func (p *PostgresDb) CancelReminders(ctx context.Context, appointmentId uuid.UUID) error {
+	_, err := p.pool.Exec(ctx, "DELETE FROM reminder_jobs WHERE appointment_id = $1", appointmentId)
+	if err != nil {
+		return fmt.Errorf("CancelReminders: failed to delete rows: %w", err)
+	}
+	return nil
+}
+
+func (p *PostgresDb) ClaimDueReminder(
+	ctx context.Context,
+	now time.Time,
+	owner string,
+	lease time.Duration,
+) (ReminderJob, error) {
+	row := p.pool.QueryRow(
+		ctx,
+		`UPDATE reminder_jobs SET locked_by = $2, locked_until = $3
+		WHERE id = (
+			SELECT id FROM reminder_jobs
+			WHERE due_at <= $1 AND (locked_until IS NULL OR locked_until <= $1)
+			ORDER BY due_at
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING `+reminderJobColumns,
+		now,
+		owner,
+		now.Add(lease),
+	)
+
+	job, err := scanReminderJob(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ReminderJob{}, ErrNotFound
+		}
+		return ReminderJob{}, fmt.Errorf("ClaimDueReminder: %w", err)
+	}
+
+	return job, nil
+}
+
+func (p *PostgresDb) CompleteReminder(ctx context.Context, id uuid.UUID, owner string) error {
+	_, err := p.pool.Exec(
+		ctx,
+		"DELETE FROM reminder_jobs WHERE id = $1 AND locked_by = $2",
+		id,
+		owner,
+	)
+	if err != nil {
+		return fmt.Errorf("CompleteReminder: failed to delete row: %w", err)
+	}
+	return nil
+}
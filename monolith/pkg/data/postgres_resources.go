@@ -0,0 +1,317 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const reservationColumns = "id, appointment_id, resource_id, resource_name, resource_type, start_time, end_time"
+
+func scanReservation(row pgx.Row) (Reservation, error) {
+	var reservation Reservation
+	err := row.Scan(
+		&reservation.Id,
+		&reservation.AppointmentId,
+		&reservation.ResourceId,
+		&reservation.ResourceName,
+		&reservation.ResourceType,
+		&reservation.StartTime,
+		&reservation.EndTime,
+	)
+	return reservation, err
+}
+
+func (p *PostgresDb) CreateResource(
+	ctx context.Context,
+	name string,
+	typ ResourceType,
+) (Resource, error) {
+	resource := Resource{
+		Id:   uuid.New(),
+		Name: name,
+		Type: typ,
+	}
+
+	_, err := p.pool.Exec(
+		ctx,
+		"INSERT INTO resources (id, name, type) VALUES ($1, $2, $3)",
+		resource.Id,
+		resource.Name,
+		resource.Type,
+	)
+	if err != nil {
+		return Resource{}, fmt.Errorf(
+			"CreateResource creating %q failed to insert row: %w",
+			typ,
+			err,
+		)
+	}
+
+	return resource, nil
+}
+
+func (p *PostgresDb) ResourceById(ctx context.Context, id uuid.UUID) (Resource, error) {
+	return resourceById(ctx, p.pool, id)
+}
+
+func (p *PostgresDb) CreateReservation(
+	ctx context.Context,
+	appointmentId uuid.UUID,
+	resourceId uuid.UUID,
+	resourceName string,
+	resourceType ResourceType,
+	startTime time.Time,
+	endTime time.Time,
+) (Reservation, error) {
+	return createReservation(
+		ctx,
+		p.pool,
+		appointmentId,
+		resourceId,
+		resourceName,
+		resourceType,
+		startTime,
+		endTime,
+	)
+}
+
+func (p *PostgresDb) FindAvailableResourcesAtTime(
+	ctx context.Context,
+	start time.Time,
+	end time.Time,
+) (AvailableResources, error) {
+	result := AvailableResources{
+		Medicines:  make([]Resource, 0),
+		Facilities: make([]Resource, 0),
+		Equipment:  make([]Resource, 0),
+	}
+
+	rows, err := p.pool.Query(ctx, `
+		SELECT r.id, r.name, r.type FROM resources r
+		WHERE NOT EXISTS (
+			SELECT 1 FROM reservations res
+			WHERE res.resource_id = r.id
+				AND res.start_time < $2
+				AND res.end_time > $1
+		)
+		ORDER BY r.name`,
+		start,
+		end,
+	)
+	if err != nil {
+		return result, fmt.Errorf("FindAvailableResourcesAtTime query failed: %w", err)
+	}
+
+	availableResources, err := pgx.CollectRows(rows, pgx.RowToStructByPos[Resource])
+	if err != nil {
+		return result, fmt.Errorf("FindAvailableResourcesAtTime scan failed: %w", err)
+	}
+
+	for _, resource := range availableResources {
+		switch resource.Type {
+		case ResourceTypeMedicine:
+			result.Medicines = append(result.Medicines, resource)
+		case ResourceTypeFacility:
+			result.Facilities = append(result.Facilities, resource)
+		case ResourceTypeEquipment:
+			result.Equipment = append(result.Equipment, resource)
+		default:
+			slog.WarnContext(
+				ctx,
+				"Found resource with unknown type",
+				"resourceId",
+				resource.Id,
+				"type",
+				resource.Type,
+			)
+		}
+	}
+
+	return result, nil
+}
+
+func (p *PostgresDb) DeleteReservationsByAppointmentId(
+	ctx context.Context,
+	appointmentId uuid.UUID,
+) error {
+	_, err := p.pool.Exec(ctx, "DELETE FROM reservations WHERE appointment_id = $1", appointmentId)
+	if err != nil {
+		return fmt.Errorf("DeleteReservationsByAppointmentId failed: %w", err)
+	}
+
+	return nil
+}
+
+func (p *PostgresDb) ResourcesByAppointmentId(
+	ctx context.Context,
+	appointmentId uuid.UUID,
+) ([]Resource, error) {
+	rows, err := p.pool.Query(ctx, `
+		SELECT DISTINCT resource_id, resource_name, resource_type FROM reservations
+		WHERE appointment_id = $1`,
+		appointmentId,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("ResourcesByAppointmentId query failed: %w", err)
+	}
+
+	resources, err := pgx.CollectRows(rows, pgx.RowToStructByPos[Resource])
+	if err != nil {
+		return nil, fmt.Errorf("ResourcesByAppointmentId scan failed: %w", err)
+	}
+
+	return resources, nil
+}
+
+func (p *PostgresDb) ReservationsByAppointmentId(
+	ctx context.Context,
+	appointmentId uuid.UUID,
+) ([]Reservation, error) {
+	rows, err := p.pool.Query(
+		ctx,
+		"SELECT "+reservationColumns+" FROM reservations WHERE appointment_id = $1",
+		appointmentId,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("ReservationsByAppointmentId: %w", err)
+	}
+
+	reservations, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (Reservation, error) {
+		return scanReservation(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ReservationsByAppointmentId scan failed: %w", err)
+	}
+
+	return reservations, nil
+}
+
+func (p *PostgresDb) ReservationsByAppointmentIds(
+	ctx context.Context,
+	appointmentIds []uuid.UUID,
+) ([]Reservation, error) {
+	rows, err := p.pool.Query(
+		ctx,
+		"SELECT "+reservationColumns+" FROM reservations WHERE appointment_id = ANY($1)",
+		appointmentIds,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("ReservationsByAppointmentIds: %w", err)
+	}
+
+	reservations, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (Reservation, error) {
+		return scanReservation(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ReservationsByAppointmentIds scan failed: %w", err)
+	}
+
+	return reservations, nil
+}
+
+func (p *PostgresDb) ReservationsByResourceId(
+	ctx context.Context,
+	resourceId uuid.UUID,
+	from time.Time,
+	to *time.Time,
+) ([]Reservation, error) {
+	rows, err := p.pool.Query(
+		ctx,
+		"SELECT "+reservationColumns+` FROM reservations
+		WHERE resource_id = $1 AND end_time > $2 AND ($3::timestamptz IS NULL OR start_time < $3)
+		ORDER BY start_time`,
+		resourceId,
+		from,
+		to,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("ReservationsByResourceId: %w", err)
+	}
+
+	reservations, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (Reservation, error) {
+		return scanReservation(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ReservationsByResourceId scan failed: %w", err)
+	}
+
+	return reservations, nil
+}
+
+func resourceById(ctx context.Context, q pgQuerier, id uuid.UUID) (Resource, error) {
+	var resource Resource
+	err := q.QueryRow(ctx, "SELECT id, name, type FROM resources WHERE id = $1", id).
+		Scan(&resource.Id, &resource.Name, &resource.Type)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Resource{}, fmt.Errorf("ResourceById %s: %w", id, ErrNotFound)
+		}
+		return Resource{}, fmt.Errorf("ResourceById query failed for %s: %w", id, err)
+	}
+
+	return resource, nil
+}
+
+// createReservation creates, or updates the time slot of an existing,
+// reservation of the resource for the appointment. Overlapping reservations
+// of the same resource are rejected by the reservation_resource_no_overlap
+// constraint.
+func createReservation(
+	ctx context.Context,
+	q pgQuerier,
+	appointmentId uuid.UUID,
+	resourceId uuid.UUID,
+	resourceName string,
+	resourceType ResourceType,
+	startTime time.Time,
+	endTime time.Time,
+) (Reservation, error) {
+	if endTime.Before(startTime) || endTime.Equal(startTime) {
+		return Reservation{}, fmt.Errorf("CreateReservation: endTime must be after startTime")
+	}
+
+	row := q.QueryRow(ctx, `
+		INSERT INTO reservations (`+reservationColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (appointment_id, resource_id) DO UPDATE
+		SET resource_name = EXCLUDED.resource_name,
+			resource_type = EXCLUDED.resource_type,
+			start_time = EXCLUDED.start_time,
+			end_time = EXCLUDED.end_time
+		RETURNING `+reservationColumns,
+		uuid.New(),
+		appointmentId,
+		resourceId,
+		resourceName,
+		resourceType,
+		startTime,
+		endTime,
+	)
+	reservation, err := scanReservation(row)
+	if err != nil {
+		switch pgErrCode(err) {
+		case pgForeignKeyViolation:
+			return Reservation{}, fmt.Errorf(
+				"CreateReservation appointment or resource check error: %w",
+				ErrNotFound,
+			)
+		case pgExclusionViolation:
+			return Reservation{}, fmt.Errorf(
+				"%w: resourceId %s from %s to %s (conflict with another appointment)",
+				ErrResourceUnavailable,
+				resourceId,
+				startTime.Format(time.RFC3339),
+				endTime.Format(time.RFC3339),
+			)
+		}
+		return Reservation{}, fmt.Errorf("CreateReservation upsert failed: %w", err)
+	}
+
+	return reservation, nil
+}
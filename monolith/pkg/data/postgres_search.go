@@ -0,0 +1,72 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+func (p *PostgresDb) SearchDoctors(
+	ctx context.Context,
+	specialization *string,
+	from time.Time,
+	to time.Time,
+) ([]DoctorSchedule, error) {
+	rows, err := p.pool.Query(ctx, `
+		SELECT d.id, d.email, d.first_name, d.last_name, d.specialization,
+			d.appointment_durations, b.start_time, b.end_time
+		FROM doctors d
+		LEFT JOIN (
+			SELECT doctor_id, appointment_date_time AS start_time, end_time
+			FROM appointments
+			WHERE appointment_date_time < $3
+				AND end_time > $2
+				AND status NOT IN ('cancelled', 'denied')
+			UNION ALL
+			SELECT doctor_id, start_time, end_time
+			FROM doctor_absences
+			WHERE start_time < $3 AND end_time > $2
+		) b ON b.doctor_id = d.id
+		WHERE d.deleted_at IS NULL AND ($1::text IS NULL OR d.specialization = $1)
+		ORDER BY d.last_name, d.first_name, d.id, b.start_time`,
+		specialization,
+		from,
+		to,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("SearchDoctors query failed: %w", err)
+	}
+	defer rows.Close()
+
+	schedules := make([]DoctorSchedule, 0)
+	for rows.Next() {
+		var doctor Doctor
+		var start, end *time.Time
+		err := rows.Scan(
+			&doctor.Id,
+			&doctor.Email,
+			&doctor.FirstName,
+			&doctor.LastName,
+			&doctor.Specialization,
+			&doctor.AppointmentDurations,
+			&start,
+			&end,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("SearchDoctors scan failed: %w", err)
+		}
+
+		if len(schedules) == 0 || schedules[len(schedules)-1].Doctor.Id != doctor.Id {
+			schedules = append(schedules, DoctorSchedule{Doctor: doctor, Busy: []Interval{}})
+		}
+		if start != nil && end != nil {
+			schedule := &schedules[len(schedules)-1]
+			schedule.Busy = append(schedule.Busy, Interval{Start: *start, End: *end})
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("SearchDoctors rows failed: %w", err)
+	}
+
+	return schedules, nil
+}
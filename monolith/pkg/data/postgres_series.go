@@ -0,0 +1,97 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const appointmentSeriesColumns = "id, patient_id, doctor_id, type, frequency, interval, count, until, created_at"
+
+func (p *PostgresDb) CreateAppointmentSeries(
+	ctx context.Context,
+	series AppointmentSeries,
+) (AppointmentSeries, error) {
+	series.Id = uuid.New()
+	series.CreatedAt = time.Now()
+
+	_, err := p.pool.Exec(
+		ctx,
+		"INSERT INTO appointment_series ("+appointmentSeriesColumns+") VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
+		series.Id,
+		series.PatientId,
+		series.DoctorId,
+		series.Type,
+		series.Frequency,
+		series.Interval,
+		series.Count,
+		series.Until,
+		series.CreatedAt,
+	)
+	if err != nil {
+		if isPgErr(err, pgForeignKeyViolation) {
+			return AppointmentSeries{}, fmt.Errorf(
+				"CreateAppointmentSeries patient or doctor check: %w",
+				ErrNotFound,
+			)
+		}
+		return AppointmentSeries{}, fmt.Errorf("CreateAppointmentSeries: failed to insert row: %w", err)
+	}
+
+	return series, nil
+}
+
+func (p *PostgresDb) AppointmentSeriesById(
+	ctx context.Context,
+	id uuid.UUID,
+) (AppointmentSeries, error) {
+	var series AppointmentSeries
+	err := p.pool.QueryRow(
+		ctx,
+		"SELECT "+appointmentSeriesColumns+" FROM appointment_series WHERE id = $1",
+		id,
+	).Scan(
+		&series.Id,
+		&series.PatientId,
+		&series.DoctorId,
+		&series.Type,
+		&series.Frequency,
+		&series.Interval,
+		&series.Count,
+		&series.Until,
+		&series.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return AppointmentSeries{}, ErrNotFound
+		}
+		return AppointmentSeries{}, fmt.Errorf("AppointmentSeriesById: %w", err)
+	}
+
+	return series, nil
+}
+
+func (p *PostgresDb) AppointmentsBySeriesId(
+	ctx context.Context,
+	seriesId uuid.UUID,
+) ([]Appointment, error) {
+	rows, err := p.pool.Query(
+		ctx,
+		"SELECT "+appointmentColumns+" FROM appointments WHERE series_id = $1 ORDER BY appointment_date_time",
+		seriesId,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("AppointmentsBySeriesId: failed to query rows: %w", err)
+	}
+
+	appts, err := collectAppointments(rows)
+	if err != nil {
+		return nil, fmt.Errorf("AppointmentsBySeriesId: failed to scan rows: %w", err)
+	}
+
+	return appts, nil
+}
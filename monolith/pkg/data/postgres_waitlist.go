@@ -0,0 +1,360 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	waitlistEntryColumns = "id, doctor_id, patient_id, from_day, to_day, type, status, created_at"
+	waitlistOfferColumns = "id, entry_id, doctor_id, appointment_date_time, end_time, " +
+		"type, status, expires_at, created_at"
+)
+
+func scanWaitlistEntry(row pgx.Row) (WaitlistEntry, error) {
+	var entry WaitlistEntry
+	err := row.Scan(
+		&entry.Id,
+		&entry.DoctorId,
+		&entry.PatientId,
+		&entry.From,
+		&entry.To,
+		&entry.Type,
+		&entry.Status,
+		&entry.CreatedAt,
+	)
+	return entry, err
+}
+
+func scanWaitlistOffer(row pgx.Row) (WaitlistOffer, error) {
+	var offer WaitlistOffer
+	var endTime *time.Time
+	err := row.Scan(
+		&offer.Id,
+		&offer.EntryId,
+		&offer.DoctorId,
+		&offer.AppointmentDateTime,
+		&endTime,
+		&offer.Type,
+		&offer.Status,
+		&offer.ExpiresAt,
+		&offer.CreatedAt,
+	)
+	if endTime != nil {
+		offer.EndTime = *endTime
+	}
+	return offer, err
+}
+
+func (p *PostgresDb) CreateWaitlistEntry(
+	ctx context.Context,
+	entry WaitlistEntry,
+) (WaitlistEntry, error) {
+	entry.Id = uuid.New()
+	entry.Status = WaitlistEntryStatusWaiting
+	entry.CreatedAt = time.Now()
+
+	_, err := p.pool.Exec(
+		ctx,
+		"INSERT INTO waitlist_entries ("+waitlistEntryColumns+") VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
+		entry.Id,
+		entry.DoctorId,
+		entry.PatientId,
+		entry.From,
+		entry.To,
+		entry.Type,
+		entry.Status,
+		entry.CreatedAt,
+	)
+	if err != nil {
+		if isPgErr(err, pgForeignKeyViolation) {
+			return WaitlistEntry{}, fmt.Errorf(
+				"CreateWaitlistEntry patient or doctor check: %w",
+				ErrNotFound,
+			)
+		}
+		return WaitlistEntry{}, fmt.Errorf("CreateWaitlistEntry: failed to insert row: %w", err)
+	}
+
+	return entry, nil
+}
+
+func (p *PostgresDb) WaitlistEntryById(ctx context.Context, id uuid.UUID) (WaitlistEntry, error) {
+	row := p.pool.QueryRow(
+		ctx,
+		"SELECT "+waitlistEntryColumns+" FROM waitlist_entries WHERE id = $1",
+		id,
+	)
+	entry, err := scanWaitlistEntry(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return WaitlistEntry{}, ErrNotFound
+		}
+		return WaitlistEntry{}, fmt.Errorf("WaitlistEntryById: %w", err)
+	}
+
+	return entry, nil
+}
+
+func (p *PostgresDb) WaitlistEntriesByPatientId(
+	ctx context.Context,
+	patientId uuid.UUID,
+) ([]WaitlistEntry, error) {
+	rows, err := p.pool.Query(
+		ctx,
+		"SELECT "+waitlistEntryColumns+" FROM waitlist_entries WHERE patient_id = $1 ORDER BY created_at",
+		patientId,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("WaitlistEntriesByPatientId: failed to query rows: %w", err)
+	}
+
+	entries, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (WaitlistEntry, error) {
+		return scanWaitlistEntry(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("WaitlistEntriesByPatientId: failed to scan rows: %w", err)
+	}
+
+	return entries, nil
+}
+
+func (p *PostgresDb) DeleteWaitlistEntry(ctx context.Context, id uuid.UUID) error {
+	tag, err := p.pool.Exec(ctx, "DELETE FROM waitlist_entries WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("DeleteWaitlistEntry: failed to delete row: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (p *PostgresDb) DeleteWaitlistEntriesByDoctorId(
+	ctx context.Context,
+	doctorId uuid.UUID,
+) error {
+	_, err := p.pool.Exec(ctx, "DELETE FROM waitlist_entries WHERE doctor_id = $1", doctorId)
+	if err != nil {
+		return fmt.Errorf("DeleteWaitlistEntriesByDoctorId: failed to delete rows: %w", err)
+	}
+
+	return nil
+}
+
+func (p *PostgresDb) NextWaitlistCandidate(
+	ctx context.Context,
+	doctorId uuid.UUID,
+	start time.Time,
+	typ string,
+) (WaitlistEntry, error) {
+	row := p.pool.QueryRow(
+		ctx,
+		`SELECT `+waitlistEntryColumns+` FROM waitlist_entries e
+		WHERE e.doctor_id = $1 AND e.status = $2
+			AND (e.from_day IS NULL OR e.from_day <= $3)
+			AND (e.to_day IS NULL OR e.to_day >= $3)
+			AND (e.type IS NULL OR e.type = $4)
+			AND NOT EXISTS (
+				SELECT 1 FROM waitlist_offers o
+				WHERE o.entry_id = e.id AND o.appointment_date_time = $5
+			)
+		ORDER BY e.created_at
+		LIMIT 1`,
+		doctorId,
+		WaitlistEntryStatusWaiting,
+		slotDay(start),
+		typ,
+		start,
+	)
+
+	entry, err := scanWaitlistEntry(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return WaitlistEntry{}, ErrNotFound
+		}
+		return WaitlistEntry{}, fmt.Errorf("NextWaitlistCandidate: %w", err)
+	}
+
+	return entry, nil
+}
+
+func (p *PostgresDb) CreateWaitlistOffer(
+	ctx context.Context,
+	offer WaitlistOffer,
+) (WaitlistOffer, error) {
+	offer.Id = uuid.New()
+	offer.Status = WaitlistOfferStatusPending
+	offer.CreatedAt = time.Now()
+
+	err := pgx.BeginFunc(ctx, p.pool, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(
+			ctx,
+			"UPDATE waitlist_entries SET status = $3 WHERE id = $1 AND status = $2",
+			offer.EntryId,
+			WaitlistEntryStatusWaiting,
+			WaitlistEntryStatusOffered,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to update entry: %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			return ErrNotFound
+		}
+
+		_, err = tx.Exec(
+			ctx,
+			"INSERT INTO waitlist_offers ("+waitlistOfferColumns+") "+
+				"VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
+			offer.Id,
+			offer.EntryId,
+			offer.DoctorId,
+			offer.AppointmentDateTime,
+			offer.EndTime,
+			offer.Type,
+			offer.Status,
+			offer.ExpiresAt,
+			offer.CreatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert row: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return WaitlistOffer{}, fmt.Errorf("CreateWaitlistOffer: %w", err)
+	}
+
+	return offer, nil
+}
+
+func (p *PostgresDb) PendingWaitlistOffer(
+	ctx context.Context,
+	entryId uuid.UUID,
+) (WaitlistOffer, error) {
+	row := p.pool.QueryRow(
+		ctx,
+		"SELECT "+waitlistOfferColumns+" FROM waitlist_offers WHERE entry_id = $1 AND status = $2",
+		entryId,
+		WaitlistOfferStatusPending,
+	)
+	offer, err := scanWaitlistOffer(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return WaitlistOffer{}, ErrNotFound
+		}
+		return WaitlistOffer{}, fmt.Errorf("PendingWaitlistOffer: %w", err)
+	}
+
+	return offer, nil
+}
+
+func (p *PostgresDb) PendingWaitlistOffersByDoctorIdAndDate(
+	ctx context.Context,
+	doctorId uuid.UUID,
+	date time.Time,
+) ([]WaitlistOffer, error) {
+	startOfDay := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	rows, err := p.pool.Query(
+		ctx,
+		`SELECT `+waitlistOfferColumns+` FROM waitlist_offers
+		WHERE doctor_id = $1 AND status = $2
+		AND appointment_date_time >= $3 AND appointment_date_time < $4`,
+		doctorId,
+		WaitlistOfferStatusPending,
+		startOfDay,
+		startOfDay.AddDate(0, 0, 1),
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"PendingWaitlistOffersByDoctorIdAndDate: failed to query rows: %w",
+			err,
+		)
+	}
+
+	offers, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (WaitlistOffer, error) {
+		return scanWaitlistOffer(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf(
+			"PendingWaitlistOffersByDoctorIdAndDate: failed to scan rows: %w",
+			err,
+		)
+	}
+
+	return offers, nil
+}
+
+func (p *PostgresDb) ExpiredWaitlistOffers(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+) ([]WaitlistOffer, error) {
+	rows, err := p.pool.Query(
+		ctx,
+		`SELECT `+waitlistOfferColumns+` FROM waitlist_offers
+		WHERE status = $1 AND expires_at <= $2
+		ORDER BY expires_at
+		LIMIT $3`,
+		WaitlistOfferStatusPending,
+		now,
+		limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("ExpiredWaitlistOffers: failed to query rows: %w", err)
+	}
+
+	offers, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (WaitlistOffer, error) {
+		return scanWaitlistOffer(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ExpiredWaitlistOffers: failed to scan rows: %w", err)
+	}
+
+	return offers, nil
+}
+
+func (p *PostgresDb) ResolveWaitlistOffer(
+	ctx context.Context,
+	offer WaitlistOffer,
+	status WaitlistOfferStatus,
+	entryStatus WaitlistEntryStatus,
+) error {
+	err := pgx.BeginFunc(ctx, p.pool, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(
+			ctx,
+			"UPDATE waitlist_offers SET status = $3 WHERE id = $1 AND status = $2",
+			offer.Id,
+			WaitlistOfferStatusPending,
+			status,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to update row: %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			return ErrNotFound
+		}
+
+		_, err = tx.Exec(
+			ctx,
+			"UPDATE waitlist_entries SET status = $2 WHERE id = $1",
+			offer.EntryId,
+			entryStatus,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to update entry: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("ResolveWaitlistOffer: %w", err)
+	}
+
+	return nil
+}
@@ -21,6 +21,10 @@ type Prescription struct {
 	Start         time.Time  `bson:"start"                   json:"start"`
 	End           time.Time  `bson:"end"                     json:"end"`
 	DoctorsNote   *string    `bson:"doctorsNote,omitempty"   json:"doctorsNote,omitempty"`
+	DeletedAt     *time.Time `bson:"deletedAt,omitempty"     json:"deletedAt,omitempty"`
+	// Version is bumped by every update, an update expecting another version
+	// fails with ErrVersionMismatch.
+	Version int64 `bson:"version" json:"version"`
 }
 
 func (m *MongoDb) CreatePrescription(
@@ -33,6 +37,7 @@ func (m *MongoDb) CreatePrescription(
 
 	collection := m.Database.Collection(prescriptionsCollection)
 	prescription.Id = uuid.New()
+	prescription.Version = 1
 
 	_, err := collection.InsertOne(ctx, prescription)
 	if err != nil {
@@ -44,7 +49,7 @@ func (m *MongoDb) CreatePrescription(
 
 func (m *MongoDb) PrescriptionById(ctx context.Context, id uuid.UUID) (Prescription, error) {
 	collection := m.Database.Collection(prescriptionsCollection)
-	filter := bson.M{"_id": id}
+	filter := bson.M{"_id": id, "deletedAt": nil}
 	var prescription Prescription
 
 	err := collection.FindOne(ctx, filter).Decode(&prescription)
@@ -70,6 +75,7 @@ func (m *MongoDb) FindPrescriptionsByPatientId(
 	filter := bson.M{
 		"patientId": patientId,
 		"end":       bson.M{"$gte": from},
+		"deletedAt": nil,
 	}
 	if to != nil {
 		filter["start"] = bson.M{"$lte": *to}
@@ -86,23 +92,25 @@ func (m *MongoDb) FindPrescriptionsByPatientId(
 
 	defer func() {
 		if cerr := cursor.Close(ctx); cerr != nil {
-			slog.Warn("Failed to close prescriptions cursor", "error", cerr.Error())
+			slog.WarnContext(ctx, "Failed to close prescriptions cursor", "error", cerr.Error())
 		}
 	}()
 
 	if err = cursor.All(ctx, &prescriptions); err != nil {
-		slog.Error("Failed to decode documents from cursor", "error", err)
+		slog.ErrorContext(ctx, "Failed to decode documents from cursor", "error", err)
 		return nil, fmt.Errorf("FindPrescriptionsByPatientId decode failed: %w", err)
 	}
 
 	if err = cursor.Err(); err != nil {
-		slog.Error("Prescriptions cursor iteration error", "error", err)
+		slog.ErrorContext(ctx, "Prescriptions cursor iteration error", "error", err)
 		return nil, fmt.Errorf("FindPrescriptionsByPatientId cursor error: %w", err)
 	}
 
 	return prescriptions, nil
 }
 
+// UpdatePrescription updates the prescription if it is still at
+// prescription.Version, otherwise it fails with ErrVersionMismatch.
 func (m *MongoDb) UpdatePrescription(
 	ctx context.Context,
 	id uuid.UUID,
@@ -116,7 +124,11 @@ func (m *MongoDb) UpdatePrescription(
 	}
 
 	collection := m.Database.Collection(prescriptionsCollection)
-	filter := bson.M{"_id": id}
+	filter := bson.M{
+		"_id":       id,
+		"deletedAt": nil,
+		"version":   versionFilter(prescription.Version),
+	}
 
 	updatePayload := bson.M{
 		"patientId":     prescription.PatientId,
@@ -126,7 +138,7 @@ func (m *MongoDb) UpdatePrescription(
 		"end":           prescription.End,
 		"doctorsNote":   prescription.DoctorsNote,
 	}
-	update := bson.M{"$set": updatePayload}
+	update := bson.M{"$set": updatePayload, "$inc": bson.M{"version": 1}}
 
 	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
 
@@ -135,7 +147,11 @@ func (m *MongoDb) UpdatePrescription(
 		Decode(&updatedPrescription)
 	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
-			return Prescription{}, ErrNotFound
+			return Prescription{}, m.missingOrMoved(
+				ctx,
+				prescriptionsCollection,
+				bson.M{"_id": id, "deletedAt": nil},
+			)
 		}
 		return Prescription{}, fmt.Errorf("UpdatePrescription failed: %w", err)
 	}
@@ -150,7 +166,7 @@ func (m *MongoDb) PrescriptionByAppointmentId(
 	collection := m.Database.Collection(prescriptionsCollection)
 	prescriptions := make([]Prescription, 0)
 
-	filter := bson.M{"appointmentId": appointmentId}
+	filter := bson.M{"appointmentId": appointmentId, "deletedAt": nil}
 
 	findOptions := options.Find().SetSort(bson.D{{Key: "start", Value: 1}})
 
@@ -164,35 +180,73 @@ func (m *MongoDb) PrescriptionByAppointmentId(
 
 	defer func() {
 		if cerr := cursor.Close(ctx); cerr != nil {
-			slog.Warn("Failed to close prescriptions by appointment cursor", "error", cerr.Error())
+			slog.WarnContext(
+				ctx,
+				"Failed to close prescriptions by appointment cursor",
+				"error",
+				cerr.Error(),
+			)
 		}
 	}()
 
 	if err = cursor.All(ctx, &prescriptions); err != nil {
-		slog.Error("Failed to decode prescription documents from cursor", "error", err)
+		slog.ErrorContext(ctx, "Failed to decode prescription documents from cursor", "error", err)
 		return nil, fmt.Errorf("PrescriptionByAppointmentId decode failed: %w", err)
 	}
 
 	if err = cursor.Err(); err != nil {
-		slog.Error("Prescriptions by appointment cursor iteration error", "error", err)
+		slog.ErrorContext(ctx, "Prescriptions by appointment cursor iteration error", "error", err)
 		return nil, fmt.Errorf("PrescriptionByAppointmentId cursor error: %w", err)
 	}
 
 	return prescriptions, nil
 }
 
+// DeletePrescription soft deletes the prescription, it is no longer returned
+// by any query, except the patient data export.
 func (m *MongoDb) DeletePrescription(ctx context.Context, id uuid.UUID) error {
 	collection := m.Database.Collection(prescriptionsCollection)
-	filter := bson.M{"_id": id}
+	filter := bson.M{"_id": id, "deletedAt": nil}
+	update := bson.M{"$set": bson.M{"deletedAt": time.Now()}}
 
-	result, err := collection.DeleteOne(ctx, filter)
+	result, err := collection.UpdateOne(ctx, filter, update)
 	if err != nil {
 		return fmt.Errorf("DeletePrescription failed: %w", err)
 	}
 
-	if result.DeletedCount == 0 {
+	if result.MatchedCount == 0 {
 		return ErrNotFound
 	}
 
 	return nil
 }
+
+// AllPrescriptionsByPatientId returns every prescription of the patient,
+// including the deleted ones.
+func (m *MongoDb) AllPrescriptionsByPatientId(
+	ctx context.Context,
+	patientId uuid.UUID,
+) ([]Prescription, error) {
+	collection := m.Database.Collection(prescriptionsCollection)
+	prescriptions := make([]Prescription, 0)
+
+	filter := bson.M{"patientId": patientId}
+	findOptions := options.Find().SetSort(bson.D{{Key: "start", Value: 1}})
+
+	cursor, err := collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, fmt.Errorf("AllPrescriptionsByPatientId find failed: %w", err)
+	}
+
+	defer func() {
+		if cerr := cursor.Close(ctx); cerr != nil {
+			slog.WarnContext(ctx, "Failed to close prescriptions cursor", "error", cerr.Error())
+		}
+	}()
+
+	if err = cursor.All(ctx, &prescriptions); err != nil {
+		return nil, fmt.Errorf("AllPrescriptionsByPatientId decode failed: %w", err)
+	}
+
+	return prescriptions, nil
+}
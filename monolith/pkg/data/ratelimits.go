@@ -0,0 +1,103 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// RateLimit allows Burst requests at once, the bucket of a client is then
+// refilled with Burst tokens per Period.
+type RateLimit struct {
+	Burst  int
+	Period time.Duration
+}
+
+// refillRate is how many tokens are added to a bucket per second.
+func (l RateLimit) refillRate() float64 {
+	return float64(l.Burst) / l.Period.Seconds()
+}
+
+// Take refills the bucket, which had tokens at updatedAt, up to now and takes
+// a token from it. It returns the tokens left and, when the bucket had no whole
+// token to take, how long until it has one.
+func (l RateLimit) Take(
+	tokens float64,
+	updatedAt time.Time,
+	now time.Time,
+) (float64, time.Duration) {
+	elapsed := max(now.Sub(updatedAt).Seconds(), 0)
+	tokens = math.Min(float64(l.Burst), tokens+elapsed*l.refillRate())
+	if tokens >= 1 {
+		return tokens - 1, 0
+	}
+	return tokens, l.retryAfter(tokens)
+}
+
+func (l RateLimit) retryAfter(tokens float64) time.Duration {
+	return time.Duration(math.Ceil((1 - tokens) / l.refillRate() * float64(time.Second)))
+}
+
+// TakeRateLimitToken takes a token from the bucket of key, a missing bucket
+// is full. When the bucket is empty, it returns how long until a token is
+// available. The bucket is refilled and taken from atomically, so replicas
+// sharing the database share the limit. A bucket expires when it's surely full
+// again, so buckets of idle clients are dropped.
+func (m *MongoDb) TakeRateLimitToken(
+	ctx context.Context,
+	key string,
+	limit RateLimit,
+	now time.Time,
+) (time.Duration, error) {
+	elapsedSeconds := bson.M{"$divide": bson.A{
+		bson.M{"$subtract": bson.A{now, bson.M{"$ifNull": bson.A{"$updatedAt", now}}}},
+		1000,
+	}}
+	refilled := bson.M{"$min": bson.A{
+		float64(limit.Burst),
+		bson.M{"$add": bson.A{
+			bson.M{"$ifNull": bson.A{"$tokens", float64(limit.Burst)}},
+			bson.M{"$multiply": bson.A{elapsedSeconds, limit.refillRate()}},
+		}},
+	}}
+	pipeline := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{"tokens": refilled}}},
+		{{Key: "$set", Value: bson.M{
+			"taken":     bson.M{"$gte": bson.A{"$tokens", 1}},
+			"updatedAt": now,
+			"expiresAt": now.Add(limit.Period),
+		}}},
+		{{Key: "$set", Value: bson.M{
+			"tokens": bson.M{"$cond": bson.A{
+				"$taken",
+				bson.M{"$subtract": bson.A{"$tokens", 1}},
+				"$tokens",
+			}},
+		}}},
+	}
+
+	collection := m.Database.Collection(rateLimitBucketsCollection)
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	var bucket struct {
+		Tokens float64 `bson:"tokens"`
+		Taken  bool    `bson:"taken"`
+	}
+	err := collection.FindOneAndUpdate(ctx, bson.M{"_id": key}, pipeline, opts).Decode(&bucket)
+	if mongo.IsDuplicateKeyError(err) {
+		// a concurrent request created the bucket first
+		err = collection.FindOneAndUpdate(ctx, bson.M{"_id": key}, pipeline, opts).Decode(&bucket)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("TakeRateLimitToken: failed to update bucket: %w", err)
+	}
+
+	if bucket.Taken {
+		return 0, nil
+	}
+	return limit.retryAfter(bucket.Tokens), nil
+}
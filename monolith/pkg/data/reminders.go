@@ -0,0 +1,115 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// ReminderJob is a reminder of an appointment due at DueAt. A scheduler
+// claims the job by leasing it until LockedUntil, so replicas don't send the
+// same reminder, and deletes it once the reminder is queued. Jobs whose
+// lease expired can be claimed again. Offset is how long before the
+// appointment the reminder is sent.
+type ReminderJob struct {
+	Id            uuid.UUID     `bson:"_id"                   json:"id"`
+	AppointmentId uuid.UUID     `bson:"appointmentId"         json:"appointmentId"`
+	Offset        time.Duration `bson:"offset"                json:"offset"`
+	DueAt         time.Time     `bson:"dueAt"                 json:"dueAt"`
+	LockedBy      *string       `bson:"lockedBy,omitempty"    json:"lockedBy,omitempty"`
+	LockedUntil   *time.Time    `bson:"lockedUntil,omitempty" json:"lockedUntil,omitempty"`
+	CreatedAt     time.Time     `bson:"createdAt"             json:"createdAt"`
+}
+
+// newReminderJobs prepares jobs of the appointment for their first claim.
+func newReminderJobs(appointmentId uuid.UUID, jobs []ReminderJob) []ReminderJob {
+	now := time.Now()
+	for i := range jobs {
+		jobs[i].Id = uuid.New()
+		jobs[i].AppointmentId = appointmentId
+		jobs[i].LockedBy = nil
+		jobs[i].LockedUntil = nil
+		jobs[i].CreatedAt = now
+	}
+	return jobs
+}
+
+// ScheduleReminders replaces reminder jobs of the appointment with jobs.
+func (m *MongoDb) ScheduleReminders(
+	ctx context.Context,
+	appointmentId uuid.UUID,
+	jobs []ReminderJob,
+) error {
+	if err := m.CancelReminders(ctx, appointmentId); err != nil {
+		return fmt.Errorf("ScheduleReminders: %w", err)
+	}
+	if len(jobs) == 0 {
+		return nil
+	}
+
+	collection := m.Database.Collection(reminderJobsCollection)
+	_, err := collection.InsertMany(ctx, newReminderJobs(appointmentId, jobs))
+	if err != nil {
+		return fmt.Errorf("ScheduleReminders: failed to insert documents: %w", err)
+	}
+
+	return nil
+}
+
+func (m *MongoDb) CancelReminders(ctx context.Context, appointmentId uuid.UUID) error {
+	collection := m.Database.Collection(reminderJobsCollection)
+	_, err := collection.DeleteMany(ctx, bson.M{"appointmentId": appointmentId})
+	if err != nil {
+		return fmt.Errorf("CancelReminders: failed to delete documents: %w", err)
+	}
+	return nil
+}
+
+// ClaimDueReminder leases the longest due job, which isn't leased by anyone
+// else, to owner. ErrNotFound is returned if there is no such job.
+func (m *MongoDb) ClaimDueReminder(
+	ctx context.Context,
+	now time.Time,
+	owner string,
+	lease time.Duration,
+) (ReminderJob, error) {
+	collection := m.Database.Collection(reminderJobsCollection)
+	filter := bson.M{
+		"dueAt": bson.M{"$lte": now},
+		"$or": bson.A{
+			bson.M{"lockedUntil": bson.M{"$exists": false}},
+			bson.M{"lockedUntil": bson.M{"$lte": now}},
+		},
+	}
+	update := bson.M{"$set": bson.M{"lockedBy": owner, "lockedUntil": now.Add(lease)}}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.M{"dueAt": 1}).
+		SetReturnDocument(options.After)
+
+	var job ReminderJob
+	err := collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&job)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return ReminderJob{}, ErrNotFound
+		}
+		return ReminderJob{}, fmt.Errorf("ClaimDueReminder: failed to update document: %w", err)
+	}
+
+	return job, nil
+}
+
+// CompleteReminder deletes the job, if it is still leased by owner.
+func (m *MongoDb) CompleteReminder(ctx context.Context, id uuid.UUID, owner string) error {
+	collection := m.Database.Collection(reminderJobsCollection)
+	_, err := collection.DeleteOne(ctx, bson.M{"_id": id, "lockedBy": owner})
+	if err != nil {
+		return fmt.Errorf("CompleteReminder: failed to delete document: %w", err)
+	}
+	return nil
+}
@@ -173,18 +173,10 @@ func (m *MongoDb) CreateReservation(
 
 func (m *MongoDb) FindAvailableResourcesAtTime(
 	ctx context.Context,
-	appointmentDate time.Time,
-) (struct {
-	Medicines  []Resource
-	Facilities []Resource
-	Equipment  []Resource
-}, error,
-) {
-	result := struct {
-		Medicines  []Resource
-		Facilities []Resource
-		Equipment  []Resource
-	}{
+	start time.Time,
+	end time.Time,
+) (AvailableResources, error) {
+	result := AvailableResources{
 		Medicines:  make([]Resource, 0),
 		Facilities: make([]Resource, 0),
 		Equipment:  make([]Resource, 0),
@@ -195,9 +187,9 @@ func (m *MongoDb) FindAvailableResourcesAtTime(
 	// --- Aggregation Pipeline ---
 	// 1. $lookup: Join resources with reservations to find conflicting bookings.
 	//    - Use a pipeline within $lookup to filter reservations *before* joining.
-	//    - Filter condition: Find reservations where the given appointmentDate
-	//      falls within the reservation's time slot [startTime, endTime).
-	//      (startTime <= appointmentDate < endTime)
+	//    - Filter condition: Find reservations whose time slot [startTime, endTime)
+	//      overlaps the requested slot [start, end).
+	//      (startTime < end && endTime > start)
 	// 2. $match: Keep only those resources where the lookup found *no*
 	//    conflicting reservations (i.e., the resulting array is empty).
 
@@ -217,11 +209,11 @@ func (m *MongoDb) FindAvailableResourcesAtTime(
 										"$eq": []any{"$resourceId", "$$resource_id"},
 									}, // Match resource ID
 									{
-										"$lte": []any{"$startTime", appointmentDate},
-									}, // Reservation starts <= appointmentDate
+										"$lt": []any{"$startTime", end},
+									}, // Reservation starts before the slot ends
 									{
-										"$gt": []any{"$endTime", appointmentDate},
-									}, // Reservation ends > appointmentDate
+										"$gt": []any{"$endTime", start},
+									}, // Reservation ends after the slot starts
 								},
 							},
 						}},
@@ -247,7 +239,12 @@ func (m *MongoDb) FindAvailableResourcesAtTime(
 	}
 	defer func() {
 		if cerr := cursor.Close(ctx); cerr != nil {
-			slog.Warn("Failed to close available resources cursor", "error", cerr.Error())
+			slog.WarnContext(
+				ctx,
+				"Failed to close available resources cursor",
+				"error",
+				cerr.Error(),
+			)
 		}
 	}()
 
@@ -265,7 +262,8 @@ func (m *MongoDb) FindAvailableResourcesAtTime(
 		case ResourceTypeEquipment:
 			result.Equipment = append(result.Equipment, resource)
 		default:
-			slog.Warn(
+			slog.WarnContext(
+				ctx,
 				"Found resource with unknown type",
 				"resourceId",
 				resource.Id,
@@ -338,7 +336,7 @@ func (m *MongoDb) ReservationsByAppointmentId(
 	}
 	defer func() {
 		if cerr := cursor.Close(ctx); cerr != nil {
-			slog.Warn("Failed to close reservations cursor", "error", cerr.Error())
+			slog.WarnContext(ctx, "Failed to close reservations cursor", "error", cerr.Error())
 		}
 	}()
 
@@ -353,6 +351,69 @@ func (m *MongoDb) ReservationsByAppointmentId(
 	return reservations, nil
 }
 
+// ReservationsByAppointmentIds returns reservations of all the appointments.
+func (m *MongoDb) ReservationsByAppointmentIds(
+	ctx context.Context,
+	appointmentIds []uuid.UUID,
+) ([]Reservation, error) {
+	collection := m.Database.Collection(reservationsCollection)
+	filter := bson.M{"appointmentId": bson.M{"$in": appointmentIds}}
+
+	reservations := make([]Reservation, 0)
+	cursor, err := collection.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("ReservationsByAppointmentIds: %w", err)
+	}
+	defer func() {
+		if cerr := cursor.Close(ctx); cerr != nil {
+			slog.WarnContext(ctx, "Failed to close reservations cursor", "error", cerr.Error())
+		}
+	}()
+
+	if err = cursor.All(ctx, &reservations); err != nil {
+		return nil, fmt.Errorf("ReservationsByAppointmentIds decode failed: %w", err)
+	}
+
+	if err = cursor.Err(); err != nil {
+		return nil, fmt.Errorf("ReservationsByAppointmentIds cursor error: %w", err)
+	}
+
+	return reservations, nil
+}
+
+// ReservationsByResourceId returns reservations of the resource which
+// overlap with the interval from from to to, to being optional.
+func (m *MongoDb) ReservationsByResourceId(
+	ctx context.Context,
+	resourceId uuid.UUID,
+	from time.Time,
+	to *time.Time,
+) ([]Reservation, error) {
+	collection := m.Database.Collection(reservationsCollection)
+	filter := bson.M{"resourceId": resourceId, "endTime": bson.M{"$gt": from}}
+	if to != nil {
+		filter["startTime"] = bson.M{"$lt": *to}
+	}
+
+	reservations := make([]Reservation, 0)
+	opts := options.Find().SetSort(bson.D{{Key: "startTime", Value: 1}})
+	cursor, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("ReservationsByResourceId: %w", err)
+	}
+	defer func() {
+		if cerr := cursor.Close(ctx); cerr != nil {
+			slog.WarnContext(ctx, "Failed to close reservations cursor", "error", cerr.Error())
+		}
+	}()
+
+	if err = cursor.All(ctx, &reservations); err != nil {
+		return nil, fmt.Errorf("ReservationsByResourceId decode failed: %w", err)
+	}
+
+	return reservations, nil
+}
+
 func (m *MongoDb) resourceExists(ctx context.Context, id uuid.UUID) error {
 	resourcesColl := m.Database.Collection(resourcesCollection)
 	filter := bson.M{"_id": id}
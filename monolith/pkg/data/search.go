@@ -0,0 +1,117 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// Interval is the half-open time interval [Start, End).
+type Interval struct {
+	Start time.Time `bson:"start" json:"start"`
+	End   time.Time `bson:"end"   json:"end"`
+}
+
+// DoctorSchedule is a doctor with the intervals in which they are busy with
+// active appointments or absent. The intervals may overlap.
+type DoctorSchedule struct {
+	Doctor Doctor     `bson:",inline"`
+	Busy   []Interval `bson:"busy"`
+}
+
+// SearchDoctors returns doctors with the specialization, or all doctors if it
+// is nil, together with their appointments and absences overlapping
+// [from, to). Doctors, their appointments and absences are joined in a single
+// aggregation.
+func (m *MongoDb) SearchDoctors(
+	ctx context.Context,
+	specialization *string,
+	from time.Time,
+	to time.Time,
+) ([]DoctorSchedule, error) {
+	doctorsColl := m.Database.Collection(doctorsCollection)
+
+	match := bson.M{"deletedAt": nil}
+	if specialization != nil {
+		match["specialization"] = *specialization
+	}
+
+	pipeline := mongo.Pipeline{
+		bson.D{{Key: "$match", Value: match}},
+		bson.D{{Key: "$lookup", Value: bson.M{
+			"from": appointmentsCollection,
+			"let":  bson.M{"doctor_id": "$_id"},
+			"pipeline": mongo.Pipeline{
+				bson.D{{Key: "$match", Value: bson.M{
+					"$expr": bson.M{
+						"$and": []bson.M{
+							{"$eq": []any{"$doctorId", "$$doctor_id"}},
+							{"$lt": []any{"$appointmentDateTime", to}},
+							{"$gt": []any{"$endTime", from}},
+							{"$not": bson.M{
+								"$in": []any{"$status", []string{"cancelled", "denied"}},
+							}},
+						},
+					},
+				}}},
+				bson.D{{Key: "$sort", Value: bson.M{"appointmentDateTime": 1}}},
+				bson.D{{Key: "$project", Value: bson.M{
+					"_id":   0,
+					"start": "$appointmentDateTime",
+					"end":   "$endTime",
+				}}},
+			},
+			"as": "busy",
+		}}},
+		bson.D{{Key: "$lookup", Value: bson.M{
+			"from": doctorAbsencesCollection,
+			"let":  bson.M{"doctor_id": "$_id"},
+			"pipeline": mongo.Pipeline{
+				bson.D{{Key: "$match", Value: bson.M{
+					"$expr": bson.M{
+						"$and": []bson.M{
+							{"$eq": []any{"$doctorId", "$$doctor_id"}},
+							{"$lt": []any{"$start", to}},
+							{"$gt": []any{"$end", from}},
+						},
+					},
+				}}},
+				bson.D{{Key: "$project", Value: bson.M{"_id": 0, "start": 1, "end": 1}}},
+			},
+			"as": "absent",
+		}}},
+		bson.D{{Key: "$set", Value: bson.M{
+			"busy": bson.M{"$concatArrays": []string{"$busy", "$absent"}},
+		}}},
+		bson.D{{Key: "$unset", Value: "absent"}},
+		bson.D{{Key: "$sort", Value: bson.D{
+			{Key: "lastName", Value: 1},
+			{Key: "firstName", Value: 1},
+		}}},
+	}
+
+	cursor, err := doctorsColl.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("SearchDoctors aggregation failed: %w", err)
+	}
+	defer func() {
+		if cerr := cursor.Close(ctx); cerr != nil {
+			slog.WarnContext(ctx, "Failed to close doctor search cursor", "error", cerr.Error())
+		}
+	}()
+
+	schedules := make([]DoctorSchedule, 0)
+	if err = cursor.All(ctx, &schedules); err != nil {
+		return nil, fmt.Errorf("SearchDoctors decode failed: %w", err)
+	}
+
+	if err = cursor.Err(); err != nil {
+		return nil, fmt.Errorf("SearchDoctors cursor error: %w", err)
+	}
+
+	return schedules, nil
+}
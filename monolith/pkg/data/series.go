@@ -0,0 +1,98 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// AppointmentSeries is a recurring series of appointments, its occurrences
+// are appointments with the series' id. Occurrences repeat every Interval
+// units of Frequency, until there are Count of them, or until Until.
+type AppointmentSeries struct {
+	Id        uuid.UUID  `bson:"_id"             json:"id"`
+	PatientId uuid.UUID  `bson:"patientId"       json:"patientId"`
+	DoctorId  uuid.UUID  `bson:"doctorId"        json:"doctorId"`
+	Type      string     `bson:"type"            json:"type"`
+	Frequency string     `bson:"frequency"       json:"frequency"`
+	Interval  int        `bson:"interval"        json:"interval"`
+	Count     *int       `bson:"count,omitempty" json:"count,omitempty"`
+	Until     *time.Time `bson:"until,omitempty" json:"until,omitempty"`
+	CreatedAt time.Time  `bson:"createdAt"       json:"createdAt"`
+}
+
+func (m *MongoDb) CreateAppointmentSeries(
+	ctx context.Context,
+	series AppointmentSeries,
+) (AppointmentSeries, error) {
+	if err := m.patientExists(ctx, series.PatientId); err != nil {
+		return AppointmentSeries{}, fmt.Errorf("CreateAppointmentSeries patient check: %w", err)
+	}
+	if err := m.doctorExists(ctx, series.DoctorId); err != nil {
+		return AppointmentSeries{}, fmt.Errorf("CreateAppointmentSeries doctor check: %w", err)
+	}
+
+	collection := m.Database.Collection(appointmentSeriesCollection)
+	series.Id = uuid.New()
+	series.CreatedAt = time.Now()
+
+	_, err := collection.InsertOne(ctx, series)
+	if err != nil {
+		return AppointmentSeries{}, fmt.Errorf(
+			"CreateAppointmentSeries: failed to insert document: %w",
+			err,
+		)
+	}
+
+	return series, nil
+}
+
+func (m *MongoDb) AppointmentSeriesById(
+	ctx context.Context,
+	id uuid.UUID,
+) (AppointmentSeries, error) {
+	collection := m.Database.Collection(appointmentSeriesCollection)
+
+	var series AppointmentSeries
+	err := collection.FindOne(ctx, bson.M{"_id": id}).Decode(&series)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return AppointmentSeries{}, ErrNotFound
+		}
+		return AppointmentSeries{}, fmt.Errorf(
+			"AppointmentSeriesById: failed to find document: %w",
+			err,
+		)
+	}
+
+	return series, nil
+}
+
+// AppointmentsBySeriesId returns occurrences of the series, the earliest
+// first.
+func (m *MongoDb) AppointmentsBySeriesId(
+	ctx context.Context,
+	seriesId uuid.UUID,
+) ([]Appointment, error) {
+	collection := m.Database.Collection(appointmentsCollection)
+	opts := options.Find().SetSort(bson.M{"appointmentDateTime": 1})
+
+	cursor, err := collection.Find(ctx, bson.M{"seriesId": seriesId}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("AppointmentsBySeriesId: failed to find documents: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var appts []Appointment
+	if err = cursor.All(ctx, &appts); err != nil {
+		return nil, fmt.Errorf("AppointmentsBySeriesId: failed to decode documents: %w", err)
+	}
+
+	return appts, nil
+}
@@ -0,0 +1,383 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+type WaitlistEntryStatus string
+
+const (
+	WaitlistEntryStatusWaiting WaitlistEntryStatus = "waiting"
+	WaitlistEntryStatusOffered WaitlistEntryStatus = "offered"
+	WaitlistEntryStatusBooked  WaitlistEntryStatus = "booked"
+)
+
+type WaitlistOfferStatus string
+
+const (
+	WaitlistOfferStatusPending  WaitlistOfferStatus = "pending"
+	WaitlistOfferStatusAccepted WaitlistOfferStatus = "accepted"
+	WaitlistOfferStatusDeclined WaitlistOfferStatus = "declined"
+	WaitlistOfferStatusExpired  WaitlistOfferStatus = "expired"
+)
+
+// WaitlistEntry is a patient waiting for a freed slot of a doctor. Only
+// slots on days From through To, both inclusive, of Type are offered to the
+// entry, if they are set.
+type WaitlistEntry struct {
+	Id        uuid.UUID           `bson:"_id"            json:"id"`
+	DoctorId  uuid.UUID           `bson:"doctorId"       json:"doctorId"`
+	PatientId uuid.UUID           `bson:"patientId"      json:"patientId"`
+	From      *time.Time          `bson:"from,omitempty" json:"from,omitempty"`
+	To        *time.Time          `bson:"to,omitempty"   json:"to,omitempty"`
+	Type      *string             `bson:"type,omitempty" json:"type,omitempty"`
+	Status    WaitlistEntryStatus `bson:"status"         json:"status"`
+	CreatedAt time.Time           `bson:"createdAt"      json:"createdAt"`
+}
+
+// WaitlistOffer is a freed slot held for a waitlist entry until ExpiresAt.
+// Offers stay after they are resolved, so a slot isn't offered to the same
+// entry twice. EndTime is zero for offers made before it was stored.
+type WaitlistOffer struct {
+	Id                  uuid.UUID           `bson:"_id"                 json:"id"`
+	EntryId             uuid.UUID           `bson:"entryId"             json:"entryId"`
+	DoctorId            uuid.UUID           `bson:"doctorId"            json:"doctorId"`
+	AppointmentDateTime time.Time           `bson:"appointmentDateTime" json:"appointmentDateTime"`
+	EndTime             time.Time           `bson:"endTime"             json:"endTime"`
+	Type                string              `bson:"type"                json:"type"`
+	Status              WaitlistOfferStatus `bson:"status"              json:"status"`
+	ExpiresAt           time.Time           `bson:"expiresAt"           json:"expiresAt"`
+	CreatedAt           time.Time           `bson:"createdAt"           json:"createdAt"`
+}
+
+// slotDay returns the day of the slot, as the days of waitlist entries are
+// stored.
+func slotDay(start time.Time) time.Time {
+	start = start.UTC()
+	return time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func (m *MongoDb) CreateWaitlistEntry(
+	ctx context.Context,
+	entry WaitlistEntry,
+) (WaitlistEntry, error) {
+	if err := m.patientExists(ctx, entry.PatientId); err != nil {
+		return WaitlistEntry{}, fmt.Errorf("CreateWaitlistEntry patient check: %w", err)
+	}
+	if err := m.doctorExists(ctx, entry.DoctorId); err != nil {
+		return WaitlistEntry{}, fmt.Errorf("CreateWaitlistEntry doctor check: %w", err)
+	}
+
+	collection := m.Database.Collection(waitlistEntriesCollection)
+	entry.Id = uuid.New()
+	entry.Status = WaitlistEntryStatusWaiting
+	entry.CreatedAt = time.Now()
+
+	_, err := collection.InsertOne(ctx, entry)
+	if err != nil {
+		return WaitlistEntry{}, fmt.Errorf("CreateWaitlistEntry: failed to insert document: %w", err)
+	}
+
+	return entry, nil
+}
+
+func (m *MongoDb) WaitlistEntryById(ctx context.Context, id uuid.UUID) (WaitlistEntry, error) {
+	collection := m.Database.Collection(waitlistEntriesCollection)
+
+	var entry WaitlistEntry
+	err := collection.FindOne(ctx, bson.M{"_id": id}).Decode(&entry)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return WaitlistEntry{}, ErrNotFound
+		}
+		return WaitlistEntry{}, fmt.Errorf("WaitlistEntryById: failed to find document: %w", err)
+	}
+
+	return entry, nil
+}
+
+func (m *MongoDb) WaitlistEntriesByPatientId(
+	ctx context.Context,
+	patientId uuid.UUID,
+) ([]WaitlistEntry, error) {
+	collection := m.Database.Collection(waitlistEntriesCollection)
+	opts := options.Find().SetSort(bson.M{"createdAt": 1})
+
+	cursor, err := collection.Find(ctx, bson.M{"patientId": patientId}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("WaitlistEntriesByPatientId: failed to find documents: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var entries []WaitlistEntry
+	if err = cursor.All(ctx, &entries); err != nil {
+		return nil, fmt.Errorf("WaitlistEntriesByPatientId: failed to decode documents: %w", err)
+	}
+
+	return entries, nil
+}
+
+// DeleteWaitlistEntry deletes the entry together with its offers.
+func (m *MongoDb) DeleteWaitlistEntry(ctx context.Context, id uuid.UUID) error {
+	collection := m.Database.Collection(waitlistEntriesCollection)
+	res, err := collection.DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		return fmt.Errorf("DeleteWaitlistEntry: failed to delete document: %w", err)
+	}
+	if res.DeletedCount == 0 {
+		return ErrNotFound
+	}
+
+	offersColl := m.Database.Collection(waitlistOffersCollection)
+	_, err = offersColl.DeleteMany(ctx, bson.M{"entryId": id})
+	if err != nil {
+		return fmt.Errorf("DeleteWaitlistEntry: failed to delete offers: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteWaitlistEntriesByDoctorId deletes all entries of the doctor together
+// with their offers.
+func (m *MongoDb) DeleteWaitlistEntriesByDoctorId(ctx context.Context, doctorId uuid.UUID) error {
+	collection := m.Database.Collection(waitlistEntriesCollection)
+	_, err := collection.DeleteMany(ctx, bson.M{"doctorId": doctorId})
+	if err != nil {
+		return fmt.Errorf("DeleteWaitlistEntriesByDoctorId: failed to delete documents: %w", err)
+	}
+
+	offersColl := m.Database.Collection(waitlistOffersCollection)
+	_, err = offersColl.DeleteMany(ctx, bson.M{"doctorId": doctorId})
+	if err != nil {
+		return fmt.Errorf("DeleteWaitlistEntriesByDoctorId: failed to delete offers: %w", err)
+	}
+
+	return nil
+}
+
+// NextWaitlistCandidate returns the longest waiting entry of the doctor,
+// which matches the slot and wasn't offered it yet. ErrNotFound is returned
+// if there is no such entry.
+func (m *MongoDb) NextWaitlistCandidate(
+	ctx context.Context,
+	doctorId uuid.UUID,
+	start time.Time,
+	typ string,
+) (WaitlistEntry, error) {
+	offersColl := m.Database.Collection(waitlistOffersCollection)
+	offeredFilter := bson.M{"doctorId": doctorId, "appointmentDateTime": start}
+	cursor, err := offersColl.Find(ctx, offeredFilter)
+	if err != nil {
+		return WaitlistEntry{}, fmt.Errorf("NextWaitlistCandidate: failed to find offers: %w", err)
+	}
+	defer func() {
+		if cerr := cursor.Close(ctx); cerr != nil {
+			slog.WarnContext(ctx, "Failed to close cursor", "error", cerr.Error())
+		}
+	}()
+
+	var offers []WaitlistOffer
+	if err = cursor.All(ctx, &offers); err != nil {
+		return WaitlistEntry{}, fmt.Errorf("NextWaitlistCandidate: failed to decode offers: %w", err)
+	}
+	offered := make([]uuid.UUID, 0, len(offers))
+	for _, offer := range offers {
+		offered = append(offered, offer.EntryId)
+	}
+
+	day := slotDay(start)
+	filter := bson.M{
+		"doctorId": doctorId,
+		"status":   WaitlistEntryStatusWaiting,
+		"_id":      bson.M{"$nin": offered},
+		"$and": bson.A{
+			bson.M{"$or": bson.A{
+				bson.M{"from": bson.M{"$exists": false}},
+				bson.M{"from": bson.M{"$lte": day}},
+			}},
+			bson.M{"$or": bson.A{
+				bson.M{"to": bson.M{"$exists": false}},
+				bson.M{"to": bson.M{"$gte": day}},
+			}},
+			bson.M{"$or": bson.A{
+				bson.M{"type": bson.M{"$exists": false}},
+				bson.M{"type": typ},
+			}},
+		},
+	}
+	opts := options.FindOne().SetSort(bson.M{"createdAt": 1})
+
+	collection := m.Database.Collection(waitlistEntriesCollection)
+	var entry WaitlistEntry
+	err = collection.FindOne(ctx, filter, opts).Decode(&entry)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return WaitlistEntry{}, ErrNotFound
+		}
+		return WaitlistEntry{}, fmt.Errorf("NextWaitlistCandidate: failed to find document: %w", err)
+	}
+
+	return entry, nil
+}
+
+// CreateWaitlistOffer holds the slot for the offer's entry and marks the
+// entry as offered. ErrNotFound is returned if the entry doesn't exist or
+// isn't waiting anymore.
+func (m *MongoDb) CreateWaitlistOffer(
+	ctx context.Context,
+	offer WaitlistOffer,
+) (WaitlistOffer, error) {
+	entriesColl := m.Database.Collection(waitlistEntriesCollection)
+	filter := bson.M{"_id": offer.EntryId, "status": WaitlistEntryStatusWaiting}
+	update := bson.M{"$set": bson.M{"status": WaitlistEntryStatusOffered}}
+
+	res, err := entriesColl.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return WaitlistOffer{}, fmt.Errorf("CreateWaitlistOffer: failed to update entry: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return WaitlistOffer{}, ErrNotFound
+	}
+
+	collection := m.Database.Collection(waitlistOffersCollection)
+	offer.Id = uuid.New()
+	offer.Status = WaitlistOfferStatusPending
+	offer.CreatedAt = time.Now()
+
+	_, err = collection.InsertOne(ctx, offer)
+	if err != nil {
+		return WaitlistOffer{}, fmt.Errorf("CreateWaitlistOffer: failed to insert document: %w", err)
+	}
+
+	return offer, nil
+}
+
+// PendingWaitlistOffer returns the offer held for the entry. ErrNotFound is
+// returned if the entry has no pending offer.
+func (m *MongoDb) PendingWaitlistOffer(
+	ctx context.Context,
+	entryId uuid.UUID,
+) (WaitlistOffer, error) {
+	collection := m.Database.Collection(waitlistOffersCollection)
+	filter := bson.M{"entryId": entryId, "status": WaitlistOfferStatusPending}
+
+	var offer WaitlistOffer
+	err := collection.FindOne(ctx, filter).Decode(&offer)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return WaitlistOffer{}, ErrNotFound
+		}
+		return WaitlistOffer{}, fmt.Errorf("PendingWaitlistOffer: failed to find document: %w", err)
+	}
+
+	return offer, nil
+}
+
+// PendingWaitlistOffersByDoctorIdAndDate returns the pending offers of the
+// doctor's slots starting on the date.
+func (m *MongoDb) PendingWaitlistOffersByDoctorIdAndDate(
+	ctx context.Context,
+	doctorId uuid.UUID,
+	date time.Time,
+) ([]WaitlistOffer, error) {
+	startOfDay := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	collection := m.Database.Collection(waitlistOffersCollection)
+	filter := bson.M{
+		"doctorId": doctorId,
+		"status":   WaitlistOfferStatusPending,
+		"appointmentDateTime": bson.M{
+			"$gte": startOfDay,
+			"$lt":  startOfDay.AddDate(0, 0, 1),
+		},
+	}
+
+	cursor, err := collection.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"PendingWaitlistOffersByDoctorIdAndDate: failed to find documents: %w",
+			err,
+		)
+	}
+	defer cursor.Close(ctx)
+
+	var offers []WaitlistOffer
+	if err = cursor.All(ctx, &offers); err != nil {
+		return nil, fmt.Errorf(
+			"PendingWaitlistOffersByDoctorIdAndDate: failed to decode documents: %w",
+			err,
+		)
+	}
+
+	return offers, nil
+}
+
+// ExpiredWaitlistOffers returns at most limit pending offers which expired
+// at now, the longest expired first.
+func (m *MongoDb) ExpiredWaitlistOffers(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+) ([]WaitlistOffer, error) {
+	collection := m.Database.Collection(waitlistOffersCollection)
+	filter := bson.M{
+		"status":    WaitlistOfferStatusPending,
+		"expiresAt": bson.M{"$lte": now},
+	}
+	opts := options.Find().SetSort(bson.M{"expiresAt": 1}).SetLimit(int64(limit))
+
+	cursor, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("ExpiredWaitlistOffers: failed to find documents: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var offers []WaitlistOffer
+	if err = cursor.All(ctx, &offers); err != nil {
+		return nil, fmt.Errorf("ExpiredWaitlistOffers: failed to decode documents: %w", err)
+	}
+
+	return offers, nil
+}
+
+// ResolveWaitlistOffer moves the pending offer to status and its entry to
+// entryStatus. ErrNotFound is returned if the offer isn't pending, e.g.
+// because it was resolved concurrently.
+func (m *MongoDb) ResolveWaitlistOffer(
+	ctx context.Context,
+	offer WaitlistOffer,
+	status WaitlistOfferStatus,
+	entryStatus WaitlistEntryStatus,
+) error {
+	collection := m.Database.Collection(waitlistOffersCollection)
+	filter := bson.M{"_id": offer.Id, "status": WaitlistOfferStatusPending}
+	update := bson.M{"$set": bson.M{"status": status}}
+
+	res, err := collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("ResolveWaitlistOffer: failed to update document: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return ErrNotFound
+	}
+
+	entriesColl := m.Database.Collection(waitlistEntriesCollection)
+	_, err = entriesColl.UpdateOne(
+		ctx,
+		bson.M{"_id": offer.EntryId},
+		bson.M{"$set": bson.M{"status": entryStatus}},
+	)
+	if err != nil {
+		return fmt.Errorf("ResolveWaitlistOffer: failed to update entry: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,499 @@
+package fhir
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/Nesquiko/wac/pkg/api"
+	"github.com/Nesquiko/wac/pkg/data"
+)
+
+// ErrInvalidResource is returned when a resource received from a client can't
+// be mapped to the app's data model.
+var ErrInvalidResource = errors.New("invalid FHIR resource")
+
+const (
+	conditionClinicalSystem = "http://terminology.hl7.org/CodeSystem/condition-clinical"
+	specializationSystem    = "urn:wac:specialization"
+	appointmentTypeSystem   = "urn:wac:appointment-type"
+	resourceTypeSystem      = "urn:wac:resource-type"
+	cancelledBySystem       = "urn:wac:cancelled-by"
+)
+
+func PatientFromData(p data.Patient) Patient {
+	return Patient{
+		ResourceType: ResourceTypePatient,
+		Id:           p.Id.String(),
+		Active:       asPtr(p.DeletedAt == nil),
+		Name:         []HumanName{{Use: "official", Family: p.LastName, Given: []string{p.FirstName}}},
+		Telecom:      []ContactPoint{{System: "email", Value: p.Email}},
+	}
+}
+
+func PractitionerFromData(d data.Doctor) Practitioner {
+	return Practitioner{
+		ResourceType: ResourceTypePractitioner,
+		Id:           d.Id.String(),
+		Name:         []HumanName{{Use: "official", Family: d.LastName, Given: []string{d.FirstName}}},
+		Telecom:      []ContactPoint{{System: "email", Value: d.Email}},
+		Qualification: []PractitionerQualification{{
+			Code: CodeableConcept{
+				Coding: []Coding{{System: specializationSystem, Code: d.Specialization}},
+				Text:   d.Specialization,
+			},
+		}},
+	}
+}
+
+func ConditionFromData(c data.Condition) Condition {
+	status := "active"
+	if c.End != nil && c.End.Before(time.Now()) {
+		status = "resolved"
+	}
+
+	return Condition{
+		ResourceType: ResourceTypeCondition,
+		Id:           c.Id.String(),
+		ClinicalStatus: &CodeableConcept{
+			Coding: []Coding{{System: conditionClinicalSystem, Code: status}},
+		},
+		Code:              &CodeableConcept{Text: c.Name},
+		Subject:           reference(ResourceTypePatient, c.PatientId),
+		OnsetDateTime:     asPtr(c.Start),
+		AbatementDateTime: c.End,
+	}
+}
+
+func MedicationRequestFromData(p data.Prescription) MedicationRequest {
+	status := "active"
+	if p.End.Before(time.Now()) {
+		status = "completed"
+	}
+
+	req := MedicationRequest{
+		ResourceType:              ResourceTypeMedicationRequest,
+		Id:                        p.Id.String(),
+		Status:                    status,
+		Intent:                    "order",
+		MedicationCodeableConcept: &CodeableConcept{Text: p.Name},
+		Subject:                   reference(ResourceTypePatient, p.PatientId),
+		DispenseRequest: &MedicationRequestDispenseRequest{
+			ValidityPeriod: &Period{Start: asPtr(p.Start), End: asPtr(p.End)},
+		},
+	}
+	if p.AppointmentId != nil {
+		req.SupportingInformation = []Reference{
+			reference(ResourceTypeAppointment, *p.AppointmentId),
+		}
+	}
+	if p.DoctorsNote != nil {
+		req.Note = []Annotation{{Text: *p.DoctorsNote}}
+	}
+
+	return req
+}
+
+// AppointmentFromData maps the appointment, reservations of its resources are
+// referenced as its slots.
+func AppointmentFromData(a data.Appointment, reservations []data.Reservation) Appointment {
+	appt := Appointment{
+		ResourceType: ResourceTypeAppointment,
+		Id:           a.Id.String(),
+		Status:       appointmentStatus(a.Status),
+		Start:        asPtr(a.AppointmentDateTime),
+		End:          asPtr(a.EndTime),
+		Participant: []AppointmentParticipant{
+			{
+				Actor:  asPtr(reference(ResourceTypePatient, a.PatientId)),
+				Status: "accepted",
+			},
+			{
+				Actor:  asPtr(reference(ResourceTypePractitioner, a.DoctorId)),
+				Status: practitionerParticipation(a.Status),
+			},
+		},
+	}
+	if a.Type != "" {
+		appt.AppointmentType = &CodeableConcept{
+			Coding: []Coding{{System: appointmentTypeSystem, Code: a.Type}},
+		}
+	}
+	if a.Reason != nil {
+		appt.ReasonCode = []CodeableConcept{{Text: *a.Reason}}
+	}
+	if a.ConditionId != nil {
+		appt.ReasonReference = []Reference{reference(ResourceTypeCondition, *a.ConditionId)}
+	}
+
+	switch api.AppointmentStatus(a.Status) {
+	case api.Cancelled:
+		appt.CancelationReason = &CodeableConcept{}
+		if a.CancelledBy != nil {
+			appt.CancelationReason.Coding = []Coding{
+				{System: cancelledBySystem, Code: *a.CancelledBy},
+			}
+		}
+		if a.CancellationReason != nil {
+			appt.CancelationReason.Text = *a.CancellationReason
+		}
+	case api.Denied:
+		appt.CancelationReason = &CodeableConcept{
+			Coding: []Coding{{System: cancelledBySystem, Code: string(api.UserRoleDoctor)}},
+		}
+		if a.DenialReason != nil {
+			appt.CancelationReason.Text = *a.DenialReason
+		}
+	}
+
+	for _, r := range reservations {
+		appt.Slot = append(appt.Slot, reference(ResourceTypeSlot, r.Id))
+	}
+
+	return appt
+}
+
+func appointmentStatus(status string) string {
+	switch api.AppointmentStatus(status) {
+	case api.Requested:
+		return "proposed"
+	case api.Scheduled:
+		return "booked"
+	case api.Completed:
+		return "fulfilled"
+	case api.Cancelled, api.Denied:
+		return "cancelled"
+	default:
+		return "proposed"
+	}
+}
+
+func practitionerParticipation(status string) string {
+	switch api.AppointmentStatus(status) {
+	case api.Requested:
+		return "needs-action"
+	case api.Denied:
+		return "declined"
+	default:
+		return "accepted"
+	}
+}
+
+// ScheduleFromData maps a reservable resource to the schedule of its
+// reservations. Resources aren't FHIR actors, so the actor is only named.
+func ScheduleFromData(r data.Resource) Schedule {
+	return Schedule{
+		ResourceType: ResourceTypeSchedule,
+		Id:           r.Id.String(),
+		Active:       asPtr(true),
+		ServiceType: []CodeableConcept{{
+			Coding: []Coding{{System: resourceTypeSystem, Code: string(r.Type)}},
+		}},
+		Actor: []Reference{{Display: r.Name}},
+	}
+}
+
+// SlotFromData maps a reservation to a busy slot of its resource's schedule.
+func SlotFromData(r data.Reservation) Slot {
+	return Slot{
+		ResourceType: ResourceTypeSlot,
+		Id:           r.Id.String(),
+		Schedule:     reference(ResourceTypeSchedule, r.ResourceId),
+		Status:       "busy",
+		Start:        r.StartTime,
+		End:          r.EndTime,
+	}
+}
+
+func PatientToRegistration(p Patient) (api.PatientRegistration, error) {
+	if err := checkResourceType(p.ResourceType, ResourceTypePatient); err != nil {
+		return api.PatientRegistration{}, err
+	}
+
+	firstName, lastName, err := parseName(p.Name)
+	if err != nil {
+		return api.PatientRegistration{}, err
+	}
+	email, err := parseEmail(p.Telecom)
+	if err != nil {
+		return api.PatientRegistration{}, err
+	}
+
+	return api.PatientRegistration{
+		Email:     email,
+		FirstName: firstName,
+		LastName:  lastName,
+		Role:      api.UserRolePatient,
+	}, nil
+}
+
+var specializations = []api.SpecializationEnum{
+	api.Cardiologist,
+	api.Dermatologist,
+	api.Diagnostician,
+	api.Endocrinologist,
+	api.Gastroenterologist,
+	api.GeneralPractitioner,
+	api.Neurologist,
+	api.Oncologist,
+	api.Orthopedist,
+	api.Other,
+	api.Pediatrician,
+	api.Psychiatrist,
+	api.Radiologist,
+	api.Surgeon,
+	api.Urologist,
+}
+
+func PractitionerToRegistration(p Practitioner) (api.DoctorRegistration, error) {
+	if err := checkResourceType(p.ResourceType, ResourceTypePractitioner); err != nil {
+		return api.DoctorRegistration{}, err
+	}
+
+	firstName, lastName, err := parseName(p.Name)
+	if err != nil {
+		return api.DoctorRegistration{}, err
+	}
+	email, err := parseEmail(p.Telecom)
+	if err != nil {
+		return api.DoctorRegistration{}, err
+	}
+
+	specialization := api.Other
+	for _, q := range p.Qualification {
+		for _, c := range q.Code.Coding {
+			if c.System != specializationSystem {
+				continue
+			}
+			if !slices.Contains(specializations, api.SpecializationEnum(c.Code)) {
+				return api.DoctorRegistration{}, fmt.Errorf(
+					"%w: unknown specialization %q",
+					ErrInvalidResource,
+					c.Code,
+				)
+			}
+			specialization = api.SpecializationEnum(c.Code)
+		}
+	}
+
+	return api.DoctorRegistration{
+		Email:          email,
+		FirstName:      firstName,
+		LastName:       lastName,
+		Role:           api.UserRoleDoctor,
+		Specialization: specialization,
+	}, nil
+}
+
+func ConditionToNewCondition(c Condition) (api.NewCondition, error) {
+	if err := checkResourceType(c.ResourceType, ResourceTypeCondition); err != nil {
+		return api.NewCondition{}, err
+	}
+
+	patientId, err := ParseReference(c.Subject, ResourceTypePatient)
+	if err != nil {
+		return api.NewCondition{}, fmt.Errorf("subject: %w", err)
+	}
+	if c.Code == nil || c.Code.Text == "" {
+		return api.NewCondition{}, fmt.Errorf("%w: code.text is required", ErrInvalidResource)
+	}
+	if c.OnsetDateTime == nil {
+		return api.NewCondition{}, fmt.Errorf("%w: onsetDateTime is required", ErrInvalidResource)
+	}
+
+	return api.NewCondition{
+		PatientId: patientId,
+		Name:      c.Code.Text,
+		Start:     *c.OnsetDateTime,
+		End:       c.AbatementDateTime,
+	}, nil
+}
+
+func MedicationRequestToNewPrescription(m MedicationRequest) (api.NewPrescription, error) {
+	if err := checkResourceType(m.ResourceType, ResourceTypeMedicationRequest); err != nil {
+		return api.NewPrescription{}, err
+	}
+
+	patientId, err := ParseReference(m.Subject, ResourceTypePatient)
+	if err != nil {
+		return api.NewPrescription{}, fmt.Errorf("subject: %w", err)
+	}
+	if m.MedicationCodeableConcept == nil || m.MedicationCodeableConcept.Text == "" {
+		return api.NewPrescription{}, fmt.Errorf(
+			"%w: medicationCodeableConcept.text is required",
+			ErrInvalidResource,
+		)
+	}
+	if m.DispenseRequest == nil || m.DispenseRequest.ValidityPeriod == nil ||
+		m.DispenseRequest.ValidityPeriod.Start == nil ||
+		m.DispenseRequest.ValidityPeriod.End == nil {
+		return api.NewPrescription{}, fmt.Errorf(
+			"%w: dispenseRequest.validityPeriod with start and end is required",
+			ErrInvalidResource,
+		)
+	}
+
+	pres := api.NewPrescription{
+		PatientId: patientId,
+		Name:      m.MedicationCodeableConcept.Text,
+		Start:     *m.DispenseRequest.ValidityPeriod.Start,
+		End:       *m.DispenseRequest.ValidityPeriod.End,
+	}
+	for _, info := range m.SupportingInformation {
+		appointmentId, err := ParseReference(info, ResourceTypeAppointment)
+		if err != nil {
+			return api.NewPrescription{}, fmt.Errorf("supportingInformation: %w", err)
+		}
+		pres.AppointmentId = &appointmentId
+	}
+	if len(m.Note) != 0 {
+		notes := make([]string, len(m.Note))
+		for i, n := range m.Note {
+			notes[i] = n.Text
+		}
+		pres.DoctorsNote = asPtr(strings.Join(notes, "\n"))
+	}
+
+	return pres, nil
+}
+
+// AppointmentToRequest maps a proposed appointment. Its patient and
+// practitioner are taken from participants, the end is always derived by the
+// app from the start.
+func AppointmentToRequest(a Appointment) (api.NewAppointmentRequest, error) {
+	if err := checkResourceType(a.ResourceType, ResourceTypeAppointment); err != nil {
+		return api.NewAppointmentRequest{}, err
+	}
+	if a.Status != "" && a.Status != "proposed" {
+		return api.NewAppointmentRequest{}, fmt.Errorf(
+			"%w: only proposed appointments can be created",
+			ErrInvalidResource,
+		)
+	}
+	if a.Start == nil {
+		return api.NewAppointmentRequest{}, fmt.Errorf("%w: start is required", ErrInvalidResource)
+	}
+
+	req := api.NewAppointmentRequest{AppointmentDateTime: *a.Start}
+	if a.End != nil {
+		if !a.End.After(*a.Start) {
+			return api.NewAppointmentRequest{}, fmt.Errorf(
+				"%w: end must be after start",
+				ErrInvalidResource,
+			)
+		}
+		req.DurationMinutes = asPtr(int(a.End.Sub(*a.Start) / time.Minute))
+	}
+	var hasPatient, hasPractitioner bool
+	for _, p := range a.Participant {
+		if p.Actor == nil {
+			continue
+		}
+		switch typ, _, _ := strings.Cut(p.Actor.Reference, "/"); typ {
+		case ResourceTypePatient:
+			id, err := ParseReference(*p.Actor, ResourceTypePatient)
+			if err != nil {
+				return api.NewAppointmentRequest{}, fmt.Errorf("participant: %w", err)
+			}
+			req.PatientId, hasPatient = id, true
+		case ResourceTypePractitioner:
+			id, err := ParseReference(*p.Actor, ResourceTypePractitioner)
+			if err != nil {
+				return api.NewAppointmentRequest{}, fmt.Errorf("participant: %w", err)
+			}
+			req.DoctorId, hasPractitioner = id, true
+		}
+	}
+	if !hasPatient || !hasPractitioner {
+		return api.NewAppointmentRequest{}, fmt.Errorf(
+			"%w: a Patient and a Practitioner participant are required",
+			ErrInvalidResource,
+		)
+	}
+
+	if a.AppointmentType != nil {
+		for _, c := range a.AppointmentType.Coding {
+			if c.System == appointmentTypeSystem {
+				req.Type = asPtr(api.AppointmentType(c.Code))
+			}
+		}
+	}
+	if len(a.ReasonCode) != 0 && a.ReasonCode[0].Text != "" {
+		req.Reason = asPtr(a.ReasonCode[0].Text)
+	}
+	for _, r := range a.ReasonReference {
+		conditionId, err := ParseReference(r, ResourceTypeCondition)
+		if err != nil {
+			return api.NewAppointmentRequest{}, fmt.Errorf("reasonReference: %w", err)
+		}
+		req.ConditionId = &conditionId
+	}
+
+	return req, nil
+}
+
+// ParseReference returns the id of a literal reference to a resource of the
+// given type, either relative "Patient/<id>", or a plain "<id>".
+func ParseReference(r Reference, resourceType string) (uuid.UUID, error) {
+	raw := r.Reference
+	if typ, id, found := strings.Cut(raw, "/"); found {
+		if typ != resourceType {
+			return uuid.Nil, fmt.Errorf(
+				"%w: expected a reference to %s, got %q",
+				ErrInvalidResource,
+				resourceType,
+				raw,
+			)
+		}
+		raw = id
+	}
+
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%w: malformed reference %q", ErrInvalidResource, r.Reference)
+	}
+	return id, nil
+}
+
+func checkResourceType(got, expected string) error {
+	if got != expected {
+		return fmt.Errorf(
+			"%w: expected resourceType %q, got %q",
+			ErrInvalidResource,
+			expected,
+			got,
+		)
+	}
+	return nil
+}
+
+func parseName(names []HumanName) (string, string, error) {
+	for _, n := range names {
+		if n.Family != "" && len(n.Given) != 0 && n.Given[0] != "" {
+			return n.Given[0], n.Family, nil
+		}
+	}
+	return "", "", fmt.Errorf("%w: a name with family and given is required", ErrInvalidResource)
+}
+
+func parseEmail(telecom []ContactPoint) (types.Email, error) {
+	for _, t := range telecom {
+		if t.System == "email" && strings.Contains(t.Value, "@") {
+			return types.Email(t.Value), nil
+		}
+	}
+	return "", fmt.Errorf("%w: an email telecom is required", ErrInvalidResource)
+}
+
+func reference(resourceType string, id uuid.UUID) Reference {
+	return Reference{Reference: resourceType + "/" + id.String()}
+}
+
+func asPtr[T any](v T) *T {
+	return &v
+}
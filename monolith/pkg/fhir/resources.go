@@ -0,0 +1,195 @@
+// Package fhir contains the subset of HL7 FHIR R4 resources the monolith
+// exposes to integration engines, and their mapping from and to the app's
+// own data model.
+package fhir
+
+import "time"
+
+const (
+	ContentType = "application/fhir+json"
+
+	ResourceTypePatient           = "Patient"
+	ResourceTypePractitioner      = "Practitioner"
+	ResourceTypeCondition         = "Condition"
+	ResourceTypeMedicationRequest = "MedicationRequest"
+	ResourceTypeAppointment       = "Appointment"
+	ResourceTypeSchedule          = "Schedule"
+	ResourceTypeSlot              = "Slot"
+	ResourceTypeBundle            = "Bundle"
+	ResourceTypeOperationOutcome  = "OperationOutcome"
+)
+
+type Identifier struct {
+	System string `json:"system,omitempty"`
+	Value  string `json:"value,omitempty"`
+}
+
+type HumanName struct {
+	Use    string   `json:"use,omitempty"`
+	Family string   `json:"family,omitempty"`
+	Given  []string `json:"given,omitempty"`
+}
+
+type ContactPoint struct {
+	System string `json:"system,omitempty"`
+	Value  string `json:"value,omitempty"`
+	Use    string `json:"use,omitempty"`
+}
+
+type Coding struct {
+	System  string `json:"system,omitempty"`
+	Code    string `json:"code,omitempty"`
+	Display string `json:"display,omitempty"`
+}
+
+type CodeableConcept struct {
+	Coding []Coding `json:"coding,omitempty"`
+	Text   string   `json:"text,omitempty"`
+}
+
+type Reference struct {
+	Reference string `json:"reference,omitempty"`
+	Display   string `json:"display,omitempty"`
+}
+
+type Period struct {
+	Start *time.Time `json:"start,omitempty"`
+	End   *time.Time `json:"end,omitempty"`
+}
+
+type Annotation struct {
+	Text string `json:"text"`
+}
+
+type Patient struct {
+	ResourceType string         `json:"resourceType"`
+	Id           string         `json:"id,omitempty"`
+	Active       *bool          `json:"active,omitempty"`
+	Name         []HumanName    `json:"name,omitempty"`
+	Telecom      []ContactPoint `json:"telecom,omitempty"`
+}
+
+type Practitioner struct {
+	ResourceType  string                      `json:"resourceType"`
+	Id            string                      `json:"id,omitempty"`
+	Name          []HumanName                 `json:"name,omitempty"`
+	Telecom       []ContactPoint              `json:"telecom,omitempty"`
+	Qualification []PractitionerQualification `json:"qualification,omitempty"`
+}
+
+type PractitionerQualification struct {
+	Code CodeableConcept `json:"code"`
+}
+
+type Condition struct {
+	ResourceType      string           `json:"resourceType"`
+	Id                string           `json:"id,omitempty"`
+	ClinicalStatus    *CodeableConcept `json:"clinicalStatus,omitempty"`
+	Code              *CodeableConcept `json:"code,omitempty"`
+	Subject           Reference        `json:"subject"`
+	OnsetDateTime     *time.Time       `json:"onsetDateTime,omitempty"`
+	AbatementDateTime *time.Time       `json:"abatementDateTime,omitempty"`
+}
+
+type MedicationRequest struct {
+	ResourceType              string                            `json:"resourceType"`
+	Id                        string                            `json:"id,omitempty"`
+	Status                    string                            `json:"status"`
+	Intent                    string                            `json:"intent"`
+	MedicationCodeableConcept *CodeableConcept                  `json:"medicationCodeableConcept,omitempty"`
+	Subject                   Reference                         `json:"subject"`
+	SupportingInformation     []Reference                       `json:"supportingInformation,omitempty"`
+	Note                      []Annotation                      `json:"note,omitempty"`
+	DispenseRequest           *MedicationRequestDispenseRequest `json:"dispenseRequest,omitempty"`
+}
+
+type MedicationRequestDispenseRequest struct {
+	ValidityPeriod *Period `json:"validityPeriod,omitempty"`
+}
+
+type Appointment struct {
+	ResourceType      string                   `json:"resourceType"`
+	Id                string                   `json:"id,omitempty"`
+	Status            string                   `json:"status"`
+	CancelationReason *CodeableConcept         `json:"cancelationReason,omitempty"`
+	AppointmentType   *CodeableConcept         `json:"appointmentType,omitempty"`
+	ReasonCode        []CodeableConcept        `json:"reasonCode,omitempty"`
+	ReasonReference   []Reference              `json:"reasonReference,omitempty"`
+	Start             *time.Time               `json:"start,omitempty"`
+	End               *time.Time               `json:"end,omitempty"`
+	Slot              []Reference              `json:"slot,omitempty"`
+	Participant       []AppointmentParticipant `json:"participant"`
+}
+
+type AppointmentParticipant struct {
+	Actor  *Reference `json:"actor,omitempty"`
+	Status string     `json:"status"`
+}
+
+type Schedule struct {
+	ResourceType string            `json:"resourceType"`
+	Id           string            `json:"id,omitempty"`
+	Active       *bool             `json:"active,omitempty"`
+	ServiceType  []CodeableConcept `json:"serviceType,omitempty"`
+	Actor        []Reference       `json:"actor"`
+}
+
+type Slot struct {
+	ResourceType string    `json:"resourceType"`
+	Id           string    `json:"id,omitempty"`
+	Schedule     Reference `json:"schedule"`
+	Status       string    `json:"status"`
+	Start        time.Time `json:"start"`
+	End          time.Time `json:"end"`
+}
+
+type Bundle struct {
+	ResourceType string        `json:"resourceType"`
+	Type         string        `json:"type"`
+	Total        int           `json:"total"`
+	Entry        []BundleEntry `json:"entry,omitempty"`
+}
+
+type BundleEntry struct {
+	FullUrl  string `json:"fullUrl,omitempty"`
+	Resource any    `json:"resource"`
+}
+
+type OperationOutcome struct {
+	ResourceType string                  `json:"resourceType"`
+	Issue        []OperationOutcomeIssue `json:"issue"`
+}
+
+type OperationOutcomeIssue struct {
+	Severity    string `json:"severity"`
+	Code        string `json:"code"`
+	Diagnostics string `json:"diagnostics,omitempty"`
+}
+
+// SearchSet wraps resources returned by a search into a Bundle. Resource
+// URLs are relative to baseUrl.
+func SearchSet[T any](baseUrl string, resources []T, id func(T) (string, string)) Bundle {
+	bundle := Bundle{
+		ResourceType: ResourceTypeBundle,
+		Type:         "searchset",
+		Total:        len(resources),
+		Entry:        make([]BundleEntry, len(resources)),
+	}
+	for i, resource := range resources {
+		typ, resourceId := id(resource)
+		bundle.Entry[i] = BundleEntry{
+			FullUrl:  baseUrl + "/" + typ + "/" + resourceId,
+			Resource: resource,
+		}
+	}
+	return bundle
+}
+
+func NewOperationOutcome(code string, diagnostics string) OperationOutcome {
+	return OperationOutcome{
+		ResourceType: ResourceTypeOperationOutcome,
+		Issue: []OperationOutcomeIssue{
+			{Severity: "error", Code: code, Diagnostics: diagnostics},
+		},
+	}
+}
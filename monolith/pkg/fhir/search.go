@@ -0,0 +1,67 @@
+package fhir
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+const dateLayout = time.DateOnly
+
+var datePrefixes = []string{"eq", "ge", "gt", "le", "lt"}
+
+// ParseDateParams turns values of a FHIR "date" search parameter into the
+// interval [from, to] used by the app's queries. Each value is a date
+// (YYYY-MM-DD) or a date-time (RFC 3339) with an optional prefix eq, ge, gt,
+// le or lt, so a range is expressed as "date=ge2025-01-01&date=lt2025-02-01".
+// Without a lower bound from is the zero time, without an upper bound to is
+// nil.
+func ParseDateParams(values []string) (time.Time, *time.Time, error) {
+	var from time.Time
+	var to *time.Time
+
+	for _, value := range values {
+		prefix := "eq"
+		if len(value) > 2 && slices.Contains(datePrefixes, value[:2]) {
+			prefix, value = value[:2], value[2:]
+		}
+
+		start, end, err := parseDateValue(value)
+		if err != nil {
+			return time.Time{}, nil, err
+		}
+
+		switch prefix {
+		case "eq":
+			from, to = start, &end
+		case "ge":
+			from = start
+		case "gt":
+			from = end
+		case "le":
+			to = &end
+		case "lt":
+			to = &start
+		}
+	}
+
+	return from, to, nil
+}
+
+// parseDateValue returns the interval covered by the value, a whole day for
+// dates and a single instant for date-times.
+func parseDateValue(value string) (time.Time, time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, t, nil
+	}
+
+	day, err := time.ParseInLocation(dateLayout, value, time.Local)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf(
+			"%w: malformed date %q",
+			ErrInvalidResource,
+			value,
+		)
+	}
+	return day, day.AddDate(0, 0, 1).Add(-time.Nanosecond), nil
+}
@@ -0,0 +1,174 @@
+// Package notify renders messages about appointment lifecycle events and
+// delivers them through pluggable senders, retrying failed deliveries.
+package notify
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+	"time"
+)
+
+type Kind string
+
+const (
+	KindAppointmentAccepted           Kind = "appointment.accepted"
+	KindAppointmentDenied             Kind = "appointment.denied"
+	KindAppointmentCancelled          Kind = "appointment.cancelled"
+	KindAppointmentRescheduled        Kind = "appointment.rescheduled"
+	KindAppointmentReminder           Kind = "appointment.reminder"
+	KindWaitlistOffer                 Kind = "appointment.waitlist_offer"
+	KindAppointmentRescheduleRequired Kind = "appointment.reschedule_required"
+	KindAppointmentReassigned         Kind = "appointment.reassigned"
+	KindAppointmentMoved              Kind = "appointment.moved"
+)
+
+// Event is an appointment transition as seen by one of its participants.
+type Event struct {
+	Kind Kind
+	// RecipientName and RecipientAddress identify the notified participant.
+	RecipientName    string
+	RecipientAddress string
+	// With is the name of the other participant of the appointment.
+	With   string
+	Start  time.Time
+	Reason *string
+	// ExpiresAt is when a slot offered from the waitlist is offered to
+	// someone else.
+	ExpiresAt time.Time
+}
+
+// Message is a rendered notification ready to be sent.
+type Message struct {
+	To      string `json:"to"`
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+}
+
+type templates struct {
+	subject *template.Template
+	body    *template.Template
+}
+
+var funcs = template.FuncMap{
+	"when": func(t time.Time) string {
+		return t.In(time.Local).Format("Monday, 2 January 2006 at 15:04")
+	},
+}
+
+func mustTemplates(subject, body string) templates {
+	return templates{
+		subject: template.Must(template.New("subject").Funcs(funcs).Parse(subject)),
+		body:    template.Must(template.New("body").Funcs(funcs).Parse(body)),
+	}
+}
+
+var kindTemplates = map[Kind]templates{
+	KindAppointmentAccepted: mustTemplates(
+		"Your appointment on {{when .Start}} is confirmed",
+		`Hello {{.RecipientName}},
+
+your appointment with {{.With}} on {{when .Start}} was accepted.
+`,
+	),
+	KindAppointmentDenied: mustTemplates(
+		"Your appointment request for {{when .Start}} was denied",
+		`Hello {{.RecipientName}},
+
+your appointment request with {{.With}} on {{when .Start}} was denied.
+{{- if .Reason}}
+
+Reason: {{.Reason}}
+{{- end}}
+`,
+	),
+	KindAppointmentCancelled: mustTemplates(
+		"Your appointment on {{when .Start}} was cancelled",
+		`Hello {{.RecipientName}},
+
+your appointment with {{.With}} on {{when .Start}} was cancelled.
+{{- if .Reason}}
+
+Reason: {{.Reason}}
+{{- end}}
+`,
+	),
+	KindAppointmentRescheduled: mustTemplates(
+		"Your appointment was moved to {{when .Start}}",
+		`Hello {{.RecipientName}},
+
+your appointment with {{.With}} was moved to {{when .Start}} and awaits
+confirmation.
+`,
+	),
+	KindAppointmentMoved: mustTemplates(
+		"Your appointment was moved to {{when .Start}}",
+		`Hello {{.RecipientName}},
+
+your appointment with {{.With}} was moved to {{when .Start}} and stays
+confirmed.
+`,
+	),
+	KindAppointmentReminder: mustTemplates(
+		"Reminder: your appointment on {{when .Start}}",
+		`Hello {{.RecipientName}},
+
+this is a reminder of your appointment with {{.With}} on {{when .Start}}.
+`,
+	),
+	KindAppointmentRescheduleRequired: mustTemplates(
+		"Your appointment on {{when .Start}} needs to be rescheduled",
+		`Hello {{.RecipientName}},
+
+{{.With}} is unavailable at the time of your appointment on {{when .Start}}.
+Please reschedule it to another time.
+`,
+	),
+	KindAppointmentReassigned: mustTemplates(
+		"Your appointment on {{when .Start}} was reassigned",
+		`Hello {{.RecipientName}},
+
+your appointment on {{when .Start}} is now with {{.With}}.
+{{- if .Reason}}
+
+Reason: {{.Reason}}
+{{- end}}
+`,
+	),
+	KindWaitlistOffer: mustTemplates(
+		"A slot on {{when .Start}} is available",
+		`Hello {{.RecipientName}},
+
+a slot with {{.With}} on {{when .Start}} became available and is held for
+you until {{when .ExpiresAt}}. Accept it in your waitlist to request the
+appointment.
+`,
+	),
+}
+
+// Render returns the message about the event for its recipient.
+func Render(event Event) (Message, error) {
+	tmpl, ok := kindTemplates[event.Kind]
+	if !ok {
+		return Message{}, fmt.Errorf("Render unknown kind %q", event.Kind)
+	}
+
+	// templates print the reason, not the pointer
+	data := struct {
+		Event
+		Reason string
+	}{Event: event}
+	if event.Reason != nil {
+		data.Reason = *event.Reason
+	}
+
+	var subject, body bytes.Buffer
+	if err := tmpl.subject.Execute(&subject, data); err != nil {
+		return Message{}, fmt.Errorf("Render subject: %w", err)
+	}
+	if err := tmpl.body.Execute(&body, data); err != nil {
+		return Message{}, fmt.Errorf("Render body: %w", err)
+	}
+
+	return Message{To: event.RecipientAddress, Subject: subject.String(), Body: body.String()}, nil
+}
@@ -0,0 +1,138 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"mime"
+	"net"
+	"net/http"
+	"net/smtp"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Sender delivers a message to its recipient. Returned error means the
+// delivery failed and may be retried.
+type Sender interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// SmtpSender sends messages as plain text emails.
+type SmtpSender struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+// NewSmtpSender returns a sender using the SMTP server at host:port. If user
+// is empty, no authentication is used.
+func NewSmtpSender(host, port, user, password, from string) SmtpSender {
+	var auth smtp.Auth
+	if user != "" {
+		auth = smtp.PlainAuth("", user, password, host)
+	}
+	return SmtpSender{addr: net.JoinHostPort(host, port), from: from, auth: auth}
+}
+
+func (s SmtpSender) Send(ctx context.Context, msg Message) error {
+	var email bytes.Buffer
+	fmt.Fprintf(&email, "From: %s\r\n", s.from)
+	fmt.Fprintf(&email, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&email, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&email, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	email.WriteString("MIME-Version: 1.0\r\n")
+	email.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	email.WriteString("\r\n")
+	email.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+
+	err := smtp.SendMail(s.addr, s.auth, s.from, []string{msg.To}, email.Bytes())
+	if err != nil {
+		return fmt.Errorf("SmtpSender.Send: %w", err)
+	}
+	return nil
+}
+
+// WebhookSender posts messages as JSON to a URL, for example to a gateway
+// forwarding them as SMS.
+type WebhookSender struct {
+	url    string
+	client *http.Client
+}
+
+func NewWebhookSender(url string) WebhookSender {
+	return WebhookSender{url: url, client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+func (s WebhookSender) Send(ctx context.Context, msg Message) error {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("WebhookSender.Send marshal: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("WebhookSender.Send request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("WebhookSender.Send: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("WebhookSender.Send unexpected status %d", res.StatusCode)
+	}
+	return nil
+}
+
+// FileSender appends messages as JSON lines to a file, for local testing.
+type FileSender struct {
+	path string
+	mu   *sync.Mutex
+}
+
+func NewFileSender(path string) FileSender {
+	return FileSender{path: path, mu: &sync.Mutex{}}
+}
+
+func (s FileSender) Send(ctx context.Context, msg Message) error {
+	line, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("FileSender.Send marshal: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("FileSender.Send open: %w", err)
+	}
+	defer f.Close()
+
+	if _, err = f.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("FileSender.Send write: %w", err)
+	}
+	return nil
+}
+
+// LogSender only logs messages, for local testing.
+type LogSender struct{}
+
+func (LogSender) Send(ctx context.Context, msg Message) error {
+	slog.InfoContext(
+		ctx,
+		"notification",
+		"to", msg.To,
+		"subject", msg.Subject,
+		"body", msg.Body,
+	)
+	return nil
+}
@@ -0,0 +1,95 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/Nesquiko/wac/pkg/api"
+	"github.com/Nesquiko/wac/pkg/app"
+)
+
+// CreateAbsence implements api.ServerInterface.
+func (s Server) CreateAbsence(w http.ResponseWriter, r *http.Request, doctorId api.DoctorId) {
+	req, decodeErr := Decode[api.NewAbsence](w, r)
+	if decodeErr != nil {
+		encodeError(w, decodeErr)
+		return
+	}
+
+	absence, err := s.app.CreateAbsence(r.Context(), doctorId, req)
+	if err != nil {
+		encodeAbsenceError(w, err, "Doctor", doctorId.String(), "CreateAbsence")
+		return
+	}
+
+	encode(w, http.StatusCreated, absence)
+}
+
+// DoctorsAbsences implements api.ServerInterface.
+func (s Server) DoctorsAbsences(
+	w http.ResponseWriter,
+	r *http.Request,
+	doctorId api.DoctorId,
+	params api.DoctorsAbsencesParams,
+) {
+	absences, err := s.app.DoctorAbsences(r.Context(), doctorId, params)
+	if err != nil {
+		encodeAbsenceError(w, err, "Doctor", doctorId.String(), "DoctorsAbsences")
+		return
+	}
+
+	encode(w, http.StatusOK, api.Absences{Absences: absences})
+}
+
+// AbsenceById implements api.ServerInterface.
+func (s Server) AbsenceById(w http.ResponseWriter, r *http.Request, absenceId api.AbsenceId) {
+	absence, err := s.app.AbsenceById(r.Context(), absenceId)
+	if err != nil {
+		encodeAbsenceError(w, err, "Absence", absenceId.String(), "AbsenceById")
+		return
+	}
+
+	encode(w, http.StatusOK, absence)
+}
+
+// UpdateAbsence implements api.ServerInterface.
+func (s Server) UpdateAbsence(w http.ResponseWriter, r *http.Request, absenceId api.AbsenceId) {
+	req, decodeErr := Decode[api.NewAbsence](w, r)
+	if decodeErr != nil {
+		encodeError(w, decodeErr)
+		return
+	}
+
+	absence, err := s.app.UpdateAbsence(r.Context(), absenceId, req)
+	if err != nil {
+		encodeAbsenceError(w, err, "Absence", absenceId.String(), "UpdateAbsence")
+		return
+	}
+
+	encode(w, http.StatusOK, absence)
+}
+
+// DeleteAbsence implements api.ServerInterface.
+func (s Server) DeleteAbsence(w http.ResponseWriter, r *http.Request, absenceId api.AbsenceId) {
+	err := s.app.DeleteAbsence(r.Context(), absenceId)
+	if err != nil {
+		encodeAbsenceError(w, err, "Absence", absenceId.String(), "DeleteAbsence")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func encodeAbsenceError(w http.ResponseWriter, err error, resource, id, where string) {
+	var validationErr *app.ValidationError
+	switch {
+	case errors.As(err, &validationErr):
+		encodeError(w, &ApiError{ErrorDetail: validationErr.ErrorDetail})
+	case errors.Is(err, app.ErrNotFound):
+		encodeError(w, notFound(resource, id))
+	default:
+		slog.Error(UnexpectedError, "error", err.Error(), "where", where)
+		encodeError(w, internalServerError())
+	}
+}
@@ -0,0 +1,56 @@
+package server
+
+import (
+	"crypto/subtle"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/Nesquiko/wac/pkg/api"
+)
+
+// AuditEvents implements api.ServerInterface.
+func (s Server) AuditEvents(w http.ResponseWriter, r *http.Request, params api.AuditEventsParams) {
+	if !s.isAdmin(r) {
+		apiErr := &ApiError{
+			ErrorDetail: api.ErrorDetail{
+				Code:   "audit.forbidden",
+				Title:  "Forbidden",
+				Detail: "Only administrators can read the audit log.",
+				Status: http.StatusForbidden,
+			},
+		}
+		encodeError(w, apiErr)
+		return
+	}
+
+	page, err := s.app.AuditEvents(r.Context(), params)
+	if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"AuditEvents",
+		)
+		encodeError(w, internalServerError())
+		return
+	}
+
+	encode(w, http.StatusOK, page)
+}
+
+// isAdmin tells whether the request carries the admin token, the only
+// credential the server can verify.
+func (s Server) isAdmin(r *http.Request) bool {
+	if s.auditAdminToken == "" {
+		return false
+	}
+
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(token), []byte(s.auditAdminToken)) == 1
+}
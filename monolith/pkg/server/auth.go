@@ -41,7 +41,16 @@ func (s Server) RegisterUser(w http.ResponseWriter, r *http.Request) {
 			encodeError(w, apiErr)
 			return
 		} else if err != nil {
-			slog.Error(UnexpectedError, "error", err.Error(), "where", "RegisterUser", "role", "doctor")
+			slog.ErrorContext(
+				r.Context(),
+				UnexpectedError,
+				"error",
+				err.Error(),
+				"where",
+				"RegisterUser",
+				"role",
+				"doctor",
+			)
 			encodeError(w, internalServerError())
 			return
 		}
@@ -67,7 +76,16 @@ func (s Server) RegisterUser(w http.ResponseWriter, r *http.Request) {
 		encodeError(w, apiErr)
 		return
 	} else if err != nil {
-		slog.Error(UnexpectedError, "error", err.Error(), "where", "RegisterUser", "role", "patient")
+		slog.ErrorContext(
+			r.Context(),
+			UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"RegisterUser",
+			"role",
+			"patient",
+		)
 		encodeError(w, internalServerError())
 		return
 	}
@@ -96,7 +114,16 @@ func (s Server) LoginUser(w http.ResponseWriter, r *http.Request) {
 			encodeError(w, apiErr)
 			return
 		} else if err != nil {
-			slog.Error(UnexpectedError, "error", err.Error(), "where", "GetPatientById", "role", "doctor")
+			slog.ErrorContext(
+				r.Context(),
+				UnexpectedError,
+				"error",
+				err.Error(),
+				"where",
+				"GetPatientById",
+				"role",
+				"doctor",
+			)
 			encodeError(w, internalServerError())
 			return
 		}
@@ -118,7 +145,16 @@ func (s Server) LoginUser(w http.ResponseWriter, r *http.Request) {
 		encodeError(w, apiErr)
 		return
 	} else if err != nil {
-		slog.Error(UnexpectedError, "error", err.Error(), "where", "GetPatientById", "role", "patient")
+		slog.ErrorContext(
+			r.Context(),
+			UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"GetPatientById",
+			"role",
+			"patient",
+		)
 		encodeError(w, internalServerError())
 		return
 	}
@@ -0,0 +1,167 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/Nesquiko/wac/pkg/api"
+	"github.com/Nesquiko/wac/pkg/app"
+	"github.com/Nesquiko/wac/pkg/ics"
+)
+
+// IssueDoctorCalendarFeed implements api.ServerInterface.
+func (s Server) IssueDoctorCalendarFeed(
+	w http.ResponseWriter,
+	r *http.Request,
+	doctorId api.DoctorId,
+) {
+	s.issueCalendarFeed(w, r, doctorId, api.UserRoleDoctor, "Doctor")
+}
+
+// RevokeDoctorCalendarFeed implements api.ServerInterface.
+func (s Server) RevokeDoctorCalendarFeed(
+	w http.ResponseWriter,
+	r *http.Request,
+	doctorId api.DoctorId,
+) {
+	s.revokeCalendarFeed(w, r, doctorId, "Doctor")
+}
+
+// IssuePatientCalendarFeed implements api.ServerInterface.
+func (s Server) IssuePatientCalendarFeed(
+	w http.ResponseWriter,
+	r *http.Request,
+	patientId api.PatientId,
+) {
+	s.issueCalendarFeed(w, r, patientId, api.UserRolePatient, "Patient")
+}
+
+// RevokePatientCalendarFeed implements api.ServerInterface.
+func (s Server) RevokePatientCalendarFeed(
+	w http.ResponseWriter,
+	r *http.Request,
+	patientId api.PatientId,
+) {
+	s.revokeCalendarFeed(w, r, patientId, "Patient")
+}
+
+func (s Server) issueCalendarFeed(
+	w http.ResponseWriter,
+	r *http.Request,
+	userId uuid.UUID,
+	role api.UserRole,
+	resource string,
+) {
+	token, err := s.app.IssueCalendarFeed(r.Context(), userId, role)
+	if errors.Is(err, app.ErrNotFound) {
+		encodeError(w, notFoundId(resource, userId))
+		return
+	} else if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"issueCalendarFeed",
+		)
+		encodeError(w, internalServerError())
+		return
+	}
+
+	feedUrl := externalUrl(r, fmt.Sprintf("/api/calendar/%s.ics", token))
+	encode(w, http.StatusCreated, api.CalendarFeed{Url: feedUrl})
+}
+
+func (s Server) revokeCalendarFeed(
+	w http.ResponseWriter,
+	r *http.Request,
+	userId uuid.UUID,
+	resource string,
+) {
+	err := s.app.RevokeCalendarFeed(r.Context(), userId)
+	if errors.Is(err, app.ErrNotFound) {
+		encodeError(w, notFound(resource+" calendar feed", userId.String()))
+		return
+	} else if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"revokeCalendarFeed",
+		)
+		encodeError(w, internalServerError())
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// CalendarFeed implements api.ServerInterface.
+func (s Server) CalendarFeed(w http.ResponseWriter, r *http.Request, token string) {
+	calendar, err := s.app.CalendarFeed(r.Context(), token)
+	if errors.Is(err, app.ErrNotFound) {
+		// don't echo the secret token back
+		encodeError(w, notFound("Calendar feed", "***"))
+		return
+	} else if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"CalendarFeed",
+		)
+		encodeError(w, internalServerError())
+		return
+	}
+
+	encodeCalendar(w, calendar, "")
+}
+
+// AppointmentIcs implements api.ServerInterface.
+func (s Server) AppointmentIcs(
+	w http.ResponseWriter,
+	r *http.Request,
+	appointmentId api.AppointmentId,
+) {
+	calendar, err := s.app.AppointmentIcs(r.Context(), appointmentId)
+	if errors.Is(err, app.ErrNotFound) {
+		encodeError(w, notFoundId("Appointment", appointmentId))
+		return
+	} else if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"AppointmentIcs",
+		)
+		encodeError(w, internalServerError())
+		return
+	}
+
+	encodeCalendar(w, calendar, fmt.Sprintf("appointment-%s.ics", appointmentId))
+}
+
+// encodeCalendar writes the calendar, if filename isn't empty as an
+// attachment.
+func encodeCalendar(w http.ResponseWriter, calendar ics.Calendar, filename string) {
+	w.Header().Set(ContentType, ics.ContentType)
+	if filename != "" {
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	}
+	w.WriteHeader(http.StatusOK)
+
+	if err := calendar.Encode(w); err != nil {
+		slog.Error(UnexpectedError, "error", err.Error(), "where", "encodeCalendar")
+	}
+}
@@ -0,0 +1,70 @@
+package server
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+// RealIpHeader is set by a proxy in front of the server to the IP of the
+// client whose request it forwards.
+const RealIpHeader = "X-Real-IP"
+
+// trustedProxies are the addresses of the proxies in front of the server, only
+// they may name the client of a request in RealIpHeader.
+type trustedProxies []netip.Prefix
+
+// parseTrustedProxies parses IPs and CIDRs, e.g. `10.0.0.2` or `10.0.0.0/8`.
+func parseTrustedProxies(proxies []string) (trustedProxies, error) {
+	trusted := make(trustedProxies, 0, len(proxies))
+	for _, proxy := range proxies {
+		proxy = strings.TrimSpace(proxy)
+		if proxy == "" {
+			continue
+		}
+		if !strings.Contains(proxy, "/") {
+			addr, err := netip.ParseAddr(proxy)
+			if err != nil {
+				return nil, fmt.Errorf("trusted proxy %q isn't an IP: %w", proxy, err)
+			}
+			trusted = append(trusted, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+		prefix, err := netip.ParsePrefix(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("trusted proxy %q isn't a CIDR: %w", proxy, err)
+		}
+		trusted = append(trusted, prefix.Masked())
+	}
+	return trusted, nil
+}
+
+func (t trustedProxies) contains(addr netip.Addr) bool {
+	for _, prefix := range t {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientIp is the IP of the peer the request came from. When the peer is a
+// trusted proxy, it's the IP the proxy forwarded in RealIpHeader instead, any
+// other peer can't pick its IP by setting the header.
+func (t trustedProxies) clientIp(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	peer, err := netip.ParseAddr(host)
+	if err != nil || !t.contains(peer.Unmap()) {
+		return host
+	}
+	forwarded, err := netip.ParseAddr(strings.TrimSpace(r.Header.Get(RealIpHeader)))
+	if err != nil {
+		return host
+	}
+	return forwarded.Unmap().String()
+}
@@ -3,7 +3,9 @@ package server
 import (
 	"fmt"
 	"log/slog"
+	"net/url"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -13,12 +15,21 @@ type Config struct {
 		Host     string `mapstructure:"host"`
 		Port     string `mapstructure:"port"`
 		Timezone string `mapstructure:"timezone"`
+		// TrustedProxies are the IPs and CIDRs of the proxies in front of the
+		// server, whose X-Real-IP header names the client, e.g.
+		// `WAC_APP_TRUSTED_PROXIES=10.0.0.2,10.1.0.0/16`. If empty, the client
+		// is the peer of the connection.
+		TrustedProxies []string `mapstructure:"trusted_proxies"`
 	} `mapstructure:"app"`
 
 	Log struct {
 		Level slog.Level `mapstructure:"level"`
 	} `mapstructure:"log"`
 
+	Storage struct {
+		Driver string `mapstructure:"driver"`
+	} `mapstructure:"storage"`
+
 	Mongo struct {
 		Host     string `mapstructure:"host"`
 		Port     int    `mapstructure:"port"`
@@ -26,6 +37,112 @@ type Config struct {
 		Password string `mapstructure:"password"`
 		Db       string `mapstructure:"db"`
 	} `mapstructure:"mongo"`
+
+	Postgres struct {
+		Host     string `mapstructure:"host"`
+		Port     int    `mapstructure:"port"`
+		User     string `mapstructure:"user"`
+		Password string `mapstructure:"password"`
+		Db       string `mapstructure:"db"`
+		SslMode  string `mapstructure:"sslmode"`
+	} `mapstructure:"postgres"`
+
+	Audit struct {
+		// AdminToken authorizes administrators to read the audit log and to
+		// override the appointment policy, if empty nobody can.
+		AdminToken string `mapstructure:"admin_token"`
+	} `mapstructure:"audit"`
+
+	Notify struct {
+		// Sender delivers notifications, one of NotifySender* values.
+		Sender       string        `mapstructure:"sender"`
+		PollInterval time.Duration `mapstructure:"poll_interval"`
+		MaxAttempts  int           `mapstructure:"max_attempts"`
+		// File is where the file sender appends notifications.
+		File string `mapstructure:"file"`
+
+		Smtp struct {
+			Host     string `mapstructure:"host"`
+			Port     string `mapstructure:"port"`
+			User     string `mapstructure:"user"`
+			Password string `mapstructure:"password"`
+			From     string `mapstructure:"from"`
+		} `mapstructure:"smtp"`
+
+		Webhook struct {
+			Url string `mapstructure:"url"`
+		} `mapstructure:"webhook"`
+	} `mapstructure:"notify"`
+
+	Reminders struct {
+		// Offsets are how long before appointments patients are reminded,
+		// e.g. `24h,2h`.
+		Offsets      []time.Duration `mapstructure:"offsets"`
+		PollInterval time.Duration   `mapstructure:"poll_interval"`
+		// Lease is how long a replica may take to queue a reminder before
+		// another one takes it over.
+		Lease time.Duration `mapstructure:"lease"`
+	} `mapstructure:"reminders"`
+
+	Waitlist struct {
+		// OfferHold is how long a freed slot is held for a waitlisted
+		// patient before it is offered to the next one.
+		OfferHold     time.Duration `mapstructure:"offer_hold"`
+		SweepInterval time.Duration `mapstructure:"sweep_interval"`
+	} `mapstructure:"waitlist"`
+
+	Appointments struct {
+		// Durations are the default appointment lengths keyed by appointment
+		// type, e.g. `WAC_APPOINTMENTS_DURATIONS_PROCEDURE=90m`.
+		Durations map[string]time.Duration `mapstructure:"durations"`
+	} `mapstructure:"appointments"`
+
+	Policies struct {
+		// CancelLeadTime is how long before appointments they can be
+		// cancelled at the latest, e.g. `WAC_POLICIES_CANCEL_LEAD_TIME=1h`.
+		CancelLeadTime time.Duration `mapstructure:"cancel_lead_time"`
+		// LateCancellationWindow is how long before appointments patients'
+		// cancellations are flagged as late.
+		LateCancellationWindow time.Duration `mapstructure:"late_cancellation_window"`
+		// RescheduleLeadTime is how long before both the current and the new
+		// time appointments can be rescheduled at the latest.
+		RescheduleLeadTime time.Duration `mapstructure:"reschedule_lead_time"`
+		// MaxReschedules is how many times an appointment can be
+		// rescheduled, zero means unlimited.
+		MaxReschedules int `mapstructure:"max_reschedules"`
+	} `mapstructure:"policies"`
+
+	Tracing struct {
+		// Exporter is where spans are exported to, one of TracingExporter*
+		// values.
+		Exporter string `mapstructure:"exporter"`
+		// OtlpEndpoint is the host and port of the collector.
+		OtlpEndpoint string `mapstructure:"otlp_endpoint"`
+		OtlpInsecure bool   `mapstructure:"otlp_insecure"`
+		// SampleRatio is the fraction of traces started by the monolith which
+		// are sampled, a trace propagated from a caller is sampled as the
+		// caller decided.
+		SampleRatio float64 `mapstructure:"sample_ratio"`
+	} `mapstructure:"tracing"`
+
+	RateLimit struct {
+		Enabled bool `mapstructure:"enabled"`
+		// Store keeps the token buckets of clients, one of RateLimitStore*
+		// values.
+		Store string `mapstructure:"store"`
+		// Default limits each client on routes without their own limit, N
+		// requests per period, e.g. `300/1m`.
+		Default string `mapstructure:"default"`
+		// Routes are the limits of specific routes, e.g.
+		// `WAC_RATE_LIMIT_ROUTES=POST /api/auth/login=10/1m,GET /api/doctors=60/1m`.
+		Routes []string `mapstructure:"routes"`
+	} `mapstructure:"rate_limit"`
+
+	Idempotency struct {
+		// Ttl is how long the first response to an idempotency key is
+		// replayed to the retries.
+		Ttl time.Duration `mapstructure:"ttl"`
+	} `mapstructure:"idempotency"`
 }
 
 func (c Config) MongoURI() string {
@@ -39,6 +156,49 @@ func (c Config) MongoURI() string {
 	)
 }
 
+func (c Config) PostgresURI() string {
+	return fmt.Sprintf(
+		"postgres://%s:%s@%s:%d/%s?sslmode=%s",
+		url.QueryEscape(c.Postgres.User),
+		url.QueryEscape(c.Postgres.Password),
+		c.Postgres.Host,
+		c.Postgres.Port,
+		c.Postgres.Db,
+		c.Postgres.SslMode,
+	)
+}
+
+const (
+	StorageDriverMongo    = "mongo"
+	StorageDriverPostgres = "postgres"
+)
+
+const (
+	// TracingExporterOtlp sends spans to a collector over OTLP/HTTP.
+	TracingExporterOtlp = "otlp"
+	// TracingExporterStdout prints spans, meant for local debugging.
+	TracingExporterStdout = "stdout"
+	// TracingExporterNone disables tracing. It is the default, so that a run
+	// without a collector doesn't keep failing to export.
+	TracingExporterNone = "none"
+)
+
+const (
+	// RateLimitStoreMemory keeps the buckets in the replica, each replica
+	// limits clients on its own.
+	RateLimitStoreMemory = "memory"
+	// RateLimitStoreStorage keeps the buckets in the database, replicas share
+	// the limits.
+	RateLimitStoreStorage = "storage"
+)
+
+const (
+	NotifySenderLog     = "log"
+	NotifySenderFile    = "file"
+	NotifySenderSmtp    = "smtp"
+	NotifySenderWebhook = "webhook"
+)
+
 const (
 	AppHostDefault   = "localhost"
 	AppPortDefault   = "42069"
@@ -47,8 +207,63 @@ const (
 	MongoHostDefault = "localhost"
 	MongoPortDefault = 27017
 	MongoDbDefault   = "xcastven-xkilian-db"
+
+	StorageDriverDefault   = StorageDriverMongo
+	PostgresHostDefault    = "localhost"
+	PostgresPortDefault    = 5432
+	PostgresDbDefault      = "xcastven-xkilian-db"
+	PostgresSslModeDefault = "disable"
+
+	NotifySenderDefault       = NotifySenderLog
+	NotifyPollIntervalDefault = 10 * time.Second
+	NotifyMaxAttemptsDefault  = 5
+	NotifyFileDefault         = "notifications.jsonl"
+	NotifySmtpPortDefault     = "587"
+
+	RemindersPollIntervalDefault = 30 * time.Second
+	RemindersLeaseDefault        = 5 * time.Minute
+
+	WaitlistOfferHoldDefault     = 30 * time.Minute
+	WaitlistSweepIntervalDefault = time.Minute
+
+	PoliciesCancelLeadTimeDefault         = time.Duration(0)
+	PoliciesLateCancellationWindowDefault = 24 * time.Hour
+	PoliciesRescheduleLeadTimeDefault     = time.Duration(0)
+	PoliciesMaxReschedulesDefault         = 3
+
+	TracingExporterDefault     = TracingExporterNone
+	TracingOtlpEndpointDefault = "localhost:4318"
+	TracingOtlpInsecureDefault = true
+	TracingSampleRatioDefault  = 1.0
+
+	RateLimitEnabledDefault = true
+	RateLimitStoreDefault   = RateLimitStoreMemory
+	RateLimitDefault        = "300/1m"
+
+	IdempotencyTtlDefault = 24 * time.Hour
 )
 
+var RemindersOffsetsDefault = []time.Duration{24 * time.Hour, 2 * time.Hour}
+
+var RateLimitRoutesDefault = []string{
+	"POST /api/auth/register=10/1h",
+	"POST /api/auth/login=10/1m",
+	"POST /api/appointments=30/1h",
+	"POST /api/appointment-series=10/1h",
+}
+
+var AppointmentDurationsDefault = map[string]time.Duration{
+	"regular_check":    30 * time.Minute,
+	"new_patient":      time.Hour,
+	"follow_up":        30 * time.Minute,
+	"annual_physical":  time.Hour,
+	"consultation":     30 * time.Minute,
+	"vaccination":      15 * time.Minute,
+	"urgent_care":      30 * time.Minute,
+	"procedure":        90 * time.Minute,
+	"specialist_visit": 45 * time.Minute,
+}
+
 const EnvPrefix = "wac"
 
 func loadConfig() (*Config, error) {
@@ -60,6 +275,7 @@ func loadConfig() (*Config, error) {
 
 	v.SetDefault("app.host", AppHostDefault)
 	v.SetDefault("app.port", AppPortDefault)
+	v.SetDefault("app.trusted_proxies", []string{})
 	v.SetDefault("app.timezone", TzDefault)
 	v.SetDefault("log.level", LogLevelDefault)
 	v.SetDefault("mongo.host", MongoHostDefault)
@@ -67,6 +283,45 @@ func loadConfig() (*Config, error) {
 	v.SetDefault("mongo.db", MongoDbDefault)
 	v.SetDefault("mongo.user", "")
 	v.SetDefault("mongo.password", "")
+	v.SetDefault("storage.driver", StorageDriverDefault)
+	v.SetDefault("postgres.host", PostgresHostDefault)
+	v.SetDefault("postgres.port", PostgresPortDefault)
+	v.SetDefault("postgres.db", PostgresDbDefault)
+	v.SetDefault("postgres.user", "")
+	v.SetDefault("postgres.password", "")
+	v.SetDefault("postgres.sslmode", PostgresSslModeDefault)
+	v.SetDefault("audit.admin_token", "")
+	v.SetDefault("notify.sender", NotifySenderDefault)
+	v.SetDefault("notify.poll_interval", NotifyPollIntervalDefault)
+	v.SetDefault("notify.max_attempts", NotifyMaxAttemptsDefault)
+	v.SetDefault("notify.file", NotifyFileDefault)
+	v.SetDefault("notify.smtp.host", "")
+	v.SetDefault("notify.smtp.port", NotifySmtpPortDefault)
+	v.SetDefault("notify.smtp.user", "")
+	v.SetDefault("notify.smtp.password", "")
+	v.SetDefault("notify.smtp.from", "")
+	v.SetDefault("notify.webhook.url", "")
+	v.SetDefault("reminders.offsets", RemindersOffsetsDefault)
+	v.SetDefault("reminders.poll_interval", RemindersPollIntervalDefault)
+	v.SetDefault("reminders.lease", RemindersLeaseDefault)
+	v.SetDefault("waitlist.offer_hold", WaitlistOfferHoldDefault)
+	v.SetDefault("waitlist.sweep_interval", WaitlistSweepIntervalDefault)
+	v.SetDefault("policies.cancel_lead_time", PoliciesCancelLeadTimeDefault)
+	v.SetDefault("policies.late_cancellation_window", PoliciesLateCancellationWindowDefault)
+	v.SetDefault("policies.reschedule_lead_time", PoliciesRescheduleLeadTimeDefault)
+	v.SetDefault("policies.max_reschedules", PoliciesMaxReschedulesDefault)
+	v.SetDefault("tracing.exporter", TracingExporterDefault)
+	v.SetDefault("tracing.otlp_endpoint", TracingOtlpEndpointDefault)
+	v.SetDefault("tracing.otlp_insecure", TracingOtlpInsecureDefault)
+	v.SetDefault("tracing.sample_ratio", TracingSampleRatioDefault)
+	v.SetDefault("rate_limit.enabled", RateLimitEnabledDefault)
+	v.SetDefault("rate_limit.store", RateLimitStoreDefault)
+	v.SetDefault("rate_limit.default", RateLimitDefault)
+	v.SetDefault("rate_limit.routes", RateLimitRoutesDefault)
+	v.SetDefault("idempotency.ttl", IdempotencyTtlDefault)
+	for typ, duration := range AppointmentDurationsDefault {
+		v.SetDefault("appointments.durations."+typ, duration)
+	}
 
 	var cfg Config
 	err := v.Unmarshal(&cfg)
@@ -0,0 +1,59 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/Nesquiko/wac/pkg/api"
+	"github.com/Nesquiko/wac/pkg/app"
+)
+
+// DoctorAppointmentDurations implements api.ServerInterface.
+func (s Server) DoctorAppointmentDurations(
+	w http.ResponseWriter,
+	r *http.Request,
+	doctorId api.DoctorId,
+) {
+	durations, err := s.app.DoctorAppointmentDurations(r.Context(), doctorId)
+	if err != nil {
+		encodeDurationsError(w, err, doctorId.String(), "DoctorAppointmentDurations")
+		return
+	}
+
+	encode(w, http.StatusOK, durations)
+}
+
+// UpdateDoctorAppointmentDurations implements api.ServerInterface.
+func (s Server) UpdateDoctorAppointmentDurations(
+	w http.ResponseWriter,
+	r *http.Request,
+	doctorId api.DoctorId,
+) {
+	req, decodeErr := Decode[api.AppointmentDurations](w, r)
+	if decodeErr != nil {
+		encodeError(w, decodeErr)
+		return
+	}
+
+	durations, err := s.app.UpdateDoctorAppointmentDurations(r.Context(), doctorId, req)
+	if err != nil {
+		encodeDurationsError(w, err, doctorId.String(), "UpdateDoctorAppointmentDurations")
+		return
+	}
+
+	encode(w, http.StatusOK, durations)
+}
+
+func encodeDurationsError(w http.ResponseWriter, err error, doctorId, where string) {
+	var validationErr *app.ValidationError
+	switch {
+	case errors.As(err, &validationErr):
+		encodeError(w, &ApiError{ErrorDetail: validationErr.ErrorDetail})
+	case errors.Is(err, app.ErrNotFound):
+		encodeError(w, notFound("Doctor", doctorId))
+	default:
+		slog.Error(UnexpectedError, "error", err.Error(), "where", where)
+		encodeError(w, internalServerError())
+	}
+}
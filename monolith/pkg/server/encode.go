@@ -9,6 +9,8 @@ import (
 	"net/http"
 	"strings"
 
+	chi_middleware "github.com/go-chi/chi/v5/middleware"
+
 	"github.com/Nesquiko/wac/pkg/api"
 )
 
@@ -16,8 +18,14 @@ func encode[T any](w http.ResponseWriter, status int, response T) {
 	encodeWithContentType(w, status, response, ApplicationJSON)
 }
 
+// encodeError encodes the problem with the request id sent back in the
+// response headers, so that the problem can be found in the logs.
 func encodeError(w http.ResponseWriter, err *ApiError) {
-	encodeWithContentType(w, err.Status, err.ErrorDetail, ApplicationProblemJSON)
+	detail := err.ErrorDetail
+	if id := w.Header().Get(chi_middleware.RequestIDHeader); id != "" {
+		detail.RequestId = &id
+	}
+	encodeWithContentType(w, err.Status, detail, ApplicationProblemJSON)
 }
 
 func encodeWithContentType[T any](
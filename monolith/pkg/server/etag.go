@@ -0,0 +1,81 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/Nesquiko/wac/pkg/api"
+	"github.com/Nesquiko/wac/pkg/app"
+)
+
+const (
+	// ETagHeader carries the version of the returned resource.
+	ETagHeader = "ETag"
+	// IfMatchHeader carries the ETag of the resource to update, so that an
+	// update based on a stale read doesn't overwrite a newer change.
+	IfMatchHeader = "If-Match"
+)
+
+const PreconditionFailedCode = "precondition.failed"
+
+func setETag(w http.ResponseWriter, version int64) {
+	w.Header().Set(ETagHeader, strconv.Quote(strconv.FormatInt(version, 10)))
+}
+
+// ifMatchVersions parses the versions out of the If-Match header, a list of
+// ETags separated by commas. * or a missing header matches any version, so
+// that clients which don't read ETags can still update. ETags are compared
+// strongly, a weak one never matches, nor does one which isn't a version. If
+// none of the ETags can match, the request is rejected with 412.
+func ifMatchVersions(ifMatch *string) ([]int64, *ApiError) {
+	if ifMatch == nil || strings.TrimSpace(*ifMatch) == "" {
+		return []int64{app.AnyVersion}, nil
+	}
+	header := strings.TrimSpace(*ifMatch)
+	if header == "*" {
+		return []int64{app.AnyVersion}, nil
+	}
+
+	var versions []int64
+	for header != "" {
+		weak := strings.HasPrefix(header, "W/")
+		header = strings.TrimPrefix(header, "W/")
+		if !strings.HasPrefix(header, `"`) {
+			return nil, preconditionFailed()
+		}
+		opaque, rest, ok := strings.Cut(header[1:], `"`)
+		if !ok {
+			return nil, preconditionFailed()
+		}
+		header = strings.TrimSpace(rest)
+		if header != "" {
+			header, ok = strings.CutPrefix(header, ",")
+			if !ok {
+				return nil, preconditionFailed()
+			}
+			header = strings.TrimSpace(header)
+		}
+
+		version, err := strconv.ParseInt(opaque, 10, 64)
+		if weak || err != nil {
+			continue
+		}
+		versions = append(versions, version)
+	}
+	if len(versions) == 0 {
+		return nil, preconditionFailed()
+	}
+	return versions, nil
+}
+
+func preconditionFailed() *ApiError {
+	return &ApiError{
+		ErrorDetail: api.ErrorDetail{
+			Code:   PreconditionFailedCode,
+			Title:  "Precondition Failed",
+			Detail: "Resource was changed since it was read, read it again and retry.",
+			Status: http.StatusPreconditionFailed,
+		},
+	}
+}
@@ -0,0 +1,413 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	chi_middleware "github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/httplog/v2"
+	"github.com/google/uuid"
+
+	"github.com/Nesquiko/wac/pkg/app"
+	"github.com/Nesquiko/wac/pkg/fhir"
+)
+
+// FhirBaseUrl is where the FHIR R4 facade is mounted. It lives outside of the
+// OpenAPI spec, requests and responses are FHIR resources, errors are
+// OperationOutcomes.
+const FhirBaseUrl = "/api/fhir/R4"
+
+func fhirRouter(srv Server, logger *httplog.Logger, limiter *rateLimiter) http.Handler {
+	r := chi.NewRouter()
+	r.Use(
+		chi_middleware.Recoverer,
+		limiter.middleware(encodeRateLimitedOutcome),
+		httplog.RequestLogger(logger),
+		traceRoute,
+		auditMeta,
+	)
+	r.NotFound(func(w http.ResponseWriter, r *http.Request) {
+		encodeOutcome(w, http.StatusNotFound, "not-supported", "Unknown FHIR interaction")
+	})
+	r.MethodNotAllowed(func(w http.ResponseWriter, r *http.Request) {
+		encodeOutcome(
+			w,
+			http.StatusMethodNotAllowed,
+			"not-supported",
+			"Interaction is not supported for this resource type",
+		)
+	})
+
+	r.Route("/"+fhir.ResourceTypePatient, func(r chi.Router) {
+		r.Post("/", srv.fhirCreatePatient)
+		r.Get("/{id}", srv.fhirReadPatient)
+	})
+	r.Route("/"+fhir.ResourceTypePractitioner, func(r chi.Router) {
+		r.Get("/", srv.fhirSearchPractitioners)
+		r.Post("/", srv.fhirCreatePractitioner)
+		r.Get("/{id}", srv.fhirReadPractitioner)
+	})
+	r.Route("/"+fhir.ResourceTypeCondition, func(r chi.Router) {
+		r.Get("/", srv.fhirSearchConditions)
+		r.Post("/", srv.fhirCreateCondition)
+		r.Get("/{id}", srv.fhirReadCondition)
+	})
+	r.Route("/"+fhir.ResourceTypeMedicationRequest, func(r chi.Router) {
+		r.Get("/", srv.fhirSearchMedicationRequests)
+		r.Post("/", srv.fhirCreateMedicationRequest)
+		r.Get("/{id}", srv.fhirReadMedicationRequest)
+	})
+	r.Route("/"+fhir.ResourceTypeAppointment, func(r chi.Router) {
+		r.Get("/", srv.fhirSearchAppointments)
+		r.Post("/", srv.fhirCreateAppointment)
+		r.Get("/{id}", srv.fhirReadAppointment)
+	})
+	r.Get("/"+fhir.ResourceTypeSchedule+"/{id}", srv.fhirReadSchedule)
+	r.Get("/"+fhir.ResourceTypeSlot, srv.fhirSearchSlots)
+
+	return r
+}
+
+func (s Server) fhirReadPatient(w http.ResponseWriter, r *http.Request) {
+	id, ok := fhirId(w, r)
+	if !ok {
+		return
+	}
+	patient, err := s.app.FhirPatientById(r.Context(), id)
+	fhirRespond(w, http.StatusOK, patient, err, "fhirReadPatient")
+}
+
+func (s Server) fhirCreatePatient(w http.ResponseWriter, r *http.Request) {
+	req, ok := fhirDecode[fhir.Patient](w, r)
+	if !ok {
+		return
+	}
+	patient, err := s.app.FhirCreatePatient(r.Context(), req)
+	fhirRespondCreated(w, r, fhir.ResourceTypePatient, patient.Id, patient, err)
+}
+
+func (s Server) fhirReadPractitioner(w http.ResponseWriter, r *http.Request) {
+	id, ok := fhirId(w, r)
+	if !ok {
+		return
+	}
+	practitioner, err := s.app.FhirPractitionerById(r.Context(), id)
+	fhirRespond(w, http.StatusOK, practitioner, err, "fhirReadPractitioner")
+}
+
+func (s Server) fhirSearchPractitioners(w http.ResponseWriter, r *http.Request) {
+	practitioners, err := s.app.FhirPractitioners(r.Context())
+	if err != nil {
+		fhirRespondError(w, err, "fhirSearchPractitioners")
+		return
+	}
+
+	bundle := fhir.SearchSet(fhirBaseUrl(r), practitioners, func(p fhir.Practitioner) (string, string) {
+		return p.ResourceType, p.Id
+	})
+	encodeWithContentType(w, http.StatusOK, bundle, fhir.ContentType)
+}
+
+func (s Server) fhirCreatePractitioner(w http.ResponseWriter, r *http.Request) {
+	req, ok := fhirDecode[fhir.Practitioner](w, r)
+	if !ok {
+		return
+	}
+	practitioner, err := s.app.FhirCreatePractitioner(r.Context(), req)
+	fhirRespondCreated(w, r, fhir.ResourceTypePractitioner, practitioner.Id, practitioner, err)
+}
+
+func (s Server) fhirReadCondition(w http.ResponseWriter, r *http.Request) {
+	id, ok := fhirId(w, r)
+	if !ok {
+		return
+	}
+	condition, err := s.app.FhirConditionById(r.Context(), id)
+	fhirRespond(w, http.StatusOK, condition, err, "fhirReadCondition")
+}
+
+func (s Server) fhirSearchConditions(w http.ResponseWriter, r *http.Request) {
+	patientId, ok := fhirRequiredReference(w, r, "patient", fhir.ResourceTypePatient)
+	if !ok {
+		return
+	}
+	from, to, err := fhir.ParseDateParams(r.URL.Query()["date"])
+	if err != nil {
+		fhirRespondError(w, err, "fhirSearchConditions")
+		return
+	}
+
+	conditions, err := s.app.FhirConditions(r.Context(), patientId, from, to)
+	if err != nil {
+		fhirRespondError(w, err, "fhirSearchConditions")
+		return
+	}
+
+	bundle := fhir.SearchSet(fhirBaseUrl(r), conditions, func(c fhir.Condition) (string, string) {
+		return c.ResourceType, c.Id
+	})
+	encodeWithContentType(w, http.StatusOK, bundle, fhir.ContentType)
+}
+
+func (s Server) fhirCreateCondition(w http.ResponseWriter, r *http.Request) {
+	req, ok := fhirDecode[fhir.Condition](w, r)
+	if !ok {
+		return
+	}
+	condition, err := s.app.FhirCreateCondition(r.Context(), req)
+	fhirRespondCreated(w, r, fhir.ResourceTypeCondition, condition.Id, condition, err)
+}
+
+func (s Server) fhirReadMedicationRequest(w http.ResponseWriter, r *http.Request) {
+	id, ok := fhirId(w, r)
+	if !ok {
+		return
+	}
+	medicationRequest, err := s.app.FhirMedicationRequestById(r.Context(), id)
+	fhirRespond(w, http.StatusOK, medicationRequest, err, "fhirReadMedicationRequest")
+}
+
+func (s Server) fhirSearchMedicationRequests(w http.ResponseWriter, r *http.Request) {
+	patientId, ok := fhirRequiredReference(w, r, "patient", fhir.ResourceTypePatient)
+	if !ok {
+		return
+	}
+	from, to, err := fhir.ParseDateParams(r.URL.Query()["date"])
+	if err != nil {
+		fhirRespondError(w, err, "fhirSearchMedicationRequests")
+		return
+	}
+
+	medicationRequests, err := s.app.FhirMedicationRequests(r.Context(), patientId, from, to)
+	if err != nil {
+		fhirRespondError(w, err, "fhirSearchMedicationRequests")
+		return
+	}
+
+	bundle := fhir.SearchSet(
+		fhirBaseUrl(r),
+		medicationRequests,
+		func(m fhir.MedicationRequest) (string, string) { return m.ResourceType, m.Id },
+	)
+	encodeWithContentType(w, http.StatusOK, bundle, fhir.ContentType)
+}
+
+func (s Server) fhirCreateMedicationRequest(w http.ResponseWriter, r *http.Request) {
+	req, ok := fhirDecode[fhir.MedicationRequest](w, r)
+	if !ok {
+		return
+	}
+	medicationRequest, err := s.app.FhirCreateMedicationRequest(r.Context(), req)
+	fhirRespondCreated(
+		w,
+		r,
+		fhir.ResourceTypeMedicationRequest,
+		medicationRequest.Id,
+		medicationRequest,
+		err,
+	)
+}
+
+func (s Server) fhirReadAppointment(w http.ResponseWriter, r *http.Request) {
+	id, ok := fhirId(w, r)
+	if !ok {
+		return
+	}
+	appointment, err := s.app.FhirAppointmentById(r.Context(), id)
+	fhirRespond(w, http.StatusOK, appointment, err, "fhirReadAppointment")
+}
+
+// fhirSearchAppointments searches appointments of either a patient, or a
+// practitioner, in the period given by the date parameter.
+func (s Server) fhirSearchAppointments(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	var patientId, practitionerId *uuid.UUID
+	if query.Has("patient") {
+		id, ok := fhirRequiredReference(w, r, "patient", fhir.ResourceTypePatient)
+		if !ok {
+			return
+		}
+		patientId = &id
+	} else {
+		id, ok := fhirRequiredReference(w, r, "practitioner", fhir.ResourceTypePractitioner)
+		if !ok {
+			return
+		}
+		practitionerId = &id
+	}
+	from, to, err := fhir.ParseDateParams(query["date"])
+	if err != nil {
+		fhirRespondError(w, err, "fhirSearchAppointments")
+		return
+	}
+
+	appointments, err := s.app.FhirAppointments(r.Context(), patientId, practitionerId, from, to)
+	if err != nil {
+		fhirRespondError(w, err, "fhirSearchAppointments")
+		return
+	}
+
+	bundle := fhir.SearchSet(fhirBaseUrl(r), appointments, func(a fhir.Appointment) (string, string) {
+		return a.ResourceType, a.Id
+	})
+	encodeWithContentType(w, http.StatusOK, bundle, fhir.ContentType)
+}
+
+func (s Server) fhirCreateAppointment(w http.ResponseWriter, r *http.Request) {
+	req, ok := fhirDecode[fhir.Appointment](w, r)
+	if !ok {
+		return
+	}
+	appointment, err := s.app.FhirCreateAppointment(r.Context(), req)
+	fhirRespondCreated(w, r, fhir.ResourceTypeAppointment, appointment.Id, appointment, err)
+}
+
+func (s Server) fhirReadSchedule(w http.ResponseWriter, r *http.Request) {
+	id, ok := fhirId(w, r)
+	if !ok {
+		return
+	}
+	schedule, err := s.app.FhirScheduleById(r.Context(), id)
+	fhirRespond(w, http.StatusOK, schedule, err, "fhirReadSchedule")
+}
+
+func (s Server) fhirSearchSlots(w http.ResponseWriter, r *http.Request) {
+	scheduleId, ok := fhirRequiredReference(w, r, "schedule", fhir.ResourceTypeSchedule)
+	if !ok {
+		return
+	}
+	from, to, err := fhir.ParseDateParams(r.URL.Query()["start"])
+	if err != nil {
+		fhirRespondError(w, err, "fhirSearchSlots")
+		return
+	}
+
+	slots, err := s.app.FhirSlots(r.Context(), scheduleId, from, to)
+	if err != nil {
+		fhirRespondError(w, err, "fhirSearchSlots")
+		return
+	}
+
+	bundle := fhir.SearchSet(fhirBaseUrl(r), slots, func(s fhir.Slot) (string, string) {
+		return s.ResourceType, s.Id
+	})
+	encodeWithContentType(w, http.StatusOK, bundle, fhir.ContentType)
+}
+
+func fhirId(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		encodeOutcome(w, http.StatusNotFound, "not-found", "Resource ids are UUIDs")
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+func fhirRequiredReference(
+	w http.ResponseWriter,
+	r *http.Request,
+	param string,
+	resourceType string,
+) (uuid.UUID, bool) {
+	value := r.URL.Query().Get(param)
+	if value == "" {
+		encodeOutcome(
+			w,
+			http.StatusBadRequest,
+			"required",
+			"Search parameter '"+param+"' is required",
+		)
+		return uuid.Nil, false
+	}
+
+	id, err := fhir.ParseReference(fhir.Reference{Reference: value}, resourceType)
+	if err != nil {
+		encodeOutcome(w, http.StatusBadRequest, "invalid", err.Error())
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+func fhirDecode[T any](w http.ResponseWriter, r *http.Request) (T, bool) {
+	r.Body = http.MaxBytesReader(w, r.Body, int64(MaxBytes))
+
+	var resource T
+	if err := json.NewDecoder(r.Body).Decode(&resource); err != nil {
+		encodeOutcome(w, http.StatusBadRequest, "structure", "Body is not a valid FHIR resource")
+		return resource, false
+	}
+	return resource, true
+}
+
+func fhirRespond[T any](w http.ResponseWriter, status int, resource T, err error, where string) {
+	if err != nil {
+		fhirRespondError(w, err, where)
+		return
+	}
+	encodeWithContentType(w, status, resource, fhir.ContentType)
+}
+
+// fhirRespondCreated responds with the created resource and its location. A
+// reference to a missing resource makes the request unprocessable, not
+// missing.
+func fhirRespondCreated[T any](
+	w http.ResponseWriter,
+	r *http.Request,
+	resourceType string,
+	id string,
+	resource T,
+	err error,
+) {
+	if errors.Is(err, app.ErrNotFound) {
+		encodeOutcome(
+			w,
+			http.StatusUnprocessableEntity,
+			"processing",
+			"Referenced resource was not found",
+		)
+		return
+	} else if err != nil {
+		fhirRespondError(w, err, "fhirCreate"+resourceType)
+		return
+	}
+
+	w.Header().Set("Location", fhirBaseUrl(r)+"/"+resourceType+"/"+id)
+	encodeWithContentType(w, http.StatusCreated, resource, fhir.ContentType)
+}
+
+func fhirRespondError(w http.ResponseWriter, err error, where string) {
+	switch {
+	case errors.Is(err, fhir.ErrInvalidResource):
+		encodeOutcome(w, http.StatusBadRequest, "invalid", err.Error())
+	case errors.Is(err, app.ErrNotFound):
+		encodeOutcome(w, http.StatusNotFound, "not-found", "Resource was not found")
+	case errors.Is(err, app.ErrDeleted):
+		encodeOutcome(w, http.StatusGone, "deleted", "Resource was deleted")
+	case errors.Is(err, app.ErrDuplicateEmail):
+		encodeOutcome(w, http.StatusConflict, "duplicate", "Email address is already used")
+	case errors.Is(err, app.ErrDoctorUnavailable):
+		encodeOutcome(
+			w,
+			http.StatusConflict,
+			"conflict",
+			"Practitioner is unavailable at the requested time",
+		)
+	default:
+		slog.Error(UnexpectedError, "error", err.Error(), "where", where)
+		encodeOutcome(w, http.StatusInternalServerError, "exception", "Unexpected error on server")
+	}
+}
+
+func encodeOutcome(w http.ResponseWriter, status int, code string, diagnostics string) {
+	encodeWithContentType(w, status, fhir.NewOperationOutcome(code, diagnostics), fhir.ContentType)
+}
+
+// fhirBaseUrl is the absolute base of the facade used in fullUrls of bundle
+// entries and in Location headers.
+func fhirBaseUrl(r *http.Request) string {
+	return externalUrl(r, FhirBaseUrl)
+}
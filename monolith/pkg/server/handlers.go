@@ -22,10 +22,25 @@ func (s Server) CancelAppointment(
 		encodeError(w, decodeErr)
 		return
 	}
+	if overrideErr := s.checkPolicyOverride(r, req.OverridePolicy); overrideErr != nil {
+		encodeError(w, overrideErr)
+		return
+	}
 
 	err := s.app.CancelAppointment(r.Context(), appointmentId, req)
-	if err != nil {
-		slog.Error(UnexpectedError, "error", err.Error(), "where", "CancelAppointment")
+	var policyErr *app.PolicyError
+	if errors.As(err, &policyErr) {
+		encodeError(w, &ApiError{ErrorDetail: policyErr.ErrorDetail})
+		return
+	} else if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"CancelAppointment",
+		)
 		encodeError(w, internalServerError())
 		return
 	}
@@ -33,6 +48,23 @@ func (s Server) CancelAppointment(
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// checkPolicyOverride rejects an override of the appointment policy, unless
+// the caller is an administrator. The role in the request body is claimed by
+// the client, so it can't authorize the override.
+func (s Server) checkPolicyOverride(r *http.Request, override *bool) *ApiError {
+	if override == nil || !*override || s.isAdmin(r) {
+		return nil
+	}
+	return &ApiError{
+		ErrorDetail: api.ErrorDetail{
+			Code:   app.PolicyCodeOverrideForbidden,
+			Title:  "Override forbidden",
+			Detail: "Only administrators may override the appointment policy",
+			Status: http.StatusForbidden,
+		},
+	}
+}
+
 // ConditionDetail implements api.ServerInterface.
 func (s Server) ConditionDetail(
 	w http.ResponseWriter,
@@ -41,11 +73,19 @@ func (s Server) ConditionDetail(
 ) {
 	cond, err := s.app.ConditionById(r.Context(), conditionId)
 	if err != nil {
-		slog.Error(UnexpectedError, "error", err.Error(), "where", "ConditionDetail")
+		slog.ErrorContext(
+			r.Context(),
+			UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"ConditionDetail",
+		)
 		encodeError(w, internalServerError())
 		return
 	}
 
+	setETag(w, cond.Version)
 	encode(w, http.StatusOK, cond)
 }
 
@@ -54,15 +94,25 @@ func (s Server) DecideAppointment(
 	w http.ResponseWriter,
 	r *http.Request,
 	appointmentId api.AppointmentId,
+	params api.DecideAppointmentParams,
 ) {
+	versions, preconditionErr := ifMatchVersions(params.IfMatch)
+	if preconditionErr != nil {
+		encodeError(w, preconditionErr)
+		return
+	}
 	req, decodeErr := Decode[api.AppointmentDecision](w, r)
 	if decodeErr != nil {
 		encodeError(w, decodeErr)
 		return
 	}
 
-	doctorAppt, err := s.app.DecideAppointment(r.Context(), appointmentId, req)
+	doctorAppt, err := s.app.DecideAppointment(r.Context(), appointmentId, versions, req)
 	if err != nil {
+		if errors.Is(err, app.ErrVersionMismatch) {
+			encodeError(w, preconditionFailed())
+			return
+		}
 		if errors.Is(err, app.ErrResourceUnavailable) {
 			apiErr := &ApiError{
 				ErrorDetail: api.ErrorDetail{
@@ -75,11 +125,19 @@ func (s Server) DecideAppointment(
 			encodeError(w, apiErr)
 			return
 		}
-		slog.Error(UnexpectedError, "error", err.Error(), "where", "DecideAppointment")
+		slog.ErrorContext(
+			r.Context(),
+			UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"DecideAppointment",
+		)
 		encodeError(w, internalServerError())
 		return
 	}
 
+	setETag(w, doctorAppt.Version)
 	encode(w, http.StatusOK, doctorAppt)
 }
 
@@ -92,7 +150,14 @@ func (s Server) DoctorsCalendar(
 ) {
 	calendar, err := s.app.DoctorsCalendar(r.Context(), doctorId, params.From, params.To)
 	if err != nil {
-		slog.Error(UnexpectedError, "error", err.Error(), "where", "DoctorsCalendar")
+		slog.ErrorContext(
+			r.Context(),
+			UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"DoctorsCalendar",
+		)
 		encodeError(w, internalServerError())
 		return
 	}
@@ -109,7 +174,14 @@ func (s Server) DoctorsTimeslots(
 ) {
 	slots, err := s.app.DoctorTimeSlots(r.Context(), doctorId, params.Date.Time)
 	if err != nil {
-		slog.Error(UnexpectedError, "error", err.Error(), "where", "DoctorsCalendar")
+		slog.ErrorContext(
+			r.Context(),
+			UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"DoctorsCalendar",
+		)
 		encodeError(w, internalServerError())
 		return
 	}
@@ -123,9 +195,16 @@ func (s Server) GetAvailableResources(
 	r *http.Request,
 	params api.GetAvailableResourcesParams,
 ) {
-	resources, err := s.app.AvailableResources(r.Context(), params.DateTime)
+	resources, err := s.app.AvailableResources(r.Context(), params.DateTime, params.DurationMinutes)
 	if err != nil {
-		slog.Error(UnexpectedError, "error", err.Error(), "where", "GetAvailableResources")
+		slog.ErrorContext(
+			r.Context(),
+			UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"GetAvailableResources",
+		)
 		encodeError(w, internalServerError())
 		return
 	}
@@ -149,7 +228,14 @@ func (s Server) GetDoctorById(w http.ResponseWriter, r *http.Request, doctorId a
 			encodeError(w, apiErr)
 			return
 		}
-		slog.Error(UnexpectedError, "error", err.Error(), "where", "GetDoctorById")
+		slog.ErrorContext(
+			r.Context(),
+			UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"GetDoctorById",
+		)
 		encodeError(w, internalServerError())
 		return
 	}
@@ -157,6 +243,31 @@ func (s Server) GetDoctorById(w http.ResponseWriter, r *http.Request, doctorId a
 	encode(w, http.StatusOK, doctor)
 }
 
+// EraseDoctor implements api.ServerInterface.
+func (s Server) EraseDoctor(w http.ResponseWriter, r *http.Request, doctorId api.DoctorId) {
+	err := s.app.EraseDoctor(r.Context(), doctorId)
+	if err != nil {
+		if errors.Is(err, app.ErrNotFound) {
+			encodeError(w, notFoundId("Doctor", doctorId))
+			return
+		}
+		slog.ErrorContext(
+			r.Context(),
+			UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"EraseDoctor",
+			"doctorId",
+			doctorId.String(),
+		)
+		encodeError(w, internalServerError())
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
 func (s Server) AppointmentById(
 	w http.ResponseWriter,
 	r *http.Request,
@@ -164,10 +275,18 @@ func (s Server) AppointmentById(
 ) {
 	appt, err := s.app.AppointmentById(r.Context(), appointmentId)
 	if err != nil {
-		slog.Error(UnexpectedError, "error", err.Error(), "where", "AppointmentById")
+		slog.ErrorContext(
+			r.Context(),
+			UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"AppointmentById",
+		)
 		encodeError(w, internalServerError())
 		return
 	}
+	setETag(w, appt.Version)
 	encode(w, http.StatusOK, appt)
 }
 
@@ -187,7 +306,14 @@ func (s Server) GetPatientById(w http.ResponseWriter, r *http.Request, patientId
 			encodeError(w, apiErr)
 			return
 		}
-		slog.Error(UnexpectedError, "error", err.Error(), "where", "GetPatientById")
+		slog.ErrorContext(
+			r.Context(),
+			UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"GetPatientById",
+		)
 		encodeError(w, internalServerError())
 		return
 	}
@@ -195,6 +321,60 @@ func (s Server) GetPatientById(w http.ResponseWriter, r *http.Request, patientId
 	encode(w, http.StatusOK, patient)
 }
 
+// ErasePatient implements api.ServerInterface.
+func (s Server) ErasePatient(w http.ResponseWriter, r *http.Request, patientId api.PatientId) {
+	err := s.app.ErasePatient(r.Context(), patientId)
+	if err != nil {
+		if errors.Is(err, app.ErrNotFound) {
+			encodeError(w, notFoundId("Patient", patientId))
+			return
+		}
+		slog.ErrorContext(
+			r.Context(),
+			UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"ErasePatient",
+			"patientId",
+			patientId.String(),
+		)
+		encodeError(w, internalServerError())
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ExportPatientData implements api.ServerInterface.
+func (s Server) ExportPatientData(
+	w http.ResponseWriter,
+	r *http.Request,
+	patientId api.PatientId,
+) {
+	export, err := s.app.ExportPatientData(r.Context(), patientId)
+	if err != nil {
+		if errors.Is(err, app.ErrNotFound) {
+			encodeError(w, notFoundId("Patient", patientId))
+			return
+		}
+		slog.ErrorContext(
+			r.Context(),
+			UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"ExportPatientData",
+			"patientId",
+			patientId.String(),
+		)
+		encodeError(w, internalServerError())
+		return
+	}
+
+	encode(w, http.StatusOK, export)
+}
+
 // PatientsCalendar implements api.ServerInterface.
 func (s Server) PatientsCalendar(
 	w http.ResponseWriter,
@@ -204,7 +384,14 @@ func (s Server) PatientsCalendar(
 ) {
 	calendar, err := s.app.PatientsCalendar(r.Context(), patientId, params.From, params.To)
 	if err != nil {
-		slog.Error(UnexpectedError, "error", err.Error(), "where", "PatientsCalendar")
+		slog.ErrorContext(
+			r.Context(),
+			UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"PatientsCalendar",
+		)
 		encodeError(w, internalServerError())
 		return
 	}
@@ -217,15 +404,34 @@ func (s Server) RescheduleAppointment(
 	w http.ResponseWriter,
 	r *http.Request,
 	appointmentId api.AppointmentId,
+	params api.RescheduleAppointmentParams,
 ) {
+	versions, preconditionErr := ifMatchVersions(params.IfMatch)
+	if preconditionErr != nil {
+		encodeError(w, preconditionErr)
+		return
+	}
 	req, decodeErr := Decode[api.AppointmentReschedule](w, r)
 	if decodeErr != nil {
 		encodeError(w, decodeErr)
 		return
 	}
+	if overrideErr := s.checkPolicyOverride(r, req.OverridePolicy); overrideErr != nil {
+		encodeError(w, overrideErr)
+		return
+	}
 
-	appt, err := s.app.RescheduleAppointment(r.Context(), appointmentId, req.NewAppointmentDateTime)
+	appt, err := s.app.RescheduleAppointment(r.Context(), appointmentId, versions, req)
 	if err != nil {
+		if errors.Is(err, app.ErrVersionMismatch) {
+			encodeError(w, preconditionFailed())
+			return
+		}
+		var policyErr *app.PolicyError
+		if errors.As(err, &policyErr) {
+			encodeError(w, &ApiError{ErrorDetail: policyErr.ErrorDetail})
+			return
+		}
 		if errors.Is(err, app.ErrDoctorUnavailable) {
 			apiErr := &ApiError{
 				ErrorDetail: api.ErrorDetail{
@@ -238,11 +444,19 @@ func (s Server) RescheduleAppointment(
 			encodeError(w, apiErr)
 			return
 		}
-		slog.Error(UnexpectedError, "error", err.Error(), "where", "RescheduleAppointment")
+		slog.ErrorContext(
+			r.Context(),
+			UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"RescheduleAppointment",
+		)
 		encodeError(w, internalServerError())
 		return
 	}
 
+	setETag(w, appt.Version)
 	encode(w, http.StatusOK, appt)
 }
 
@@ -256,7 +470,14 @@ func (s Server) CreatePatientCondition(w http.ResponseWriter, r *http.Request) {
 
 	cond, err := s.app.CreatePatientCondition(r.Context(), req)
 	if err != nil {
-		slog.Error(UnexpectedError, "error", err.Error(), "where", "CreatePatientCondition")
+		slog.ErrorContext(
+			r.Context(),
+			UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"CreatePatientCondition",
+		)
 		encodeError(w, internalServerError())
 		return
 	}
@@ -274,7 +495,14 @@ func (s Server) CreatePrescription(w http.ResponseWriter, r *http.Request) {
 
 	presc, err := s.app.CreatePatientPrescription(r.Context(), req)
 	if err != nil {
-		slog.Error(UnexpectedError, "error", err.Error(), "where", "CreatePrescription")
+		slog.ErrorContext(
+			r.Context(),
+			UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"CreatePrescription",
+		)
 		encodeError(w, internalServerError())
 		return
 	}
@@ -292,7 +520,26 @@ func (s Server) RequestAppointment(w http.ResponseWriter, r *http.Request) {
 
 	appt, err := s.app.CreateAppointment(r.Context(), req)
 	if err != nil {
-		slog.Error(UnexpectedError, "error", err.Error(), "where", "RequestAppointment")
+		if errors.Is(err, app.ErrDoctorUnavailable) {
+			apiErr := &ApiError{
+				ErrorDetail: api.ErrorDetail{
+					Code:   "doctor.unavailable",
+					Title:  "Conflict",
+					Detail: "Doctor is unavailable in requested time",
+					Status: http.StatusConflict,
+				},
+			}
+			encodeError(w, apiErr)
+			return
+		}
+		slog.ErrorContext(
+			r.Context(),
+			UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"RequestAppointment",
+		)
 		encodeError(w, internalServerError())
 		return
 	}
@@ -310,7 +557,14 @@ func (s Server) CreateResource(w http.ResponseWriter, r *http.Request) {
 
 	resource, err := s.app.CreateResource(r.Context(), req)
 	if err != nil {
-		slog.Error(UnexpectedError, "error", err.Error(), "where", "CreateResource")
+		slog.ErrorContext(
+			r.Context(),
+			UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"CreateResource",
+		)
 		encodeError(w, internalServerError())
 		return
 	}
@@ -330,7 +584,8 @@ func (s Server) PrescriptionDetail(
 			encodeError(w, notFoundId("Prescription", prescriptionId))
 			return
 		}
-		slog.Error(
+		slog.ErrorContext(
+			r.Context(),
 			UnexpectedError,
 			"error",
 			err.Error(),
@@ -343,6 +598,7 @@ func (s Server) PrescriptionDetail(
 		return
 	}
 
+	setETag(w, prescription.Version)
 	encode(w, http.StatusOK, prescription)
 }
 
@@ -383,7 +639,8 @@ func (s Server) ReserveAppointmentResources(
 			return
 		}
 
-		slog.Error(
+		slog.ErrorContext(
+			r.Context(),
 			UnexpectedError,
 			"error",
 			err.Error(),
@@ -404,7 +661,13 @@ func (s Server) UpdateCondition(
 	w http.ResponseWriter,
 	r *http.Request,
 	conditionId api.ConditionId,
+	params api.UpdateConditionParams,
 ) {
+	versions, preconditionErr := ifMatchVersions(params.IfMatch)
+	if preconditionErr != nil {
+		encodeError(w, preconditionErr)
+		return
+	}
 	req, decodeErr := Decode[api.UpdateCondition](w, r)
 	if decodeErr != nil {
 		encodeError(w, decodeErr)
@@ -414,6 +677,7 @@ func (s Server) UpdateCondition(
 	updatedCondition, err := s.app.UpdatePatientCondition(
 		r.Context(),
 		conditionId,
+		versions,
 		req,
 	)
 	if err != nil {
@@ -421,7 +685,12 @@ func (s Server) UpdateCondition(
 			encodeError(w, notFoundId("Condition", conditionId))
 			return
 		}
-		slog.Error(
+		if errors.Is(err, app.ErrVersionMismatch) {
+			encodeError(w, preconditionFailed())
+			return
+		}
+		slog.ErrorContext(
+			r.Context(),
 			UnexpectedError,
 			"error",
 			err.Error(),
@@ -434,6 +703,7 @@ func (s Server) UpdateCondition(
 		return
 	}
 
+	setETag(w, updatedCondition.Version)
 	encode(w, http.StatusOK, updatedCondition)
 }
 
@@ -441,7 +711,13 @@ func (s Server) UpdatePrescription(
 	w http.ResponseWriter,
 	r *http.Request,
 	prescriptionId api.PrescriptionId,
+	params api.UpdatePrescriptionParams,
 ) {
+	versions, preconditionErr := ifMatchVersions(params.IfMatch)
+	if preconditionErr != nil {
+		encodeError(w, preconditionErr)
+		return
+	}
 	req, decodeErr := Decode[api.UpdatePrescription](w, r)
 	if decodeErr != nil {
 		encodeError(w, decodeErr)
@@ -451,6 +727,7 @@ func (s Server) UpdatePrescription(
 	updatedPrescription, err := s.app.UpdatePatientPrescription(
 		r.Context(),
 		prescriptionId,
+		versions,
 		req,
 	)
 	if err != nil {
@@ -458,7 +735,12 @@ func (s Server) UpdatePrescription(
 			encodeError(w, notFoundId("Prescription", prescriptionId))
 			return
 		}
-		slog.Error(
+		if errors.Is(err, app.ErrVersionMismatch) {
+			encodeError(w, preconditionFailed())
+			return
+		}
+		slog.ErrorContext(
+			r.Context(),
 			UnexpectedError,
 			"error",
 			err.Error(),
@@ -471,6 +753,7 @@ func (s Server) UpdatePrescription(
 		return
 	}
 
+	setETag(w, updatedPrescription.Version)
 	encode(w, http.StatusOK, updatedPrescription)
 }
 
@@ -478,7 +761,8 @@ func (s Server) UpdatePrescription(
 func (s Server) GetDoctors(w http.ResponseWriter, r *http.Request) {
 	doctors, err := s.app.GetAllDoctors(r.Context())
 	if err != nil {
-		slog.Error(
+		slog.ErrorContext(
+			r.Context(),
 			UnexpectedError,
 			"error",
 			err.Error(),
@@ -492,6 +776,30 @@ func (s Server) GetDoctors(w http.ResponseWriter, r *http.Request) {
 	encode(w, http.StatusOK, api.Doctors{Doctors: doctors})
 }
 
+// SearchDoctors implements api.ServerInterface.
+func (s Server) SearchDoctors(w http.ResponseWriter, r *http.Request, params api.SearchDoctorsParams) {
+	doctors, err := s.app.SearchDoctors(r.Context(), params)
+	if err != nil {
+		var validationErr *app.ValidationError
+		if errors.As(err, &validationErr) {
+			encodeError(w, &ApiError{ErrorDetail: validationErr.ErrorDetail})
+			return
+		}
+		slog.ErrorContext(
+			r.Context(),
+			UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"SearchDoctors",
+		)
+		encodeError(w, internalServerError())
+		return
+	}
+
+	encode(w, http.StatusOK, doctors)
+}
+
 // ConditionsInDate implements api.ServerInterface.
 func (s Server) ConditionsInDateRange(
 	w http.ResponseWriter,
@@ -511,7 +819,8 @@ func (s Server) ConditionsInDateRange(
 		to,
 	)
 	if err != nil {
-		slog.Error(
+		slog.ErrorContext(
+			r.Context(),
 			UnexpectedError,
 			"error",
 			err.Error(),
@@ -544,7 +853,8 @@ func (s Server) DeletePrescription(
 			return
 		}
 
-		slog.Error(
+		slog.ErrorContext(
+			r.Context(),
 			UnexpectedError,
 			"error",
 			err.Error(),
@@ -578,7 +888,8 @@ func (s Server) PrescriptionsInDateRange(
 		to,
 	)
 	if err != nil {
-		slog.Error(
+		slog.ErrorContext(
+			r.Context(),
 			UnexpectedError,
 			"error",
 			err.Error(),
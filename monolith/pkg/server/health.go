@@ -0,0 +1,117 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/Nesquiko/wac/pkg/data"
+)
+
+const (
+	livenessPath  = "/healthz"
+	readinessPath = "/readyz"
+
+	defaultCheckTimeout = 2 * time.Second
+)
+
+// HealthCheck checks one dependency of the server on readiness.
+type HealthCheck struct {
+	Name string
+	// Timeout limits the check, defaultCheckTimeout if zero.
+	Timeout time.Duration
+	Check   func(ctx context.Context) error
+}
+
+// storageCheck pings the database of the storage driver.
+func storageCheck(driver string, db data.Storage) HealthCheck {
+	return HealthCheck{Name: driver, Check: db.Ping}
+}
+
+const (
+	statusUp   = "up"
+	statusDown = "down"
+
+	statusReady   = "ready"
+	statusUnready = "unready"
+)
+
+type checkReport struct {
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	Error      string `json:"error,omitempty"`
+	DurationMs int64  `json:"durationMs"`
+}
+
+type readinessReport struct {
+	Status string        `json:"status"`
+	Checks []checkReport `json:"checks"`
+}
+
+// ready runs the checks concurrently, each limited by its timeout.
+func ready(ctx context.Context, checks []HealthCheck) readinessReport {
+	reports := make([]checkReport, len(checks))
+	var wg sync.WaitGroup
+	for i, check := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			timeout := check.Timeout
+			if timeout == 0 {
+				timeout = defaultCheckTimeout
+			}
+			checkCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			start := time.Now()
+			err := check.Check(checkCtx)
+			reports[i] = checkReport{
+				Name:       check.Name,
+				Status:     statusUp,
+				DurationMs: time.Since(start).Milliseconds(),
+			}
+			if err != nil {
+				reports[i].Status = statusDown
+				reports[i].Error = err.Error()
+			}
+		}()
+	}
+	wg.Wait()
+
+	report := readinessReport{Status: statusReady, Checks: reports}
+	for _, check := range reports {
+		if check.Status != statusUp {
+			report.Status = statusUnready
+			break
+		}
+	}
+	return report
+}
+
+// health serves the liveness of the server on livenessPath, and its readiness,
+// a report of the checks, on readinessPath. The server is unready if any of
+// the checks fails.
+func health(checks []HealthCheck) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet {
+				next.ServeHTTP(w, r)
+				return
+			}
+			switch r.URL.Path {
+			case livenessPath:
+				encode(w, http.StatusOK, map[string]string{"status": "alive"})
+			case readinessPath:
+				report := ready(r.Context(), checks)
+				status := http.StatusOK
+				if report.Status == statusUnready {
+					status = http.StatusServiceUnavailable
+				}
+				encode(w, status, report)
+			default:
+				next.ServeHTTP(w, r)
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	chi_middleware "github.com/go-chi/chi/v5/middleware"
+
+	"github.com/Nesquiko/wac/pkg/api"
+	"github.com/Nesquiko/wac/pkg/data"
+)
+
+const (
+	// IdempotencyKeyHeader lets clients retry a POST without repeating its
+	// effects, the first response to the key is replayed to the retries.
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader marks a replayed response.
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+	// maxIdempotentBodySize caps the body of a request with an idempotency
+	// key, which is read whole to hash it.
+	maxIdempotentBodySize = 1 << 20
+)
+
+const (
+	IdempotencyInvalidKeyCode   = "idempotency.invalid-key"
+	IdempotencyInProgressCode   = "idempotency.in-progress"
+	IdempotencyKeyReusedCode    = "idempotency.key-reused"
+	IdempotencyBodyTooLargeCode = "idempotency.body-too-large"
+)
+
+// requestHash identifies the request, a key reused for a request with another
+// path or body is rejected.
+func requestHash(r *http.Request, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(r.Method + " " + r.URL.RequestURI() + "\n"))
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// idempotency stores the first response to a POST with an idempotency key,
+// keyed by the principal and the key, for ttl and replays it to the retries.
+// A retry while the first request is in flight is rejected with 409, and a key
+// reused for another request with 422. Server errors aren't stored, so that
+// the request can be retried. If the storage fails, the request is handled as
+// if it had no key.
+//
+// The principal is the IP of the client. Users aren't authenticated, scoping
+// keys by the user a request claims to be made for would let anyone replay the
+// responses of another user.
+func idempotency(
+	db data.Storage,
+	ttl time.Duration,
+	proxies trustedProxies,
+) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(IdempotencyKeyHeader)
+			if r.Method != http.MethodPost || key == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if len(key) > maxIdempotencyKeyLength {
+				encodeError(w, &ApiError{
+					ErrorDetail: api.ErrorDetail{
+						Code:   IdempotencyInvalidKeyCode,
+						Title:  "Bad Request",
+						Detail: "Idempotency key can be at most 255 characters long.",
+						Status: http.StatusBadRequest,
+					},
+				})
+				return
+			}
+
+			body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxIdempotentBodySize))
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				encodeError(w, &ApiError{
+					ErrorDetail: api.ErrorDetail{
+						Code:   IdempotencyBodyTooLargeCode,
+						Title:  "Request Entity Too Large",
+						Detail: "Request with an idempotency key can be at most 1 MiB large.",
+						Status: http.StatusRequestEntityTooLarge,
+					},
+				})
+				return
+			} else if err != nil {
+				encodeError(w, internalServerError())
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			now := time.Now()
+			record := data.IdempotencyRecord{
+				Principal:   proxies.clientIp(r),
+				Key:         key,
+				RequestHash: requestHash(r, body),
+				ExpiresAt:   now.Add(ttl),
+			}
+			existing, err := db.ReserveIdempotencyKey(r.Context(), record, now)
+			if errors.Is(err, data.ErrIdempotencyKeyReserved) {
+				replayIdempotent(w, existing, record.RequestHash)
+				return
+			} else if err != nil {
+				slog.ErrorContext(
+					r.Context(),
+					UnexpectedError,
+					slog.String("error", err.Error()),
+					slog.String("where", "idempotency"),
+				)
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ww := chi_middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			var response bytes.Buffer
+			ww.Tee(&response)
+
+			// the response is stored even if the client is gone, its retry
+			// gets it
+			ctx := context.WithoutCancel(r.Context())
+
+			// a panicking handler releases the key, otherwise the retries
+			// would be rejected as in flight until the key expires
+			defer func() {
+				if p := recover(); p != nil {
+					err := db.ReleaseIdempotencyKey(ctx, record.Principal, record.Key)
+					if err != nil {
+						slog.ErrorContext(
+							r.Context(),
+							UnexpectedError,
+							slog.String("error", err.Error()),
+							slog.String("where", "idempotency"),
+						)
+					}
+					panic(p)
+				}
+			}()
+			next.ServeHTTP(ww, r)
+
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+			if status >= http.StatusInternalServerError {
+				err = db.ReleaseIdempotencyKey(ctx, record.Principal, record.Key)
+			} else {
+				record.Status = status
+				record.ContentType = ww.Header().Get(ContentType)
+				record.Location = ww.Header().Get("Location")
+				record.Body = response.Bytes()
+				err = db.CompleteIdempotencyKey(ctx, record)
+			}
+			if err != nil {
+				slog.ErrorContext(
+					r.Context(),
+					UnexpectedError,
+					slog.String("error", err.Error()),
+					slog.String("where", "idempotency"),
+				)
+			}
+		})
+	}
+}
+
+func replayIdempotent(w http.ResponseWriter, record data.IdempotencyRecord, requestHash string) {
+	switch {
+	case record.RequestHash != requestHash:
+		encodeError(w, &ApiError{
+			ErrorDetail: api.ErrorDetail{
+				Code:   IdempotencyKeyReusedCode,
+				Title:  "Unprocessable Entity",
+				Detail: "Idempotency key was already used for another request.",
+				Status: http.StatusUnprocessableEntity,
+			},
+		})
+	case record.Status == 0:
+		encodeError(w, &ApiError{
+			ErrorDetail: api.ErrorDetail{
+				Code:   IdempotencyInProgressCode,
+				Title:  "Conflict",
+				Detail: "A request with the idempotency key is still being processed.",
+				Status: http.StatusConflict,
+			},
+		})
+	default:
+		if record.ContentType != "" {
+			w.Header().Set(ContentType, record.ContentType)
+		}
+		if record.Location != "" {
+			w.Header().Set("Location", record.Location)
+		}
+		w.Header().Set(IdempotentReplayedHeader, "true")
+		w.WriteHeader(record.Status)
+		if _, err := w.Write(record.Body); err != nil {
+			slog.Error(
+				UnexpectedError,
+				slog.String("where", "replayIdempotent"),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+}
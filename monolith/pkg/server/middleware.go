@@ -10,6 +10,7 @@ import (
 	validation_middleware "github.com/oapi-codegen/nethttp-middleware"
 
 	"github.com/Nesquiko/wac/pkg/api"
+	"github.com/Nesquiko/wac/pkg/app"
 )
 
 type OapiValidationOptions struct {
@@ -17,30 +18,64 @@ type OapiValidationOptions struct {
 	errorHandler func(w http.ResponseWriter, message string, statusCode int)
 }
 
+// middleware returns the api middlewares in the order they run, the api is
+// generated with apply-chi-middleware-first-to-last.
 func middleware(
 	logger *httplog.Logger,
 	opts OapiValidationOptions,
+	limiter *rateLimiter,
+	idempotent func(http.Handler) http.Handler,
 ) []api.MiddlewareFunc {
 	return []api.MiddlewareFunc{
+		traceRoute,
 		chi_middleware.Recoverer,
 		cors.Handler(cors.Options{
 			AllowedOrigins: []string{"*"},
 			AllowedMethods: []string{"GET", "POST", "PATCH", "PUT", "DELETE", "OPTIONS"},
-			AllowedHeaders: []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
+			AllowedHeaders: []string{
+				"Accept",
+				"Authorization",
+				"Content-Type",
+				"X-CSRF-Token",
+				"X-Request-ID",
+				ActorHeader,
+				IdempotencyKeyHeader,
+				IfMatchHeader,
+			},
+			ExposedHeaders: []string{ETagHeader},
 			MaxAge:         300,
 		}),
-		chi_middleware.RealIP,
+		limiter.middleware(encodeRateLimited),
+		idempotent,
 		validation_middleware.OapiRequestValidatorWithOptions(
 			opts.spec,
 			&validation_middleware.Options{ErrorHandler: opts.errorHandler},
 		),
 		httplog.RequestLogger(logger),
 		chi_middleware.AllowContentType(ApplicationJSON),
+		auditMeta,
 	}
 }
 
+// ActorHeader identifies the user on whose behalf the request is made.
+const ActorHeader = "X-User-Id"
+
+// auditMeta passes the actor and the request id to the app, which records
+// them in the audit log.
+func auditMeta(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := app.WithAuditMeta(r.Context(), app.AuditMeta{
+			Actor:     r.Header.Get(ActorHeader),
+			RequestId: chi_middleware.GetReqID(r.Context()),
+		})
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+const heartbeatPath = "/api/monitoring/heartbeat"
+
 func heartbeat() func(http.Handler) http.Handler {
-	return chi_middleware.Heartbeat("/api/monitoring/heartbeat")
+	return chi_middleware.Heartbeat(heartbeatPath)
 }
 
 func optionsMiddleware(next http.Handler) http.Handler {
@@ -49,7 +84,10 @@ func optionsMiddleware(next http.Handler) http.Handler {
 			w.Header().Set("Access-Control-Allow-Origin", "*") // Or specific origins
 			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, PATCH, OPTIONS")
 			w.Header().
-				Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Request-ID")
+				Set(
+					"Access-Control-Allow-Headers",
+					"Content-Type, Authorization, X-Request-ID, X-User-Id, Idempotency-Key, If-Match",
+				)
 				// Add any other headers your frontend sends
 			w.Header().
 				Set("Access-Control-Max-Age", "86400")
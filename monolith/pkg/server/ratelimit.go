@@ -0,0 +1,237 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/Nesquiko/wac/pkg/api"
+	"github.com/Nesquiko/wac/pkg/data"
+)
+
+// rateLimitStore keeps the token buckets of clients. data.Storage shares them
+// between replicas, memoryRateLimitStore keeps them per replica.
+type rateLimitStore interface {
+	TakeRateLimitToken(
+		ctx context.Context,
+		key string,
+		limit data.RateLimit,
+		now time.Time,
+	) (time.Duration, error)
+}
+
+// sweepInterval is how often the memory store drops buckets of idle clients.
+const sweepInterval = time.Minute
+
+type memoryBucket struct {
+	tokens    float64
+	updatedAt time.Time
+	expiresAt time.Time
+}
+
+type memoryRateLimitStore struct {
+	mu      sync.Mutex
+	buckets map[string]memoryBucket
+	sweptAt time.Time
+}
+
+func newMemoryRateLimitStore() *memoryRateLimitStore {
+	return &memoryRateLimitStore{buckets: make(map[string]memoryBucket)}
+}
+
+func (s *memoryRateLimitStore) TakeRateLimitToken(
+	_ context.Context,
+	key string,
+	limit data.RateLimit,
+	now time.Time,
+) (time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if now.Sub(s.sweptAt) >= sweepInterval {
+		for key, bucket := range s.buckets {
+			if bucket.expiresAt.Before(now) {
+				delete(s.buckets, key)
+			}
+		}
+		s.sweptAt = now
+	}
+
+	bucket, ok := s.buckets[key]
+	if !ok {
+		bucket = memoryBucket{tokens: float64(limit.Burst), updatedAt: now}
+	}
+	tokens, retryAfter := limit.Take(bucket.tokens, bucket.updatedAt, now)
+	s.buckets[key] = memoryBucket{
+		tokens:    tokens,
+		updatedAt: now,
+		expiresAt: now.Add(limit.Period),
+	}
+	return retryAfter, nil
+}
+
+// parseRateLimit parses a limit of N requests per period, e.g. `300/1m`.
+func parseRateLimit(limit string) (data.RateLimit, error) {
+	burst, period, ok := strings.Cut(limit, "/")
+	if !ok {
+		return data.RateLimit{}, fmt.Errorf("rate limit %q isn't in the N/period format", limit)
+	}
+	n, err := strconv.Atoi(burst)
+	if err != nil || n < 1 {
+		return data.RateLimit{}, fmt.Errorf("rate limit %q must allow at least 1 request", limit)
+	}
+	d, err := time.ParseDuration(period)
+	if err != nil || d <= 0 {
+		return data.RateLimit{}, fmt.Errorf("rate limit %q must have a positive period", limit)
+	}
+	return data.RateLimit{Burst: n, Period: d}, nil
+}
+
+// defaultRoute keys the buckets of routes without their own limit, a client
+// shares one bucket among all of them.
+const defaultRoute = "*"
+
+// rateLimiter limits the requests of each client per route. A client is the
+// IP of the caller, users aren't authenticated, so the user a request claims
+// to be made for can't key the limit, rotating the claim would lift it.
+type rateLimiter struct {
+	store        rateLimitStore
+	proxies      trustedProxies
+	defaultLimit data.RateLimit
+	// routes are keyed by the method and the route pattern, e.g.
+	// `POST /api/auth/login`.
+	routes map[string]data.RateLimit
+}
+
+// newRateLimiter returns nil if rate limiting is disabled.
+func newRateLimiter(
+	cfg *Config,
+	db data.Storage,
+	proxies trustedProxies,
+) (*rateLimiter, error) {
+	if !cfg.RateLimit.Enabled {
+		return nil, nil
+	}
+
+	defaultLimit, err := parseRateLimit(cfg.RateLimit.Default)
+	if err != nil {
+		return nil, fmt.Errorf("newRateLimiter: default: %w", err)
+	}
+	routes := make(map[string]data.RateLimit, len(cfg.RateLimit.Routes))
+	for _, route := range cfg.RateLimit.Routes {
+		pattern, limit, ok := strings.Cut(route, "=")
+		if !ok {
+			return nil, fmt.Errorf(
+				"newRateLimiter: route %q isn't in the route=limit format",
+				route,
+			)
+		}
+		routes[strings.TrimSpace(pattern)], err = parseRateLimit(strings.TrimSpace(limit))
+		if err != nil {
+			return nil, fmt.Errorf("newRateLimiter: route %q: %w", pattern, err)
+		}
+	}
+
+	var store rateLimitStore
+	switch cfg.RateLimit.Store {
+	case RateLimitStoreMemory:
+		store = newMemoryRateLimitStore()
+	case RateLimitStoreStorage:
+		store = db
+	default:
+		return nil, fmt.Errorf("newRateLimiter: unknown store %q", cfg.RateLimit.Store)
+	}
+
+	return &rateLimiter{
+		store:        store,
+		proxies:      proxies,
+		defaultLimit: defaultLimit,
+		routes:       routes,
+	}, nil
+}
+
+// middleware calls onLimited instead of the next handler when the client ran
+// out of requests. The route pattern must be resolved, so it must run after
+// routing. Every request is limited, whether or not it came through a proxy.
+// If the store fails, the request is let through, the limiter must not take
+// the server down with it.
+func (l *rateLimiter) middleware(
+	onLimited func(w http.ResponseWriter, r *http.Request, retryAfter time.Duration),
+) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if l == nil {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route := r.Method + " " + chi.RouteContext(r.Context()).RoutePattern()
+			limit, ok := l.routes[route]
+			if !ok {
+				route, limit = defaultRoute, l.defaultLimit
+			}
+
+			client := "ip:" + l.proxies.clientIp(r)
+			retryAfter, err := l.store.TakeRateLimitToken(
+				r.Context(),
+				route+"|"+client,
+				limit,
+				time.Now(),
+			)
+			if err != nil {
+				slog.ErrorContext(
+					r.Context(),
+					UnexpectedError,
+					slog.String("error", err.Error()),
+					slog.String("where", "rateLimiter"),
+				)
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if retryAfter > 0 {
+				slog.WarnContext(
+					r.Context(),
+					"rate limit exceeded",
+					slog.String("route", route),
+					slog.String("client", client),
+				)
+				onLimited(w, r, retryAfter)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+const RateLimitExceededCode = "rate-limit.exceeded"
+
+// setRetryAfter sets the Retry-After header in whole seconds, rounded up so
+// that a client waiting for it finds a token.
+func setRetryAfter(w http.ResponseWriter, retryAfter time.Duration) {
+	seconds := int(math.Ceil(retryAfter.Seconds()))
+	w.Header().Set("Retry-After", strconv.Itoa(max(seconds, 1)))
+}
+
+func encodeRateLimited(w http.ResponseWriter, _ *http.Request, retryAfter time.Duration) {
+	setRetryAfter(w, retryAfter)
+	encodeError(w, &ApiError{
+		ErrorDetail: api.ErrorDetail{
+			Code:   RateLimitExceededCode,
+			Title:  "Too Many Requests",
+			Detail: "Too many requests, retry later.",
+			Status: http.StatusTooManyRequests,
+		},
+	})
+}
+
+func encodeRateLimitedOutcome(w http.ResponseWriter, _ *http.Request, retryAfter time.Duration) {
+	setRetryAfter(w, retryAfter)
+	encodeOutcome(w, http.StatusTooManyRequests, "throttled", "Too many requests, retry later.")
+}
@@ -0,0 +1,90 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/Nesquiko/wac/pkg/api"
+	"github.com/Nesquiko/wac/pkg/app"
+)
+
+// ReassignAppointment implements api.ServerInterface.
+func (s Server) ReassignAppointment(
+	w http.ResponseWriter,
+	r *http.Request,
+	appointmentId api.AppointmentId,
+) {
+	req, decodeErr := Decode[api.AppointmentReassignment](w, r)
+	if decodeErr != nil {
+		encodeError(w, decodeErr)
+		return
+	}
+
+	appt, err := s.app.ReassignAppointment(r.Context(), appointmentId, req)
+	if err != nil {
+		encodeReassignmentError(w, err, "ReassignAppointment")
+		return
+	}
+
+	encode(w, http.StatusOK, appt)
+}
+
+// ReassignDoctorAppointments implements api.ServerInterface.
+func (s Server) ReassignDoctorAppointments(
+	w http.ResponseWriter,
+	r *http.Request,
+	doctorId api.DoctorId,
+) {
+	req, decodeErr := Decode[api.DoctorReassignment](w, r)
+	if decodeErr != nil {
+		encodeError(w, decodeErr)
+		return
+	}
+
+	report, err := s.app.ReassignDoctorAppointments(r.Context(), doctorId, req)
+	if err != nil {
+		encodeReassignmentError(w, err, "ReassignDoctorAppointments")
+		return
+	}
+
+	encode(w, http.StatusOK, report)
+}
+
+func encodeReassignmentError(w http.ResponseWriter, err error, where string) {
+	var validationErr *app.ValidationError
+	switch {
+	case errors.As(err, &validationErr):
+		encodeError(w, &ApiError{ErrorDetail: validationErr.ErrorDetail})
+	case errors.Is(err, app.ErrNotFound):
+		encodeError(w, &ApiError{
+			ErrorDetail: api.ErrorDetail{
+				Code:   NotFoundCode,
+				Title:  "Not Found",
+				Detail: "The appointment or one of the doctors doesn't exist",
+				Status: http.StatusNotFound,
+			},
+		})
+	case errors.Is(err, app.ErrDoctorUnavailable):
+		encodeError(w, &ApiError{
+			ErrorDetail: api.ErrorDetail{
+				Code:   "doctor.unavailable",
+				Title:  "Conflict",
+				Detail: "Substitute doctor is unavailable at the appointment's time",
+				Status: http.StatusConflict,
+			},
+		})
+	case errors.Is(err, app.ErrVersionMismatch):
+		encodeError(w, &ApiError{
+			ErrorDetail: api.ErrorDetail{
+				Code:   "appointment.changed",
+				Title:  "Conflict",
+				Detail: "The appointment was changed during the reassignment, retry it",
+				Status: http.StatusConflict,
+			},
+		})
+	default:
+		slog.Error(UnexpectedError, "error", err.Error(), "where", where)
+		encodeError(w, internalServerError())
+	}
+}
@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	"net"
 	"net/http"
@@ -15,6 +16,7 @@ import (
 	"github.com/Nesquiko/wac/pkg/api"
 	"github.com/Nesquiko/wac/pkg/app"
 	"github.com/Nesquiko/wac/pkg/data"
+	"github.com/Nesquiko/wac/pkg/notify"
 )
 
 func Run(ctx context.Context) error {
@@ -29,6 +31,19 @@ func Run(ctx context.Context) error {
 
 	httpLogger := SetupLogger(cfg.Log.Level)
 
+	shutdownTracing, err := setupTracing(ctx, cfg)
+	if err != nil {
+		slog.Error("failed to setup tracing", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	defer func() {
+		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer shutdownCancel()
+		if err := shutdownTracing(shutdownCtx); err != nil {
+			slog.Error("error flushing spans", slog.String("error", err.Error()))
+		}
+	}()
+
 	loc, err := time.LoadLocation(cfg.App.Timezone)
 	if err != nil {
 		slog.Error("failed to load timezone", slog.String("error", err.Error()))
@@ -37,11 +52,21 @@ func Run(ctx context.Context) error {
 	httpLogger.Info("loaded timezone", slog.String("tz", loc.String()))
 	time.Local = loc
 
-	db, err := data.ConnectMongo(ctx, cfg.MongoURI(), cfg.Mongo.Db)
+	db, err := connectStorage(ctx, cfg)
 	if err != nil {
 		slog.Error("failed to connect to database", slog.String("error", err.Error()))
 		os.Exit(1)
 	}
+	httpLogger.Info("connected to database", slog.String("driver", cfg.Storage.Driver))
+
+	sender, err := newNotifySender(cfg)
+	if err != nil {
+		slog.Error("failed to create notification sender", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	dispatcher := notify.NewDispatcher(db, sender, cfg.Notify.PollInterval, cfg.Notify.MaxAttempts)
+	go dispatcher.Run(ctx)
+	httpLogger.Info("started notification dispatcher", slog.String("sender", cfg.Notify.Sender))
 
 	spec, err := api.GetSwagger()
 	if err != nil {
@@ -49,8 +74,54 @@ func Run(ctx context.Context) error {
 		os.Exit(1)
 	}
 
-	app := app.New(db)
-	srv := NewServer(app, spec, httpLogger)
+	monolithApp := app.New(db, app.Options{
+		ReminderOffsets:      cfg.Reminders.Offsets,
+		WaitlistOfferHold:    cfg.Waitlist.OfferHold,
+		AppointmentDurations: cfg.Appointments.Durations,
+		Policy: app.Policy{
+			CancelLeadTime:         cfg.Policies.CancelLeadTime,
+			LateCancellationWindow: cfg.Policies.LateCancellationWindow,
+			RescheduleLeadTime:     cfg.Policies.RescheduleLeadTime,
+			MaxReschedules:         cfg.Policies.MaxReschedules,
+		},
+	})
+	reminders := app.NewReminderScheduler(
+		monolithApp,
+		cfg.Reminders.PollInterval,
+		cfg.Reminders.Lease,
+	)
+	go reminders.Run(ctx)
+	httpLogger.Info(
+		"started reminder scheduler",
+		slog.Any("offsets", cfg.Reminders.Offsets),
+	)
+	waitlist := app.NewWaitlistSweeper(monolithApp, cfg.Waitlist.SweepInterval)
+	go waitlist.Run(ctx)
+	httpLogger.Info(
+		"started waitlist sweeper",
+		slog.Duration("offerHold", cfg.Waitlist.OfferHold),
+	)
+
+	proxies, err := parseTrustedProxies(cfg.App.TrustedProxies)
+	if err != nil {
+		slog.Error("invalid trusted proxies", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	limiter, err := newRateLimiter(cfg, db, proxies)
+	if err != nil {
+		slog.Error("failed to create rate limiter", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+
+	srv := NewServer(
+		monolithApp,
+		spec,
+		httpLogger,
+		cfg.Audit.AdminToken,
+		[]HealthCheck{storageCheck(cfg.Storage.Driver, db)},
+		limiter,
+		idempotency(db, cfg.Idempotency.Ttl, proxies),
+	)
 
 	httpServer := &http.Server{
 		Addr:    net.JoinHostPort(cfg.App.Host, cfg.App.Port),
@@ -80,10 +151,37 @@ func Run(ctx context.Context) error {
 	return nil
 }
 
+func connectStorage(ctx context.Context, cfg *Config) (data.Storage, error) {
+	switch cfg.Storage.Driver {
+	case StorageDriverMongo:
+		return data.ConnectMongo(ctx, cfg.MongoURI(), cfg.Mongo.Db)
+	case StorageDriverPostgres:
+		return data.ConnectPostgres(ctx, cfg.PostgresURI())
+	default:
+		return nil, fmt.Errorf("connectStorage unknown storage driver %q", cfg.Storage.Driver)
+	}
+}
+
+func newNotifySender(cfg *Config) (notify.Sender, error) {
+	switch cfg.Notify.Sender {
+	case NotifySenderLog:
+		return notify.LogSender{}, nil
+	case NotifySenderFile:
+		return notify.NewFileSender(cfg.Notify.File), nil
+	case NotifySenderSmtp:
+		smtp := cfg.Notify.Smtp
+		return notify.NewSmtpSender(smtp.Host, smtp.Port, smtp.User, smtp.Password, smtp.From), nil
+	case NotifySenderWebhook:
+		return notify.NewWebhookSender(cfg.Notify.Webhook.Url), nil
+	default:
+		return nil, fmt.Errorf("newNotifySender unknown sender %q", cfg.Notify.Sender)
+	}
+}
+
 func SetupLogger(logLevel slog.Level) *httplog.Logger {
-	logger := httplog.NewLogger("wac", httplog.Options{
+	logger := httplog.NewLogger(serviceName, httplog.Options{
 		LogLevel: slog.Level(logLevel),
 	})
-	slog.SetDefault(logger.Logger)
+	slog.SetDefault(slog.New(requestIdHandler{logger.Logger.Handler()}))
 	return logger
 }
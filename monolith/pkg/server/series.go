@@ -0,0 +1,99 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/Nesquiko/wac/pkg/api"
+	"github.com/Nesquiko/wac/pkg/app"
+)
+
+// CreateAppointmentSeries implements api.ServerInterface.
+func (s Server) CreateAppointmentSeries(w http.ResponseWriter, r *http.Request) {
+	req, decodeErr := Decode[api.NewAppointmentSeries](w, r)
+	if decodeErr != nil {
+		encodeError(w, decodeErr)
+		return
+	}
+
+	series, err := s.app.CreateAppointmentSeries(r.Context(), req)
+	if err != nil {
+		encodeSeriesError(
+			w,
+			err,
+			"Patient, doctor or condition",
+			req.PatientId.String(),
+			"CreateAppointmentSeries",
+		)
+		return
+	}
+
+	encode(w, http.StatusCreated, series)
+}
+
+// AppointmentSeries implements api.ServerInterface.
+func (s Server) AppointmentSeries(w http.ResponseWriter, r *http.Request, seriesId api.SeriesId) {
+	series, err := s.app.AppointmentSeries(r.Context(), seriesId)
+	if err != nil {
+		encodeSeriesError(w, err, "Appointment series", seriesId.String(), "AppointmentSeries")
+		return
+	}
+
+	encode(w, http.StatusOK, series)
+}
+
+// RescheduleAppointmentSeries implements api.ServerInterface.
+func (s Server) RescheduleAppointmentSeries(
+	w http.ResponseWriter,
+	r *http.Request,
+	seriesId api.SeriesId,
+) {
+	req, decodeErr := Decode[api.SeriesReschedule](w, r)
+	if decodeErr != nil {
+		encodeError(w, decodeErr)
+		return
+	}
+
+	series, err := s.app.RescheduleAppointmentSeries(r.Context(), seriesId, req)
+	if err != nil {
+		encodeSeriesError(w, err, "Appointment series", seriesId.String(), "RescheduleAppointmentSeries")
+		return
+	}
+
+	encode(w, http.StatusOK, series)
+}
+
+// CancelAppointmentSeries implements api.ServerInterface.
+func (s Server) CancelAppointmentSeries(
+	w http.ResponseWriter,
+	r *http.Request,
+	seriesId api.SeriesId,
+) {
+	req, decodeErr := Decode[api.SeriesCancellation](w, r)
+	if decodeErr != nil {
+		encodeError(w, decodeErr)
+		return
+	}
+
+	series, err := s.app.CancelAppointmentSeries(r.Context(), seriesId, req)
+	if err != nil {
+		encodeSeriesError(w, err, "Appointment series", seriesId.String(), "CancelAppointmentSeries")
+		return
+	}
+
+	encode(w, http.StatusOK, series)
+}
+
+func encodeSeriesError(w http.ResponseWriter, err error, resource, id, where string) {
+	var validationErr *app.ValidationError
+	switch {
+	case errors.As(err, &validationErr):
+		encodeError(w, &ApiError{ErrorDetail: validationErr.ErrorDetail})
+	case errors.Is(err, app.ErrNotFound):
+		encodeError(w, notFound(resource, id))
+	default:
+		slog.Error(UnexpectedError, "error", err.Error(), "where", where)
+		encodeError(w, internalServerError())
+	}
+}
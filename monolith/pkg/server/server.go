@@ -27,7 +27,8 @@ const (
 )
 
 type Server struct {
-	app app.MonolithApp
+	app             app.MonolithApp
+	auditAdminToken string
 }
 
 type ApiError struct {
@@ -42,42 +43,54 @@ func NewServer(
 	app app.MonolithApp,
 	spec *openapi3.T,
 	middlewareLogger *httplog.Logger,
+	auditAdminToken string,
+	healthChecks []HealthCheck,
+	limiter *rateLimiter,
+	idempotent func(http.Handler) http.Handler,
 ) http.Handler {
 	r := chi.NewMux()
 	r.Use(heartbeat())
+	r.Use(health(healthChecks))
+	r.Use(metrics())
+	r.Use(requestId)
+	r.Use(observeRequest)
 	r.Use(optionsMiddleware)
-	srv := Server{app: app}
+	srv := Server{app: app, auditAdminToken: auditAdminToken}
+	r.Mount(FhirBaseUrl, fhirRouter(srv, middlewareLogger, limiter))
 
 	validationOpts := OapiValidationOptions{
 		spec:         spec,
 		errorHandler: validationErrorHandler,
 	}
 
-	return api.HandlerWithOptions(srv, api.ChiServerOptions{
+	handler := api.HandlerWithOptions(srv, api.ChiServerOptions{
 		BaseURL:     "/api",
 		BaseRouter:  r,
-		Middlewares: middleware(middlewareLogger, validationOpts),
+		Middlewares: middleware(middlewareLogger, validationOpts, limiter, idempotent),
 		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
 			var invalidParamErr *api.InvalidParamFormatError
 			var requiredParamError *api.RequiredParamError
 
 			switch {
 			case errors.As(err, &invalidParamErr):
-				slog.Warn(
+				slog.WarnContext(
+					r.Context(),
 					"invalid path param",
 					slog.String("error", err.Error()),
 					slog.String("where", "ErrorHandlerFunc"),
 				)
 				encodeError(w, fromInvalidParamErr(invalidParamErr, chi.URLParam(r, "id")))
 			case errors.As(err, &requiredParamError):
-				slog.Warn(
+				slog.WarnContext(
+					r.Context(),
 					"missing required path param",
 					slog.String("error", err.Error()),
 					slog.String("where", "ErrorHandlerFunc"),
 				)
 				encodeError(w, fromRequiredParamErr(requiredParamError))
 			default:
-				slog.Error(
+				slog.ErrorContext(
+					r.Context(),
 					"unexpected error handling in ErrorHandlerFunc",
 					slog.String("error", err.Error()),
 				)
@@ -85,6 +98,7 @@ func NewServer(
 			}
 		},
 	})
+	return traceHandler(handler)
 }
 
 func validationErrorHandler(w http.ResponseWriter, message string, statusCode int) {
@@ -256,3 +270,13 @@ func fromRequiredParamErr(e *api.RequiredParamError) *ApiError {
 		},
 	}
 }
+
+// externalUrl returns the absolute URL of path on this server, as seen by
+// the client.
+func externalUrl(r *http.Request, path string) string {
+	scheme := "http"
+	if r.TLS != nil || strings.EqualFold(r.Header.Get("X-Forwarded-Proto"), "https") {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host + path
+}
@@ -0,0 +1,146 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/Nesquiko/wac/pkg/api"
+	"github.com/Nesquiko/wac/pkg/app"
+)
+
+// JoinWaitlist implements api.ServerInterface.
+func (s Server) JoinWaitlist(w http.ResponseWriter, r *http.Request, doctorId api.DoctorId) {
+	req, decodeErr := Decode[api.NewWaitlistEntry](w, r)
+	if decodeErr != nil {
+		encodeError(w, decodeErr)
+		return
+	}
+
+	entry, err := s.app.JoinWaitlist(r.Context(), doctorId, req)
+	if errors.Is(err, app.ErrNotFound) {
+		encodeError(w, notFound("Doctor or patient", doctorId.String()))
+		return
+	} else if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"JoinWaitlist",
+		)
+		encodeError(w, internalServerError())
+		return
+	}
+
+	encode(w, http.StatusCreated, entry)
+}
+
+// PatientsWaitlist implements api.ServerInterface.
+func (s Server) PatientsWaitlist(w http.ResponseWriter, r *http.Request, patientId api.PatientId) {
+	entries, err := s.app.PatientsWaitlist(r.Context(), patientId)
+	if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"PatientsWaitlist",
+		)
+		encodeError(w, internalServerError())
+		return
+	}
+
+	encode(w, http.StatusOK, entries)
+}
+
+// LeaveWaitlist implements api.ServerInterface.
+func (s Server) LeaveWaitlist(
+	w http.ResponseWriter,
+	r *http.Request,
+	waitlistEntryId api.WaitlistEntryId,
+) {
+	err := s.app.LeaveWaitlist(r.Context(), waitlistEntryId)
+	if errors.Is(err, app.ErrNotFound) {
+		encodeError(w, notFoundId("Waitlist entry", waitlistEntryId))
+		return
+	} else if err != nil {
+		slog.ErrorContext(
+			r.Context(),
+			UnexpectedError,
+			"error",
+			err.Error(),
+			"where",
+			"LeaveWaitlist",
+		)
+		encodeError(w, internalServerError())
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// AcceptWaitlistOffer implements api.ServerInterface.
+func (s Server) AcceptWaitlistOffer(
+	w http.ResponseWriter,
+	r *http.Request,
+	waitlistEntryId api.WaitlistEntryId,
+) {
+	appt, err := s.app.AcceptWaitlistOffer(r.Context(), waitlistEntryId)
+	if err != nil {
+		s.encodeWaitlistOfferError(w, err, waitlistEntryId, "AcceptWaitlistOffer")
+		return
+	}
+
+	encode(w, http.StatusCreated, appt)
+}
+
+// DeclineWaitlistOffer implements api.ServerInterface.
+func (s Server) DeclineWaitlistOffer(
+	w http.ResponseWriter,
+	r *http.Request,
+	waitlistEntryId api.WaitlistEntryId,
+) {
+	err := s.app.DeclineWaitlistOffer(r.Context(), waitlistEntryId)
+	if err != nil {
+		s.encodeWaitlistOfferError(w, err, waitlistEntryId, "DeclineWaitlistOffer")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s Server) encodeWaitlistOfferError(
+	w http.ResponseWriter,
+	err error,
+	waitlistEntryId api.WaitlistEntryId,
+	where string,
+) {
+	switch {
+	case errors.Is(err, app.ErrNotFound):
+		encodeError(w, notFoundId("Waitlist entry", waitlistEntryId))
+	case errors.Is(err, app.ErrNoWaitlistOffer):
+		encodeError(w, &ApiError{
+			ErrorDetail: api.ErrorDetail{
+				Code:   "waitlist.no.offer",
+				Title:  "Conflict",
+				Detail: "No slot is held for the waitlist entry, or its offer expired",
+				Status: http.StatusConflict,
+			},
+		})
+	case errors.Is(err, app.ErrDoctorUnavailable):
+		encodeError(w, &ApiError{
+			ErrorDetail: api.ErrorDetail{
+				Code:   "doctor.unavailable",
+				Title:  "Conflict",
+				Detail: "The offered slot was already booked",
+				Status: http.StatusConflict,
+			},
+		})
+	default:
+		slog.Error(UnexpectedError, "error", err.Error(), "where", where)
+		encodeError(w, internalServerError())
+	}
+}
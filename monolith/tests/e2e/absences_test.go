@@ -0,0 +1,126 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/test-go/testify/require"
+
+	"github.com/Nesquiko/wac/pkg/api"
+	"github.com/Nesquiko/wac/pkg/server"
+)
+
+func TestAbsences_FlagBookedAndBlockNewAppointments(t *testing.T) {
+	t.Parallel()
+
+	loc, err := time.LoadLocation(server.TzDefault)
+	require.NoError(t, err, "Failed to load server timezone")
+	day := time.Now().In(loc).AddDate(0, 0, 12)
+	start := time.Date(day.Year(), day.Month(), day.Day(), 9, 0, 0, 0, loc)
+
+	doctorEmail := fmt.Sprintf("test.absences.%s@doctor.com", uuid.NewString())
+	doctor := mustCreateDoctor(t, newDoctor(doctorEmail))
+	patientEmail := fmt.Sprintf("test.absences.%s@patient.com", uuid.NewString())
+	patient := mustCreatePatient(t, newPatient(patientEmail))
+
+	res := postAppointment(t, api.NewAppointmentRequest{
+		PatientId:           patient.Id,
+		DoctorId:            doctor.Id,
+		AppointmentDateTime: start,
+		DurationMinutes:     asPtr(60),
+	})
+	defer res.Body.Close()
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+	var booked api.Appointment
+	err = json.NewDecoder(res.Body).Decode(&booked)
+	require.NoError(t, err, "Failed to decode created appointment")
+
+	absence := mustCreateAbsence(t, doctor.Id, api.NewAbsence{
+		Type:  api.Vacation,
+		Start: start.Add(-time.Hour),
+		End:   start.Add(8 * time.Hour),
+	})
+	require.NotNil(t, absence.FlaggedAppointments)
+	assert.Equal(t, []uuid.UUID{booked.Id}, *absence.FlaggedAppointments)
+
+	res = postAppointment(t, api.NewAppointmentRequest{
+		PatientId:           patient.Id,
+		DoctorId:            doctor.Id,
+		AppointmentDateTime: start.Add(2 * time.Hour),
+		DurationMinutes:     asPtr(30),
+	})
+	res.Body.Close()
+	assert.Equal(t, http.StatusConflict, res.StatusCode, "Appointment booked during absence")
+
+	req, err := http.NewRequest(
+		http.MethodDelete,
+		fmt.Sprintf("%s/absences/%s", ServerUrl, absence.Id),
+		nil,
+	)
+	require.NoError(t, err, "Failed to create DeleteAbsence request")
+	res, err = http.DefaultClient.Do(req)
+	require.NoError(t, err, "DeleteAbsence request failed")
+	res.Body.Close()
+	require.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	res = postAppointment(t, api.NewAppointmentRequest{
+		PatientId:           patient.Id,
+		DoctorId:            doctor.Id,
+		AppointmentDateTime: start.Add(2 * time.Hour),
+		DurationMinutes:     asPtr(30),
+	})
+	res.Body.Close()
+	assert.Equal(t, http.StatusCreated, res.StatusCode)
+}
+
+func TestAbsences_EndBeforeStart(t *testing.T) {
+	t.Parallel()
+
+	doctorEmail := fmt.Sprintf("test.absences.invalid.%s@doctor.com", uuid.NewString())
+	doctor := mustCreateDoctor(t, newDoctor(doctorEmail))
+
+	start := time.Now().Add(48 * time.Hour)
+	body, err := json.Marshal(api.NewAbsence{
+		Type:  api.SickLeave,
+		Start: start,
+		End:   start.Add(-time.Hour),
+	})
+	require.NoError(t, err, "Failed to marshal absence request body")
+	res, err := http.Post(
+		fmt.Sprintf("%s/doctors/%s/absences", ServerUrl, doctor.Id),
+		server.ApplicationJSON,
+		bytes.NewBuffer(body),
+	)
+	require.NoError(t, err, "CreateAbsence request failed")
+	res.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func mustCreateAbsence(t *testing.T, doctorId uuid.UUID, absence api.NewAbsence) api.Absence {
+	t.Helper()
+	require := require.New(t)
+
+	body, err := json.Marshal(absence)
+	require.NoError(err, "mustCreateAbsence: Failed to marshal request")
+	res, err := http.Post(
+		fmt.Sprintf("%s/doctors/%s/absences", ServerUrl, doctorId),
+		server.ApplicationJSON,
+		bytes.NewBuffer(body),
+	)
+	require.NoError(err, "mustCreateAbsence: http.Post failed")
+	defer res.Body.Close()
+	require.Equal(http.StatusCreated, res.StatusCode, "mustCreateAbsence: Expected '201 Created'")
+
+	var created api.Absence
+	err = json.NewDecoder(res.Body).Decode(&created)
+	require.NoError(err, "mustCreateAbsence: Failed to decode response")
+	return created
+}
@@ -35,8 +35,8 @@ func TestRescheduleAppointment(t *testing.T) {
 		DoctorId:            doctor.Id,
 		AppointmentDateTime: appointmentTime,
 	}
-	createdAppointment := mustCreateAppointment(t, newAppointmentReq)
-	appointmentId := *createdAppointment.Id
+	createdAppointment := mustPostAppointment(t, newAppointmentReq)
+	appointmentId := createdAppointment.Id
 
 	newDateTime := appointmentTime.Add(2 * 24 * time.Hour)
 	rescheduleReq := api.AppointmentReschedule{
@@ -49,6 +49,10 @@ func TestRescheduleAppointment(t *testing.T) {
 	req, err := http.NewRequest(http.MethodPatch, url, bytes.NewBuffer(rescheduleReqBody))
 	require.NoError(t, err, "Failed to create HTTP PATCH request")
 	req.Header.Set("Content-Type", server.ApplicationJSON)
+	req.Header.Set(
+		server.IfMatchHeader,
+		mustETag(t, fmt.Sprintf("/appointments/%s", appointmentId)),
+	)
 
 	res, err := http.DefaultClient.Do(req)
 	require.NoError(t, err, "http.Patch failed for RescheduleAppointment")
@@ -56,12 +60,12 @@ func TestRescheduleAppointment(t *testing.T) {
 
 	require.Equal(t, http.StatusOK, res.StatusCode, "Expected '200 OK' status code")
 
-	var fetchedAppointment api.PatientAppointment
+	var fetchedAppointment api.Appointment
 	err = json.NewDecoder(res.Body).Decode(&fetchedAppointment)
 	require.NoError(t, err, "Failed to decode fetched appointment")
 
 	assert := assert.New(t)
-	assert.Equal(appointmentId, *fetchedAppointment.Id, "Appointment ID mismatch")
+	assert.Equal(appointmentId, fetchedAppointment.Id, "Appointment ID mismatch")
 	assert.True(
 		newDateTime.Equal(fetchedAppointment.AppointmentDateTime),
 		"Appointment date time mismatch",
@@ -99,10 +103,10 @@ func TestDoctorsTimeslots(t *testing.T) {
 		DoctorId:            doctor.Id,
 		AppointmentDateTime: appointmentTime,
 	}
-	mustCreateAppointment(t, newAppointmentReq)
+	mustPostAppointment(t, newAppointmentReq)
 
 	url := fmt.Sprintf(
-		"%s/doctors/%s/timeslots?date=%s",
+		"%s/timeslots/%s?date=%s",
 		ServerUrl,
 		doctor.Id,
 		netUrl.QueryEscape(date.Format("2006-01-02")),
@@ -159,9 +163,9 @@ func TestPatientsCalendar(t *testing.T) {
 			DoctorId:            doctor.Id,
 			AppointmentDateTime: appointmentTime,
 		}
-		createdAppointment := mustCreateAppointment(t, newAppointmentReq)
-		appointmentIds[*createdAppointment.Id] = true
-		appointmentTimes[*createdAppointment.Id] = appointmentTime
+		createdAppointment := mustPostAppointment(t, newAppointmentReq)
+		appointmentIds[createdAppointment.Id] = true
+		appointmentTimes[createdAppointment.Id] = appointmentTime
 	}
 
 	conditionIds := make(map[uuid.UUID]bool)
@@ -197,23 +201,17 @@ func TestPatientsCalendar(t *testing.T) {
 	fromDate := startDate.AddDate(0, 0, 3)
 	toDate := startDate.AddDate(0, 0, 6)
 
-	url := fmt.Sprintf(
-		"%s/patients/%s/calendar?from=%s&to=%s",
-		ServerUrl,
-		patient.Id,
+	query := fmt.Sprintf(
+		"from=%s&to=%s",
 		netUrl.QueryEscape(fromDate.Format("2006-01-02")),
 		netUrl.QueryEscape(toDate.Format("2006-01-02")),
 	)
-
-	res, err := http.Get(url)
-	require.NoError(t, err, "http.Get failed for PatientsCalendar")
-	defer res.Body.Close()
-
-	require.Equal(t, http.StatusOK, res.StatusCode, "Expected '200 OK' status code")
-
-	var patientCalendar api.PatientsCalendar
-	err = json.NewDecoder(res.Body).Decode(&patientCalendar)
-	require.NoError(t, err, "Failed to decode patient calendar")
+	var patientCalendar api.Appointments
+	mustGetJSON(t, fmt.Sprintf("/appointments/patient/%s?%s", patient.Id, query), &patientCalendar)
+	var conditions api.Conditions
+	mustGetJSON(t, fmt.Sprintf("/conditions/patient/%s?%s", patient.Id, query), &conditions)
+	var prescriptions api.Prescriptions
+	mustGetJSON(t, fmt.Sprintf("/prescriptions/patient/%s?%s", patient.Id, query), &prescriptions)
 
 	assert := assert.New(t)
 	assert.NotNil(patientCalendar.Appointments)
@@ -233,10 +231,9 @@ func TestPatientsCalendar(t *testing.T) {
 		)
 	}
 
-	assert.NotNil(patientCalendar.Conditions)
-	assert.Len(*patientCalendar.Conditions, 7)
+	assert.Len(conditions.Conditions, 7)
 
-	for _, cond := range *patientCalendar.Conditions {
+	for _, cond := range conditions.Conditions {
 		assert.True(conditionIds[*cond.Id], "Unexpected condition ID")
 		assert.True(
 			conditionStartDates[*cond.Id].Equal(cond.Start),
@@ -249,10 +246,9 @@ func TestPatientsCalendar(t *testing.T) {
 
 	}
 
-	assert.NotNil(patientCalendar.Prescriptions)
-	assert.Len(*patientCalendar.Prescriptions, 7)
+	assert.Len(prescriptions.Prescriptions, 7)
 
-	for _, presc := range *patientCalendar.Prescriptions {
+	for _, presc := range prescriptions.Prescriptions {
 		assert.True(prescriptionIds[*presc.Id], "Unexpected prescription ID")
 		assert.True(prescriptionDates[*presc.Id].Equal(presc.Start), "Prescription date mismatch")
 		assert.True(
@@ -281,16 +277,16 @@ func TestDoctorsCalendar(t *testing.T) {
 			DoctorId:            doctor.Id,
 			AppointmentDateTime: appointmentTime,
 		}
-		createdAppointment := mustCreateAppointment(t, newAppointmentReq)
-		appointmentIds[*createdAppointment.Id] = true
-		appointmentTimes[*createdAppointment.Id] = appointmentTime
+		createdAppointment := mustPostAppointment(t, newAppointmentReq)
+		appointmentIds[createdAppointment.Id] = true
+		appointmentTimes[createdAppointment.Id] = appointmentTime
 	}
 
 	fromDate := startDate.Add(3 * 24 * time.Hour)
 	toDate := startDate.Add(6 * 24 * time.Hour)
 
 	url := fmt.Sprintf(
-		"%s/doctors/%s/calendar?from=%s&to=%s",
+		"%s/appointments/doctor/%s?from=%s&to=%s",
 		ServerUrl,
 		doctor.Id,
 		netUrl.QueryEscape(fromDate.Format("2006-01-02")),
@@ -303,7 +299,7 @@ func TestDoctorsCalendar(t *testing.T) {
 
 	require.Equal(t, http.StatusOK, res.StatusCode, "Expected '200 OK' status code")
 
-	var doctorCalendar api.DoctorCalendar
+	var doctorCalendar api.Appointments
 	err = json.NewDecoder(res.Body).Decode(&doctorCalendar)
 	require.NoError(t, err, "Failed to decode doctor calendar")
 
@@ -342,21 +338,16 @@ func TestDecideAppointmentApprove(t *testing.T) {
 		AppointmentDateTime: appointmentTime,
 		Type:                asPtr(api.RegularCheck),
 	}
-	createdAppointment := mustCreateAppointment(t, newAppointmentReq)
-	appointmentId := *createdAppointment.Id
+	createdAppointment := mustPostAppointment(t, newAppointmentReq)
+	appointmentId := createdAppointment.Id
 
 	resourceName := "Test Resource"
 	resourceType := api.ResourceTypeEquipment
 	resource := mustCreateResource(t, api.NewResource{Name: resourceName, Type: resourceType})
 
 	decision := api.AppointmentDecision{
-		Action: api.Accept,
-		Equipment: &[]api.Equipment{
-			{
-				Id:   *resource.Id,
-				Name: resource.Name,
-			},
-		},
+		Action:    api.Accept,
+		Equipment: resource.Id,
 	}
 	decisionReqBody, err := json.Marshal(decision)
 	require.NoError(t, err, "Failed to marshal decision request body")
@@ -365,6 +356,10 @@ func TestDecideAppointmentApprove(t *testing.T) {
 	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(decisionReqBody))
 	require.NoError(t, err, "Failed to create HTTP POST request")
 	req.Header.Set("Content-Type", server.ApplicationJSON)
+	req.Header.Set(
+		server.IfMatchHeader,
+		mustETag(t, fmt.Sprintf("/appointments/%s", appointmentId)),
+	)
 
 	res, err := http.DefaultClient.Do(req)
 	require.NoError(t, err, "http.Post failed for DecideAppointment")
@@ -372,7 +367,7 @@ func TestDecideAppointmentApprove(t *testing.T) {
 
 	require.Equal(t, http.StatusOK, res.StatusCode, "Expected '200 OK' status code")
 
-	var fetchedAppointment api.DoctorAppointment
+	var fetchedAppointment api.Appointment
 	err = json.NewDecoder(res.Body).Decode(&fetchedAppointment)
 	require.NoError(t, err, "Failed to decode fetched appointment")
 
@@ -404,8 +399,8 @@ func TestDecideAppointmentReject(t *testing.T) {
 		AppointmentDateTime: appointmentTime,
 		Type:                asPtr(api.RegularCheck),
 	}
-	createdAppointment := mustCreateAppointment(t, newAppointmentReq)
-	appointmentId := *createdAppointment.Id
+	createdAppointment := mustPostAppointment(t, newAppointmentReq)
+	appointmentId := createdAppointment.Id
 
 	rejectionReason := "Test rejection reason"
 	decision := api.AppointmentDecision{
@@ -419,6 +414,10 @@ func TestDecideAppointmentReject(t *testing.T) {
 	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(decisionReqBody))
 	require.NoError(t, err, "Failed to create HTTP POST request")
 	req.Header.Set("Content-Type", server.ApplicationJSON)
+	req.Header.Set(
+		server.IfMatchHeader,
+		mustETag(t, fmt.Sprintf("/appointments/%s", appointmentId)),
+	)
 
 	res, err := http.DefaultClient.Do(req)
 	require.NoError(t, err, "http.Post failed for DecideAppointment")
@@ -426,13 +425,13 @@ func TestDecideAppointmentReject(t *testing.T) {
 
 	require.Equal(t, http.StatusOK, res.StatusCode, "Expected '200 OK' status code")
 
-	var fetchedAppointment api.DoctorAppointment
+	var fetchedAppointment api.Appointment
 	err = json.NewDecoder(res.Body).Decode(&fetchedAppointment)
 	require.NoError(t, err, "Failed to decode fetched appointment")
 
 	assert := assert.New(t)
 	assert.Equal(api.Denied, fetchedAppointment.Status, "Appointment status should be 'denied'")
-	assert.Equal(rejectionReason, *fetchedAppointment.Reason, "Rejection reason mismatch")
+	assert.Equal(rejectionReason, *fetchedAppointment.DenialReason, "Rejection reason mismatch")
 }
 
 func TestDoctorsAppointmentById(t *testing.T) {
@@ -451,30 +450,29 @@ func TestDoctorsAppointmentById(t *testing.T) {
 		AppointmentDateTime: appointmentTime,
 		Type:                asPtr(api.RegularCheck),
 	}
-	createdAppointment := mustCreateAppointment(t, newAppointmentReq)
-	appointmentId := *createdAppointment.Id
+	createdAppointment := mustPostAppointment(t, newAppointmentReq)
+	appointmentId := createdAppointment.Id
 
 	resourceName := "Test Resource"
 	resourceType := api.ResourceTypeEquipment
 	resource := mustCreateResource(t, api.NewResource{Name: resourceName, Type: resourceType})
 	mustCreateReservation(
 		t,
-		api.ResourceReservation{
-			AppointmentId: appointmentId,
-			Start:         appointmentTime,
-			End:           appointmentTime.Add(time.Hour),
+		appointmentId,
+		api.ReserveAppointmentResourcesJSONRequestBody{
+			Start:       appointmentTime,
+			EquipmentId: resource.Id,
 		},
-		*resource.Id,
 	)
 
-	url := fmt.Sprintf("%s/doctors/%s/appointment/%s", ServerUrl, doctor.Id, appointmentId)
+	url := fmt.Sprintf("%s/appointments/%s", ServerUrl, appointmentId)
 	res, err := http.Get(url)
 	require.NoError(t, err, "http.Get failed for DoctorsAppointmentById")
 	defer res.Body.Close()
 
 	require.Equal(t, http.StatusOK, res.StatusCode, "Expected '200 OK' status code")
 
-	var fetchedAppointment api.DoctorAppointment
+	var fetchedAppointment api.Appointment
 	err = json.NewDecoder(res.Body).Decode(&fetchedAppointment)
 	require.NoError(t, err, "Failed to decode fetched appointment")
 
@@ -488,7 +486,7 @@ func TestDoctorsAppointmentById(t *testing.T) {
 		"Cancellation reason should be nil for a new appointment",
 	)
 	assert.Equal(patient.Id, fetchedAppointment.Patient.Id, "Patient ID mismatch")
-	assert.Equal(appointmentId, *fetchedAppointment.Id, "Appointment ID mismatch")
+	assert.Equal(appointmentId, fetchedAppointment.Id, "Appointment ID mismatch")
 	assert.Equal(
 		newAppointmentReq.Reason,
 		fetchedAppointment.Reason,
@@ -523,17 +521,17 @@ func TestPatientsAppointmentById(t *testing.T) {
 		AppointmentDateTime: appointmentTime,
 		Type:                asPtr(api.RegularCheck),
 	}
-	createdAppointment := mustCreateAppointment(t, newAppointmentReq)
-	appointmentId := *createdAppointment.Id
+	createdAppointment := mustPostAppointment(t, newAppointmentReq)
+	appointmentId := createdAppointment.Id
 
-	url := fmt.Sprintf("%s/patients/%s/appointment/%s", ServerUrl, patient.Id, appointmentId)
+	url := fmt.Sprintf("%s/appointments/%s", ServerUrl, appointmentId)
 	res, err := http.Get(url)
 	require.NoError(t, err, "http.Get failed for PatientsAppointmentById")
 	defer res.Body.Close()
 
 	require.Equal(t, http.StatusOK, res.StatusCode, "Expected '200 OK' status code")
 
-	var fetchedAppointment api.PatientAppointment
+	var fetchedAppointment api.Appointment
 	err = json.NewDecoder(res.Body).Decode(&fetchedAppointment)
 	require.NoError(t, err, "Failed to decode fetched appointment")
 
@@ -548,7 +546,7 @@ func TestPatientsAppointmentById(t *testing.T) {
 	)
 	assert.Nil(fetchedAppointment.Condition, "Condition should be nil if not provided")
 	assert.Equal(doctor.Id, fetchedAppointment.Doctor.Id, "Doctor ID mismatch")
-	assert.Equal(appointmentId, *fetchedAppointment.Id, "Appointment ID mismatch")
+	assert.Equal(appointmentId, fetchedAppointment.Id, "Appointment ID mismatch")
 	assert.Equal(
 		newAppointmentReq.Reason,
 		fetchedAppointment.Reason,
@@ -577,8 +575,8 @@ func TestCancelAppointment(t *testing.T) {
 		DoctorId:            doctor.Id,
 		AppointmentDateTime: appointmentTime,
 	}
-	createdAppointment := mustCreateAppointment(t, newAppointmentReq)
-	appointmentId := *createdAppointment.Id
+	createdAppointment := mustPostAppointment(t, newAppointmentReq)
+	appointmentId := createdAppointment.Id
 
 	cancellationReason := "Test cancellation reason"
 	cancellationReqBody, err := json.Marshal(
@@ -597,7 +595,7 @@ func TestCancelAppointment(t *testing.T) {
 
 	require.Equal(t, http.StatusNoContent, res.StatusCode, "Expected '204 No Content' status code")
 
-	getUrl := fmt.Sprintf("%s/patients/%s/appointment/%s", ServerUrl, patient.Id, appointmentId)
+	getUrl := fmt.Sprintf("%s/appointments/%s", ServerUrl, appointmentId)
 	getRes, err := http.Get(getUrl)
 	require.NoError(t, err, "Failed to fetch appointment after cancellation")
 	defer getRes.Body.Close()
@@ -609,7 +607,7 @@ func TestCancelAppointment(t *testing.T) {
 		"Expected '200 OK' status code for fetched appointment",
 	)
 
-	var fetchedAppointment api.PatientAppointment
+	var fetchedAppointment api.Appointment
 	err = json.NewDecoder(getRes.Body).Decode(&fetchedAppointment)
 	require.NoError(t, err, "Failed to decode fetched appointment")
 
@@ -627,38 +625,6 @@ func TestCancelAppointment(t *testing.T) {
 	)
 }
 
-func mustCreateAppointment(t *testing.T, request api.NewAppointmentRequest) api.PatientAppointment {
-	t.Helper()
-	require := require.New(t)
-
-	reqBodyBytes, err := json.Marshal(request)
-	require.NoError(err, "mustCreateAppointment: Failed to marshal request")
-
-	url := fmt.Sprintf("%s/appointments", ServerUrl)
-	res, err := http.Post(url, server.ApplicationJSON, bytes.NewBuffer(reqBodyBytes))
-	require.NoError(err, "mustCreateAppointment: http.Post failed")
-	defer res.Body.Close()
-
-	bodyBytes, readErr := io.ReadAll(res.Body)
-	require.NoError(readErr, "mustCreateAppointment: Failed to read response body")
-	res.Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
-
-	require.Equal(
-		http.StatusCreated,
-		res.StatusCode,
-		"mustCreateAppointment: Expected '201 Created'. Body: %s",
-		string(bodyBytes),
-	)
-
-	var createdAppointment api.PatientAppointment
-	err = json.NewDecoder(res.Body).Decode(&createdAppointment)
-	require.NoError(err, "mustCreateAppointment: Failed to decode response")
-	require.NotNil(createdAppointment.Id, "mustCreateAppointment: Response ID is nil")
-	require.NotEmpty(*createdAppointment.Id, "mustCreateAppointment: Response ID is empty UUID")
-
-	return createdAppointment
-}
-
 func mustCreatePrescription(t *testing.T, request api.NewPrescription) api.PrescriptionDisplay {
 	t.Helper()
 	require := require.New(t)
@@ -690,3 +656,15 @@ func mustCreatePrescription(t *testing.T, request api.NewPrescription) api.Presc
 
 	return createdPrescription
 }
+
+// mustGetJSON reads the resource at path into dst.
+func mustGetJSON(t *testing.T, path string, dst any) {
+	t.Helper()
+
+	res, err := http.Get(ServerUrl + path)
+	require.NoError(t, err, "mustGetJSON: http.Get failed")
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode, "mustGetJSON: Expected '200 OK'")
+	err = json.NewDecoder(res.Body).Decode(dst)
+	require.NoError(t, err, "mustGetJSON: Failed to decode response")
+}
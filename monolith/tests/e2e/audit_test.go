@@ -0,0 +1,122 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/test-go/testify/require"
+
+	"github.com/Nesquiko/wac/pkg/api"
+	"github.com/Nesquiko/wac/pkg/server"
+)
+
+func TestAuditConditionUpdate(t *testing.T) {
+	t.Parallel()
+
+	patientEmail := fmt.Sprintf("test.patient.audit.%s@example.com", uuid.NewString())
+	createdPatient := mustCreatePatient(t, newPatient(patientEmail))
+	condition := mustCreateCondition(t, api.NewCondition{
+		PatientId: createdPatient.Id,
+		Name:      "Migraine",
+		Start:     time.Now().Truncate(time.Second),
+	})
+	actor := uuid.NewString()
+
+	req, err := http.NewRequest(
+		http.MethodPatch,
+		fmt.Sprintf("%s/conditions/%s", ServerUrl, *condition.Id),
+		bytes.NewBufferString(`{"name": "Chronic migraine"}`),
+	)
+	require.NoError(t, err, "Failed to create UpdateCondition request")
+	req.Header.Set(server.ContentType, server.ApplicationJSON)
+	req.Header.Set(server.ActorHeader, actor)
+	req.Header.Set(server.IfMatchHeader, mustETag(t, fmt.Sprintf("/conditions/%s", *condition.Id)))
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err, "UpdateCondition request failed")
+	res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	events := mustAuditEvents(t, fmt.Sprintf("targetId=%s", *condition.Id))
+	require.Len(t, events, 1, "Expected exactly one audit event for the condition")
+
+	event := events[0]
+	assert := assert.New(t)
+	assert.Equal(actor, event.Actor)
+	assert.Equal("condition.update", event.Action)
+	assert.Equal(*condition.Id, event.TargetId)
+	assert.NotNil(event.RequestId)
+	assert.NotEmpty(event.Hash)
+	assert.True(event.HashValid, "Stored hash should match the event")
+	require.NotNil(t, event.Diff)
+	require.Contains(t, *event.Diff, "name")
+	assert.Equal("Migraine", *(*event.Diff)["name"].Before)
+	assert.Equal("Chronic migraine", *(*event.Diff)["name"].After)
+}
+
+func TestAuditEvents_Forbidden(t *testing.T) {
+	t.Parallel()
+
+	res, err := http.Get(ServerUrl + "/audit")
+	require.NoError(t, err, "http.Get failed for AuditEvents")
+	res.Body.Close()
+	assert.Equal(t, http.StatusForbidden, res.StatusCode, "Audit log requires the admin token")
+}
+
+func TestAuditEvents_Pagination(t *testing.T) {
+	t.Parallel()
+
+	patientEmail := fmt.Sprintf("test.patient.audit.page.%s@example.com", uuid.NewString())
+	createdPatient := mustCreatePatient(t, newPatient(patientEmail))
+	condition := mustCreateCondition(t, api.NewCondition{
+		PatientId: createdPatient.Id,
+		Name:      "Migraine",
+		Start:     time.Now().Truncate(time.Second),
+	})
+	for range 3 {
+		var read api.Condition
+		mustGetJSON(t, fmt.Sprintf("/conditions/%s", *condition.Id), &read)
+	}
+
+	query := fmt.Sprintf("targetId=%s&limit=2", *condition.Id)
+	first := mustAuditEventsPage(t, query)
+	require.Len(t, first.Events, 2)
+	require.NotNil(t, first.NextAfterSeq, "First page should point to the next one")
+	assert.Equal(t, first.Events[1].Seq, *first.NextAfterSeq)
+
+	second := mustAuditEventsPage(t, fmt.Sprintf("%s&afterSeq=%d", query, *first.NextAfterSeq))
+	require.Len(t, second.Events, 1)
+	assert.Nil(t, second.NextAfterSeq, "Last page shouldn't point to another one")
+	assert.Greater(t, second.Events[0].Seq, first.Events[1].Seq)
+}
+
+func mustAuditEvents(t *testing.T, query string) []api.AuditEvent {
+	t.Helper()
+	return mustAuditEventsPage(t, query).Events
+}
+
+func mustAuditEventsPage(t *testing.T, query string) api.AuditEvents {
+	t.Helper()
+	require := require.New(t)
+
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/audit?%s", ServerUrl, query), nil)
+	require.NoError(err, "mustAuditEvents: failed to create request")
+	req.Header.Set("Authorization", "Bearer "+auditAdminToken)
+
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(err, "mustAuditEvents: request failed")
+	defer res.Body.Close()
+	require.Equal(http.StatusOK, res.StatusCode, "mustAuditEvents: Expected '200 OK'")
+
+	var page api.AuditEvents
+	err = json.NewDecoder(res.Body).Decode(&page)
+	require.NoError(err, "mustAuditEvents: Failed to decode response")
+	return page
+}
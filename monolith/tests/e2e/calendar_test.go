@@ -0,0 +1,94 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/test-go/testify/require"
+
+	"github.com/Nesquiko/wac/pkg/api"
+	"github.com/Nesquiko/wac/pkg/ics"
+)
+
+func TestDoctorCalendarFeed(t *testing.T) {
+	t.Parallel()
+
+	doctorEmail := fmt.Sprintf("test.doctor.feed.%s@example.com", uuid.NewString())
+	doctor := mustCreateDoctor(t, newDoctor(doctorEmail))
+	feed := mustIssueCalendarFeed(t, fmt.Sprintf("doctor/%s", doctor.Id))
+
+	res, err := http.Get(feed.Url)
+	require.NoError(t, err, "http.Get failed for CalendarFeed")
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, ics.ContentType, res.Header.Get("Content-Type"))
+
+	body, err := io.ReadAll(res.Body)
+	require.NoError(t, err, "Failed to read CalendarFeed body")
+	assert := assert.New(t)
+	assert.Contains(string(body), "BEGIN:VCALENDAR\r\n")
+	assert.Contains(string(body), "BEGIN:VTIMEZONE\r\n")
+	assert.Contains(string(body), "X-WR-CALNAME:Dr. Gregory")
+}
+
+func TestCalendarFeed_ReissueRevokesPrevious(t *testing.T) {
+	t.Parallel()
+
+	patientEmail := fmt.Sprintf("test.patient.feed.%s@example.com", uuid.NewString())
+	patient := mustCreatePatient(t, newPatient(patientEmail))
+	first := mustIssueCalendarFeed(t, fmt.Sprintf("patient/%s", patient.Id))
+	second := mustIssueCalendarFeed(t, fmt.Sprintf("patient/%s", patient.Id))
+	require.NotEqual(t, first.Url, second.Url)
+
+	res, err := http.Get(first.Url)
+	require.NoError(t, err, "http.Get failed for revoked CalendarFeed")
+	res.Body.Close()
+	assert.Equal(t, http.StatusNotFound, res.StatusCode, "Reissued feed should revoke the old URL")
+
+	req, err := http.NewRequest(
+		http.MethodDelete,
+		fmt.Sprintf("%s/appointments/patient/%s/feed", ServerUrl, patient.Id),
+		nil,
+	)
+	require.NoError(t, err, "Failed to create RevokePatientCalendarFeed request")
+	res, err = http.DefaultClient.Do(req)
+	require.NoError(t, err, "RevokePatientCalendarFeed request failed")
+	res.Body.Close()
+	require.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	res, err = http.Get(second.Url)
+	require.NoError(t, err, "http.Get failed for revoked CalendarFeed")
+	res.Body.Close()
+	assert.Equal(t, http.StatusNotFound, res.StatusCode, "Revoked feed should not be readable")
+}
+
+func TestAppointmentIcs_NotFound(t *testing.T) {
+	t.Parallel()
+
+	res, err := http.Get(fmt.Sprintf("%s/appointments/%s/ics", ServerUrl, uuid.New()))
+	require.NoError(t, err, "http.Get failed for AppointmentIcs")
+	res.Body.Close()
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+}
+
+func mustIssueCalendarFeed(t *testing.T, owner string) api.CalendarFeed {
+	t.Helper()
+	require := require.New(t)
+
+	res, err := http.Post(fmt.Sprintf("%s/appointments/%s/feed", ServerUrl, owner), "", nil)
+	require.NoError(err, "mustIssueCalendarFeed: request failed")
+	defer res.Body.Close()
+	require.Equal(http.StatusCreated, res.StatusCode, "mustIssueCalendarFeed: Expected '201 Created'")
+
+	var feed api.CalendarFeed
+	err = json.NewDecoder(res.Body).Decode(&feed)
+	require.NoError(err, "mustIssueCalendarFeed: Failed to decode response")
+	return feed
+}
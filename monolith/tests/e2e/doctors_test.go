@@ -9,6 +9,7 @@ import (
 	"io"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime/types"
@@ -71,6 +72,65 @@ func TestGetDoctorById_NotFound(t *testing.T) {
 	)
 }
 
+func TestEraseDoctor(t *testing.T) {
+	t.Parallel()
+
+	doctorEmail := fmt.Sprintf("test.doctor.erase.%s@example.com", uuid.NewString())
+	doctor := mustCreateDoctor(t, newDoctor(doctorEmail))
+	bookedEmail := fmt.Sprintf("test.doctor.erase.booked.%s@patient.com", uuid.NewString())
+	booked := mustCreatePatient(t, newPatient(bookedEmail))
+	waitingEmail := fmt.Sprintf("test.doctor.erase.waiting.%s@patient.com", uuid.NewString())
+	waiting := mustCreatePatient(t, newPatient(waitingEmail))
+
+	appointment := mustPostAppointment(t, api.NewAppointmentRequest{
+		PatientId:           booked.Id,
+		DoctorId:            doctor.Id,
+		AppointmentDateTime: time.Now().Add(48 * time.Hour).Truncate(time.Hour),
+	})
+	mustJoinWaitlist(t, doctor.Id, api.NewWaitlistEntry{PatientId: waiting.Id})
+
+	res := eraseDoctor(t, doctor.Id)
+	res.Body.Close()
+	require.Equal(t, http.StatusNoContent, res.StatusCode, "Expected No Content status code")
+
+	res, err := http.Get(fmt.Sprintf("%s/doctors/%s", ServerUrl, doctor.Id))
+	require.NoError(t, err, "http.Get failed for GetDoctorById after erasure")
+	res.Body.Close()
+	assert.Equal(t, http.StatusNotFound, res.StatusCode, "Erased doctor should not be found")
+
+	var doctors api.Doctors
+	mustGetJSON(t, "/doctors", &doctors)
+	for _, listed := range doctors.Doctors {
+		assert.NotEqual(t, doctor.Id, listed.Id, "Erased doctor should not be listed")
+	}
+
+	var cancelled api.Appointment
+	mustGetJSON(t, fmt.Sprintf("/appointments/%s", appointment.Id), &cancelled)
+	assert.Equal(t, api.Cancelled, cancelled.Status)
+
+	entries := mustGetPatientsWaitlist(t, waiting.Id)
+	assert.Empty(t, *entries.Entries, "Erased doctor's waitlist should be dropped")
+
+	// the original email can be used again
+	mustCreateDoctor(t, newDoctor(doctorEmail))
+
+	res = eraseDoctor(t, doctor.Id)
+	res.Body.Close()
+	assert.Equal(t, http.StatusNotFound, res.StatusCode, "Doctor can't be erased twice")
+}
+
+func eraseDoctor(t *testing.T, doctorId uuid.UUID) *http.Response {
+	t.Helper()
+
+	url := fmt.Sprintf("%s/doctors/%s", ServerUrl, doctorId)
+	req, err := http.NewRequest(http.MethodDelete, url, nil)
+	require.NoError(t, err, "eraseDoctor: failed to create request")
+
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err, "eraseDoctor: request failed")
+	return res
+}
+
 func mustCreateDoctor(t *testing.T, request *api.DoctorRegistration) api.Doctor {
 	t.Helper()
 	require := require.New(t)
@@ -0,0 +1,138 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/test-go/testify/require"
+
+	"github.com/Nesquiko/wac/pkg/api"
+	"github.com/Nesquiko/wac/pkg/server"
+)
+
+func TestAppointmentDurations_DoctorOverrideBlocksWholeInterval(t *testing.T) {
+	t.Parallel()
+
+	doctorEmail := fmt.Sprintf("test.durations.%s@doctor.com", uuid.NewString())
+	doctor := mustCreateDoctor(t, newDoctor(doctorEmail))
+	patientEmail := fmt.Sprintf("test.durations.%s@patient.com", uuid.NewString())
+	patient := mustCreatePatient(t, newPatient(patientEmail))
+
+	durations := mustUpdateAppointmentDurations(
+		t,
+		doctor.Id,
+		api.AppointmentDurations{string(api.Procedure): 90},
+	)
+	assert.Equal(t, 90, durations[string(api.Procedure)])
+	assert.Contains(t, durations, string(api.Vaccination), "Defaults should be included")
+
+	start := time.Now().Add(72 * time.Hour).Truncate(time.Hour)
+	res := postAppointment(t, api.NewAppointmentRequest{
+		PatientId:           patient.Id,
+		DoctorId:            doctor.Id,
+		AppointmentDateTime: start,
+		Type:                asPtr(api.Procedure),
+	})
+	defer res.Body.Close()
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+	var procedure api.Appointment
+	err := json.NewDecoder(res.Body).Decode(&procedure)
+	require.NoError(t, err, "Failed to decode created appointment")
+	require.NotNil(t, procedure.EndTime)
+	assert.True(t, start.Add(90*time.Minute).Equal(*procedure.EndTime))
+
+	res = postAppointment(t, api.NewAppointmentRequest{
+		PatientId:           patient.Id,
+		DoctorId:            doctor.Id,
+		AppointmentDateTime: start.Add(time.Hour),
+		Type:                asPtr(api.RegularCheck),
+	})
+	res.Body.Close()
+	assert.NotEqual(t, http.StatusCreated, res.StatusCode, "Overlapping appointment was booked")
+
+	res = postAppointment(t, api.NewAppointmentRequest{
+		PatientId:           patient.Id,
+		DoctorId:            doctor.Id,
+		AppointmentDateTime: start.Add(90 * time.Minute),
+		Type:                asPtr(api.RegularCheck),
+		DurationMinutes:     asPtr(20),
+	})
+	defer res.Body.Close()
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+	var followUp api.Appointment
+	err = json.NewDecoder(res.Body).Decode(&followUp)
+	require.NoError(t, err, "Failed to decode created appointment")
+	require.NotNil(t, followUp.EndTime)
+	assert.True(t, start.Add(110*time.Minute).Equal(*followUp.EndTime))
+}
+
+func TestAppointmentDurations_UnknownType(t *testing.T) {
+	t.Parallel()
+
+	doctorEmail := fmt.Sprintf("test.durations.unknown.%s@doctor.com", uuid.NewString())
+	doctor := mustCreateDoctor(t, newDoctor(doctorEmail))
+
+	body, err := json.Marshal(api.AppointmentDurations{"massage": 30})
+	require.NoError(t, err, "Failed to marshal durations request body")
+	req, err := http.NewRequest(
+		http.MethodPut,
+		fmt.Sprintf("%s/doctors/%s/durations", ServerUrl, doctor.Id),
+		bytes.NewBuffer(body),
+	)
+	require.NoError(t, err, "Failed to create UpdateDoctorAppointmentDurations request")
+	req.Header.Set("Content-Type", server.ApplicationJSON)
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err, "UpdateDoctorAppointmentDurations request failed")
+	res.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func postAppointment(t *testing.T, request api.NewAppointmentRequest) *http.Response {
+	t.Helper()
+
+	body, err := json.Marshal(request)
+	require.NoError(t, err, "postAppointment: Failed to marshal request")
+	res, err := http.Post(
+		fmt.Sprintf("%s/appointments", ServerUrl),
+		server.ApplicationJSON,
+		bytes.NewBuffer(body),
+	)
+	require.NoError(t, err, "postAppointment: http.Post failed")
+	return res
+}
+
+func mustUpdateAppointmentDurations(
+	t *testing.T,
+	doctorId uuid.UUID,
+	durations api.AppointmentDurations,
+) api.AppointmentDurations {
+	t.Helper()
+	require := require.New(t)
+
+	body, err := json.Marshal(durations)
+	require.NoError(err, "mustUpdateAppointmentDurations: Failed to marshal request")
+	req, err := http.NewRequest(
+		http.MethodPut,
+		fmt.Sprintf("%s/doctors/%s/durations", ServerUrl, doctorId),
+		bytes.NewBuffer(body),
+	)
+	require.NoError(err, "mustUpdateAppointmentDurations: Failed to create request")
+	req.Header.Set("Content-Type", server.ApplicationJSON)
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(err, "mustUpdateAppointmentDurations: request failed")
+	defer res.Body.Close()
+	require.Equal(http.StatusOK, res.StatusCode, "mustUpdateAppointmentDurations: Expected '200 OK'")
+
+	var updated api.AppointmentDurations
+	err = json.NewDecoder(res.Body).Decode(&updated)
+	require.NoError(err, "mustUpdateAppointmentDurations: Failed to decode response")
+	return updated
+}
@@ -0,0 +1,153 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nesquiko/wac/pkg/api"
+	"github.com/Nesquiko/wac/pkg/server"
+)
+
+// mustETag reads the resource at path and returns its ETag, to be sent in the
+// If-Match header of its update.
+func mustETag(t *testing.T, path string) string {
+	t.Helper()
+
+	res, err := http.Get(ServerUrl + path)
+	require.NoError(t, err, "mustETag: http.Get failed")
+	res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode, "mustETag: Expected '200 OK'")
+	etag := res.Header.Get(server.ETagHeader)
+	require.NotEmpty(t, etag, "mustETag: Missing ETag")
+	return etag
+}
+
+func updateCondition(
+	t *testing.T,
+	conditionId uuid.UUID,
+	ifMatch string,
+	body string,
+) *http.Response {
+	t.Helper()
+
+	req, err := http.NewRequest(
+		http.MethodPatch,
+		fmt.Sprintf("%s/conditions/%s", ServerUrl, conditionId),
+		bytes.NewBufferString(body),
+	)
+	require.NoError(t, err, "Failed to create UpdateCondition request")
+	req.Header.Set(server.ContentType, server.ApplicationJSON)
+	if ifMatch != "" {
+		req.Header.Set(server.IfMatchHeader, ifMatch)
+	}
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err, "UpdateCondition request failed")
+	return res
+}
+
+func TestETag_UpdateCondition(t *testing.T) {
+	t.Parallel()
+
+	patientEmail := fmt.Sprintf("test.etag.condition.%s@patient.com", uuid.NewString())
+	patient := mustCreatePatient(t, newPatient(patientEmail))
+	condition := mustCreateCondition(t, api.NewCondition{
+		PatientId: patient.Id,
+		Name:      "Migraine",
+		Start:     time.Now().Truncate(time.Second),
+	})
+	etag := mustETag(t, fmt.Sprintf("/conditions/%s", *condition.Id))
+
+	res := updateCondition(t, *condition.Id, "W/"+etag, `{"name": "Chronic migraine"}`)
+	res.Body.Close()
+	require.Equal(
+		t,
+		http.StatusPreconditionFailed,
+		res.StatusCode,
+		"Expected '412 Precondition Failed' for a weak ETag",
+	)
+
+	res = updateCondition(t, *condition.Id, `"999999", `+etag, `{"name": "Chronic migraine"}`)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode, "Expected '200 OK' status code")
+	var updated api.Condition
+	err := json.NewDecoder(res.Body).Decode(&updated)
+	require.NoError(t, err, "Failed to decode updated condition")
+	assert.Equal(t, "Chronic migraine", updated.Name)
+	assert.NotEqual(t, etag, res.Header.Get(server.ETagHeader), "Update should move the ETag")
+
+	stale := updateCondition(t, *condition.Id, etag, `{"name": "Tension headache"}`)
+	defer stale.Body.Close()
+	require.Equal(
+		t,
+		http.StatusPreconditionFailed,
+		stale.StatusCode,
+		"Expected '412 Precondition Failed' for a stale ETag",
+	)
+	var problem api.ErrorDetail
+	err = json.NewDecoder(stale.Body).Decode(&problem)
+	require.NoError(t, err, "Failed to decode problem detail")
+	assert.Equal(t, server.PreconditionFailedCode, problem.Code)
+
+	unconditional := updateCondition(t, *condition.Id, "", `{"name": "Tension headache"}`)
+	defer unconditional.Body.Close()
+	require.Equal(
+		t,
+		http.StatusOK,
+		unconditional.StatusCode,
+		"Expected '200 OK' without If-Match",
+	)
+}
+
+func TestETag_DecideAfterReschedule(t *testing.T) {
+	t.Parallel()
+
+	doctorEmail := fmt.Sprintf("test.etag.decide.%s@doctor.com", uuid.NewString())
+	doctor := mustCreateDoctor(t, newDoctor(doctorEmail))
+	patientEmail := fmt.Sprintf("test.etag.decide.%s@patient.com", uuid.NewString())
+	patient := mustCreatePatient(t, newPatient(patientEmail))
+
+	start := time.Now().Add(30 * 24 * time.Hour).Truncate(time.Hour)
+	appt := mustPostAppointment(t, api.NewAppointmentRequest{
+		PatientId:           patient.Id,
+		DoctorId:            doctor.Id,
+		AppointmentDateTime: start,
+		DurationMinutes:     asPtr(30),
+	})
+	etag := mustETag(t, fmt.Sprintf("/appointments/%s", appt.Id))
+
+	res := sendAppointmentChange(t, http.MethodPatch, appt.Id, api.AppointmentReschedule{
+		NewAppointmentDateTime: start.Add(time.Hour),
+	})
+	res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode, "Expected '200 OK' status code")
+
+	body, err := json.Marshal(api.AppointmentDecision{Action: api.Accept})
+	require.NoError(t, err, "Failed to marshal decision")
+	req, err := http.NewRequest(
+		http.MethodPost,
+		fmt.Sprintf("%s/appointments/%s", ServerUrl, appt.Id),
+		bytes.NewBuffer(body),
+	)
+	require.NoError(t, err, "Failed to create DecideAppointment request")
+	req.Header.Set(server.ContentType, server.ApplicationJSON)
+	req.Header.Set(server.IfMatchHeader, etag)
+	res, err = http.DefaultClient.Do(req)
+	require.NoError(t, err, "DecideAppointment request failed")
+	res.Body.Close()
+	assert.Equal(
+		t,
+		http.StatusPreconditionFailed,
+		res.StatusCode,
+		"Decision based on the appointment before its reschedule should fail",
+	)
+}
@@ -0,0 +1,128 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/test-go/testify/require"
+
+	"github.com/Nesquiko/wac/pkg/fhir"
+)
+
+func TestFhirCreateAndReadPatient(t *testing.T) {
+	t.Parallel()
+
+	email := fmt.Sprintf("test.patient.fhir.%s@example.com", uuid.NewString())
+	createdId := mustFhirCreate(t, fhir.ResourceTypePatient, fhir.Patient{
+		ResourceType: fhir.ResourceTypePatient,
+		Name:         []fhir.HumanName{{Family: "Doe", Given: []string{"Jane"}}},
+		Telecom:      []fhir.ContactPoint{{System: "email", Value: email}},
+	})
+
+	res, err := http.Get(fmt.Sprintf("%s/fhir/R4/Patient/%s", ServerUrl, createdId))
+	require.NoError(t, err, "http.Get failed for FHIR Patient read")
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, fhir.ContentType, res.Header.Get("Content-Type"))
+
+	var patient fhir.Patient
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&patient))
+
+	assert := assert.New(t)
+	assert.Equal(createdId, patient.Id)
+	require.Len(t, patient.Name, 1)
+	assert.Equal("Doe", patient.Name[0].Family)
+	assert.Equal([]string{"Jane"}, patient.Name[0].Given)
+	require.Len(t, patient.Telecom, 1)
+	assert.Equal(email, patient.Telecom[0].Value)
+}
+
+func TestFhirSearchConditionsByPatientAndDate(t *testing.T) {
+	t.Parallel()
+
+	email := fmt.Sprintf("test.patient.fhir.%s@example.com", uuid.NewString())
+	patient := mustCreatePatient(t, newPatient(email))
+	onset := time.Date(2025, time.March, 10, 9, 0, 0, 0, time.UTC)
+	createdId := mustFhirCreate(t, fhir.ResourceTypeCondition, fhir.Condition{
+		ResourceType:  fhir.ResourceTypeCondition,
+		Code:          &fhir.CodeableConcept{Text: "Asthma"},
+		Subject:       fhir.Reference{Reference: "Patient/" + patient.Id.String()},
+		OnsetDateTime: &onset,
+	})
+
+	res, err := http.Get(fmt.Sprintf(
+		"%s/fhir/R4/Condition?patient=Patient/%s&date=ge2025-03-01",
+		ServerUrl,
+		patient.Id,
+	))
+	require.NoError(t, err, "http.Get failed for FHIR Condition search")
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	var bundle struct {
+		ResourceType string `json:"resourceType"`
+		Type         string `json:"type"`
+		Total        int    `json:"total"`
+		Entry        []struct {
+			Resource fhir.Condition `json:"resource"`
+		} `json:"entry"`
+	}
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&bundle))
+
+	assert := assert.New(t)
+	assert.Equal(fhir.ResourceTypeBundle, bundle.ResourceType)
+	assert.Equal("searchset", bundle.Type)
+	require.Equal(t, 1, bundle.Total)
+	assert.Equal(createdId, bundle.Entry[0].Resource.Id)
+	assert.Equal("Asthma", bundle.Entry[0].Resource.Code.Text)
+}
+
+func TestFhirRead_NotFound(t *testing.T) {
+	t.Parallel()
+
+	res, err := http.Get(fmt.Sprintf("%s/fhir/R4/Condition/%s", ServerUrl, uuid.New()))
+	require.NoError(t, err, "http.Get failed for FHIR Condition read")
+	defer res.Body.Close()
+	require.Equal(t, http.StatusNotFound, res.StatusCode)
+
+	var outcome fhir.OperationOutcome
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&outcome))
+	assert.Equal(t, fhir.ResourceTypeOperationOutcome, outcome.ResourceType)
+	require.Len(t, outcome.Issue, 1)
+	assert.Equal(t, "not-found", outcome.Issue[0].Code)
+}
+
+// mustFhirCreate posts the resource to the FHIR facade and returns the id of
+// the created resource.
+func mustFhirCreate(t *testing.T, resourceType string, resource any) string {
+	t.Helper()
+	require := require.New(t)
+
+	body, err := json.Marshal(resource)
+	require.NoError(err, "mustFhirCreate: failed to marshal resource")
+
+	res, err := http.Post(
+		fmt.Sprintf("%s/fhir/R4/%s", ServerUrl, resourceType),
+		fhir.ContentType,
+		bytes.NewBuffer(body),
+	)
+	require.NoError(err, "mustFhirCreate: request failed")
+	defer res.Body.Close()
+	require.Equal(http.StatusCreated, res.StatusCode, "mustFhirCreate: Expected '201 Created'")
+	require.NotEmpty(res.Header.Get("Location"), "mustFhirCreate: Expected Location header")
+
+	var created struct {
+		Id string `json:"id"`
+	}
+	require.NoError(json.NewDecoder(res.Body).Decode(&created))
+	require.NotEmpty(created.Id, "mustFhirCreate: created resource has no id")
+	return created.Id
+}
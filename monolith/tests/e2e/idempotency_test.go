@@ -0,0 +1,137 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nesquiko/wac/pkg/api"
+	"github.com/Nesquiko/wac/pkg/server"
+)
+
+func registerIdempotently(
+	t *testing.T,
+	key string,
+	request *api.PatientRegistration,
+) *http.Response {
+	body, err := json.Marshal(request)
+	require.NoError(t, err, "Failed to marshal registration")
+
+	req, err := http.NewRequest(
+		http.MethodPost,
+		ServerUrl+"/auth/register",
+		bytes.NewReader(body),
+	)
+	require.NoError(t, err, "Failed to create RegisterUser request")
+	req.Header.Set(server.ContentType, server.ApplicationJSON)
+	req.Header.Set(server.IdempotencyKeyHeader, key)
+
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err, "RegisterUser request failed")
+	return res
+}
+
+func TestIdempotency_Replayed(t *testing.T) {
+	t.Parallel()
+
+	key := uuid.NewString()
+	request := newPatient(fmt.Sprintf("idempotent.%s@patient.com", uuid.NewString()))
+
+	first := registerIdempotently(t, key, request)
+	defer first.Body.Close()
+	require.Equal(t, http.StatusCreated, first.StatusCode, "Expected '201 Created' status code")
+	firstBody, err := io.ReadAll(first.Body)
+	require.NoError(t, err, "Failed to read first response")
+
+	retry := registerIdempotently(t, key, request)
+	defer retry.Body.Close()
+	require.Equal(
+		t,
+		http.StatusCreated,
+		retry.StatusCode,
+		"Expected the replayed '201 Created' status code",
+	)
+	retryBody, err := io.ReadAll(retry.Body)
+	require.NoError(t, err, "Failed to read retried response")
+
+	assert := assert.New(t)
+	assert.Equal("true", retry.Header.Get(server.IdempotentReplayedHeader))
+	assert.Equal(first.Header.Get(server.ContentType), retry.Header.Get(server.ContentType))
+	assert.JSONEq(string(firstBody), string(retryBody))
+
+	var patient api.Patient
+	err = json.Unmarshal(retryBody, &patient)
+	require.NoError(t, err, "Failed to decode patient")
+	res, err := http.Get(fmt.Sprintf("%s/patients/%s", ServerUrl, patient.Id))
+	require.NoError(t, err, "http.Get failed for patient")
+	defer res.Body.Close()
+	assert.Equal(http.StatusOK, res.StatusCode, "Replayed patient should exist")
+}
+
+func TestIdempotency_KeyReused(t *testing.T) {
+	t.Parallel()
+
+	key := uuid.NewString()
+	first := registerIdempotently(
+		t,
+		key,
+		newPatient(fmt.Sprintf("idempotent.%s@patient.com", uuid.NewString())),
+	)
+	defer first.Body.Close()
+	require.Equal(t, http.StatusCreated, first.StatusCode, "Expected '201 Created' status code")
+
+	reused := registerIdempotently(
+		t,
+		key,
+		newPatient(fmt.Sprintf("idempotent.%s@patient.com", uuid.NewString())),
+	)
+	defer reused.Body.Close()
+	require.Equal(
+		t,
+		http.StatusUnprocessableEntity,
+		reused.StatusCode,
+		"Expected '422 Unprocessable Entity' status code",
+	)
+
+	var errDetail api.ErrorDetail
+	err := json.NewDecoder(reused.Body).Decode(&errDetail)
+	require.NoError(t, err, "Failed to decode error detail")
+	assert.Equal(t, server.IdempotencyKeyReusedCode, errDetail.Code)
+}
+
+func TestIdempotency_BodyTooLarge(t *testing.T) {
+	t.Parallel()
+
+	req, err := http.NewRequest(
+		http.MethodPost,
+		ServerUrl+"/auth/register",
+		bytes.NewReader(bytes.Repeat([]byte(" "), 2<<20)),
+	)
+	require.NoError(t, err, "Failed to create RegisterUser request")
+	req.Header.Set(server.ContentType, server.ApplicationJSON)
+	req.Header.Set(server.IdempotencyKeyHeader, uuid.NewString())
+
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err, "RegisterUser request failed")
+	defer res.Body.Close()
+	require.Equal(
+		t,
+		http.StatusRequestEntityTooLarge,
+		res.StatusCode,
+		"Expected '413 Request Entity Too Large' status code",
+	)
+
+	var errDetail api.ErrorDetail
+	err = json.NewDecoder(res.Body).Decode(&errDetail)
+	require.NoError(t, err, "Failed to decode error detail")
+	assert.Equal(t, server.IdempotencyBodyTooLargeCode, errDetail.Code)
+}
@@ -139,6 +139,151 @@ func TestGetPatientById_NotFound(t *testing.T) {
 	)
 }
 
+func TestErasePatient(t *testing.T) {
+	t.Parallel()
+
+	patientEmail := fmt.Sprintf("test.patient.erase.%s@example.com", uuid.NewString())
+	createdPatient := mustCreatePatient(t, newPatient(patientEmail))
+	condition := mustCreateCondition(t, api.NewCondition{
+		PatientId: createdPatient.Id,
+		Name:      "Asthma",
+		Start:     time.Now().Truncate(time.Second),
+	})
+
+	res := erasePatient(t, createdPatient.Id)
+	res.Body.Close()
+	require.Equal(t, http.StatusNoContent, res.StatusCode, "Expected No Content status code")
+
+	res, err := http.Get(fmt.Sprintf("%s/patients/%s", ServerUrl, createdPatient.Id))
+	require.NoError(t, err, "http.Get failed for GetPatientById after erasure")
+	res.Body.Close()
+	assert.Equal(t, http.StatusNotFound, res.StatusCode, "Erased patient should not be found")
+
+	res, err = http.Get(fmt.Sprintf("%s/conditions/%s", ServerUrl, *condition.Id))
+	require.NoError(t, err, "http.Get failed for ConditionDetail after erasure")
+	res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode, "Clinical records should be retained")
+
+	// the original email can be used again
+	mustCreatePatient(t, newPatient(patientEmail))
+
+	res = erasePatient(t, createdPatient.Id)
+	res.Body.Close()
+	assert.Equal(t, http.StatusNotFound, res.StatusCode, "Patient can't be erased twice")
+}
+
+func TestErasePatient_CancelsFutureAppointments(t *testing.T) {
+	t.Parallel()
+
+	doctorEmail := fmt.Sprintf("test.erase.%s@doctor.com", uuid.NewString())
+	doctor := mustCreateDoctor(t, newDoctor(doctorEmail))
+	erasedEmail := fmt.Sprintf("test.erase.erased.%s@patient.com", uuid.NewString())
+	erased := mustCreatePatient(t, newPatient(erasedEmail))
+	waitingEmail := fmt.Sprintf("test.erase.waiting.%s@patient.com", uuid.NewString())
+	waiting := mustCreatePatient(t, newPatient(waitingEmail))
+
+	appointmentTime := time.Now().Add(48 * time.Hour).Truncate(time.Hour)
+	appointment := mustPostAppointment(t, api.NewAppointmentRequest{
+		PatientId:           erased.Id,
+		DoctorId:            doctor.Id,
+		AppointmentDateTime: appointmentTime,
+	})
+	mustJoinWaitlist(t, doctor.Id, api.NewWaitlistEntry{PatientId: erased.Id})
+	mustJoinWaitlist(t, doctor.Id, api.NewWaitlistEntry{PatientId: waiting.Id})
+
+	res := erasePatient(t, erased.Id)
+	res.Body.Close()
+	require.Equal(t, http.StatusNoContent, res.StatusCode, "Expected No Content status code")
+
+	var cancelled api.Appointment
+	mustGetJSON(t, fmt.Sprintf("/appointments/%s", appointment.Id), &cancelled)
+	assert.Equal(t, api.Cancelled, cancelled.Status)
+
+	entries := mustGetPatientsWaitlist(t, erased.Id)
+	assert.Empty(t, *entries.Entries, "Erased patient should leave all waitlists")
+
+	// the freed slot is offered to the next waiting patient
+	entries = mustGetPatientsWaitlist(t, waiting.Id)
+	require.Len(t, *entries.Entries, 1)
+	offered := (*entries.Entries)[0]
+	assert.Equal(t, api.Offered, offered.Status)
+	require.NotNil(t, offered.Offer, "Offered entry should carry its offer")
+	assert.True(t, appointmentTime.Equal(offered.Offer.AppointmentDateTime))
+}
+
+func TestExportPatientData(t *testing.T) {
+	t.Parallel()
+
+	patientEmail := fmt.Sprintf("test.patient.export.%s@example.com", uuid.NewString())
+	createdPatient := mustCreatePatient(t, newPatient(patientEmail))
+	start := time.Now().Truncate(time.Second)
+	mustCreateCondition(t, api.NewCondition{
+		PatientId: createdPatient.Id,
+		Name:      "Hypertension",
+		Start:     start,
+	})
+
+	reqBodyBytes, err := json.Marshal(api.NewPrescription{
+		Name:      "Lisinopril 10mg",
+		PatientId: createdPatient.Id,
+		Start:     start,
+		End:       start.AddDate(0, 1, 0),
+	})
+	require.NoError(t, err, "Failed to marshal NewPrescription request")
+	res, err := http.Post(
+		ServerUrl+"/prescriptions",
+		server.ApplicationJSON,
+		bytes.NewBuffer(reqBodyBytes),
+	)
+	require.NoError(t, err, "http.Post failed for CreatePrescription")
+	var prescription api.Prescription
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&prescription))
+	res.Body.Close()
+
+	req, err := http.NewRequest(
+		http.MethodDelete,
+		fmt.Sprintf("%s/prescriptions/%s", ServerUrl, *prescription.Id),
+		nil,
+	)
+	require.NoError(t, err, "Failed to create DeletePrescription request")
+	res, err = http.DefaultClient.Do(req)
+	require.NoError(t, err, "DeletePrescription request failed")
+	res.Body.Close()
+	require.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	res, err = http.Get(fmt.Sprintf("%s/patients/%s/export", ServerUrl, createdPatient.Id))
+	require.NoError(t, err, "http.Get failed for ExportPatientData")
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode, "Expected OK status code")
+
+	var export api.PatientDataExport
+	err = json.NewDecoder(res.Body).Decode(&export)
+	require.NoError(t, err, "Failed to decode response body for ExportPatientData")
+
+	assert := assert.New(t)
+	assert.Equal(createdPatient.Id, export.Patient.Id)
+	assert.Equal(createdPatient.Email, export.Patient.Email)
+	assert.Nil(export.Patient.DeletedAt)
+	assert.Len(export.Conditions, 1)
+	assert.Empty(export.Appointments)
+	assert.Empty(export.Reservations)
+	require.Len(t, export.Prescriptions, 1, "Deleted prescription should be exported")
+	assert.Equal(*prescription.Id, export.Prescriptions[0].Id)
+	assert.NotNil(export.Prescriptions[0].DeletedAt)
+}
+
+func erasePatient(t *testing.T, patientId uuid.UUID) *http.Response {
+	t.Helper()
+
+	url := fmt.Sprintf("%s/patients/%s", ServerUrl, patientId)
+	req, err := http.NewRequest(http.MethodDelete, url, nil)
+	require.NoError(t, err, "erasePatient: failed to create request")
+
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err, "erasePatient: request failed")
+	return res
+}
+
 func mustCreatePatient(t *testing.T, request *api.PatientRegistration) api.Patient {
 	t.Helper()
 	require := require.New(t)
@@ -0,0 +1,156 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/test-go/testify/require"
+
+	"github.com/Nesquiko/wac/pkg/api"
+	"github.com/Nesquiko/wac/pkg/app"
+	"github.com/Nesquiko/wac/pkg/server"
+)
+
+func TestPolicies_LateCancellationCountedOnPatient(t *testing.T) {
+	t.Parallel()
+
+	doctorEmail := fmt.Sprintf("test.policies.late.%s@doctor.com", uuid.NewString())
+	doctor := mustCreateDoctor(t, newDoctor(doctorEmail))
+	patientEmail := fmt.Sprintf("test.policies.late.%s@patient.com", uuid.NewString())
+	patient := mustCreatePatient(t, newPatient(patientEmail))
+
+	appt := mustPostAppointment(t, api.NewAppointmentRequest{
+		PatientId:           patient.Id,
+		DoctorId:            doctor.Id,
+		AppointmentDateTime: time.Now().Add(3 * time.Hour).Truncate(time.Hour),
+		DurationMinutes:     asPtr(30),
+	})
+
+	res := sendAppointmentChange(t, http.MethodDelete, appt.Id, api.AppointmentCancellation{
+		By: api.UserRolePatient,
+	})
+	res.Body.Close()
+	require.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	res, err := http.Get(fmt.Sprintf("%s/patients/%s", ServerUrl, patient.Id))
+	require.NoError(t, err, "GetPatientById request failed")
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	var fetched api.Patient
+	err = json.NewDecoder(res.Body).Decode(&fetched)
+	require.NoError(t, err, "Failed to decode patient")
+	require.NotNil(t, fetched.LateCancellations)
+	assert.Equal(t, 1, *fetched.LateCancellations)
+}
+
+func TestPolicies_RescheduleLimitAndAdminOverride(t *testing.T) {
+	t.Parallel()
+
+	doctorEmail := fmt.Sprintf("test.policies.limit.%s@doctor.com", uuid.NewString())
+	doctor := mustCreateDoctor(t, newDoctor(doctorEmail))
+	patientEmail := fmt.Sprintf("test.policies.limit.%s@patient.com", uuid.NewString())
+	patient := mustCreatePatient(t, newPatient(patientEmail))
+
+	start := time.Now().Add(30 * 24 * time.Hour).Truncate(time.Hour)
+	appt := mustPostAppointment(t, api.NewAppointmentRequest{
+		PatientId:           patient.Id,
+		DoctorId:            doctor.Id,
+		AppointmentDateTime: start,
+		DurationMinutes:     asPtr(30),
+	})
+
+	for i := range server.PoliciesMaxReschedulesDefault {
+		res := sendAppointmentChange(t, http.MethodPatch, appt.Id, api.AppointmentReschedule{
+			NewAppointmentDateTime: start.Add(time.Duration(i+1) * time.Hour),
+		})
+		res.Body.Close()
+		require.Equal(t, http.StatusOK, res.StatusCode, "Reschedule %d should be allowed", i+1)
+	}
+
+	newDateTime := start.Add(24 * time.Hour)
+	res := sendAppointmentChange(t, http.MethodPatch, appt.Id, api.AppointmentReschedule{
+		NewAppointmentDateTime: newDateTime,
+	})
+	defer res.Body.Close()
+	require.Equal(t, http.StatusConflict, res.StatusCode)
+	var problem api.ErrorDetail
+	err := json.NewDecoder(res.Body).Decode(&problem)
+	require.NoError(t, err, "Failed to decode problem detail")
+	assert.Equal(t, app.PolicyCodeRescheduleLimit, problem.Code)
+
+	res = sendAppointmentChange(t, http.MethodPatch, appt.Id, api.AppointmentReschedule{
+		NewAppointmentDateTime: newDateTime,
+		OverridePolicy:         asPtr(true),
+	})
+	res.Body.Close()
+	assert.Equal(t, http.StatusForbidden, res.StatusCode, "Patients may not override the policy")
+
+	res = sendAppointmentChange(t, http.MethodPatch, appt.Id, api.AppointmentReschedule{
+		NewAppointmentDateTime: newDateTime,
+		By:                     asPtr(api.UserRoleDoctor),
+		OverridePolicy:         asPtr(true),
+	})
+	res.Body.Close()
+	assert.Equal(
+		t,
+		http.StatusForbidden,
+		res.StatusCode,
+		"Claiming to be a doctor doesn't authorize the override",
+	)
+
+	req := appointmentChangeRequest(t, http.MethodPatch, appt.Id, api.AppointmentReschedule{
+		NewAppointmentDateTime: newDateTime,
+		OverridePolicy:         asPtr(true),
+	})
+	req.Header.Set("Authorization", "Bearer "+auditAdminToken)
+	res, err = http.DefaultClient.Do(req)
+	require.NoError(t, err, "RescheduleAppointment request failed")
+	res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode, "Administrators may override the policy")
+}
+
+func sendAppointmentChange(
+	t *testing.T,
+	method string,
+	appointmentId uuid.UUID,
+	request any,
+) *http.Response {
+	t.Helper()
+
+	res, err := http.DefaultClient.Do(appointmentChangeRequest(t, method, appointmentId, request))
+	require.NoError(t, err, "sendAppointmentChange: request failed")
+	return res
+}
+
+func appointmentChangeRequest(
+	t *testing.T,
+	method string,
+	appointmentId uuid.UUID,
+	request any,
+) *http.Request {
+	t.Helper()
+
+	body, err := json.Marshal(request)
+	require.NoError(t, err, "appointmentChangeRequest: Failed to marshal request")
+	req, err := http.NewRequest(
+		method,
+		fmt.Sprintf("%s/appointments/%s", ServerUrl, appointmentId),
+		bytes.NewBuffer(body),
+	)
+	require.NoError(t, err, "appointmentChangeRequest: Failed to create request")
+	req.Header.Set("Content-Type", server.ApplicationJSON)
+	req.Header.Set(
+		server.IfMatchHeader,
+		mustETag(t, fmt.Sprintf("/appointments/%s", appointmentId)),
+	)
+	return req
+}
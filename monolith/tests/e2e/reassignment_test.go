@@ -0,0 +1,157 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/test-go/testify/require"
+
+	"github.com/Nesquiko/wac/pkg/api"
+	"github.com/Nesquiko/wac/pkg/server"
+)
+
+func TestReassignAppointment_ToSubstitute(t *testing.T) {
+	t.Parallel()
+
+	doctorEmail := fmt.Sprintf("test.reassign.%s@doctor.com", uuid.NewString())
+	doctor := mustCreateDoctor(t, newDoctor(doctorEmail))
+	substituteEmail := fmt.Sprintf("test.reassign.substitute.%s@doctor.com", uuid.NewString())
+	substitute := mustCreateDoctor(t, newDoctor(substituteEmail))
+	patientEmail := fmt.Sprintf("test.reassign.%s@patient.com", uuid.NewString())
+	patient := mustCreatePatient(t, newPatient(patientEmail))
+
+	start := time.Now().Add(96 * time.Hour).Truncate(time.Hour)
+	appt := mustPostAppointment(t, api.NewAppointmentRequest{
+		PatientId:           patient.Id,
+		DoctorId:            doctor.Id,
+		AppointmentDateTime: start,
+		DurationMinutes:     asPtr(60),
+	})
+
+	res := postReassignment(
+		t,
+		fmt.Sprintf("/appointments/%s/reassign", appt.Id),
+		api.AppointmentReassignment{DoctorId: substitute.Id},
+	)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	var reassigned api.Appointment
+	err := json.NewDecoder(res.Body).Decode(&reassigned)
+	require.NoError(t, err, "Failed to decode reassigned appointment")
+	assert.Equal(t, substitute.Id, reassigned.Doctor.Id)
+	assert.True(t, start.Equal(reassigned.AppointmentDateTime))
+	assert.Equal(t, api.Requested, reassigned.Status, "Status should be kept")
+}
+
+func TestReassignAppointment_DifferentSpecialization(t *testing.T) {
+	t.Parallel()
+
+	doctorEmail := fmt.Sprintf("test.reassign.spec.%s@doctor.com", uuid.NewString())
+	doctor := mustCreateDoctor(t, newDoctor(doctorEmail))
+	cardiologist := newDoctor(fmt.Sprintf("test.reassign.spec.%s@doctor.com", uuid.NewString()))
+	cardiologist.Specialization = api.Cardiologist
+	substitute := mustCreateDoctor(t, cardiologist)
+	patientEmail := fmt.Sprintf("test.reassign.spec.%s@patient.com", uuid.NewString())
+	patient := mustCreatePatient(t, newPatient(patientEmail))
+
+	appt := mustPostAppointment(t, api.NewAppointmentRequest{
+		PatientId:           patient.Id,
+		DoctorId:            doctor.Id,
+		AppointmentDateTime: time.Now().Add(96 * time.Hour).Truncate(time.Hour),
+	})
+
+	res := postReassignment(
+		t,
+		fmt.Sprintf("/appointments/%s/reassign", appt.Id),
+		api.AppointmentReassignment{DoctorId: substitute.Id},
+	)
+	res.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func TestReassignDoctorAppointments_ReportsConflicts(t *testing.T) {
+	t.Parallel()
+
+	loc, err := time.LoadLocation(server.TzDefault)
+	require.NoError(t, err, "Failed to load server timezone")
+	day := time.Now().In(loc).AddDate(0, 0, 14)
+	from := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, loc)
+
+	doctorEmail := fmt.Sprintf("test.reassign.bulk.%s@doctor.com", uuid.NewString())
+	doctor := mustCreateDoctor(t, newDoctor(doctorEmail))
+	substituteEmail := fmt.Sprintf("test.reassign.bulk.substitute.%s@doctor.com", uuid.NewString())
+	substitute := mustCreateDoctor(t, newDoctor(substituteEmail))
+	patientEmail := fmt.Sprintf("test.reassign.bulk.%s@patient.com", uuid.NewString())
+	patient := mustCreatePatient(t, newPatient(patientEmail))
+
+	free := mustPostAppointment(t, api.NewAppointmentRequest{
+		PatientId:           patient.Id,
+		DoctorId:            doctor.Id,
+		AppointmentDateTime: from.Add(9 * time.Hour),
+		DurationMinutes:     asPtr(60),
+	})
+	busy := mustPostAppointment(t, api.NewAppointmentRequest{
+		PatientId:           patient.Id,
+		DoctorId:            doctor.Id,
+		AppointmentDateTime: from.Add(11 * time.Hour),
+		DurationMinutes:     asPtr(60),
+	})
+	mustPostAppointment(t, api.NewAppointmentRequest{
+		PatientId:           patient.Id,
+		DoctorId:            substitute.Id,
+		AppointmentDateTime: from.Add(11 * time.Hour),
+		DurationMinutes:     asPtr(60),
+	})
+
+	res := postReassignment(t, fmt.Sprintf("/doctors/%s/reassign", doctor.Id), api.DoctorReassignment{
+		From:               from,
+		To:                 from.AddDate(0, 0, 1),
+		SubstituteDoctorId: &substitute.Id,
+	})
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	var report api.ReassignmentReport
+	err = json.NewDecoder(res.Body).Decode(&report)
+	require.NoError(t, err, "Failed to decode reassignment report")
+	require.Len(t, report.Reassigned, 1)
+	assert.Equal(t, free.Id, report.Reassigned[0].AppointmentId)
+	require.NotNil(t, report.Reassigned[0].DoctorId)
+	assert.Equal(t, substitute.Id, *report.Reassigned[0].DoctorId)
+	require.Len(t, report.Conflicts, 1)
+	assert.Equal(t, busy.Id, report.Conflicts[0].AppointmentId)
+	assert.NotNil(t, report.Conflicts[0].Conflict)
+}
+
+func mustPostAppointment(t *testing.T, request api.NewAppointmentRequest) api.Appointment {
+	t.Helper()
+	require := require.New(t)
+
+	res := postAppointment(t, request)
+	defer res.Body.Close()
+	require.Equal(http.StatusCreated, res.StatusCode, "mustPostAppointment: Expected '201 Created'")
+
+	var created api.Appointment
+	err := json.NewDecoder(res.Body).Decode(&created)
+	require.NoError(err, "mustPostAppointment: Failed to decode response")
+	return created
+}
+
+func postReassignment(t *testing.T, path string, request any) *http.Response {
+	t.Helper()
+
+	body, err := json.Marshal(request)
+	require.NoError(t, err, "postReassignment: Failed to marshal request")
+	res, err := http.Post(ServerUrl+path, server.ApplicationJSON, bytes.NewBuffer(body))
+	require.NoError(t, err, "postReassignment: http.Post failed")
+	return res
+}
@@ -0,0 +1,120 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/test-go/testify/require"
+
+	"github.com/Nesquiko/wac/pkg/api"
+	"github.com/Nesquiko/wac/pkg/server"
+)
+
+func TestRescheduleKeepingReservations(t *testing.T) {
+	t.Parallel()
+
+	doctorEmail := fmt.Sprintf("test.reschedule.keep.%s@doctor.com", uuid.NewString())
+	doctor := mustCreateDoctor(t, newDoctor(doctorEmail))
+	otherEmail := fmt.Sprintf("test.reschedule.keep.other.%s@doctor.com", uuid.NewString())
+	other := mustCreateDoctor(t, newDoctor(otherEmail))
+	patientEmail := fmt.Sprintf("test.reschedule.keep.%s@patient.com", uuid.NewString())
+	patient := mustCreatePatient(t, newPatient(patientEmail))
+	equipment := mustCreateResource(t, api.NewResource{
+		Name: fmt.Sprintf("Ultrasound %s", uuid.NewString()),
+		Type: api.ResourceTypeEquipment,
+	})
+
+	start := time.Now().Add(20 * 24 * time.Hour).Truncate(time.Hour)
+	appt := mustPostAppointment(t, api.NewAppointmentRequest{
+		PatientId:           patient.Id,
+		DoctorId:            doctor.Id,
+		AppointmentDateTime: start,
+		DurationMinutes:     asPtr(60),
+	})
+	mustAcceptAppointment(t, appt.Id, equipment.Id)
+
+	moved := mustRescheduleKeepingReservations(t, appt.Id, start.Add(2*time.Hour))
+	assert.Equal(t, api.Scheduled, moved.Status, "Appointment with free resources should stay scheduled")
+	require.NotNil(t, moved.ReservationConflicts)
+	assert.Empty(t, *moved.ReservationConflicts)
+
+	blocking := mustPostAppointment(t, api.NewAppointmentRequest{
+		PatientId:           patient.Id,
+		DoctorId:            other.Id,
+		AppointmentDateTime: start.Add(4 * time.Hour),
+		DurationMinutes:     asPtr(60),
+	})
+	mustAcceptAppointment(t, blocking.Id, equipment.Id)
+
+	conflicted := mustRescheduleKeepingReservations(t, appt.Id, start.Add(4*time.Hour))
+	assert.Equal(t, api.Requested, conflicted.Status, "Appointment with taken resources should be re-reviewed")
+	require.NotNil(t, conflicted.ReservationConflicts)
+	require.Len(t, *conflicted.ReservationConflicts, 1)
+	assert.Equal(t, equipment.Id, (*conflicted.ReservationConflicts)[0].Id)
+}
+
+func mustAcceptAppointment(t *testing.T, appointmentId uuid.UUID, equipmentId *uuid.UUID) {
+	t.Helper()
+	require := require.New(t)
+
+	body, err := json.Marshal(api.AppointmentDecision{Action: api.Accept, Equipment: equipmentId})
+	require.NoError(err, "mustAcceptAppointment: Failed to marshal request")
+	req, err := http.NewRequest(
+		http.MethodPost,
+		fmt.Sprintf("%s/appointments/%s", ServerUrl, appointmentId),
+		bytes.NewBuffer(body),
+	)
+	require.NoError(err, "mustAcceptAppointment: Failed to create request")
+	req.Header.Set("Content-Type", server.ApplicationJSON)
+	req.Header.Set(
+		server.IfMatchHeader,
+		mustETag(t, fmt.Sprintf("/appointments/%s", appointmentId)),
+	)
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(err, "mustAcceptAppointment: request failed")
+	res.Body.Close()
+	require.Equal(http.StatusOK, res.StatusCode, "mustAcceptAppointment: Expected '200 OK'")
+}
+
+func mustRescheduleKeepingReservations(
+	t *testing.T,
+	appointmentId uuid.UUID,
+	newDateTime time.Time,
+) api.Appointment {
+	t.Helper()
+	require := require.New(t)
+
+	body, err := json.Marshal(api.AppointmentReschedule{
+		NewAppointmentDateTime: newDateTime,
+		KeepReservations:       asPtr(true),
+	})
+	require.NoError(err, "mustRescheduleKeepingReservations: Failed to marshal request")
+	req, err := http.NewRequest(
+		http.MethodPatch,
+		fmt.Sprintf("%s/appointments/%s", ServerUrl, appointmentId),
+		bytes.NewBuffer(body),
+	)
+	require.NoError(err, "mustRescheduleKeepingReservations: Failed to create request")
+	req.Header.Set("Content-Type", server.ApplicationJSON)
+	req.Header.Set(
+		server.IfMatchHeader,
+		mustETag(t, fmt.Sprintf("/appointments/%s", appointmentId)),
+	)
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(err, "mustRescheduleKeepingReservations: request failed")
+	defer res.Body.Close()
+	require.Equal(http.StatusOK, res.StatusCode, "mustRescheduleKeepingReservations: Expected '200 OK'")
+
+	var appt api.Appointment
+	err = json.NewDecoder(res.Body).Decode(&appt)
+	require.NoError(err, "mustRescheduleKeepingReservations: Failed to decode response")
+	return appt
+}
@@ -57,24 +57,19 @@ func TestGetAvailableResources(t *testing.T) {
 		DoctorId:            doctor.Id,
 		AppointmentDateTime: apptTime,
 	}
-	appt := mustCreateAppointment(t, apptReq)
-	apptId := *appt.Id
+	appt := mustPostAppointment(t, apptReq)
+	apptId := appt.Id
 
 	// Reserve the first resource for this appointment (should be unavailable)
 	reservedResource := createdResources[0]
-	reservation := api.ResourceReservation{
-		AppointmentId: apptId,
-		Start:         apptTime,
-		End:           apptTime.Add(time.Hour),
-	}
-	body, err := json.Marshal(reservation)
-	require.NoError(t, err)
-
-	url := fmt.Sprintf("%s/resources/%s", ServerUrl, *reservedResource.Id)
-	res, err := http.Post(url, server.ApplicationJSON, bytes.NewReader(body))
-	require.NoError(t, err)
-	defer res.Body.Close()
-	require.Equal(t, http.StatusNoContent, res.StatusCode)
+	mustCreateReservation(
+		t,
+		apptId,
+		api.ReserveAppointmentResourcesJSONRequestBody{
+			Start:       apptTime,
+			EquipmentId: reservedResource.Id,
+		},
+	)
 
 	queryTime := apptTime.Add(30 * time.Minute)
 	availableURL := fmt.Sprintf(
@@ -143,21 +138,17 @@ func TestReserveResource(t *testing.T) {
 		AppointmentDateTime: appointmentTime,
 		DoctorId:            createdDoctor.Id,
 	}
-	createdAppointment := mustCreateAppointment(t, newAppointmentReq)
-	appointmentId := *createdAppointment.Id
-
-	startTime := appointmentTime
-	endTime := startTime.Add(time.Hour)
+	createdAppointment := mustPostAppointment(t, newAppointmentReq)
+	appointmentId := createdAppointment.Id
 
-	reservationPayload := api.ResourceReservation{
-		AppointmentId: appointmentId,
-		Start:         startTime,
-		End:           endTime,
+	reservationPayload := api.ReserveAppointmentResourcesJSONRequestBody{
+		Start:       appointmentTime,
+		EquipmentId: &resourceId,
 	}
 	reqBodyBytes, err := json.Marshal(reservationPayload)
 	require.NoError(t, err, "Failed to marshal ReservationRequest")
 
-	url := fmt.Sprintf("%s/resources/%s", ServerUrl, resourceId)
+	url := fmt.Sprintf("%s/resources/reserve/%s", ServerUrl, appointmentId)
 	res, err := http.Post(url, server.ApplicationJSON, bytes.NewBuffer(reqBodyBytes))
 	require.NoError(t, err, "http.Post failed for ReserveResource")
 	defer res.Body.Close()
@@ -250,8 +241,8 @@ func mustCreateResource(t *testing.T, request api.NewResource) api.NewResource {
 
 func mustCreateReservation(
 	t *testing.T,
-	request api.ResourceReservation,
-	resourceId api.ResourceId,
+	appointmentId uuid.UUID,
+	request api.ReserveAppointmentResourcesJSONRequestBody,
 ) {
 	t.Helper()
 	require := require.New(t)
@@ -259,7 +250,7 @@ func mustCreateReservation(
 	reqBodyBytes, err := json.Marshal(request)
 	require.NoError(err, "mustCreateReservation: Failed to marshal request")
 
-	url := fmt.Sprintf("%s/resources/%s", ServerUrl, resourceId)
+	url := fmt.Sprintf("%s/resources/reserve/%s", ServerUrl, appointmentId)
 	res, err := http.Post(url, server.ApplicationJSON, bytes.NewBuffer(reqBodyBytes))
 	require.NoError(err, "mustCreateReservation: http.Post failed")
 	defer res.Body.Close()
@@ -0,0 +1,96 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/test-go/testify/require"
+
+	"github.com/Nesquiko/wac/pkg/api"
+	"github.com/Nesquiko/wac/pkg/server"
+)
+
+func TestSearchDoctors_RankedByNextFreeSlot(t *testing.T) {
+	t.Parallel()
+
+	loc, err := time.LoadLocation(server.TzDefault)
+	require.NoError(t, err, "Failed to load server timezone")
+	day := time.Now().In(loc).AddDate(0, 0, 10)
+	from := time.Date(day.Year(), day.Month(), day.Day(), 8, 0, 0, 0, loc)
+
+	busyEmail := fmt.Sprintf("test.search.busy.%s@doctor.com", uuid.NewString())
+	busy := mustCreateDoctor(t, newDoctor(busyEmail))
+	freeEmail := fmt.Sprintf("test.search.free.%s@doctor.com", uuid.NewString())
+	free := mustCreateDoctor(t, newDoctor(freeEmail))
+	patientEmail := fmt.Sprintf("test.search.%s@patient.com", uuid.NewString())
+	patient := mustCreatePatient(t, newPatient(patientEmail))
+
+	res := postAppointment(t, api.NewAppointmentRequest{
+		PatientId:           patient.Id,
+		DoctorId:            busy.Id,
+		AppointmentDateTime: from,
+		DurationMinutes:     asPtr(60),
+	})
+	res.Body.Close()
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+
+	results := mustSearchDoctors(t, url.Values{
+		"specialization": {string(api.Urologist)},
+		"from":           {from.Format(time.RFC3339)},
+		"to":             {from.Add(3 * time.Hour).Format(time.RFC3339)},
+	})
+
+	busyRank, freeRank := -1, -1
+	for i, result := range results.Doctors {
+		switch result.Doctor.Id {
+		case busy.Id:
+			busyRank = i
+			require.NotNil(t, result.NextFreeSlot)
+			assert.True(t, from.Add(time.Hour).Equal(*result.NextFreeSlot))
+		case free.Id:
+			freeRank = i
+			require.NotNil(t, result.NextFreeSlot)
+			assert.True(t, from.Equal(*result.NextFreeSlot))
+		}
+	}
+	require.NotEqual(t, -1, busyRank, "Busy doctor missing from results")
+	require.NotEqual(t, -1, freeRank, "Free doctor missing from results")
+	assert.Less(t, freeRank, busyRank, "Doctor free earlier should rank higher")
+}
+
+func TestSearchDoctors_WindowTooLong(t *testing.T) {
+	t.Parallel()
+
+	from := time.Now()
+	query := url.Values{
+		"from": {from.Format(time.RFC3339)},
+		"to":   {from.AddDate(0, 2, 0).Format(time.RFC3339)},
+	}
+	res, err := http.Get(fmt.Sprintf("%s/doctors/search?%s", ServerUrl, query.Encode()))
+	require.NoError(t, err, "SearchDoctors request failed")
+	res.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func mustSearchDoctors(t *testing.T, query url.Values) api.DoctorSearchResults {
+	t.Helper()
+	require := require.New(t)
+
+	res, err := http.Get(fmt.Sprintf("%s/doctors/search?%s", ServerUrl, query.Encode()))
+	require.NoError(err, "mustSearchDoctors: http.Get failed")
+	defer res.Body.Close()
+	require.Equal(http.StatusOK, res.StatusCode, "mustSearchDoctors: Expected '200 OK'")
+
+	var results api.DoctorSearchResults
+	err = json.NewDecoder(res.Body).Decode(&results)
+	require.NoError(err, "mustSearchDoctors: Failed to decode response")
+	return results
+}
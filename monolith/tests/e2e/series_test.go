@@ -0,0 +1,140 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/test-go/testify/require"
+
+	"github.com/Nesquiko/wac/pkg/api"
+	"github.com/Nesquiko/wac/pkg/server"
+)
+
+func TestAppointmentSeries_CancelThisAndFollowing(t *testing.T) {
+	t.Parallel()
+
+	patientEmail := fmt.Sprintf("test.series.%s@patient.com", uuid.NewString())
+	patient := mustCreatePatient(t, newPatient(patientEmail))
+	doctorEmail := fmt.Sprintf("test.series.%s@doctor.com", uuid.NewString())
+	doctor := mustCreateDoctor(t, newDoctor(doctorEmail))
+
+	first := time.Now().Add(24 * time.Hour).Truncate(time.Hour)
+	series := mustCreateAppointmentSeries(t, api.NewAppointmentSeries{
+		PatientId:                patient.Id,
+		DoctorId:                 doctor.Id,
+		FirstAppointmentDateTime: first,
+		Recurrence:               api.Recurrence{Frequency: api.Weekly, Count: asPtr(3)},
+	})
+	require.Len(t, series.Occurrences, 3)
+	for i, occurrence := range series.Occurrences {
+		require.NotNil(t, occurrence.AppointmentId, "Occurrence %d should be booked", i)
+		assert.Nil(t, occurrence.Conflict)
+		assert.True(t, first.AddDate(0, 0, 7*i).Equal(occurrence.AppointmentDateTime))
+	}
+
+	cancelBody, err := json.Marshal(api.SeriesCancellation{
+		By:            api.UserRolePatient,
+		AppointmentId: series.Occurrences[1].AppointmentId,
+		Scope:         api.ThisAndFollowing,
+	})
+	require.NoError(t, err, "Failed to marshal series cancellation request body")
+	req, err := http.NewRequest(
+		http.MethodDelete,
+		fmt.Sprintf("%s/appointment-series/%s", ServerUrl, series.Id),
+		bytes.NewBuffer(cancelBody),
+	)
+	require.NoError(t, err, "Failed to create CancelAppointmentSeries request")
+	req.Header.Set("Content-Type", server.ApplicationJSON)
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err, "CancelAppointmentSeries request failed")
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	var cancelled api.AppointmentSeries
+	err = json.NewDecoder(res.Body).Decode(&cancelled)
+	require.NoError(t, err, "Failed to decode cancelled series")
+	require.Len(t, cancelled.Occurrences, 3)
+	assert.Equal(t, api.Requested, *cancelled.Occurrences[0].Status)
+	assert.Equal(t, api.Cancelled, *cancelled.Occurrences[1].Status)
+	assert.Equal(t, api.Cancelled, *cancelled.Occurrences[2].Status)
+}
+
+func TestAppointmentSeries_ReportsConflicts(t *testing.T) {
+	t.Parallel()
+
+	patientEmail := fmt.Sprintf("test.series.conflict.%s@patient.com", uuid.NewString())
+	patient := mustCreatePatient(t, newPatient(patientEmail))
+	doctorEmail := fmt.Sprintf("test.series.conflict.%s@doctor.com", uuid.NewString())
+	doctor := mustCreateDoctor(t, newDoctor(doctorEmail))
+
+	first := time.Now().Add(24 * time.Hour).Truncate(time.Hour)
+	mustCreateAppointmentSeries(t, api.NewAppointmentSeries{
+		PatientId:                patient.Id,
+		DoctorId:                 doctor.Id,
+		FirstAppointmentDateTime: first.AddDate(0, 0, 1),
+		Recurrence:               api.Recurrence{Frequency: api.Daily, Count: asPtr(1)},
+	})
+
+	series := mustCreateAppointmentSeries(t, api.NewAppointmentSeries{
+		PatientId:                patient.Id,
+		DoctorId:                 doctor.Id,
+		FirstAppointmentDateTime: first,
+		Recurrence:               api.Recurrence{Frequency: api.Daily, Count: asPtr(2)},
+	})
+	require.Len(t, series.Occurrences, 2)
+	assert.NotNil(t, series.Occurrences[0].AppointmentId)
+	assert.Nil(t, series.Occurrences[1].AppointmentId, "Conflicting occurrence shouldn't be booked")
+	assert.NotNil(t, series.Occurrences[1].Conflict)
+}
+
+func TestAppointmentSeries_InvalidRecurrence(t *testing.T) {
+	t.Parallel()
+
+	body, err := json.Marshal(api.NewAppointmentSeries{
+		PatientId:                uuid.New(),
+		DoctorId:                 uuid.New(),
+		FirstAppointmentDateTime: time.Now().Add(24 * time.Hour),
+		Recurrence:               api.Recurrence{Frequency: api.Weekly},
+	})
+	require.NoError(t, err, "Failed to marshal series request body")
+
+	url := fmt.Sprintf("%s/appointment-series", ServerUrl)
+	res, err := http.Post(url, server.ApplicationJSON, bytes.NewBuffer(body))
+	require.NoError(t, err, "CreateAppointmentSeries request failed")
+	res.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode, "Recurrence without count or until")
+}
+
+func mustCreateAppointmentSeries(
+	t *testing.T,
+	request api.NewAppointmentSeries,
+) api.AppointmentSeries {
+	t.Helper()
+	require := require.New(t)
+
+	reqBodyBytes, err := json.Marshal(request)
+	require.NoError(err, "mustCreateAppointmentSeries: Failed to marshal request")
+
+	url := fmt.Sprintf("%s/appointment-series", ServerUrl)
+	res, err := http.Post(url, server.ApplicationJSON, bytes.NewBuffer(reqBodyBytes))
+	require.NoError(err, "mustCreateAppointmentSeries: http.Post failed")
+	defer res.Body.Close()
+	require.Equal(
+		http.StatusCreated,
+		res.StatusCode,
+		"mustCreateAppointmentSeries: Expected '201 Created'",
+	)
+
+	var series api.AppointmentSeries
+	err = json.NewDecoder(res.Body).Decode(&series)
+	require.NoError(err, "mustCreateAppointmentSeries: Failed to decode response")
+	return series
+}
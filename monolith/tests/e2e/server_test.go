@@ -6,8 +6,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 	"testing"
 
+	chi_middleware "github.com/go-chi/chi/v5/middleware"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/test-go/testify/require"
@@ -36,3 +38,80 @@ func TestRestartPersistence(t *testing.T) {
 	assert.Equal(patient.Id, fetchedPatient.Id, "Patient ID mismatch")
 	assert.Equal(patient.Email, fetchedPatient.Email, "Patient email mismatch")
 }
+
+func TestRequestId_Accepted(t *testing.T) {
+	t.Parallel()
+
+	requestId := "e2e-" + uuid.NewString()
+	req, err := http.NewRequest(
+		http.MethodGet,
+		fmt.Sprintf("%s/patients/%s", ServerUrl, uuid.New()),
+		nil,
+	)
+	require.NoError(t, err, "Failed to create PatientById request")
+	req.Header.Set(chi_middleware.RequestIDHeader, requestId)
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err, "PatientById request failed")
+	defer res.Body.Close()
+	require.Equal(t, http.StatusNotFound, res.StatusCode, "Expected '404 Not Found' status code")
+
+	var errDetail api.ErrorDetail
+	err = json.NewDecoder(res.Body).Decode(&errDetail)
+	require.NoError(t, err, "Failed to decode error detail")
+
+	assert := assert.New(t)
+	assert.Equal(requestId, res.Header.Get(chi_middleware.RequestIDHeader))
+	require.NotNil(t, errDetail.RequestId, "Problem should carry the request id")
+	assert.Equal(requestId, *errDetail.RequestId)
+}
+
+func TestRequestId_Created(t *testing.T) {
+	t.Parallel()
+
+	res, err := http.Get(fmt.Sprintf("%s/patients/%s", ServerUrl, uuid.New()))
+	require.NoError(t, err, "http.Get failed for patient")
+	defer res.Body.Close()
+	require.Equal(t, http.StatusNotFound, res.StatusCode, "Expected '404 Not Found' status code")
+
+	var errDetail api.ErrorDetail
+	err = json.NewDecoder(res.Body).Decode(&errDetail)
+	require.NoError(t, err, "Failed to decode error detail")
+
+	requestId := res.Header.Get(chi_middleware.RequestIDHeader)
+	require.NotEmpty(t, requestId, "A request id should be created for the request")
+	require.NotNil(t, errDetail.RequestId, "Problem should carry the request id")
+	assert.Equal(t, requestId, *errDetail.RequestId)
+}
+
+func TestRateLimit_Exceeded(t *testing.T) {
+	t.Parallel()
+
+	for range 2 {
+		res, err := http.Get(ServerUrl + "/doctors")
+		require.NoError(t, err, "http.Get failed for doctors")
+		res.Body.Close()
+		require.Equal(t, http.StatusOK, res.StatusCode, "Expected '200 OK' status code")
+	}
+
+	res, err := http.Get(ServerUrl + "/doctors")
+	require.NoError(t, err, "http.Get failed for doctors")
+	defer res.Body.Close()
+	require.Equal(
+		t,
+		http.StatusTooManyRequests,
+		res.StatusCode,
+		"Expected '429 Too Many Requests' status code",
+	)
+
+	var errDetail api.ErrorDetail
+	err = json.NewDecoder(res.Body).Decode(&errDetail)
+	require.NoError(t, err, "Failed to decode error detail")
+
+	assert := assert.New(t)
+	assert.Equal("application/problem+json", res.Header.Get("Content-Type"))
+	assert.Equal("rate-limit.exceeded", errDetail.Code)
+	assert.Equal(http.StatusTooManyRequests, errDetail.Status)
+	retryAfter, err := strconv.Atoi(res.Header.Get("Retry-After"))
+	require.NoError(t, err, "Retry-After should be in seconds")
+	assert.Positive(retryAfter)
+}
@@ -17,6 +17,8 @@ import (
 	"github.com/Nesquiko/wac/pkg/server"
 )
 
+const auditAdminToken = "test-audit-admin-token"
+
 var (
 	ServerUrl    string
 	serverCtx    context.Context
@@ -65,6 +67,13 @@ func TestMain(m *testing.M) {
 		"WAC_MONGO_PASSWORD": "wac",
 		"WAC_MONGO_DB":       "wac-test",
 		"WAC_LOG_LEVEL":      fmt.Sprintf("%d", logLevel),
+
+		"WAC_AUDIT_ADMIN_TOKEN": auditAdminToken,
+
+		// tests register many users from one IP, only the route of the rate
+		// limit test is limited
+		"WAC_RATE_LIMIT_DEFAULT": "1000000/1s",
+		"WAC_RATE_LIMIT_ROUTES":  "GET /api/doctors=2/1h",
 	}
 
 	for key, value := range envVars {
@@ -0,0 +1,165 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/test-go/testify/require"
+
+	"github.com/Nesquiko/wac/pkg/api"
+	"github.com/Nesquiko/wac/pkg/server"
+)
+
+func TestWaitlist_CancelledSlotIsOffered(t *testing.T) {
+	t.Parallel()
+
+	doctorEmail := fmt.Sprintf("test.waitlist.%s@doctor.com", uuid.NewString())
+	doctor := mustCreateDoctor(t, newDoctor(doctorEmail))
+	bookedEmail := fmt.Sprintf("test.waitlist.booked.%s@patient.com", uuid.NewString())
+	booked := mustCreatePatient(t, newPatient(bookedEmail))
+	waitingEmail := fmt.Sprintf("test.waitlist.waiting.%s@patient.com", uuid.NewString())
+	waiting := mustCreatePatient(t, newPatient(waitingEmail))
+
+	appointmentTime := time.Now().Add(48 * time.Hour).Truncate(time.Hour)
+	apptBody, err := json.Marshal(api.NewAppointmentRequest{
+		PatientId:           booked.Id,
+		DoctorId:            doctor.Id,
+		AppointmentDateTime: appointmentTime,
+	})
+	require.NoError(t, err, "Failed to marshal appointment request body")
+	res, err := http.Post(
+		fmt.Sprintf("%s/appointments", ServerUrl),
+		server.ApplicationJSON,
+		bytes.NewBuffer(apptBody),
+	)
+	require.NoError(t, err, "CreateAppointment request failed")
+	defer res.Body.Close()
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+	var appointment api.Appointment
+	err = json.NewDecoder(res.Body).Decode(&appointment)
+	require.NoError(t, err, "Failed to decode created appointment")
+
+	entry := mustJoinWaitlist(t, doctor.Id, api.NewWaitlistEntry{PatientId: waiting.Id})
+	assert.Equal(t, api.Waiting, entry.Status)
+
+	cancelBody, err := json.Marshal(api.AppointmentCancellation{By: api.UserRolePatient})
+	require.NoError(t, err, "Failed to marshal cancellation request body")
+	req, err := http.NewRequest(
+		http.MethodDelete,
+		fmt.Sprintf("%s/appointments/%s", ServerUrl, appointment.Id),
+		bytes.NewBuffer(cancelBody),
+	)
+	require.NoError(t, err, "Failed to create CancelAppointment request")
+	req.Header.Set("Content-Type", server.ApplicationJSON)
+	res, err = http.DefaultClient.Do(req)
+	require.NoError(t, err, "CancelAppointment request failed")
+	res.Body.Close()
+	require.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	entries := mustGetPatientsWaitlist(t, waiting.Id)
+	require.NotNil(t, entries.Entries)
+	require.Len(t, *entries.Entries, 1)
+	offered := (*entries.Entries)[0]
+	assert.Equal(t, api.Offered, offered.Status)
+	require.NotNil(t, offered.Offer, "Offered entry should carry its offer")
+	assert.True(t, appointmentTime.Equal(offered.Offer.AppointmentDateTime))
+
+	// the slot is held for the waiting patient, nobody else can book it
+	res = postAppointment(t, api.NewAppointmentRequest{
+		PatientId:           booked.Id,
+		DoctorId:            doctor.Id,
+		AppointmentDateTime: appointmentTime,
+	})
+	res.Body.Close()
+	assert.Equal(t, http.StatusConflict, res.StatusCode)
+
+	res, err = http.Post(fmt.Sprintf("%s/waitlist/%s/accept", ServerUrl, entry.Id), "", nil)
+	require.NoError(t, err, "AcceptWaitlistOffer request failed")
+	defer res.Body.Close()
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+
+	var accepted api.Appointment
+	err = json.NewDecoder(res.Body).Decode(&accepted)
+	require.NoError(t, err, "Failed to decode accepted appointment")
+	assert.Equal(t, api.Requested, accepted.Status)
+	assert.True(t, appointmentTime.Equal(accepted.AppointmentDateTime))
+
+	entries = mustGetPatientsWaitlist(t, waiting.Id)
+	require.Len(t, *entries.Entries, 1)
+	assert.Equal(t, api.Booked, (*entries.Entries)[0].Status)
+}
+
+func TestWaitlist_AcceptWithoutOffer(t *testing.T) {
+	t.Parallel()
+
+	doctorEmail := fmt.Sprintf("test.waitlist.nooffer.%s@doctor.com", uuid.NewString())
+	doctor := mustCreateDoctor(t, newDoctor(doctorEmail))
+	patientEmail := fmt.Sprintf("test.waitlist.nooffer.%s@patient.com", uuid.NewString())
+	patient := mustCreatePatient(t, newPatient(patientEmail))
+	entry := mustJoinWaitlist(t, doctor.Id, api.NewWaitlistEntry{PatientId: patient.Id})
+
+	res, err := http.Post(fmt.Sprintf("%s/waitlist/%s/accept", ServerUrl, entry.Id), "", nil)
+	require.NoError(t, err, "AcceptWaitlistOffer request failed")
+	res.Body.Close()
+	assert.Equal(t, http.StatusConflict, res.StatusCode)
+
+	req, err := http.NewRequest(
+		http.MethodDelete,
+		fmt.Sprintf("%s/waitlist/%s", ServerUrl, entry.Id),
+		nil,
+	)
+	require.NoError(t, err, "Failed to create LeaveWaitlist request")
+	res, err = http.DefaultClient.Do(req)
+	require.NoError(t, err, "LeaveWaitlist request failed")
+	res.Body.Close()
+	require.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	entries := mustGetPatientsWaitlist(t, patient.Id)
+	assert.Empty(t, *entries.Entries)
+}
+
+func mustJoinWaitlist(
+	t *testing.T,
+	doctorId uuid.UUID,
+	request api.NewWaitlistEntry,
+) api.WaitlistEntry {
+	t.Helper()
+	require := require.New(t)
+
+	reqBodyBytes, err := json.Marshal(request)
+	require.NoError(err, "mustJoinWaitlist: Failed to marshal request")
+
+	url := fmt.Sprintf("%s/doctors/%s/waitlist", ServerUrl, doctorId)
+	res, err := http.Post(url, server.ApplicationJSON, bytes.NewBuffer(reqBodyBytes))
+	require.NoError(err, "mustJoinWaitlist: http.Post failed")
+	defer res.Body.Close()
+	require.Equal(http.StatusCreated, res.StatusCode, "mustJoinWaitlist: Expected '201 Created'")
+
+	var entry api.WaitlistEntry
+	err = json.NewDecoder(res.Body).Decode(&entry)
+	require.NoError(err, "mustJoinWaitlist: Failed to decode response")
+	return entry
+}
+
+func mustGetPatientsWaitlist(t *testing.T, patientId uuid.UUID) api.WaitlistEntries {
+	t.Helper()
+	require := require.New(t)
+
+	res, err := http.Get(fmt.Sprintf("%s/waitlist/patient/%s", ServerUrl, patientId))
+	require.NoError(err, "mustGetPatientsWaitlist: http.Get failed")
+	defer res.Body.Close()
+	require.Equal(http.StatusOK, res.StatusCode, "mustGetPatientsWaitlist: Expected '200 OK'")
+
+	var entries api.WaitlistEntries
+	err = json.NewDecoder(res.Body).Decode(&entries)
+	require.NoError(err, "mustGetPatientsWaitlist: Failed to decode response")
+	return entries
+}